@@ -141,6 +141,16 @@ module.exports = {
                   directory: false,
                   path: "/apps/interchain-accounts/transactions.html"
                 },
+                {
+                  title: "Message Policies",
+                  directory: false,
+                  path: "/apps/interchain-accounts/message-policies.html"
+                },
+                {
+                  title: "Interchain Queries",
+                  directory: false,
+                  path: "/apps/interchain-accounts/queries.html"
+                },
             ]
             },
           ]
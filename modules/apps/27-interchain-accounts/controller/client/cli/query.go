@@ -39,3 +39,149 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdInterchainAccount returns the command handler for querying the interchain account address of an owner on a connection.
+func GetCmdInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-account [owner] [connection-id]",
+		Short:   "Query the interchain account address for a given owner on a particular connection",
+		Long:    "Query the interchain account address on the host chain for a given owner address on a particular controller connection",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-account cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInterchainAccountRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInterchainAccounts returns the command handler for querying all the registered interchain accounts.
+func GetCmdInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query all the interchain accounts registered on the controller chain",
+		Long:    "Query all the interchain accounts registered on the controller chain and their associated port and connection identifiers",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-accounts", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInterchainAccountsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdActiveChannels returns the command handler for querying all the active interchain accounts channels.
+func GetCmdActiveChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active-channels",
+		Short:   "Query all the active interchain accounts channels on the controller chain",
+		Long:    "Query all the active interchain accounts channels on the controller chain and their associated port and connection identifiers",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts controller active-channels", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryActiveChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ActiveChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "active channels")
+
+	return cmd
+}
+
+// GetCmdActiveChannel returns the command handler for querying the active channel of an interchain account.
+func GetCmdActiveChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active-channel [controller-port-id] [connection-id]",
+		Short:   "Query the active channel of an interchain account and its state",
+		Long:    "Query the active channel and its state for the provided controller port identifier and controller connection identifier",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller active-channel icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryActiveChannelRequest{
+				PortId:       args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.ActiveChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+const (
+	// flagVersion is the flag used to provide an optional channel version
+	flagVersion = "version"
+	// flagOrdering is the flag used to provide an optional channel ordering
+	flagOrdering = "ordering"
+	// flagRelativePacketTimeout is the flag used to provide the relative packet timeout in nanoseconds
+	flagRelativePacketTimeout = "relative-packet-timeout"
+)
+
+// defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+// relative to the current block timestamp of the controller chain
+var defaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+// NewRegisterInterchainAccountCmd returns the command to create a MsgRegisterInterchainAccount
+func NewRegisterInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [connection-id]",
+		Short: "Register an interchain account on the provided connection.",
+		Long: `Register an interchain account on the provided connection. The transaction signer is the owner
+of the interchain account. The channel version defaults to the interchain accounts metadata for the connection.
+The channel ordering defaults to ORDER_ORDERED, ORDER_UNORDERED channels allow packets to be received and timed out
+independently.`,
+		Example: fmt.Sprintf("%s tx interchain-accounts controller register connection-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetString(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			orderingStr, err := cmd.Flags().GetString(flagOrdering)
+			if err != nil {
+				return err
+			}
+
+			var ordering channeltypes.Order
+			if orderingStr != "" {
+				value, ok := channeltypes.Order_value[orderingStr]
+				if !ok {
+					return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "invalid channel ordering: %s", orderingStr)
+				}
+
+				ordering = channeltypes.Order(value)
+			}
+
+			msg := types.NewMsgRegisterInterchainAccount(args[0], clientCtx.GetFromAddress().String(), version, ordering)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagVersion, "", "Controller chain channel version")
+	cmd.Flags().String(flagOrdering, "", "Channel ordering, either ORDER_ORDERED or ORDER_UNORDERED")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSendTxCmd returns the command to create a MsgSendTx
+func NewSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-tx [connection-id] [path/to/packet_msg.json]",
+		Short: "Send an interchain account tx on the provided connection.",
+		Long: `Submit interchain account transaction data to be executed by the interchain account owned by the
+transaction signer. The packet data may be provided as a JSON encoded string or as a path to a JSON file.`,
+		Example: fmt.Sprintf("%s tx interchain-accounts controller send-tx connection-0 packet-data.json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var packetData icatypes.InterchainAccountPacketData
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[1]), &packetData); err != nil {
+				// check for file path if JSON input is not provided
+				contents, err := ioutil.ReadFile(args[1])
+				if err != nil {
+					return sdkerrors.Wrap(err, "neither JSON input nor path to .json file for packet data were provided")
+				}
+
+				if err := clientCtx.Codec.UnmarshalJSON(contents, &packetData); err != nil {
+					return sdkerrors.Wrap(err, "error unmarshalling packet data file")
+				}
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendTx(clientCtx.GetFromAddress().String(), args[0], relativeTimeout, packetData)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRelativePacketTimeout, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
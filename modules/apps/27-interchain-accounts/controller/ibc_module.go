@@ -10,6 +10,7 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
@@ -19,7 +20,8 @@ type IBCModule struct {
 	app    porttypes.IBCModule
 }
 
-// NewIBCModule creates a new IBCModule given the associated keeper and underlying application
+// NewIBCModule creates a new IBCModule given the associated keeper and underlying application.
+// The underlying application may be nil if interchain accounts are only registered through the controller Msg service.
 func NewIBCModule(k keeper.Keeper, app porttypes.IBCModule) IBCModule {
 	return IBCModule{
 		keeper: k,
@@ -51,6 +53,15 @@ func (im IBCModule) OnChanOpenInit(
 		return err
 	}
 
+	// channels registered through the controller Msg service are owned by the controller submodule
+	if !im.isMiddlewareEnabled(ctx, portID, connectionHops[0]) {
+		if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return sdkerrors.Wrapf(err, "failed to claim capability for channel %s on port %s", channelID, portID)
+		}
+
+		return nil
+	}
+
 	// call underlying app's OnChanOpenInit callback with the appVersion
 	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID,
 		chanCap, counterparty, version)
@@ -90,6 +101,15 @@ func (im IBCModule) OnChanOpenAck(
 		return err
 	}
 
+	connectionID, err := im.keeper.GetConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if !im.isMiddlewareEnabled(ctx, portID, connectionID) {
+		return nil
+	}
+
 	// call underlying app's OnChanOpenAck callback with the counterparty app version.
 	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyVersion)
 }
@@ -122,6 +142,61 @@ func (im IBCModule) OnChanCloseConfirm(
 	return im.keeper.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
+// OnChanUpgradeInit implements the IBCModule interface. Channel upgrades are not
+// supported for interchain accounts channels.
+func (im IBCModule) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) error {
+	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrades are not supported for interchain accounts channels")
+}
+
+// OnChanUpgradeTry implements the IBCModule interface. Channel upgrades are not
+// supported for interchain accounts channels.
+func (im IBCModule) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	return "", sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrades are not supported for interchain accounts channels")
+}
+
+// OnChanUpgradeAck implements the IBCModule interface. Channel upgrades are not
+// supported for interchain accounts channels.
+func (im IBCModule) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrades are not supported for interchain accounts channels")
+}
+
+// OnChanUpgradeConfirm implements the IBCModule interface. Channel upgrades are not
+// supported for interchain accounts channels.
+func (im IBCModule) OnChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrades are not supported for interchain accounts channels")
+}
+
+// OnChanUpgradeRestore implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeRestore(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+}
+
 // OnRecvPacket implements the IBCModule interface
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
@@ -142,6 +217,15 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return types.ErrControllerSubModuleDisabled
 	}
 
+	connectionID, err := im.keeper.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	if !im.isMiddlewareEnabled(ctx, packet.GetSourcePort(), connectionID) {
+		return nil
+	}
+
 	// call underlying app's OnAcknowledgementPacket callback.
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
@@ -160,5 +244,20 @@ func (im IBCModule) OnTimeoutPacket(
 		return err
 	}
 
+	connectionID, err := im.keeper.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	if !im.isMiddlewareEnabled(ctx, packet.GetSourcePort(), connectionID) {
+		return nil
+	}
+
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
+
+// isMiddlewareEnabled returns true if the channel callbacks for the provided portID and connectionID
+// must be passed to the underlying authentication module
+func (im IBCModule) isMiddlewareEnabled(ctx sdk.Context, portID, connectionID string) bool {
+	return im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, portID, connectionID)
+}
@@ -3,13 +3,17 @@ package controller_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/stretchr/testify/suite"
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
@@ -82,7 +86,7 @@ func RegisterInterchainAccount(endpoint *ibctesting.Endpoint, owner string) erro
 
 	channelSequence := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(endpoint.Chain.GetContext())
 
-	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner); err != nil {
+	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner, "", endpoint.ChannelConfig.Order); err != nil {
 		return err
 	}
 
@@ -138,8 +142,13 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 			}, false,
 		},
 		{
-			"ICA OnChanOpenInit fails - UNORDERED channel", func() {
+			"ICA OnChanOpenInit succeeds - UNORDERED channel", func() {
 				channel.Ordering = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"ICA OnChanOpenInit fails - NONE channel order", func() {
+				channel.Ordering = channeltypes.NONE
 			}, false,
 		},
 		{
@@ -704,3 +713,75 @@ func (suite *InterchainAccountsTestSuite) TestSingleHostMultipleControllers() {
 		})
 	}
 }
+
+func (suite *InterchainAccountsTestSuite) TestControllerMsgService() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	// register the interchain account with a transaction signed by the owner
+	res, err := suite.chainA.SendMsgs(types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, "", channeltypes.ORDERED))
+	suite.Require().NoError(err)
+
+	channelID, err := ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID = channelID
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	// the channel capability is owned by the controller submodule rather than the authentication module
+	_, found := suite.chainA.GetSimApp().ScopedICAControllerKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(portID, channelID))
+	suite.Require().True(found)
+
+	_, found = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(portID, channelID))
+	suite.Require().False(found)
+
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+	suite.Require().True(found)
+
+	// fund the interchain account and allow bank sends on the host chain
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), icaAddr, amount))
+	suite.Require().NoError(err)
+
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, hosttypes.DefaultMaxQueryGas, hosttypes.DefaultMaxGasPerPacket, nil))
+
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bankSendMsg := banktypes.NewMsgSend(icaAddr, receiver, amount)
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{bankSendMsg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	balanceBefore := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, sdk.DefaultBondDenom)
+
+	// send the interchain account transaction with a relative timeout
+	res, err = suite.chainA.SendMsgs(types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), packetData))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), packet.GetSequence())
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	balanceAfter := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore.Add(amount[0]), balanceAfter)
+}
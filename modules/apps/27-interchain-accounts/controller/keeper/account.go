@@ -15,58 +15,95 @@ import (
 // call 04-channel 'ChanOpenInit'. An error is returned if the port identifier is
 // already in use. Gaining access to interchain accounts whose channels have closed
 // cannot be done with this function. A regular MsgChanOpenInit must be used.
-func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner string) error {
+// The provided version is the JSON encoded interchain accounts metadata proposed for the channel, allowing
+// the encoding of the packet data to be negotiated. The default metadata is used if the version is empty.
+// The provided ordering is used for the channel, an ORDERED channel is opened if the ordering is NONE.
+func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string, ordering channeltypes.Order) error {
 	portID, err := icatypes.NewControllerPortID(owner)
 	if err != nil {
 		return err
 	}
 
+	// an interchain account registered through the controller Msg service cannot be taken over by an authentication
+	// module unless its active channel is closed
+	if k.IsMiddlewareDisabled(ctx, portID, connectionID) && !k.IsActiveChannelClosed(ctx, connectionID, portID) {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidChannelFlow, "interchain account for portID %s on connection %s is owned by the controller Msg service and its channel is active or a handshake is in flight", portID, connectionID)
+	}
+
+	// channel callbacks for accounts registered through this entry point are passed to the authentication module
+	k.SetMiddlewareEnabled(ctx, portID, connectionID)
+
+	if _, err := k.registerInterchainAccount(ctx, connectionID, portID, version, ordering); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerInterchainAccount binds the provided portID if required and initiates a channel handshake on the
+// provided connection. The default interchain accounts metadata is used as channel version if the provided
+// version is empty and the channel is ORDERED if the provided ordering is NONE. The identifier of the newly
+// initialized channel is returned.
+func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID, version string, ordering channeltypes.Order) (string, error) {
 	// if there is an active channel for this portID / connectionID return an error
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if found {
-		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s for owner %s", activeChannelID, portID, connectionID, owner)
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s", activeChannelID, portID, connectionID)
 	}
 
 	switch {
 	case k.portKeeper.IsBound(ctx, portID) && !k.IsBound(ctx, portID):
-		return sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
+		return "", sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
 	case !k.portKeeper.IsBound(ctx, portID):
 		cap := k.BindPort(ctx, portID)
 		if err := k.ClaimCapability(ctx, cap, host.PortPath(portID)); err != nil {
-			return sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
+			return "", sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
 		}
 	}
 
-	connectionEnd, err := k.channelKeeper.GetConnection(ctx, connectionID)
-	if err != nil {
-		return err
+	if version == "" {
+		connectionEnd, err := k.channelKeeper.GetConnection(ctx, connectionID)
+		if err != nil {
+			return "", err
+		}
+
+		// NOTE: An empty string is provided for accAddress, to be fulfilled upon OnChanOpenTry handshake step
+		metadata := icatypes.NewMetadata(
+			icatypes.Version,
+			connectionID,
+			connectionEnd.GetCounterparty().GetConnectionID(),
+			"",
+			icatypes.EncodingProtobuf,
+			icatypes.TxTypeSDKMultiMsg,
+		)
+
+		versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+		if err != nil {
+			return "", err
+		}
+
+		version = string(versionBytes)
 	}
 
-	// NOTE: An empty string is provided for accAddress, to be fulfilled upon OnChanOpenTry handshake step
-	metadata := icatypes.NewMetadata(
-		icatypes.Version,
-		connectionID,
-		connectionEnd.GetCounterparty().GetConnectionID(),
-		"",
-		icatypes.EncodingProtobuf,
-		icatypes.TxTypeSDKMultiMsg,
-	)
-
-	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
-	if err != nil {
-		return err
+	if ordering == channeltypes.NONE {
+		ordering = channeltypes.ORDERED
 	}
 
-	msg := channeltypes.NewMsgChannelOpenInit(portID, string(versionBytes), channeltypes.ORDERED, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
+	msg := channeltypes.NewMsgChannelOpenInit(portID, version, ordering, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
 	handler := k.msgRouter.Handler(msg)
 
 	res, err := handler(ctx, msg)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
 	ctx.EventManager().EmitEvents(res.GetEvents())
 
-	return nil
+	var channelOpenInitResponse channeltypes.MsgChannelOpenInitResponse
+	if err := k.cdc.Unmarshal(res.Data, &channelOpenInitResponse); err != nil {
+		return "", sdkerrors.Wrap(err, "failed to unmarshal channel open init response")
+	}
+
+	return channelOpenInitResponse.ChannelId, nil
 }
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
@@ -58,6 +62,34 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
 			},
 			false,
 		},
+		{
+			"interchain account registered through the Msg service, channel handshake in flight",
+			func() {
+				msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+				_, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, "", channeltypes.ORDERED))
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"interchain account registered through the Msg service, active channel open",
+			func() {
+				err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"success: interchain account registered through the Msg service, active channel closed",
+			func() {
+				err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -72,7 +104,7 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
 
 			tc.malleate() // malleate mutates test data
 
-			err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner)
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner, "", channeltypes.ORDERED)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -94,9 +126,35 @@ func (suite *KeeperTestSuite) TestRegisterSameOwnerMultipleConnections() {
 	path2 := NewICAPath(suite.chainA, suite.chainC)
 	suite.coordinator.SetupConnections(path2)
 
-	err := suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner)
+	err := suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner, "", channeltypes.ORDERED)
+	suite.Require().NoError(err)
+
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path2.EndpointA.ConnectionID, owner, "", channeltypes.ORDERED)
 	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccountEnablesMiddleware() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
 
-	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path2.EndpointA.ConnectionID, owner)
+	err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
 	suite.Require().NoError(err)
+
+	err = path.EndpointA.SetChannelClosed()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.SetChannelClosed()
+	suite.Require().NoError(err)
+
+	// the authentication module may take over the interchain account once its channel is closed
+	path.EndpointB.ChannelID = ""
+	err = SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	isMiddlewareEnabled := suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, path.EndpointA.ConnectionID)
+	suite.Require().True(isMiddlewareEnabled)
+
+	channel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(suite.chainA.GetContext(), TestPortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.OPEN, channel.State)
 }
@@ -3,9 +3,15 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,3 +25,131 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method
+func (q Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, found := q.GetInterchainAccountAddress(ctx, req.ConnectionId, portID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve account address for %s on connection %s", portID, req.ConnectionId)
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		Address: addr,
+	}, nil
+}
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (q Keeper) InterchainAccounts(c context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var interchainAccounts []icatypes.RegisteredInterchainAccount
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(icatypes.OwnerKeyPrefix+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		portID, connectionID, err := icatypes.ParsePortAndConnectionID(key)
+		if err != nil {
+			return err
+		}
+
+		interchainAccounts = append(interchainAccounts, icatypes.RegisteredInterchainAccount{
+			ConnectionId:   connectionID,
+			PortId:         portID,
+			AccountAddress: string(value),
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// ActiveChannels implements the Query/ActiveChannels gRPC method
+func (q Keeper) ActiveChannels(c context.Context, req *types.QueryActiveChannelsRequest) (*types.QueryActiveChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var activeChannels []icatypes.ActiveChannel
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(icatypes.ActiveChannelKeyPrefix+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		portID, connectionID, err := icatypes.ParsePortAndConnectionID(key)
+		if err != nil {
+			return err
+		}
+
+		activeChannels = append(activeChannels, icatypes.ActiveChannel{
+			ConnectionId:         connectionID,
+			PortId:               portID,
+			ChannelId:            string(value),
+			IsMiddlewareDisabled: !q.IsMiddlewareEnabled(ctx, portID, connectionID),
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryActiveChannelsResponse{
+		ActiveChannels: activeChannels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// ActiveChannel implements the Query/ActiveChannel gRPC method
+func (q Keeper) ActiveChannel(c context.Context, req *types.QueryActiveChannelRequest) (*types.QueryActiveChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channelID, found := q.GetActiveChannelID(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve active channel for port %s on connection %s", req.PortId, req.ConnectionId)
+	}
+
+	channel, found := q.channelKeeper.GetChannel(ctx, req.PortId, channelID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve channel %s for port %s", channelID, req.PortId)
+	}
+
+	return &types.QueryActiveChannelResponse{
+		ChannelId: channelID,
+		State:     channel.State,
+	}, nil
+}
@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +15,196 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var req *types.QueryInterchainAccountRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid connection identifier", func() {
+				req.ConnectionId = ""
+			}, false,
+		},
+		{
+			"invalid owner address", func() {
+				req.Owner = " "
+			}, false,
+		},
+		{
+			"interchain account not found", func() {
+				req.ConnectionId = "connection-100"
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountRequest{
+				Owner:        TestOwnerAddress,
+				ConnectionId: path.EndpointA.ConnectionID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(TestAccAddress.String(), res.Address)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// register a second interchain account using a different owner on the same connection
+	secondOwner := suite.chainA.SenderAccount.GetAddress().String()
+	secondPortID, err := icatypes.NewControllerPortID(secondOwner)
+	suite.Require().NoError(err)
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, secondPortID, "cosmos1address")
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.InterchainAccounts, 2)
+	suite.Require().Contains(res.InterchainAccounts, icatypes.RegisteredInterchainAccount{
+		ConnectionId:   path.EndpointA.ConnectionID,
+		PortId:         TestPortID,
+		AccountAddress: TestAccAddress.String(),
+	})
+
+	res, err = suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{
+		Pagination: &query.PageRequest{
+			Limit:      1,
+			CountTotal: true,
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.InterchainAccounts, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryActiveChannels() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ActiveChannels(ctx, &types.QueryActiveChannelsRequest{})
+	suite.Require().NoError(err)
+
+	expActiveChannels := []icatypes.ActiveChannel{
+		{
+			ConnectionId: path.EndpointA.ConnectionID,
+			PortId:       TestPortID,
+			ChannelId:    path.EndpointA.ChannelID,
+		},
+	}
+	suite.Require().Equal(expActiveChannels, res.ActiveChannels)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.ActiveChannels(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryActiveChannel() {
+	var req *types.QueryActiveChannelRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid port identifier", func() {
+				req.PortId = ""
+			}, false,
+		},
+		{
+			"invalid connection identifier", func() {
+				req.ConnectionId = ""
+			}, false,
+		},
+		{
+			"active channel not found", func() {
+				req.ConnectionId = "connection-100"
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryActiveChannelRequest{
+				PortId:       TestPortID,
+				ConnectionId: path.EndpointA.ConnectionID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ActiveChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointA.ChannelID, res.ChannelId)
+				suite.Require().Equal(channeltypes.OPEN, res.State)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -13,7 +13,7 @@ import (
 )
 
 // OnChanOpenInit performs basic validation of channel initialization.
-// The channel order must be ORDERED, the counterparty port identifier
+// The channel order must be ORDERED, ORDERED_ALLOW_TIMEOUT or UNORDERED, the counterparty port identifier
 // must be the host chain representation as defined in the types package,
 // the channel version must be equal to the version in the types package,
 // there must not be an active channel for the specfied port identifier,
@@ -29,8 +29,8 @@ func (k Keeper) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) error {
-	if order != channeltypes.ORDERED {
-		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if !order.IsValid() {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s, %s or %s channel, got %s", channeltypes.ORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT, channeltypes.UNORDERED, order)
 	}
 
 	if !strings.HasPrefix(portID, icatypes.PortPrefix) {
@@ -103,10 +103,25 @@ func (k Keeper) OnChanOpenAck(
 		return err
 	}
 
+	// the host chain must use the encoding proposed by the controller chain in OnChanOpenInit
+	proposedMetadata, err := icatypes.MetadataFromVersion(channel.Version)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Encoding != proposedMetadata.Encoding {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidCodec, "expected encoding %s, got %s", proposedMetadata.Encoding, metadata.Encoding)
+	}
+
 	if strings.TrimSpace(metadata.Address) == "" {
 		return sdkerrors.Wrap(icatypes.ErrInvalidAccountAddress, "interchain account address cannot be empty")
 	}
 
+	// the interchain account address must be preserved when the channel is reopened
+	if address, found := k.GetInterchainAccountAddress(ctx, metadata.ControllerConnectionId, portID); found && address != metadata.Address {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidAccountAddress, "expected interchain account address %s, got %s", address, metadata.Address)
+	}
+
 	k.SetActiveChannelID(ctx, metadata.ControllerConnectionId, portID, channelID)
 	k.SetInterchainAccountAddress(ctx, metadata.ControllerConnectionId, portID, metadata.Address)
 
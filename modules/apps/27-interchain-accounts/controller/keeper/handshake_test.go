@@ -30,6 +30,19 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			},
 			true,
 		},
+		{
+			"success - proto3json encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				channel.Version = string(versionBytes)
+				path.EndpointA.SetChannel(*channel)
+			},
+			true,
+		},
 		{
 			"success - previous active channel closed",
 			func() {
@@ -76,10 +89,17 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			false,
 		},
 		{
-			"invalid order - UNORDERED",
+			"success: UNORDERED channel",
 			func() {
 				channel.Ordering = channeltypes.UNORDERED
 			},
+			true,
+		},
+		{
+			"invalid order - NONE",
+			func() {
+				channel.Ordering = channeltypes.NONE
+			},
 			false,
 		},
 		{
@@ -261,6 +281,41 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success - proto3json encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.Counterparty.ChannelConfig.Version = string(versionBytes)
+
+				// the controller chain proposed the proto3json encoding in OnChanOpenInit
+				proposedMetadata := metadata
+				proposedMetadata.Address = ""
+
+				versionBytes, err = icatypes.ModuleCdc.MarshalJSON(&proposedMetadata)
+				suite.Require().NoError(err)
+
+				channel := path.EndpointA.GetChannel()
+				channel.Version = string(versionBytes)
+				path.EndpointA.SetChannel(channel)
+			},
+			true,
+		},
+		{
+			"host chain encoding does not match the proposed encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.Counterparty.ChannelConfig.Version = string(versionBytes)
+			},
+			false,
+		},
 		{
 			"invalid port ID - host chain",
 			func() {
@@ -330,6 +385,13 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 			},
 			false,
 		},
+		{
+			"account address does not match the previously registered address",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, TestOwnerAddress)
+			},
+			false,
+		},
 		{
 			"invalid counterparty version",
 			func() {
@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 
 	baseapp "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -130,6 +132,18 @@ func (k Keeper) GetOpenActiveChannel(ctx sdk.Context, connectionID, portID strin
 	return "", false
 }
 
+// IsActiveChannelClosed returns true if the active channel for the provided connectionID and portID exists and is in
+// state CLOSED
+func (k Keeper) IsActiveChannelClosed(ctx sdk.Context, connectionID, portID string) bool {
+	channelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return false
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	return found && channel.State == channeltypes.CLOSED
+}
+
 // GetAllActiveChannels returns a list of all active interchain accounts controller channels and their associated connection and port identifiers
 func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []icatypes.ActiveChannel {
 	store := ctx.KVStore(k.storeKey)
@@ -140,10 +154,14 @@ func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []icatypes.ActiveChannel {
 	for ; iterator.Valid(); iterator.Next() {
 		keySplit := strings.Split(string(iterator.Key()), "/")
 
+		portID := keySplit[1]
+		connectionID := keySplit[2]
+
 		ch := icatypes.ActiveChannel{
-			ConnectionId: keySplit[2],
-			PortId:       keySplit[1],
-			ChannelId:    string(iterator.Value()),
+			ConnectionId:         connectionID,
+			PortId:               portID,
+			ChannelId:            string(iterator.Value()),
+			IsMiddlewareDisabled: !k.IsMiddlewareEnabled(ctx, portID, connectionID),
 		}
 
 		activeChannels = append(activeChannels, ch)
@@ -202,3 +220,58 @@ func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
 }
+
+// SetMiddlewareEnabled stores a flag indicating that the channels for the provided portID and connectionID are owned
+// by an authentication module, such that the channel callbacks are passed to the underlying authentication module
+func (k Keeper) SetMiddlewareEnabled(ctx sdk.Context, portID, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyIsMiddlewareEnabled(portID, connectionID), icatypes.MiddlewareEnabled)
+}
+
+// SetMiddlewareDisabled stores a flag indicating that the channels for the provided portID and connectionID are owned
+// by the controller Msg service, such that the channel callbacks are not passed to the underlying authentication module
+func (k Keeper) SetMiddlewareDisabled(ctx sdk.Context, portID, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyIsMiddlewareEnabled(portID, connectionID), icatypes.MiddlewareDisabled)
+}
+
+// IsMiddlewareEnabled returns true if the underlying authentication module is used for the provided portID and connectionID.
+// Middleware is enabled unless the interchain account was registered through the controller Msg service.
+func (k Keeper) IsMiddlewareEnabled(ctx sdk.Context, portID, connectionID string) bool {
+	return !k.IsMiddlewareDisabled(ctx, portID, connectionID)
+}
+
+// IsMiddlewareDisabled returns true if the interchain account for the provided portID and connectionID was registered
+// through the controller Msg service
+func (k Keeper) IsMiddlewareDisabled(ctx sdk.Context, portID, connectionID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return bytes.Equal(store.Get(icatypes.KeyIsMiddlewareEnabled(portID, connectionID)), icatypes.MiddlewareDisabled)
+}
+
+// isMiddlewareEnabledSet returns true if the interchain account for the provided portID and connectionID was registered
+// by an authentication module through RegisterInterchainAccount
+func (k Keeper) isMiddlewareEnabledSet(ctx sdk.Context, portID, connectionID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return bytes.Equal(store.Get(icatypes.KeyIsMiddlewareEnabled(portID, connectionID)), icatypes.MiddlewareEnabled)
+}
+
+// GetConnectionID returns the connection identifier associated with the provided port and channel identifiers
+func (k Keeper) GetConnectionID(ctx sdk.Context, portID, channelID string) (string, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return channel.ConnectionHops[0], nil
+}
+
+// GetAppMetadata retrieves the interchain accounts channel metadata from the channel version of the provided port and
+// channel identifiers. The metadata holds the encoding negotiated for the packet data and acknowledgements of the channel.
+func (k Keeper) GetAppMetadata(ctx sdk.Context, portID, channelID string) (icatypes.Metadata, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return icatypes.Metadata{}, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return icatypes.MetadataFromVersion(channel.Version)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the interchain accounts controller MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
+// The owner of the interchain account is the signer of the message. Channels opened through this
+// handler are owned by the controller submodule and are not routed to an underlying authentication module.
+func (k msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// an interchain account registered by an authentication module cannot be taken over by the owner unless its
+	// active channel is closed
+	if k.isMiddlewareEnabledSet(ctx, portID, msg.ConnectionId) && !k.IsActiveChannelClosed(ctx, msg.ConnectionId, portID) {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidChannelFlow, "interchain account for portID %s on connection %s is owned by an authentication module and its channel is active or a handshake is in flight", portID, msg.ConnectionId)
+	}
+
+	k.SetMiddlewareDisabled(ctx, portID, msg.ConnectionId)
+
+	channelID, err := k.registerInterchainAccount(ctx, msg.ConnectionId, portID, msg.Version, msg.Ordering)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterInterchainAccountResponse{
+		ChannelId: channelID,
+	}, nil
+}
+
+// SendTx defines a rpc handler for MsgSendTx.
+// The packet timeout timestamp is computed by adding the relative timeout to the current block time.
+func (k msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.IsMiddlewareEnabled(ctx, portID, msg.ConnectionId) {
+		return nil, sdkerrors.Wrapf(types.ErrMiddlewareEnabled, "interchain account for portID %s on connection %s is owned by an authentication module", portID, msg.ConnectionId)
+	}
+
+	channelID, found := k.GetActiveChannelID(ctx, msg.ConnectionId, portID)
+	if !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", msg.ConnectionId, portID)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "failed to retrieve capability for port %s and channel %s", portID, channelID)
+	}
+
+	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
+
+	seq, err := k.Keeper.SendTx(ctx, chanCap, msg.ConnectionId, portID, msg.PacketData, absoluteTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendTxResponse{
+		Sequence: seq,
+	}, nil
+}
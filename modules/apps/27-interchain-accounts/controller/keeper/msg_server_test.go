@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccountMsgServer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgRegisterInterchainAccount
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: custom version", func() {
+				msg.Version = TestVersion
+			}, true,
+		},
+		{
+			"invalid version", func() {
+				msg.Version = "invalid-version"
+			}, false,
+		},
+		{
+			"connection not found", func() {
+				msg.ConnectionId = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"port is already bound by another module", func() {
+				suite.chainA.GetSimApp().IBCKeeper.PortKeeper.BindPort(suite.chainA.GetContext(), TestPortID)
+			}, false,
+		},
+		{
+			"active channel already exists", func() {
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"authentication module channel handshake in flight", func() {
+				err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"success: authentication module active channel closed", func() {
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			}, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			msg = types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, "", channeltypes.ORDERED)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(ctx)
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.FormatChannelIdentifier(channelSequence), res.ChannelId)
+
+				isMiddlewareEnabled := suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(ctx, TestPortID, path.EndpointA.ConnectionID)
+				suite.Require().False(isMiddlewareEnabled)
+
+				// the channel capability is owned by the controller submodule
+				_, found := suite.chainA.GetSimApp().ScopedICAControllerKeeper.GetCapability(ctx, host.ChannelCapabilityPath(TestPortID, res.ChannelId))
+				suite.Require().True(found)
+
+				_, found = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(ctx, host.ChannelCapabilityPath(TestPortID, res.ChannelId))
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendTxMsgServer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgSendTx
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"active channel not found", func() {
+				msg.ConnectionId = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"interchain account is owned by an authentication module", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, path.EndpointA.ConnectionID)
+			}, false,
+		},
+		{
+			"channel capability not found", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID, "channel-100")
+			}, false,
+		},
+		{
+			"invalid packet data", func() {
+				msg.PacketData = icatypes.InterchainAccountPacketData{}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+			suite.Require().True(found)
+
+			bankSendMsg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{bankSendMsg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			msg = types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), packetData)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SendTx(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.Sequence)
+
+				commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, TestPortID, path.EndpointA.ChannelID, res.Sequence)
+				suite.Require().NotNil(commitment)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// SetupICAPathWithMsgServer registers an interchain account through the controller Msg service and
+// completes the subsequent channel handshake
+func SetupICAPathWithMsgServer(path *ibctesting.Path, owner string) error {
+	chain := path.EndpointA.Chain
+
+	msgServer := keeper.NewMsgServerImpl(&chain.GetSimApp().ICAControllerKeeper)
+	res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(chain.GetContext()), types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, "", channeltypes.ORDERED))
+	if err != nil {
+		return err
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	chain.App.Commit()
+	chain.NextBlock()
+
+	// update port/channel ids
+	path.EndpointA.ChannelID = res.ChannelId
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointB.ChanOpenConfirm(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -13,8 +13,9 @@ import (
 // SendTx takes pre-built packet data containing messages to be executed on the host chain from an authentication module and attempts to send the packet.
 // The packet sequence for the outgoing packet is returned as a result.
 // If the base application has the capability to send on the provided portID. An appropriate
-// absolute timeoutTimestamp must be provided. If the packet is timed out, the channel will be closed.
+// absolute timeoutTimestamp must be provided. If the packet is timed out on an ORDERED channel, the channel will be closed.
 // In the case of channel closure, a new channel may be reopened to reconnect to the host chain.
+// Packets sent on UNORDERED channels are received and timed out independently of each other.
 func (k Keeper) SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if !found {
@@ -75,7 +76,7 @@ func (k Keeper) createOutgoingPacket(
 }
 
 // OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// due to the semantics of ORDERED channels. The channel end of UNORDERED channels remains open.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
 	return nil
 }
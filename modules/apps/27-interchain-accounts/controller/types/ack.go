@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// DeserializeTxResultAcknowledgement decodes the acknowledgement of an EXECUTE_TX interchain accounts packet into the
+// results of its messages, ordered as the messages of the packet, using the encoding negotiated in the channel
+// metadata. It is intended to be used by authentication modules within
+// OnAcknowledgementPacket. An error is returned if the acknowledgement cannot be decoded or if the transaction failed
+// on the host chain, the results of the individual messages reporting their own failures.
+func DeserializeTxResultAcknowledgement(acknowledgement []byte, encoding string) (*icatypes.TxResult, error) {
+	result, err := acknowledgementResult(acknowledgement)
+	if err != nil {
+		return nil, err
+	}
+
+	txResult, err := icatypes.DeserializeTxResult(result, encoding)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot deserialize transaction result: %v", err)
+	}
+
+	return txResult, nil
+}
+
+// DeserializeQueryAcknowledgement decodes the acknowledgement of an EXECUTE_QUERY interchain accounts packet into the
+// query responses, ordered as the requests of the packet, using the encoding negotiated in the channel metadata. It is
+// intended to be used by authentication modules within OnAcknowledgementPacket. An error is returned if the
+// acknowledgement cannot be decoded or if the queries failed on the host chain.
+func DeserializeQueryAcknowledgement(acknowledgement []byte, encoding string) ([]abci.ResponseQuery, error) {
+	result, err := acknowledgementResult(acknowledgement)
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := icatypes.DeserializeCosmosResponse(result, encoding)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot deserialize query responses: %v", err)
+	}
+
+	return responses, nil
+}
+
+// acknowledgementResult returns the result of the provided acknowledgement, or an error if the acknowledgement
+// cannot be decoded or is an error acknowledgement
+func acknowledgementResult(acknowledgement []byte) ([]byte, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal interchain accounts packet acknowledgement: %v", err)
+	}
+
+	if !ack.Success() {
+		return nil, sdkerrors.Wrap(ErrAcknowledgementFailed, ack.GetError())
+	}
+
+	return ack.GetResult(), nil
+}
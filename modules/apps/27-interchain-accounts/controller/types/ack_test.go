@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestDeserializeTxResultAcknowledgement(t *testing.T) {
+	txResult := &icatypes.TxResult{
+		Results: []icatypes.MsgResult{
+			{
+				MsgType: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+				Success: true,
+				Data:    []byte("response"),
+				GasUsed: 100,
+			},
+		},
+	}
+
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		result, err := icatypes.SerializeTxResult(txResult, encoding)
+		require.NoError(t, err)
+
+		testCases := []struct {
+			name    string
+			ack     []byte
+			expPass bool
+		}{
+			{"success", channeltypes.NewResultAcknowledgement(result).Acknowledgement(), true},
+			{"error acknowledgement", channeltypes.NewErrorAcknowledgement("tx failed").Acknowledgement(), false},
+			{"invalid acknowledgement", []byte("invalid"), false},
+			{"invalid result", channeltypes.NewResultAcknowledgement([]byte("invalid")).Acknowledgement(), false},
+		}
+
+		for _, tc := range testCases {
+			res, err := types.DeserializeTxResultAcknowledgement(tc.ack, encoding)
+			if tc.expPass {
+				require.NoError(t, err, tc.name)
+				require.Equal(t, txResult, res, tc.name)
+			} else {
+				require.Error(t, err, tc.name)
+			}
+		}
+	}
+}
+
+func TestDeserializeQueryAcknowledgement(t *testing.T) {
+	responses := []abci.ResponseQuery{
+		{
+			Value:  []byte("response"),
+			Height: 10,
+		},
+	}
+
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		result, err := icatypes.SerializeCosmosResponse(responses, encoding)
+		require.NoError(t, err)
+
+		testCases := []struct {
+			name    string
+			ack     []byte
+			expPass bool
+		}{
+			{"success", channeltypes.NewResultAcknowledgement(result).Acknowledgement(), true},
+			{"error acknowledgement", channeltypes.NewErrorAcknowledgement("query failed").Acknowledgement(), false},
+			{"invalid acknowledgement", []byte("invalid"), false},
+			{"invalid result", channeltypes.NewResultAcknowledgement([]byte("invalid")).Acknowledgement(), false},
+		}
+
+		for _, tc := range testCases {
+			res, err := types.DeserializeQueryAcknowledgement(tc.ack, encoding)
+			if tc.expPass {
+				require.NoError(t, err, tc.name)
+				require.Equal(t, responses, res, tc.name)
+			} else {
+				require.Error(t, err, tc.name)
+			}
+		}
+	}
+}
@@ -7,4 +7,6 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrMiddlewareEnabled           = sdkerrors.Register(SubModuleName, 3, "interchain account is owned by an authentication module")
+	ErrAcknowledgementFailed       = sdkerrors.Register(SubModuleName, 4, "interchain account packet execution failed on the host chain")
 )
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	defaultAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	defaultPacketData = icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+)
+
+func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
+	var msg *types.MsgRegisterInterchainAccount
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: empty version", func() { msg.Version = "" }, true},
+		{"success: unordered channel", func() { msg.Ordering = channeltypes.UNORDERED }, true},
+		{"success: unspecified ordering", func() { msg.Ordering = channeltypes.NONE }, true},
+		{"invalid ordering", func() { msg.Ordering = 10 }, false},
+		{"invalid connectionID", func() { msg.ConnectionId = "" }, false},
+		{"invalid owner address", func() { msg.Owner = "invalid-address" }, false},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, defaultAddr, icatypes.Version, channeltypes.ORDERED)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSendTxValidateBasic(t *testing.T) {
+	var msg *types.MsgSendTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"invalid connectionID", func() { msg.ConnectionId = "" }, false},
+		{"invalid owner address", func() { msg.Owner = "invalid-address" }, false},
+		{"empty packet data", func() { msg.PacketData.Data = nil }, false},
+		{"unspecified packet data type", func() { msg.PacketData.Type = icatypes.UNSPECIFIED }, false},
+		{"zero relative timeout", func() { msg.RelativeTimeout = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgSendTx(defaultAddr, ibctesting.FirstConnectionID, 100000, defaultPacketData)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgGetSigners(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	registerMsg := types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, addr.String(), "", channeltypes.ORDERED)
+	require.Equal(t, []sdk.AccAddress{addr}, registerMsg.GetSigners())
+
+	sendTxMsg := types.NewMsgSendTx(addr.String(), ibctesting.FirstConnectionID, 100000, defaultPacketData)
+	require.Equal(t, []sdk.AccAddress{addr}, sendTxMsg.GetSigners())
+}
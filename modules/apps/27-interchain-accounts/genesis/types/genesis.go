@@ -1,8 +1,11 @@
 package types
 
 import (
+	"fmt"
+
 	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
@@ -43,7 +46,7 @@ func DefaultControllerGenesis() ControllerGenesisState {
 }
 
 // NewControllerGenesisState creates a returns a new ControllerGenesisState instance
-func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params) ControllerGenesisState {
+func NewControllerGenesisState(channels []icatypes.ActiveChannel, accounts []icatypes.RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params) ControllerGenesisState {
 	return ControllerGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
@@ -69,7 +72,7 @@ func (gs ControllerGenesisState) Validate() error {
 			return err
 		}
 
-		if err := ValidateAccountAddress(acc.AccountAddress); err != nil {
+		if err := icatypes.ValidateAccountAddress(acc.AccountAddress); err != nil {
 			return err
 		}
 	}
@@ -90,18 +93,19 @@ func (gs ControllerGenesisState) Validate() error {
 // DefaultHostGenesis creates and returns the default interchain accounts HostGenesisState
 func DefaultHostGenesis() HostGenesisState {
 	return HostGenesisState{
-		Port:   PortID,
+		Port:   icatypes.PortID,
 		Params: hosttypes.DefaultParams(),
 	}
 }
 
 // NewHostGenesisState creates a returns a new HostGenesisState instance
-func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params) HostGenesisState {
+func NewHostGenesisState(channels []icatypes.ActiveChannel, accounts []icatypes.RegisteredInterchainAccount, port string, hostParams hosttypes.Params, policies []hosttypes.MessagePolicy) HostGenesisState {
 	return HostGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
 		Port:               port,
 		Params:             hostParams,
+		MessagePolicies:    policies,
 	}
 }
 
@@ -122,7 +126,7 @@ func (gs HostGenesisState) Validate() error {
 			return err
 		}
 
-		if err := ValidateAccountAddress(acc.AccountAddress); err != nil {
+		if err := icatypes.ValidateAccountAddress(acc.AccountAddress); err != nil {
 			return err
 		}
 	}
@@ -135,5 +139,29 @@ func (gs HostGenesisState) Validate() error {
 		return err
 	}
 
+	seenPolicies := make(map[string]bool)
+	for _, policy := range gs.MessagePolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		key := string(hosttypes.KeyMessagePolicy(policy.ConnectionId, policy.CounterpartyChainId))
+		if seenPolicies[key] {
+			return fmt.Errorf("duplicate message policy for connection ID (%s) counterparty chain ID (%s)", policy.ConnectionId, policy.CounterpartyChainId)
+		}
+		seenPolicies[key] = true
+	}
+
 	return nil
 }
+
+// ActiveChannel is an alias of the interchain accounts ActiveChannel type, which was defined in this package.
+//
+// Deprecated: use icatypes.ActiveChannel instead.
+type ActiveChannel = icatypes.ActiveChannel
+
+// RegisteredInterchainAccount is an alias of the interchain accounts RegisteredInterchainAccount type, which was
+// defined in this package.
+//
+// Deprecated: use icatypes.RegisteredInterchainAccount instead.
+type RegisteredInterchainAccount = icatypes.RegisteredInterchainAccount
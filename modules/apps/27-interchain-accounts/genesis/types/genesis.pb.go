@@ -1,12 +1,13 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: ibc/applications/interchain_accounts/v1/genesis.proto
+// source: ibc/applications/interchain_accounts/genesis/v1/genesis.proto
 
 package types
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
-	types1 "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	types1 "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	types2 "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	types "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -35,7 +36,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{0}
+	return fileDescriptor_d4aa48c8e29a1947, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -80,17 +81,17 @@ func (m *GenesisState) GetHostGenesisState() HostGenesisState {
 
 // ControllerGenesisState defines the interchain accounts controller genesis state
 type ControllerGenesisState struct {
-	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
-	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
-	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
-	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ActiveChannels     []types.ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
+	InterchainAccounts []types.RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
+	Ports              []string                            `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	Params             types1.Params                       `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
 func (m *ControllerGenesisState) String() string { return proto.CompactTextString(m) }
 func (*ControllerGenesisState) ProtoMessage()    {}
 func (*ControllerGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{1}
+	return fileDescriptor_d4aa48c8e29a1947, []int{1}
 }
 func (m *ControllerGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,14 +120,14 @@ func (m *ControllerGenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ControllerGenesisState proto.InternalMessageInfo
 
-func (m *ControllerGenesisState) GetActiveChannels() []ActiveChannel {
+func (m *ControllerGenesisState) GetActiveChannels() []types.ActiveChannel {
 	if m != nil {
 		return m.ActiveChannels
 	}
 	return nil
 }
 
-func (m *ControllerGenesisState) GetInterchainAccounts() []RegisteredInterchainAccount {
+func (m *ControllerGenesisState) GetInterchainAccounts() []types.RegisteredInterchainAccount {
 	if m != nil {
 		return m.InterchainAccounts
 	}
@@ -140,26 +141,27 @@ func (m *ControllerGenesisState) GetPorts() []string {
 	return nil
 }
 
-func (m *ControllerGenesisState) GetParams() types.Params {
+func (m *ControllerGenesisState) GetParams() types1.Params {
 	if m != nil {
 		return m.Params
 	}
-	return types.Params{}
+	return types1.Params{}
 }
 
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
-	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
-	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
-	Port               string                        `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
-	Params             types1.Params                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ActiveChannels     []types.ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
+	InterchainAccounts []types.RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
+	Port               string                              `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Params             types2.Params                       `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	MessagePolicies    []types2.MessagePolicy              `protobuf:"bytes,5,rep,name=message_policies,json=messagePolicies,proto3" json:"message_policies" yaml:"message_policies"`
 }
 
 func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
 func (m *HostGenesisState) String() string { return proto.CompactTextString(m) }
 func (*HostGenesisState) ProtoMessage()    {}
 func (*HostGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{2}
+	return fileDescriptor_d4aa48c8e29a1947, []int{2}
 }
 func (m *HostGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,14 +190,14 @@ func (m *HostGenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HostGenesisState proto.InternalMessageInfo
 
-func (m *HostGenesisState) GetActiveChannels() []ActiveChannel {
+func (m *HostGenesisState) GetActiveChannels() []types.ActiveChannel {
 	if m != nil {
 		return m.ActiveChannels
 	}
 	return nil
 }
 
-func (m *HostGenesisState) GetInterchainAccounts() []RegisteredInterchainAccount {
+func (m *HostGenesisState) GetInterchainAccounts() []types.RegisteredInterchainAccount {
 	if m != nil {
 		return m.InterchainAccounts
 	}
@@ -209,190 +211,69 @@ func (m *HostGenesisState) GetPort() string {
 	return ""
 }
 
-func (m *HostGenesisState) GetParams() types1.Params {
+func (m *HostGenesisState) GetParams() types2.Params {
 	if m != nil {
 		return m.Params
 	}
-	return types1.Params{}
-}
-
-// ActiveChannel contains a connection ID, port ID and associated active channel ID
-type ActiveChannel struct {
-	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
-	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
-	ChannelId    string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
-}
-
-func (m *ActiveChannel) Reset()         { *m = ActiveChannel{} }
-func (m *ActiveChannel) String() string { return proto.CompactTextString(m) }
-func (*ActiveChannel) ProtoMessage()    {}
-func (*ActiveChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{3}
-}
-func (m *ActiveChannel) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ActiveChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ActiveChannel.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *ActiveChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActiveChannel.Merge(m, src)
-}
-func (m *ActiveChannel) XXX_Size() int {
-	return m.Size()
-}
-func (m *ActiveChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActiveChannel.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ActiveChannel proto.InternalMessageInfo
-
-func (m *ActiveChannel) GetConnectionId() string {
-	if m != nil {
-		return m.ConnectionId
-	}
-	return ""
+	return types2.Params{}
 }
 
-func (m *ActiveChannel) GetPortId() string {
+func (m *HostGenesisState) GetMessagePolicies() []types2.MessagePolicy {
 	if m != nil {
-		return m.PortId
+		return m.MessagePolicies
 	}
-	return ""
-}
-
-func (m *ActiveChannel) GetChannelId() string {
-	if m != nil {
-		return m.ChannelId
-	}
-	return ""
-}
-
-// RegisteredInterchainAccount contains a connection ID, port ID and associated interchain account address
-type RegisteredInterchainAccount struct {
-	ConnectionId   string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
-	PortId         string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
-	AccountAddress string `protobuf:"bytes,3,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty" yaml:"account_address"`
-}
-
-func (m *RegisteredInterchainAccount) Reset()         { *m = RegisteredInterchainAccount{} }
-func (m *RegisteredInterchainAccount) String() string { return proto.CompactTextString(m) }
-func (*RegisteredInterchainAccount) ProtoMessage()    {}
-func (*RegisteredInterchainAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{4}
-}
-func (m *RegisteredInterchainAccount) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RegisteredInterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RegisteredInterchainAccount.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *RegisteredInterchainAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisteredInterchainAccount.Merge(m, src)
-}
-func (m *RegisteredInterchainAccount) XXX_Size() int {
-	return m.Size()
-}
-func (m *RegisteredInterchainAccount) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisteredInterchainAccount.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RegisteredInterchainAccount proto.InternalMessageInfo
-
-func (m *RegisteredInterchainAccount) GetConnectionId() string {
-	if m != nil {
-		return m.ConnectionId
-	}
-	return ""
-}
-
-func (m *RegisteredInterchainAccount) GetPortId() string {
-	if m != nil {
-		return m.PortId
-	}
-	return ""
-}
-
-func (m *RegisteredInterchainAccount) GetAccountAddress() string {
-	if m != nil {
-		return m.AccountAddress
-	}
-	return ""
+	return nil
 }
 
 func init() {
-	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.v1.GenesisState")
-	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.v1.ControllerGenesisState")
-	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.v1.HostGenesisState")
-	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.v1.ActiveChannel")
-	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.GenesisState")
+	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.ControllerGenesisState")
+	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.HostGenesisState")
 }
 
 func init() {
-	proto.RegisterFile("ibc/applications/interchain_accounts/v1/genesis.proto", fileDescriptor_629b3ced0911516b)
-}
-
-var fileDescriptor_629b3ced0911516b = []byte{
-	// 645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x3f, 0x6f, 0x13, 0x3f,
-	0x18, 0xc7, 0xe3, 0xa4, 0xbf, 0xfe, 0x14, 0xf7, 0x0f, 0xc5, 0x94, 0xea, 0x08, 0xd2, 0x25, 0x78,
-	0x69, 0x24, 0xd4, 0x3b, 0xf5, 0x0f, 0x54, 0x54, 0x42, 0xa8, 0x17, 0x10, 0x64, 0x43, 0x66, 0x41,
-	0x2c, 0x27, 0xc7, 0x67, 0x25, 0x96, 0x92, 0x73, 0x74, 0x76, 0x23, 0x75, 0x62, 0x67, 0x62, 0x43,
-	0xac, 0x48, 0xbc, 0x00, 0xde, 0x01, 0x63, 0x27, 0xd4, 0x91, 0x29, 0x42, 0xed, 0x3b, 0xc8, 0x2b,
-	0x40, 0xf6, 0x9d, 0x92, 0xf4, 0x48, 0xab, 0x9b, 0x98, 0x98, 0x62, 0xdf, 0xe3, 0xef, 0xf7, 0xf9,
-	0x3c, 0x7e, 0x1c, 0x1b, 0x3e, 0x12, 0x1d, 0xe6, 0xd3, 0xe1, 0xb0, 0x2f, 0x18, 0xd5, 0x42, 0xc6,
-	0xca, 0x17, 0xb1, 0xe6, 0x09, 0xeb, 0x51, 0x11, 0x87, 0x94, 0x31, 0x79, 0x12, 0x6b, 0xe5, 0x8f,
-	0x76, 0xfd, 0x2e, 0x8f, 0xb9, 0x12, 0xca, 0x1b, 0x26, 0x52, 0x4b, 0xb4, 0x2d, 0x3a, 0xcc, 0x9b,
-	0x97, 0x79, 0x0b, 0x64, 0xde, 0x68, 0xb7, 0xb6, 0xd9, 0x95, 0x5d, 0x69, 0x35, 0xbe, 0x19, 0xa5,
-	0xf2, 0x5a, 0xab, 0x50, 0x56, 0x26, 0x63, 0x9d, 0xc8, 0x7e, 0x9f, 0x27, 0x06, 0x60, 0x36, 0xcb,
-	0x4c, 0x0e, 0x0b, 0x99, 0xf4, 0xa4, 0xd2, 0x46, 0x6e, 0x7e, 0x53, 0x21, 0xfe, 0x5e, 0x86, 0xab,
-	0x2f, 0xd3, 0x72, 0xde, 0x68, 0xaa, 0x39, 0xfa, 0x02, 0xa0, 0x33, 0xb3, 0x0f, 0xb3, 0x52, 0x43,
-	0x65, 0x82, 0x0e, 0x68, 0x80, 0xe6, 0xca, 0xde, 0x33, 0xaf, 0x60, 0xc5, 0x5e, 0x6b, 0x6a, 0x34,
-	0x9f, 0x23, 0xd8, 0x3e, 0x1b, 0xd7, 0x4b, 0x93, 0x71, 0xbd, 0x7e, 0x4a, 0x07, 0xfd, 0x23, 0x7c,
-	0x5d, 0x3a, 0x4c, 0xb6, 0xd8, 0x42, 0x03, 0xf4, 0x01, 0x40, 0x64, 0x8a, 0xc8, 0xe1, 0x95, 0x2d,
-	0xde, 0x93, 0xc2, 0x78, 0xaf, 0xa4, 0xd2, 0x57, 0xc0, 0x1e, 0x64, 0x60, 0xf7, 0x52, 0xb0, 0x3f,
-	0x53, 0x60, 0xb2, 0xd1, 0xcb, 0x89, 0xf0, 0xd7, 0x0a, 0xdc, 0x5a, 0x5c, 0x28, 0x7a, 0x0f, 0x6f,
-	0x51, 0xa6, 0xc5, 0x88, 0x87, 0xac, 0x47, 0xe3, 0x98, 0xf7, 0x95, 0x03, 0x1a, 0x95, 0xe6, 0xca,
-	0xde, 0xe3, 0xc2, 0x8c, 0xc7, 0x56, 0xdf, 0x4a, 0xe5, 0x81, 0x9b, 0x01, 0x6e, 0xa5, 0x80, 0x39,
-	0x73, 0x4c, 0xd6, 0xe9, 0xfc, 0x72, 0x85, 0x3e, 0x03, 0x78, 0x67, 0x81, 0xb1, 0x53, 0xb6, 0x14,
-	0xcf, 0x0b, 0x53, 0x10, 0xde, 0x15, 0x4a, 0xf3, 0x84, 0x47, 0xed, 0xe9, 0x82, 0xe3, 0x34, 0x1e,
-	0xe0, 0x8c, 0xa9, 0x96, 0x32, 0x2d, 0x70, 0xc0, 0x04, 0x89, 0xbc, 0x4c, 0xa1, 0x4d, 0xf8, 0xdf,
-	0x50, 0x26, 0x5a, 0x39, 0x95, 0x46, 0xa5, 0x59, 0x25, 0xe9, 0x04, 0xbd, 0x85, 0xcb, 0x43, 0x9a,
-	0xd0, 0x81, 0x72, 0x96, 0x6c, 0x37, 0x8f, 0x8a, 0x31, 0xce, 0xfd, 0x23, 0x46, 0xbb, 0xde, 0x6b,
-	0xeb, 0x10, 0x2c, 0x19, 0x32, 0x92, 0xf9, 0xe1, 0x4f, 0x15, 0xb8, 0x91, 0xef, 0xf8, 0xbf, 0x0e,
-	0xdd, 0xd4, 0x21, 0x04, 0x97, 0x4c, 0x53, 0x9c, 0x4a, 0x03, 0x34, 0xab, 0xc4, 0x8e, 0x11, 0xc9,
-	0xf5, 0xe7, 0xa0, 0x18, 0xa1, 0xbd, 0x72, 0xae, 0xeb, 0xcc, 0x37, 0x00, 0xd7, 0xae, 0xec, 0x22,
-	0x7a, 0x0a, 0xd7, 0x98, 0x8c, 0x63, 0xce, 0x8c, 0x63, 0x28, 0x22, 0x7b, 0xf3, 0x54, 0x03, 0x67,
-	0x32, 0xae, 0x6f, 0x4e, 0x2f, 0x8d, 0x59, 0x18, 0x93, 0xd5, 0xd9, 0xbc, 0x1d, 0xa1, 0x87, 0xf0,
-	0x7f, 0x03, 0x6b, 0x84, 0x65, 0x2b, 0x44, 0x93, 0x71, 0x7d, 0x3d, 0x15, 0x66, 0x01, 0x4c, 0x96,
-	0xcd, 0xa8, 0x1d, 0xa1, 0x03, 0x08, 0xb3, 0xf6, 0x98, 0xf5, 0xb6, 0xd6, 0xe0, 0xee, 0x64, 0x5c,
-	0xbf, 0x9d, 0x25, 0x9a, 0xc6, 0x30, 0xa9, 0x66, 0x93, 0x76, 0x84, 0x7f, 0x00, 0x78, 0xff, 0x86,
-	0x3d, 0xff, 0xab, 0x15, 0xb4, 0xcc, 0x21, 0xb6, 0x69, 0x43, 0x1a, 0x45, 0x09, 0x57, 0x2a, 0x2b,
-	0xa3, 0x36, 0x7f, 0x10, 0xaf, 0x2c, 0xb0, 0x07, 0xd1, 0x7e, 0x39, 0x4e, 0x3f, 0x04, 0xe1, 0xd9,
-	0x85, 0x0b, 0xce, 0x2f, 0x5c, 0xf0, 0xeb, 0xc2, 0x05, 0x1f, 0x2f, 0xdd, 0xd2, 0xf9, 0xa5, 0x5b,
-	0xfa, 0x79, 0xe9, 0x96, 0xde, 0xbd, 0xe8, 0x0a, 0xdd, 0x3b, 0xe9, 0x78, 0x4c, 0x0e, 0x7c, 0x26,
-	0xd5, 0x40, 0x2a, 0x5f, 0x74, 0xd8, 0x4e, 0x57, 0xfa, 0xa3, 0x7d, 0x7f, 0x20, 0xa3, 0x93, 0x3e,
-	0x57, 0xe6, 0xf1, 0x51, 0xfe, 0xde, 0xe1, 0xce, 0xac, 0xf9, 0x3b, 0xd3, 0x77, 0x47, 0x9f, 0x0e,
-	0xb9, 0xea, 0x2c, 0xdb, 0x17, 0x67, 0xff, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x08, 0x8f,
-	0x1f, 0x67, 0x07, 0x00, 0x00,
+	proto.RegisterFile("ibc/applications/interchain_accounts/genesis/v1/genesis.proto", fileDescriptor_d4aa48c8e29a1947)
+}
+
+var fileDescriptor_d4aa48c8e29a1947 = []byte{
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x26, 0xad, 0xd4, 0x2b, 0xa2, 0xd5, 0x51, 0x05, 0x93, 0xc1, 0x09, 0x5e, 0xc8,
+	0x12, 0x5b, 0x49, 0x81, 0x8a, 0x22, 0x86, 0x38, 0x48, 0x85, 0xa1, 0x52, 0x65, 0x16, 0xc4, 0x12,
+	0x5d, 0xae, 0x27, 0xe7, 0x24, 0xdb, 0x67, 0xf9, 0x5d, 0x2c, 0x65, 0x62, 0x64, 0x45, 0x6c, 0x8c,
+	0x0c, 0x2c, 0x7c, 0x92, 0x8e, 0x19, 0x99, 0x2a, 0x94, 0x7c, 0x03, 0x3e, 0x01, 0xf2, 0xd9, 0x6d,
+	0x82, 0x9b, 0x22, 0x67, 0x67, 0xca, 0xb3, 0xee, 0xfe, 0xff, 0xf7, 0x7b, 0xef, 0x29, 0xef, 0xd0,
+	0x2b, 0x3e, 0xa2, 0x36, 0x89, 0x22, 0x9f, 0x53, 0x22, 0xb9, 0x08, 0xc1, 0xe6, 0xa1, 0x64, 0x31,
+	0x1d, 0x13, 0x1e, 0x0e, 0x09, 0xa5, 0x62, 0x12, 0x4a, 0xb0, 0x3d, 0x16, 0x32, 0xe0, 0x60, 0x27,
+	0xdd, 0xeb, 0xd0, 0x8a, 0x62, 0x21, 0x05, 0xb6, 0xf9, 0x88, 0x5a, 0xab, 0x72, 0x6b, 0x8d, 0xdc,
+	0xba, 0xd6, 0x24, 0xdd, 0xc6, 0xa1, 0x27, 0x3c, 0xa1, 0xb4, 0x76, 0x1a, 0x65, 0x36, 0x8d, 0x41,
+	0x29, 0x0a, 0x2a, 0x42, 0x19, 0x0b, 0xdf, 0x67, 0x71, 0x0a, 0xb2, 0xfc, 0xca, 0x4d, 0x8e, 0x4b,
+	0x99, 0x8c, 0x05, 0xc8, 0x54, 0x9e, 0xfe, 0xe6, 0xc2, 0x17, 0x1b, 0x09, 0x23, 0xe1, 0x73, 0x3a,
+	0xcd, 0xa5, 0xcf, 0x4a, 0x49, 0x93, 0xae, 0x9d, 0xc7, 0x99, 0xcc, 0x9c, 0x6d, 0xa1, 0x7b, 0xa7,
+	0x59, 0x53, 0xde, 0x49, 0x22, 0x19, 0xfe, 0xa1, 0x21, 0x7d, 0x59, 0xd0, 0x30, 0x6f, 0xd8, 0x10,
+	0xd2, 0x43, 0x5d, 0x6b, 0x69, 0xed, 0xbd, 0xde, 0xa9, 0xb5, 0x61, 0xaf, 0xad, 0xc1, 0x8d, 0xe1,
+	0x6a, 0x2e, 0xe7, 0xc9, 0xe5, 0x55, 0xb3, 0xf2, 0xfb, 0xaa, 0xd9, 0x9c, 0x92, 0xc0, 0x3f, 0x31,
+	0xef, 0x4a, 0x6b, 0xba, 0x75, 0xba, 0xd6, 0x00, 0x7f, 0xd1, 0x10, 0x4e, 0xbb, 0x51, 0xc0, 0xdc,
+	0x52, 0x98, 0xfd, 0x8d, 0x31, 0xdf, 0x08, 0x90, 0x7f, 0x01, 0x3e, 0xce, 0x01, 0x1f, 0x65, 0x80,
+	0xb7, 0x53, 0x99, 0xee, 0xc1, 0xb8, 0x20, 0x32, 0xbf, 0x57, 0x51, 0x7d, 0x7d, 0xc1, 0xf8, 0x23,
+	0xda, 0x27, 0x54, 0xf2, 0x84, 0x0d, 0xe9, 0x98, 0x84, 0x21, 0xf3, 0x41, 0xd7, 0x5a, 0xd5, 0xf6,
+	0x5e, 0xef, 0x79, 0x39, 0xd6, 0xa4, 0x6b, 0xf5, 0x95, 0x7e, 0x90, 0xc9, 0x1d, 0x23, 0x07, 0xac,
+	0x67, 0x80, 0x05, 0x73, 0xd3, 0xbd, 0x4f, 0x56, 0xaf, 0x03, 0xfe, 0xaa, 0xa1, 0x07, 0x6b, 0x8c,
+	0xf5, 0x2d, 0x45, 0xf1, 0xba, 0x34, 0x85, 0xcb, 0x3c, 0x0e, 0x92, 0xc5, 0xec, 0xe2, 0xed, 0xcd,
+	0x85, 0x7e, 0x76, 0xee, 0x98, 0x39, 0x53, 0x23, 0x63, 0x5a, 0xe3, 0x60, 0xba, 0x98, 0x17, 0x65,
+	0x80, 0x0f, 0xd1, 0x76, 0x24, 0x62, 0x09, 0x7a, 0xb5, 0x55, 0x6d, 0xef, 0xba, 0xd9, 0x07, 0x7e,
+	0x8f, 0x76, 0x22, 0x12, 0x93, 0x00, 0xf4, 0x9a, 0x9a, 0xea, 0x49, 0x39, 0xc6, 0x95, 0xff, 0x64,
+	0xd2, 0xb5, 0xce, 0x95, 0x83, 0x53, 0x4b, 0xc9, 0xdc, 0xdc, 0xcf, 0xfc, 0x56, 0x43, 0x07, 0xc5,
+	0x89, 0xff, 0x9f, 0xd0, 0xbf, 0x26, 0x84, 0x51, 0x2d, 0x1d, 0x8a, 0x5e, 0x6d, 0x69, 0xed, 0x5d,
+	0x57, 0xc5, 0xd8, 0x2d, 0xcc, 0xe7, 0x69, 0x39, 0x42, 0xb5, 0xf4, 0xee, 0x98, 0x0c, 0xfe, 0xa4,
+	0xa1, 0x83, 0x80, 0x01, 0x10, 0x8f, 0x0d, 0xd5, 0x92, 0xe3, 0x0c, 0xf4, 0x6d, 0xd5, 0x80, 0x97,
+	0x9b, 0xd9, 0x9f, 0x65, 0x2e, 0xe7, 0x6a, 0x53, 0x3a, 0xcd, 0xbc, 0xee, 0x87, 0x59, 0xdd, 0xc5,
+	0x14, 0xa6, 0xbb, 0x1f, 0xac, 0xdc, 0xe7, 0x0c, 0x1c, 0xef, 0x72, 0x6e, 0x68, 0xb3, 0xb9, 0xa1,
+	0xfd, 0x9a, 0x1b, 0xda, 0xe7, 0x85, 0x51, 0x99, 0x2d, 0x8c, 0xca, 0xcf, 0x85, 0x51, 0xf9, 0x70,
+	0xe6, 0x71, 0x39, 0x9e, 0x8c, 0x2c, 0x2a, 0x02, 0x9b, 0x0a, 0x08, 0x04, 0xa4, 0x2f, 0x50, 0xc7,
+	0x13, 0x76, 0x72, 0x64, 0x07, 0xe2, 0x62, 0xe2, 0x33, 0x48, 0xf7, 0x31, 0xd8, 0xbd, 0xe3, 0xce,
+	0x12, 0xb1, 0x73, 0xeb, 0x25, 0x93, 0xd3, 0x88, 0xc1, 0x68, 0x47, 0xad, 0xe3, 0xa3, 0x3f, 0x03,
+	0x00, 0x3e, 0xe0, 0x74, 0x63, 0x06, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -528,6 +409,20 @@ func (m *HostGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessagePolicies) > 0 {
+		for iNdEx := len(m.MessagePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessagePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -576,94 +471,6 @@ func (m *HostGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ActiveChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ActiveChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ActiveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RegisteredInterchainAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RegisteredInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RegisteredInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AccountAddress) > 0 {
-		i -= len(m.AccountAddress)
-		copy(dAtA[i:], m.AccountAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AccountAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -741,47 +548,11 @@ func (m *HostGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *ActiveChannel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
-}
-
-func (m *RegisteredInterchainAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MessagePolicies) > 0 {
+		for _, e := range m.MessagePolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
 	return n
 }
@@ -966,7 +737,7 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveChannels = append(m.ActiveChannels, ActiveChannel{})
+			m.ActiveChannels = append(m.ActiveChannels, types.ActiveChannel{})
 			if err := m.ActiveChannels[len(m.ActiveChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1000,7 +771,7 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterchainAccounts = append(m.InterchainAccounts, RegisteredInterchainAccount{})
+			m.InterchainAccounts = append(m.InterchainAccounts, types.RegisteredInterchainAccount{})
 			if err := m.InterchainAccounts[len(m.InterchainAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1149,7 +920,7 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveChannels = append(m.ActiveChannels, ActiveChannel{})
+			m.ActiveChannels = append(m.ActiveChannels, types.ActiveChannel{})
 			if err := m.ActiveChannels[len(m.ActiveChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1183,7 +954,7 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterchainAccounts = append(m.InterchainAccounts, RegisteredInterchainAccount{})
+			m.InterchainAccounts = append(m.InterchainAccounts, types.RegisteredInterchainAccount{})
 			if err := m.InterchainAccounts[len(m.InterchainAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1253,125 +1024,11 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActiveChannel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveChannel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveChannel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagePolicies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1381,169 +1038,25 @@ func (m *ActiveChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+			m.MessagePolicies = append(m.MessagePolicies, types2.MessagePolicy{})
+			if err := m.MessagePolicies[len(m.MessagePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RegisteredInterchainAccount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredInterchainAccount: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
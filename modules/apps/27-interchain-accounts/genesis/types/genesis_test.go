@@ -2,12 +2,13 @@ package types_test
 
 import (
 	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/genesis/types"
 	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
-	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
-func (suite *TypesTestSuite) TestValidateGenesisState() {
+func (suite *GenesisTypesTestSuite) TestValidateGenesisState() {
 	var (
 		genesisState types.GenesisState
 	)
@@ -62,7 +63,7 @@ func (suite *TypesTestSuite) TestValidateGenesisState() {
 	}
 }
 
-func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
+func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 	var (
 		genesisState types.ControllerGenesisState
 	)
@@ -80,42 +81,42 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 		{
 			"failed to validate active channel - invalid port identifier",
 			func() {
-				activeChannels := []types.ActiveChannel{
+				activeChannels := []icatypes.ActiveChannel{
 					{
 						PortId:    "invalid|port",
 						ChannelId: ibctesting.FirstChannelID,
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, []icatypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
 			},
 			false,
 		},
 		{
 			"failed to validate active channel - invalid channel identifier",
 			func() {
-				activeChannels := []types.ActiveChannel{
+				activeChannels := []icatypes.ActiveChannel{
 					{
 						PortId:    TestPortID,
 						ChannelId: "invalid|channel",
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, []icatypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
 			},
 			false,
 		},
 		{
 			"failed to validate registered account - invalid port identifier",
 			func() {
-				activeChannels := []types.ActiveChannel{
+				activeChannels := []icatypes.ActiveChannel{
 					{
 						PortId:    TestPortID,
 						ChannelId: ibctesting.FirstChannelID,
 					},
 				}
 
-				registeredAccounts := []types.RegisteredInterchainAccount{
+				registeredAccounts := []icatypes.RegisteredInterchainAccount{
 					{
 						PortId:         "invalid|port",
 						AccountAddress: TestOwnerAddress,
@@ -129,14 +130,14 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 		{
 			"failed to validate registered account - invalid owner address",
 			func() {
-				activeChannels := []types.ActiveChannel{
+				activeChannels := []icatypes.ActiveChannel{
 					{
 						PortId:    TestPortID,
 						ChannelId: ibctesting.FirstChannelID,
 					},
 				}
 
-				registeredAccounts := []types.RegisteredInterchainAccount{
+				registeredAccounts := []icatypes.RegisteredInterchainAccount{
 					{
 						PortId:         TestPortID,
 						AccountAddress: "",
@@ -150,14 +151,14 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 		{
 			"failed to validate controller ports - invalid port identifier",
 			func() {
-				activeChannels := []types.ActiveChannel{
+				activeChannels := []icatypes.ActiveChannel{
 					{
 						PortId:    TestPortID,
 						ChannelId: ibctesting.FirstChannelID,
 					},
 				}
 
-				registeredAccounts := []types.RegisteredInterchainAccount{
+				registeredAccounts := []icatypes.RegisteredInterchainAccount{
 					{
 						PortId:         TestPortID,
 						AccountAddress: TestOwnerAddress,
@@ -187,7 +188,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 	}
 }
 
-func (suite *TypesTestSuite) TestValidateHostGenesisState() {
+func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 	var (
 		genesisState types.HostGenesisState
 	)
@@ -205,91 +206,126 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 		{
 			"failed to validate active channel - invalid port identifier",
 			func() {
-				activeChannels := []types.ActiveChannel{
+				activeChannels := []icatypes.ActiveChannel{
 					{
 						PortId:    "invalid|port",
 						ChannelId: ibctesting.FirstChannelID,
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, []icatypes.RegisteredInterchainAccount{}, icatypes.PortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
 		{
 			"failed to validate active channel - invalid channel identifier",
 			func() {
-				activeChannels := []types.ActiveChannel{
+				activeChannels := []icatypes.ActiveChannel{
 					{
 						PortId:    TestPortID,
 						ChannelId: "invalid|channel",
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, []icatypes.RegisteredInterchainAccount{}, icatypes.PortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
 		{
 			"failed to validate registered account - invalid port identifier",
 			func() {
-				activeChannels := []types.ActiveChannel{
+				activeChannels := []icatypes.ActiveChannel{
 					{
 						PortId:    TestPortID,
 						ChannelId: ibctesting.FirstChannelID,
 					},
 				}
 
-				registeredAccounts := []types.RegisteredInterchainAccount{
+				registeredAccounts := []icatypes.RegisteredInterchainAccount{
 					{
 						PortId:         "invalid|port",
 						AccountAddress: TestOwnerAddress,
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.PortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
 		{
 			"failed to validate registered account - invalid owner address",
 			func() {
-				activeChannels := []types.ActiveChannel{
+				activeChannels := []icatypes.ActiveChannel{
 					{
 						PortId:    TestPortID,
 						ChannelId: ibctesting.FirstChannelID,
 					},
 				}
 
-				registeredAccounts := []types.RegisteredInterchainAccount{
+				registeredAccounts := []icatypes.RegisteredInterchainAccount{
 					{
 						PortId:         TestPortID,
 						AccountAddress: "",
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.PortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
 		{
 			"failed to validate controller ports - invalid port identifier",
 			func() {
-				activeChannels := []types.ActiveChannel{
+				activeChannels := []icatypes.ActiveChannel{
 					{
 						PortId:    TestPortID,
 						ChannelId: ibctesting.FirstChannelID,
 					},
 				}
 
-				registeredAccounts := []types.RegisteredInterchainAccount{
+				registeredAccounts := []icatypes.RegisteredInterchainAccount{
 					{
 						PortId:         TestPortID,
 						AccountAddress: TestOwnerAddress,
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams(), nil)
+			},
+			false,
+		},
+		{
+			"success with message policies",
+			func() {
+				policies := []hosttypes.MessagePolicy{
+					hosttypes.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.staking.v1beta1.*"}, nil, nil),
+					hosttypes.NewMessagePolicy("", "testchain", []string{"*"}, []string{"/cosmos.gov.v1beta1.*"}, nil),
+				}
+
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), policies)
+			},
+			true,
+		},
+		{
+			"failed to validate message policy - invalid message pattern",
+			func() {
+				policies := []hosttypes.MessagePolicy{
+					hosttypes.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.*.v1beta1.MsgSend"}, nil, nil),
+				}
+
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), policies)
+			},
+			false,
+		},
+		{
+			"failed to validate message policies - duplicate message policy",
+			func() {
+				policies := []hosttypes.MessagePolicy{
+					hosttypes.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"*"}, nil, nil),
+					hosttypes.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil),
+				}
+
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), policies)
 			},
 			false,
 		},
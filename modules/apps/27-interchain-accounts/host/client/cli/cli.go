@@ -15,7 +15,13 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdInterchainAccount(),
+		GetCmdInterchainAccounts(),
+		GetCmdActiveChannels(),
+		GetCmdActiveChannel(),
 		GetCmdPacketEvents(),
+		GetCmdMessagePolicies(),
+		GetCmdChannelMessagePolicy(),
 	)
 
 	return queryCmd
@@ -95,3 +95,220 @@ func GetCmdPacketEvents() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdInterchainAccount returns the command handler for querying the controller port and connection of an interchain account.
+func GetCmdInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-account [address]",
+		Short:   "Query the controller port and connection identifiers of an interchain account",
+		Long:    "Query the controller port identifier and host connection identifier associated with an interchain account address",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-account cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInterchainAccountRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInterchainAccounts returns the command handler for querying all the registered interchain accounts.
+func GetCmdInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query all the interchain accounts registered on the host chain",
+		Long:    "Query all the interchain accounts registered on the host chain and their associated port and connection identifiers",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-accounts", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInterchainAccountsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdActiveChannels returns the command handler for querying all the active interchain accounts channels.
+func GetCmdActiveChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active-channels",
+		Short:   "Query all the active interchain accounts channels on the host chain",
+		Long:    "Query all the active interchain accounts channels on the host chain and their associated port and connection identifiers",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host active-channels", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryActiveChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ActiveChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "active channels")
+
+	return cmd
+}
+
+// GetCmdActiveChannel returns the command handler for querying the active channel of an interchain account.
+func GetCmdActiveChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active-channel [controller-port-id] [connection-id]",
+		Short:   "Query the active channel of an interchain account and its state",
+		Long:    "Query the active channel and its state for the provided controller port identifier and host connection identifier",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host active-channel icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryActiveChannelRequest{
+				PortId:       args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.ActiveChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdMessagePolicies returns the command handler for querying all the message policies.
+func GetCmdMessagePolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "message-policies",
+		Short:   "Query all the message policies set on the host chain",
+		Long:    "Query all the message policies set on the host chain applying to connections and counterparty chains",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host message-policies", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMessagePoliciesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.MessagePolicies(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "message policies")
+
+	return cmd
+}
+
+// GetCmdChannelMessagePolicy returns the command handler for querying the message policy applying to a host channel.
+func GetCmdChannelMessagePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-message-policy [channel-id]",
+		Short:   "Query the message policy applying to a host channel",
+		Long:    "Query the message policy applying to the connection or counterparty chain of a host channel",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host channel-message-policy channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelMessagePolicyRequest{
+				ChannelId: args[0],
+			}
+
+			res, err := queryClient.ChannelMessagePolicy(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
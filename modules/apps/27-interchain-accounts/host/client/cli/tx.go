@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+const (
+	flagConnectionID        = "connection-id"
+	flagCounterpartyChainID = "counterparty-chain-id"
+)
+
+// NewCmdSubmitSetMessagePolicyProposal implements a command handler for submitting a set message policy proposal transaction.
+func NewCmdSubmitSetMessagePolicyProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-ica-message-policy [policy-json or path/to/policy.json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the interchain accounts host message policy of a connection or counterparty chain",
+		Long: `Submit a proposal to add or replace the interchain accounts host message policy of a connection or counterparty chain along with an initial deposit.
+The message policy is provided as JSON, for example:
+
+{
+  "connection_id": "connection-0",
+  "allow_messages": ["/cosmos.staking.v1beta1.*", "/cosmos.bank.v1beta1.MsgSend"],
+  "deny_messages": ["/cosmos.staking.v1beta1.MsgUndelegate"],
+  "constraints": [
+    {"message_type": "/cosmos.bank.v1beta1.MsgSend", "max_amount": [{"denom": "stake", "amount": "1000000"}]}
+  ]
+}`,
+		Example: fmt.Sprintf("%s tx gov submit-proposal set-ica-message-policy policy.json --deposit 10000stake", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var policy types.MessagePolicy
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &policy); err != nil {
+				// check for file path if JSON input is not provided
+				contents, err := ioutil.ReadFile(args[0])
+				if err != nil {
+					return sdkerrors.Wrap(err, "neither JSON input nor path to .json file for message policy were provided")
+				}
+
+				if err := clientCtx.Codec.UnmarshalJSON(contents, &policy); err != nil {
+					return sdkerrors.Wrap(err, "error unmarshalling message policy file")
+				}
+			}
+
+			content := types.NewSetMessagePolicyProposal(title, description, policy)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveMessagePolicyProposal implements a command handler for submitting a remove message policy proposal transaction.
+func NewCmdSubmitRemoveMessagePolicyProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-ica-message-policy",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to remove the interchain accounts host message policy of a connection or counterparty chain",
+		Long:  "Submit a proposal to remove the interchain accounts host message policy of a connection or counterparty chain along with an initial deposit.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal remove-ica-message-policy --%s connection-0 --deposit 10000stake",
+			version.AppName, flagConnectionID),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			connectionID, err := cmd.Flags().GetString(flagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			counterpartyChainID, err := cmd.Flags().GetString(flagCounterpartyChainID)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveMessagePolicyProposal(title, description, connectionID, counterpartyChainID)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	cmd.Flags().String(flagConnectionID, "", "host connection identifier of the message policy")
+	cmd.Flags().String(flagCounterpartyChainID, "", "chain identifier of the controller chain of the message policy")
+
+	return cmd
+}
+
+// addProposalFlags adds the common governance proposal flags to the command
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+// parseProposalFlags returns the title, description and deposit of the proposal
+func parseProposalFlags(fs *pflag.FlagSet) (string, string, sdk.Coins, error) {
+	title, err := fs.GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	description, err := fs.GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	depositStr, err := fs.GetString(govcli.FlagDeposit)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return title, description, deposit, nil
+}
@@ -98,6 +98,61 @@ func (im IBCModule) OnChanCloseConfirm(
 	return im.keeper.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
+// OnChanUpgradeInit implements the IBCModule interface. Channel upgrades are not
+// supported for interchain accounts channels.
+func (im IBCModule) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) error {
+	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrades are not supported for interchain accounts channels")
+}
+
+// OnChanUpgradeTry implements the IBCModule interface. Channel upgrades are not
+// supported for interchain accounts channels.
+func (im IBCModule) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	return "", sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrades are not supported for interchain accounts channels")
+}
+
+// OnChanUpgradeAck implements the IBCModule interface. Channel upgrades are not
+// supported for interchain accounts channels.
+func (im IBCModule) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrades are not supported for interchain accounts channels")
+}
+
+// OnChanUpgradeConfirm implements the IBCModule interface. Channel upgrades are not
+// supported for interchain accounts channels.
+func (im IBCModule) OnChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrades are not supported for interchain accounts channels")
+}
+
+// OnChanUpgradeRestore implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeRestore(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+}
+
 // OnRecvPacket implements the IBCModule interface
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
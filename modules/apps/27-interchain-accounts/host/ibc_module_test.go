@@ -3,8 +3,10 @@ package host_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/gogo/protobuf/proto"
@@ -86,7 +88,7 @@ func RegisterInterchainAccount(endpoint *ibctesting.Endpoint, owner string) erro
 
 	channelSequence := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(endpoint.Chain.GetContext())
 
-	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner); err != nil {
+	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner, "", endpoint.ChannelConfig.Order); err != nil {
 		return err
 	}
 
@@ -154,7 +156,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil))
 			}, false,
 		},
 		{
@@ -170,8 +172,13 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 			}, true,
 		},
 		{
-			"ICA callback fails - invalid channel order", func() {
+			"ICA callback succeeds - UNORDERED channel", func() {
 				channel.Ordering = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"ICA callback fails - invalid channel order", func() {
+				channel.Ordering = channeltypes.NONE
 			}, false,
 		},
 	}
@@ -276,7 +283,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenConfirm() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil))
 			}, false,
 		},
 		{
@@ -411,7 +418,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil))
 			}, false,
 		},
 		{
@@ -455,7 +462,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
 				Amount:      amount,
 			}
-			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg})
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 			suite.Require().NoError(err)
 
 			icaPacketData := icatypes.InterchainAccountPacketData{
@@ -464,23 +471,17 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 			}
 			packetData = icaPacketData.GetBytes()
 
-			// build expected ack
+			// build expected msg result
 			msgResponseBz, err := proto.Marshal(&banktypes.MsgSendResponse{})
 			suite.Require().NoError(err)
 
-			msgData := &sdk.MsgData{
+			msgResult := icatypes.MsgResult{
 				MsgType: sdk.MsgTypeURL(msg),
+				Success: true,
 				Data:    msgResponseBz,
 			}
 
-			expectedTxResponse, err := proto.Marshal(&sdk.TxMsgData{
-				Data: []*sdk.MsgData{msgData},
-			})
-			suite.Require().NoError(err)
-
-			expectedAck := channeltypes.NewResultAcknowledgement(expectedTxResponse)
-
-			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 			// malleate packetData for test cases
@@ -500,7 +501,22 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 			ack := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, nil)
 			if tc.expAckSuccess {
 				suite.Require().True(ack.Success())
-				suite.Require().Equal(expectedAck, ack)
+
+				channelAck := ack.(channeltypes.Acknowledgement)
+				txResult, err := icatypes.DeserializeTxResult(channelAck.GetResult(), icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+				suite.Require().NotZero(txResult.GasUsed)
+
+				// build expected ack, the gas used being reported by the only message of the transaction
+				msgResult.GasUsed = txResult.GasUsed
+				expectedTxResponse, err := proto.Marshal(&icatypes.TxResult{
+					Results:  []icatypes.MsgResult{msgResult},
+					GasLimit: types.DefaultMaxGasPerPacket,
+					GasUsed:  txResult.GasUsed,
+				})
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(channeltypes.NewResultAcknowledgement(expectedTxResponse), ack)
 			} else {
 				suite.Require().False(ack.Success())
 			}
@@ -654,7 +670,7 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 		Amount:      tokenAmt,
 	}
 
-	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 	suite.Require().NoError(err)
 
 	icaPacketData := icatypes.InterchainAccountPacketData{
@@ -662,7 +678,7 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 		Data: data,
 	}
 
-	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
@@ -713,6 +729,371 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 	suite.Require().True(hasBalance)
 }
 
+// TestLocalhostInterchainAccount tests that an interchain account can be registered and controlled
+// over the localhost client, where chainA is both the controller and the host chain
+func (suite *InterchainAccountsTestSuite) TestLocalhostInterchainAccount() {
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: exported.LocalhostConnectionID,
+		HostConnectionId:       exported.LocalhostConnectionID,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	path := ibctesting.NewLocalhostPath(suite.chainA)
+	path.EndpointA.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), exported.LocalhostConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	// fund the interchain account
+	msgBankSend := &banktypes.MsgSend{
+		FromAddress: suite.chainA.SenderAccount.GetAddress().String(),
+		ToAddress:   interchainAccountAddr,
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))),
+	}
+
+	_, err = suite.chainA.SendMsgs(msgBankSend)
+	suite.Require().NoError(err)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainA.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, exported.LocalhostConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	// relay the packet
+	packet := channeltypes.NewPacket(icaPacketData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// check that the interchain account executed the bank send
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	hasBalance := suite.chainA.GetSimApp().BankKeeper.HasBalance(suite.chainA.GetContext(), icaAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)))
+	suite.Require().True(hasBalance)
+}
+
+// sendBankSendTx sends an interchain accounts packet executing a bank send of the provided amount from the
+// interchain account registered on the path and returns the packet sent
+func (suite *InterchainAccountsTestSuite) sendBankSendTx(path *ibctesting.Path, amount sdk.Coins, timeoutTimestamp uint64) channeltypes.Packet {
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      amount,
+	}
+
+	return suite.sendTx(path, msg, timeoutTimestamp)
+}
+
+// sendTx sends a transaction executing the provided msg from the controller chain and returns its packet
+func (suite *InterchainAccountsTestSuite) sendTx(path *ibctesting.Path, msg sdk.Msg, timeoutTimestamp uint64) channeltypes.Packet {
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, timeoutTimestamp)
+	suite.Require().NoError(err)
+	path.EndpointB.UpdateClient()
+
+	return channeltypes.NewPacket(icaPacketData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+}
+
+// TestUnorderedChannelParallelPackets tests that multiple transactions may be in flight on an UNORDERED channel
+// and executed by the host chain in any order
+func (suite *InterchainAccountsTestSuite) TestUnorderedChannelParallelPackets() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	var packets []channeltypes.Packet
+	for i := 0; i < 3; i++ {
+		packets = append(packets, suite.sendBankSendTx(path, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))), ^uint64(0)))
+	}
+
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	// receive the packets in the reverse order they were sent
+	for i := len(packets) - 1; i >= 0; i-- {
+		err = path.EndpointB.UpdateClient()
+		suite.Require().NoError(err)
+
+		err = path.EndpointB.RecvPacket(packets[i])
+		suite.Require().NoError(err)
+
+		_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packets[i].GetSequence())
+		suite.Require().True(found)
+	}
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.NewInt(7000), balance.Amount)
+
+	// the packet receipt prevents the host chain from executing a packet received on an UNORDERED channel twice
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.RecvPacket(packets[0])
+	suite.Require().NoError(err) // no-op
+
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.NewInt(7000), balance.Amount)
+}
+
+// TestUnorderedChannelIndependentTimeout tests that a packet timing out on an UNORDERED channel neither closes the
+// channel nor prevents the packets sent after it from being executed
+func (suite *InterchainAccountsTestSuite) TestUnorderedChannelIndependentTimeout() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	timedOutPacket := suite.sendBankSendTx(path, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))), timeoutTimestamp)
+	packet := suite.sendBankSendTx(path, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2000))), ^uint64(0))
+
+	// time out the first packet
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.TimeoutPacket(timedOutPacket)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(channeltypes.OPEN, path.EndpointA.GetChannel().State)
+	suite.Require().Equal(channeltypes.OPEN, path.EndpointB.GetChannel().State)
+
+	// the second packet is executed regardless of the timeout of the first packet
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.NewInt(8000), balance.Amount)
+
+	_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timedOutPacket.GetSequence())
+	suite.Require().False(found)
+}
+
+// TestReopenClosedChannelAsUnordered tests that the interchain account registered on an ORDERED channel closed by a
+// packet timeout is preserved when a new UNORDERED channel is opened on the same port
+func (suite *InterchainAccountsTestSuite) TestReopenClosedChannelAsUnordered() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	// time out a packet, closing the ORDERED channel on the controller chain
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	packet := suite.sendBankSendTx(path, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))), timeoutTimestamp)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+	err = path.EndpointB.SetChannelClosed()
+	suite.Require().NoError(err)
+
+	// open a new UNORDERED channel on the same port
+	path.EndpointB.ChannelID = ""
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+
+	err = SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(channeltypes.UNORDERED, path.EndpointB.GetChannel().Ordering)
+
+	addr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(interchainAccountAddr, addr)
+
+	addr, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(interchainAccountAddr, addr)
+
+	// the interchain account is controlled over the new channel
+	packet = suite.sendBankSendTx(path, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))), ^uint64(0))
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.NewInt(9000), balance.Amount)
+}
+
+// TestRecvPacketFailedTxFee tests that the fee deducted for a transaction whose messages fail, including messages
+// failing authentication, is kept by the host chain once the packet is received through the core IBC handler
+func (suite *InterchainAccountsTestSuite) TestRecvPacketFailedTxFee() {
+	var (
+		path *ibctesting.Path
+		msg  sdk.Msg
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(interchainAccountAddr string)
+	}{
+		{
+			"message fails", func(interchainAccountAddr string) {
+				// the interchain account cannot send more than its balance
+				msg = &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000))),
+				}
+			},
+		},
+		{
+			"message is not signed by the interchain account", func(interchainAccountAddr string) {
+				msg = &banktypes.MsgSend{
+					FromAddress: suite.chainB.SenderAccount.GetAddress().String(),
+					ToAddress:   interchainAccountAddr,
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			gasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3)))
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, gasPrice)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			tc.malleate(interchainAccountAddr)
+
+			packet := suite.sendTx(path, msg, ^uint64(0))
+
+			suite.coordinator.CommitBlock(suite.chainA)
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+			recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+			ctx := suite.chainB.GetContext()
+			feeCollectorAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			feeCollectorBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, feeCollectorAddr, sdk.DefaultBondDenom)
+
+			_, err = suite.chainB.App.GetIBCKeeper().RecvPacket(sdk.WrapSDKContext(ctx), recvMsg)
+			suite.Require().NoError(err)
+
+			// the acknowledgement reporting the failed message is written
+			_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().True(found)
+
+			// the fee for the gas limit of the packet is kept by the fee collector
+			fee := sdk.NewInt(1000)
+			suite.Require().Equal(feeCollectorBalance.Amount.Add(fee), suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, feeCollectorAddr, sdk.DefaultBondDenom).Amount)
+			suite.Require().Equal(sdk.NewInt(10000).Sub(fee), suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom).Amount)
+		})
+	}
+}
+
 // The safety of including SDK MsgResponses in the acknowledgement rests
 // on the inclusion of the abcitypes.ResponseDeliverTx.Data in the
 // abcitypes.ResposneDeliverTx hash. If the abcitypes.ResponseDeliverTx.Data
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 )
 
@@ -18,3 +21,31 @@ func EmitWriteErrorAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI
 		),
 	)
 }
+
+// EmitMessagePolicyProposalEvent emits an event of the provided type describing the message policy updated by governance
+func EmitMessagePolicyProposalEvent(ctx sdk.Context, eventType, connectionID, counterpartyChainID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyChainID, counterpartyChainID),
+		),
+	)
+}
+
+// EmitExecuteTxEvent emits an event describing the gas limit, gas used and fee of a transaction executed by an
+// interchain account
+func EmitExecuteTxEvent(ctx sdk.Context, packet exported.PacketI, gasLimit, gasUsed uint64, fee sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteTx,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyGasLimit, strconv.FormatUint(gasLimit, 10)),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+}
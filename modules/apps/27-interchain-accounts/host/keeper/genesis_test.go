@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	genesistypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/genesis/types"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -10,7 +11,7 @@ import (
 func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.SetupTest()
 
-	genesisState := icatypes.HostGenesisState{
+	genesisState := genesistypes.HostGenesisState{
 		ActiveChannels: []icatypes.ActiveChannel{
 			{
 				ConnectionId: ibctesting.FirstConnectionID,
@@ -26,6 +27,10 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Port: icatypes.PortID,
+		MessagePolicies: []types.MessagePolicy{
+			types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.staking.v1beta1.*"}, nil, nil),
+		},
+		Params: types.NewParams(false, nil, nil, 0, types.DefaultMaxGasPerPacket, nil),
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
@@ -38,9 +43,16 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(TestAccAddress.String(), accountAdrr)
 
-	expParams := types.NewParams(false, nil)
+	account, found := suite.chainA.GetSimApp().ICAHostKeeper.GetRegisteredInterchainAccount(suite.chainA.GetContext(), TestAccAddress.String())
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.InterchainAccounts[0], account)
+
+	policy, found := suite.chainA.GetSimApp().ICAHostKeeper.GetMessagePolicy(suite.chainA.GetContext(), ibctesting.FirstConnectionID, "")
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.MessagePolicies[0], policy)
+
 	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
-	suite.Require().Equal(expParams, params)
+	suite.Require().Equal(genesisState.Params, params)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -52,6 +64,9 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	err := SetupICAPath(path, TestOwnerAddress)
 	suite.Require().NoError(err)
 
+	policy := types.NewMessagePolicy("", suite.chainA.ChainID, []string{"*"}, nil, nil)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+
 	genesisState := keeper.ExportGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper)
 
 	suite.Require().Equal(path.EndpointB.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -64,4 +79,6 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
+
+	suite.Require().Equal([]types.MessagePolicy{policy}, genesisState.GetMessagePolicies())
 }
@@ -2,8 +2,12 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +16,279 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var req *types.QueryInterchainAccountRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid address", func() {
+				req.Address = "invalid-address"
+			}, false,
+		},
+		{
+			"interchain account not found", func() {
+				req.Address = suite.chainB.SenderAccount.GetAddress().String()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountRequest{
+				Address: TestAccAddress.String(),
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccount(sdk.WrapSDKContext(suite.chainB.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(TestPortID, res.PortId)
+				suite.Require().Equal(path.EndpointB.ConnectionID, res.ConnectionId)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// register a second interchain account using a different owner on the same connection
+	secondOwner := suite.chainA.SenderAccount.GetAddress().String()
+	secondPortID, err := icatypes.NewControllerPortID(secondOwner)
+	suite.Require().NoError(err)
+
+	suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, secondPortID, "cosmos1address")
+
+	ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+
+	res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.InterchainAccounts, 2)
+	suite.Require().Contains(res.InterchainAccounts, icatypes.RegisteredInterchainAccount{
+		ConnectionId:   path.EndpointB.ConnectionID,
+		PortId:         TestPortID,
+		AccountAddress: TestAccAddress.String(),
+	})
+
+	res, err = suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{
+		Pagination: &query.PageRequest{
+			Limit:      1,
+			CountTotal: true,
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.InterchainAccounts, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryActiveChannels() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+
+	res, err := suite.chainB.GetSimApp().ICAHostKeeper.ActiveChannels(ctx, &types.QueryActiveChannelsRequest{})
+	suite.Require().NoError(err)
+
+	expActiveChannels := []icatypes.ActiveChannel{
+		{
+			ConnectionId: path.EndpointB.ConnectionID,
+			PortId:       TestPortID,
+			ChannelId:    path.EndpointB.ChannelID,
+		},
+	}
+	suite.Require().Equal(expActiveChannels, res.ActiveChannels)
+
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.ActiveChannels(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryActiveChannel() {
+	var req *types.QueryActiveChannelRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid port identifier", func() {
+				req.PortId = ""
+			}, false,
+		},
+		{
+			"invalid connection identifier", func() {
+				req.ConnectionId = ""
+			}, false,
+		},
+		{
+			"active channel not found", func() {
+				req.ConnectionId = "connection-100"
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryActiveChannelRequest{
+				PortId:       TestPortID,
+				ConnectionId: path.EndpointB.ConnectionID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ActiveChannel(sdk.WrapSDKContext(suite.chainB.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointB.ChannelID, res.ChannelId)
+				suite.Require().Equal(channeltypes.OPEN, res.State)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryMessagePolicies() {
+	suite.SetupTest()
+
+	connectionPolicy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.staking.v1beta1.*"}, nil, nil)
+	chainPolicy := types.NewMessagePolicy("", suite.chainA.ChainID, []string{"*"}, nil, nil)
+
+	suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), connectionPolicy)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), chainPolicy)
+
+	ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+
+	res, err := suite.chainB.GetSimApp().ICAHostKeeper.MessagePolicies(ctx, &types.QueryMessagePoliciesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.MessagePolicy{connectionPolicy, chainPolicy}, res.MessagePolicies)
+
+	res, err = suite.chainB.GetSimApp().ICAHostKeeper.MessagePolicies(ctx, &types.QueryMessagePoliciesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.MessagePolicies, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.MessagePolicies(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelMessagePolicy() {
+	var (
+		path *ibctesting.Path
+		req  *types.QueryChannelMessagePolicyRequest
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid channel identifier", func() {
+				req.ChannelId = ""
+			}, false,
+		},
+		{
+			"message policy not found", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.DeleteMessagePolicy(suite.chainB.GetContext(), path.EndpointB.ConnectionID, "")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			expPolicy := types.NewMessagePolicy(path.EndpointB.ConnectionID, "", []string{"*"}, nil, nil)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), expPolicy)
+
+			req = &types.QueryChannelMessagePolicyRequest{
+				ChannelId: path.EndpointB.ChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ChannelMessagePolicy(sdk.WrapSDKContext(suite.chainB.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPolicy, res.MessagePolicy)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 // OnChanOpenTry performs basic validation of the ICA channel
 // and registers a new interchain account (if it doesn't exist).
+// The channel may be ORDERED, ORDERED_ALLOW_TIMEOUT or UNORDERED.
 // The version returned will include the registered interchain
 // account address.
 func (k Keeper) OnChanOpenTry(
@@ -27,8 +28,8 @@ func (k Keeper) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
-	if order != channeltypes.ORDERED {
-		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if !order.IsValid() {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s, %s or %s channel, got %s", channeltypes.ORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT, channeltypes.UNORDERED, order)
 	}
 
 	if portID != icatypes.PortID {
@@ -70,12 +71,19 @@ func (k Keeper) OnChanOpenTry(
 		return "", sdkerrors.Wrapf(err, "failed to claim capability for channel %s on port %s", channelID, portID)
 	}
 
-	accAddress := icatypes.GenerateAddress(k.accountKeeper.GetModuleAddress(icatypes.ModuleName), metadata.HostConnectionId, counterparty.PortId)
+	// the interchain account registered for the controller port identifier is reused when a channel is reopened,
+	// regardless of the ordering of the previous channel
+	address, found := k.GetInterchainAccountAddress(ctx, metadata.HostConnectionId, counterparty.PortId)
+	if !found {
+		accAddress := icatypes.GenerateAddress(k.accountKeeper.GetModuleAddress(icatypes.ModuleName), metadata.HostConnectionId, counterparty.PortId)
+
+		// Register interchain account if it does not already exist
+		k.RegisterInterchainAccount(ctx, metadata.HostConnectionId, counterparty.PortId, accAddress)
 
-	// Register interchain account if it does not already exist
-	k.RegisterInterchainAccount(ctx, metadata.HostConnectionId, counterparty.PortId, accAddress)
+		address = accAddress.String()
+	}
 
-	metadata.Address = accAddress.String()
+	metadata.Address = address
 	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
 	if err != nil {
 		return "", err
@@ -30,6 +30,19 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			},
 			true,
 		},
+		{
+			"success - proto3json encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
 		{
 			"success - reopening closed active channel",
 			func() {
@@ -61,10 +74,17 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			}, false,
 		},
 		{
-			"invalid order - UNORDERED",
+			"success: UNORDERED channel",
 			func() {
 				channel.Ordering = channeltypes.UNORDERED
 			},
+			true,
+		},
+		{
+			"invalid order - NONE",
+			func() {
+				channel.Ordering = channeltypes.NONE
+			},
 			false,
 		},
 		{
@@ -219,6 +239,11 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				// the host chain accepts the encoding proposed by the controller chain
+				versionMetadata, err := icatypes.MetadataFromVersion(version)
+				suite.Require().NoError(err)
+				suite.Require().Equal(metadata.Encoding, versionMetadata.Encoding)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
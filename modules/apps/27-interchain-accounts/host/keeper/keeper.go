@@ -7,6 +7,7 @@ import (
 	baseapp "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -27,17 +28,20 @@ type Keeper struct {
 	channelKeeper icatypes.ChannelKeeper
 	portKeeper    icatypes.PortKeeper
 	accountKeeper icatypes.AccountKeeper
+	bankKeeper    icatypes.BankKeeper
 
 	scopedKeeper capabilitykeeper.ScopedKeeper
 
-	msgRouter *baseapp.MsgServiceRouter
+	msgRouter   *baseapp.MsgServiceRouter
+	queryRouter *baseapp.GRPCQueryRouter
 }
 
 // NewKeeper creates a new interchain accounts host Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
-	accountKeeper icatypes.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
+	accountKeeper icatypes.AccountKeeper, bankKeeper icatypes.BankKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
+	queryRouter *baseapp.GRPCQueryRouter,
 ) Keeper {
 
 	// ensure ibc interchain accounts module account is set
@@ -57,8 +61,10 @@ func NewKeeper(
 		channelKeeper: channelKeeper,
 		portKeeper:    portKeeper,
 		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
 		scopedKeeper:  scopedKeeper,
 		msgRouter:     msgRouter,
+		queryRouter:   queryRouter,
 	}
 }
 
@@ -186,8 +192,42 @@ func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []icatypes.RegisteredI
 	return interchainAccounts
 }
 
-// SetInterchainAccountAddress stores the InterchainAccount address, keyed by the associated connectionID and portID
+// GetRegisteredInterchainAccount returns the registered interchain account, including its associated connection and
+// controller port identifiers, for the provided interchain account address
+func (k Keeper) GetRegisteredInterchainAccount(ctx sdk.Context, address string) (icatypes.RegisteredInterchainAccount, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyInterchainAccount(address))
+	if bz == nil {
+		return icatypes.RegisteredInterchainAccount{}, false
+	}
+
+	var account icatypes.RegisteredInterchainAccount
+	k.cdc.MustUnmarshal(bz, &account)
+
+	return account, true
+}
+
+// SetInterchainAccountAddress stores the InterchainAccount address, keyed by the associated connectionID and portID.
+// The registered interchain account is also indexed by its address.
 func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portID, address string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
+
+	account := icatypes.RegisteredInterchainAccount{
+		ConnectionId:   connectionID,
+		PortId:         portID,
+		AccountAddress: address,
+	}
+	store.Set(types.KeyInterchainAccount(address), k.cdc.MustMarshal(&account))
+}
+
+// GetAppMetadata retrieves the interchain accounts channel metadata from the channel version of the provided port and
+// channel identifiers. The metadata holds the encoding negotiated for the packet data and acknowledgements of the channel.
+func (k Keeper) GetAppMetadata(ctx sdk.Context, portID, channelID string) (icatypes.Metadata, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return icatypes.Metadata{}, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return icatypes.MetadataFromVersion(channel.Version)
 }
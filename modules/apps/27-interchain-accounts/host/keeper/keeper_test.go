@@ -33,6 +33,15 @@ var (
 		Encoding:               icatypes.EncodingProtobuf,
 		TxType:                 icatypes.TxTypeSDKMultiMsg,
 	}))
+
+	// TestProto3JSONVersion defines a resuable interchainaccounts version string using the proto3json encoding for testing purposes
+	TestProto3JSONVersion = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: ibctesting.FirstConnectionID,
+		HostConnectionId:       ibctesting.FirstConnectionID,
+		Encoding:               icatypes.EncodingProto3JSON,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
 )
 
 type KeeperTestSuite struct {
@@ -95,7 +104,7 @@ func RegisterInterchainAccount(endpoint *ibctesting.Endpoint, owner string) erro
 
 	channelSequence := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(endpoint.Chain.GetContext())
 
-	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner); err != nil {
+	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner, endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order); err != nil {
 		return err
 	}
 
@@ -148,6 +157,29 @@ func (suite *KeeperTestSuite) TestGetInterchainAccountAddress() {
 	suite.Require().Empty(retrievedAddr)
 }
 
+func (suite *KeeperTestSuite) TestGetRegisteredInterchainAccount() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	expectedAccount := icatypes.RegisteredInterchainAccount{
+		ConnectionId:   ibctesting.FirstConnectionID,
+		PortId:         TestPortID,
+		AccountAddress: TestAccAddress.String(),
+	}
+
+	account, found := suite.chainB.GetSimApp().ICAHostKeeper.GetRegisteredInterchainAccount(suite.chainB.GetContext(), TestAccAddress.String())
+	suite.Require().True(found)
+	suite.Require().Equal(expectedAccount, account)
+
+	_, found = suite.chainB.GetSimApp().ICAHostKeeper.GetRegisteredInterchainAccount(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress().String())
+	suite.Require().False(found)
+}
+
 func (suite *KeeperTestSuite) TestGetAllActiveChannels() {
 	var (
 		expectedChannelID string = "test-channel"
@@ -241,3 +273,22 @@ func (suite *KeeperTestSuite) TestSetInterchainAccountAddress() {
 	suite.Require().True(found)
 	suite.Require().Equal(expectedAccAddr, retrievedAddr)
 }
+
+func (suite *KeeperTestSuite) TestGetAppMetadata() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = TestProto3JSONVersion
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	metadata, err := suite.chainB.GetSimApp().ICAHostKeeper.GetAppMetadata(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(icatypes.EncodingProto3JSON, metadata.Encoding)
+	suite.Require().Equal(TestAccAddress.String(), metadata.Address)
+
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.GetAppMetadata(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, "invalid-channel-id")
+	suite.Require().Error(err)
+}
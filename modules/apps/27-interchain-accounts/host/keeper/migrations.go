@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator. A nil keeper may be provided if the host submodule is not enabled
+// by the application.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the host submodule from consensus version 1 to 2. It sets the host parameters introduced
+// since version 1 and indexes the registered interchain accounts by address.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.MigrateParams(ctx); err != nil {
+		return err
+	}
+
+	return m.MigrateInterchainAccounts(ctx)
+}
+
+// MigrateParams sets the host parameters introduced by interchain queries and gas metering to their default values.
+// Interchain queries are disabled until gRPC query paths are added to the allow queries parameter, and no fee is
+// deducted from interchain accounts until a gas price is set.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	m.keeper.paramSpace.Set(ctx, types.KeyAllowQueries, []string(nil))
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxQueryGas, types.DefaultMaxQueryGas)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxGasPerPacket, types.DefaultMaxGasPerPacket)
+	m.keeper.paramSpace.Set(ctx, types.KeyGasPrice, sdk.DecCoins(nil))
+
+	m.keeper.Logger(ctx).Info("successfully migrated host params", "max query gas", types.DefaultMaxQueryGas, "max gas per packet", types.DefaultMaxGasPerPacket)
+
+	return nil
+}
+
+// MigrateInterchainAccounts indexes the interchain accounts registered prior to version 2 by address, allowing the
+// registered interchain account of an address to be retrieved without iterating over every interchain account.
+func (m Migrator) MigrateInterchainAccounts(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	accounts := m.keeper.GetAllInterchainAccounts(ctx)
+	for _, account := range accounts {
+		m.keeper.SetInterchainAccountAddress(ctx, account.ConnectionId, account.PortId, account.AccountAddress)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully indexed interchain accounts", "accounts", len(accounts))
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestMigrateParams() {
+	ctx := suite.chainB.GetContext()
+
+	// remove the interchain queries and gas metering params from the paramstore, as if they were never set
+	paramStore := prefix.NewStore(ctx.KVStore(suite.chainB.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(types.SubModuleName+"/"))
+	paramStore.Delete(types.KeyAllowQueries)
+	paramStore.Delete(types.KeyMaxQueryGas)
+	paramStore.Delete(types.KeyMaxGasPerPacket)
+	paramStore.Delete(types.KeyGasPrice)
+
+	migrator := keeper.NewMigrator(&suite.chainB.GetSimApp().ICAHostKeeper)
+	suite.Require().NoError(migrator.MigrateParams(ctx))
+
+	expParams := types.NewParams(types.DefaultHostEnabled, nil, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+	suite.Require().Equal(expParams, suite.chainB.GetSimApp().ICAHostKeeper.GetParams(ctx))
+
+	// the migration is a no-op if the host submodule is not enabled by the application
+	suite.Require().NoError(keeper.NewMigrator(nil).MigrateParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigrateInterchainAccounts() {
+	ctx := suite.chainB.GetContext()
+	store := ctx.KVStore(suite.chainB.GetSimApp().GetKey(types.StoreKey))
+
+	// store the interchain account without its address index, as registered prior to version 2
+	store.Set(icatypes.KeyOwnerAccount(TestPortID, ibctesting.FirstConnectionID), []byte(TestAccAddress.String()))
+
+	_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetRegisteredInterchainAccount(ctx, TestAccAddress.String())
+	suite.Require().False(found)
+
+	migrator := keeper.NewMigrator(&suite.chainB.GetSimApp().ICAHostKeeper)
+	suite.Require().NoError(migrator.MigrateInterchainAccounts(ctx))
+
+	expAccount := icatypes.RegisteredInterchainAccount{
+		ConnectionId:   ibctesting.FirstConnectionID,
+		PortId:         TestPortID,
+		AccountAddress: TestAccAddress.String(),
+	}
+
+	account, found := suite.chainB.GetSimApp().ICAHostKeeper.GetRegisteredInterchainAccount(ctx, TestAccAddress.String())
+	suite.Require().True(found)
+	suite.Require().Equal(expAccount, account)
+
+	// the migration is a no-op if the host submodule is not enabled by the application
+	suite.Require().NoError(keeper.NewMigrator(nil).MigrateInterchainAccounts(ctx))
+}
@@ -21,9 +21,37 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// GetAllowQueries retrieves the host allowed gRPC query paths from the paramstore
+func (k Keeper) GetAllowQueries(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyAllowQueries, &res)
+	return res
+}
+
+// GetMaxQueryGas retrieves the maximum gas consumed by the queries of a packet from the paramstore
+func (k Keeper) GetMaxQueryGas(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxQueryGas, &res)
+	return res
+}
+
+// GetMaxGasPerPacket retrieves the maximum gas consumed by the messages of a packet from the paramstore
+func (k Keeper) GetMaxGasPerPacket(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxGasPerPacket, &res)
+	return res
+}
+
+// GetGasPrice retrieves the fee per unit of gas limit deducted from interchain accounts from the paramstore
+func (k Keeper) GetGasPrice(ctx sdk.Context) sdk.DecCoins {
+	var res sdk.DecCoins
+	k.paramSpace.Get(ctx, types.KeyGasPrice, &res)
+	return res
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx), k.GetAllowQueries(ctx), k.GetMaxQueryGas(ctx), k.GetMaxGasPerPacket(ctx), k.GetGasPrice(ctx))
 }
 
 // SetParams sets the total set of the host submodule parameters.
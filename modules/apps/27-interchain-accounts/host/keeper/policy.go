@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+// GetMessagePolicy retrieves the message policy applying to the provided connection, or to the provided
+// counterparty chain if the connection identifier is empty
+func (k Keeper) GetMessagePolicy(ctx sdk.Context, connectionID, counterpartyChainID string) (types.MessagePolicy, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyMessagePolicy(connectionID, counterpartyChainID))
+	if bz == nil {
+		return types.MessagePolicy{}, false
+	}
+
+	var policy types.MessagePolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+
+	return policy, true
+}
+
+// SetMessagePolicy stores the provided message policy, keyed by its connection or counterparty chain identifier
+func (k Keeper) SetMessagePolicy(ctx sdk.Context, policy types.MessagePolicy) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&policy)
+	store.Set(types.KeyMessagePolicy(policy.ConnectionId, policy.CounterpartyChainId), bz)
+}
+
+// DeleteMessagePolicy removes the message policy applying to the provided connection, or to the provided
+// counterparty chain if the connection identifier is empty
+func (k Keeper) DeleteMessagePolicy(ctx sdk.Context, connectionID, counterpartyChainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMessagePolicy(connectionID, counterpartyChainID))
+}
+
+// GetAllMessagePolicies returns all the message policies applying to connections and counterparty chains
+func (k Keeper) GetAllMessagePolicies(ctx sdk.Context) []types.MessagePolicy {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.MessagePolicyKeyPrefix))
+	defer iterator.Close()
+
+	var policies []types.MessagePolicy
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.MessagePolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
+// GetChannelMessagePolicy returns the message policy applying to the provided host channel. The policy applying to
+// the connection of the channel takes precedence over the policy applying to the chain identifier of the client
+// state of the channel, which only narrows the allow messages host parameter. False is returned if no message policy
+// applies to the channel.
+func (k Keeper) GetChannelMessagePolicy(ctx sdk.Context, portID, channelID string) (types.MessagePolicy, bool) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.MessagePolicy{}, false
+	}
+
+	if policy, found := k.GetMessagePolicy(ctx, channel.ConnectionHops[0], ""); found {
+		return policy, true
+	}
+
+	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return types.MessagePolicy{}, false
+	}
+
+	// only client states tracking a chain identifier, such as 07-tendermint, may be subject to a counterparty chain policy
+	chainClientState, ok := clientState.(interface{ GetChainID() string })
+	if !ok {
+		return types.MessagePolicy{}, false
+	}
+
+	return k.GetMessagePolicy(ctx, "", chainClientState.GetChainID())
+}
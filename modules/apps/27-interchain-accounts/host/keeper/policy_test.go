@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestSetMessagePolicy() {
+	suite.SetupTest()
+
+	ctx := suite.chainB.GetContext()
+	keeper := suite.chainB.GetSimApp().ICAHostKeeper
+
+	connectionPolicy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.staking.v1beta1.*"}, nil, nil)
+	chainPolicy := types.NewMessagePolicy("", suite.chainA.ChainID, []string{"*"}, []string{"/cosmos.gov.v1beta1.*"}, nil)
+
+	keeper.SetMessagePolicy(ctx, connectionPolicy)
+	keeper.SetMessagePolicy(ctx, chainPolicy)
+
+	policy, found := keeper.GetMessagePolicy(ctx, ibctesting.FirstConnectionID, "")
+	suite.Require().True(found)
+	suite.Require().Equal(connectionPolicy, policy)
+
+	policy, found = keeper.GetMessagePolicy(ctx, "", suite.chainA.ChainID)
+	suite.Require().True(found)
+	suite.Require().Equal(chainPolicy, policy)
+
+	suite.Require().ElementsMatch([]types.MessagePolicy{connectionPolicy, chainPolicy}, keeper.GetAllMessagePolicies(ctx))
+
+	keeper.DeleteMessagePolicy(ctx, ibctesting.FirstConnectionID, "")
+
+	_, found = keeper.GetMessagePolicy(ctx, ibctesting.FirstConnectionID, "")
+	suite.Require().False(found)
+	suite.Require().Equal([]types.MessagePolicy{chainPolicy}, keeper.GetAllMessagePolicies(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetChannelMessagePolicy() {
+	var (
+		path      *ibctesting.Path
+		expPolicy types.MessagePolicy
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expFound bool
+	}{
+		{
+			"no message policy", func() {}, false,
+		},
+		{
+			"message policy of connection", func() {
+				expPolicy = types.NewMessagePolicy(path.EndpointB.ConnectionID, "", []string{"*"}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), expPolicy)
+			}, true,
+		},
+		{
+			"message policy of counterparty chain", func() {
+				expPolicy = types.NewMessagePolicy("", suite.chainA.ChainID, []string{"*"}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), expPolicy)
+			}, true,
+		},
+		{
+			"message policy of connection takes precedence over message policy of counterparty chain", func() {
+				chainPolicy := types.NewMessagePolicy("", suite.chainA.ChainID, []string{"*"}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), chainPolicy)
+
+				expPolicy = types.NewMessagePolicy(path.EndpointB.ConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), expPolicy)
+			}, true,
+		},
+		{
+			"message policy of another counterparty chain", func() {
+				policy := types.NewMessagePolicy("", suite.chainC.ChainID, []string{"*"}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			policy, found := suite.chainB.GetSimApp().ICAHostKeeper.GetChannelMessagePolicy(suite.chainB.GetContext(), icatypes.PortID, path.EndpointB.ChannelID)
+
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(expPolicy, policy)
+			}
+		})
+	}
+}
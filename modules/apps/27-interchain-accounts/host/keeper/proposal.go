@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+// HandleSetMessagePolicyProposal will try to add or replace the message policy applying to a connection or
+// counterparty chain. A policy may only be set for an existing connection.
+func (k Keeper) HandleSetMessagePolicyProposal(ctx sdk.Context, p *types.SetMessagePolicyProposal) error {
+	if p.Policy.ConnectionId != "" {
+		if _, err := k.channelKeeper.GetConnection(ctx, p.Policy.ConnectionId); err != nil {
+			return err
+		}
+	}
+
+	k.SetMessagePolicy(ctx, p.Policy)
+
+	k.Logger(ctx).Info("message policy set by governance", "connection-id", p.Policy.ConnectionId, "counterparty-chain-id", p.Policy.CounterpartyChainId)
+
+	EmitMessagePolicyProposalEvent(ctx, types.EventTypeSetMessagePolicy, p.Policy.ConnectionId, p.Policy.CounterpartyChainId)
+
+	return nil
+}
+
+// HandleRemoveMessagePolicyProposal will try to remove the message policy applying to a connection or counterparty chain
+func (k Keeper) HandleRemoveMessagePolicyProposal(ctx sdk.Context, p *types.RemoveMessagePolicyProposal) error {
+	if _, found := k.GetMessagePolicy(ctx, p.ConnectionId, p.CounterpartyChainId); !found {
+		return sdkerrors.Wrapf(types.ErrMessagePolicyNotFound, "connection ID (%s) counterparty chain ID (%s)", p.ConnectionId, p.CounterpartyChainId)
+	}
+
+	k.DeleteMessagePolicy(ctx, p.ConnectionId, p.CounterpartyChainId)
+
+	k.Logger(ctx).Info("message policy removed by governance", "connection-id", p.ConnectionId, "counterparty-chain-id", p.CounterpartyChainId)
+
+	EmitMessagePolicyProposalEvent(ctx, types.EventTypeRemoveMessagePolicy, p.ConnectionId, p.CounterpartyChainId)
+
+	return nil
+}
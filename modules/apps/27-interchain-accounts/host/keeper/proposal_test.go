@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestHandleSetMessagePolicyProposal() {
+	var proposal *types.SetMessagePolicyProposal
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: message policy of connection", func() {}, true},
+		{"success: message policy of counterparty chain", func() {
+			proposal.Policy = types.NewMessagePolicy("", suite.chainA.ChainID, []string{"*"}, nil, nil)
+		}, true},
+		{"connection not found", func() { proposal.Policy.ConnectionId = "connection-100" }, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			proposal = types.NewSetMessagePolicyProposal(
+				ibctesting.Title, ibctesting.Description,
+				types.NewMessagePolicy(path.EndpointB.ConnectionID, "", []string{"/cosmos.staking.v1beta1.*"}, nil, nil),
+			).(*types.SetMessagePolicyProposal)
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			keeper := suite.chainB.GetSimApp().ICAHostKeeper
+
+			err := keeper.HandleSetMessagePolicyProposal(ctx, proposal)
+
+			policy, found := keeper.GetMessagePolicy(ctx, proposal.Policy.ConnectionId, proposal.Policy.CounterpartyChainId)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(proposal.Policy, policy)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleRemoveMessagePolicyProposal() {
+	suite.SetupTest()
+
+	ctx := suite.chainB.GetContext()
+	keeper := suite.chainB.GetSimApp().ICAHostKeeper
+
+	proposal := types.NewRemoveMessagePolicyProposal(ibctesting.Title, ibctesting.Description, "", suite.chainA.ChainID).(*types.RemoveMessagePolicyProposal)
+
+	err := keeper.HandleRemoveMessagePolicyProposal(ctx, proposal)
+	suite.Require().ErrorIs(err, types.ErrMessagePolicyNotFound)
+
+	keeper.SetMessagePolicy(ctx, types.NewMessagePolicy("", suite.chainA.ChainID, []string{"*"}, nil, nil))
+
+	err = keeper.HandleRemoveMessagePolicyProposal(ctx, proposal)
+	suite.Require().NoError(err)
+
+	_, found := keeper.GetMessagePolicy(ctx, "", suite.chainA.ChainID)
+	suite.Require().False(found)
+}
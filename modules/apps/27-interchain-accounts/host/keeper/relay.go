@@ -1,17 +1,21 @@
 package keeper
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/gogo/protobuf/proto"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
-// OnRecvPacket handles a given interchain accounts packet on a destination host chain.
-// If the transaction is successfully executed, the transaction response bytes will be returned.
+// OnRecvPacket handles a given interchain accounts packet on a destination host chain. Replay protection is provided
+// by the packet receipts of core IBC. The fee for the gas limit of the packet is deducted from the interchain account
+// before the transaction is executed, the serialized transaction or query responses are then returned.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 
@@ -20,91 +24,336 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
 	}
 
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.DestinationPort, packet.DestinationChannel)
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(channel.Version)
+	if err != nil {
+		return nil, err
+	}
+
 	switch data.Type {
 	case icatypes.EXECUTE_TX:
-		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
+		switch data.ExecutionMode {
+		case icatypes.ATOMIC, icatypes.PARTIAL:
+		default:
+			return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "unsupported execution mode %s", data.ExecutionMode)
+		}
+
+		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data, metadata.Encoding)
+		if err != nil {
+			return nil, err
+		}
+
+		gasLimit, err := k.getPacketGasLimit(ctx, data.GasLimit)
 		if err != nil {
 			return nil, err
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		fee, err := k.deductFee(ctx, channel.ConnectionHops[0], packet.SourcePort, gasLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		// the messages are executed with a gas meter limited to the gas limit of the packet
+		gasMeter := sdk.NewGasMeter(gasLimit)
+
+		var txResponse []byte
+		if data.ExecutionMode == icatypes.PARTIAL {
+			txResponse, err = k.executeTxPartial(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs, gasMeter, metadata.Encoding)
+		} else {
+			txResponse, err = k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs, gasMeter, metadata.Encoding)
+		}
+
+		// the gas consumed by the messages is charged to the transaction relaying the packet
+		gasUsed := gasMeter.GasConsumedToLimit()
+		ctx.GasMeter().ConsumeGas(gasUsed, "interchain account transaction")
+		EmitExecuteTxEvent(ctx, packet, gasLimit, gasUsed, fee)
+
 		if err != nil {
 			return nil, err
 		}
 
 		return txResponse, nil
+	case icatypes.EXECUTE_QUERY:
+		if data.ExecutionMode != icatypes.ATOMIC {
+			return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "execution mode %s is not supported by queries", data.ExecutionMode)
+		}
+
+		reqs, err := icatypes.DeserializeCosmosQuery(data.Data, metadata.Encoding)
+		if err != nil {
+			return nil, err
+		}
+
+		queryResponse, err := k.executeQuery(ctx, reqs, metadata.Encoding)
+		if err != nil {
+			return nil, err
+		}
+
+		return queryResponse, nil
 	default:
 		return nil, icatypes.ErrUnknownDataType
 	}
 }
 
-// executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
-// If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
-// into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
-// execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+// executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer and
+// ensuring the total amounts of the transaction satisfy the message policy applying to the channel. Each message is
+// then authorized against the message policy applying to the channel, validated and delivered into state.
+// The state changes will only be committed if all messages in the transaction succeed. Thus the execution of the
+// transaction is atomic, all state changes are reverted if a single message fails. The messages are executed using the
+// provided gas meter. The success, response data and gas consumed of each message are returned along with the
+// deterministic error description of the failing message, every message being reported as failed if the state changes
+// are reverted. A failing message, including a message failing authentication, does not fail the packet so that the
+// fee deducted for the transaction is kept.
+func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg, gasMeter sdk.GasMeter, encoding string) ([]byte, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
 	if !found {
 		return nil, channeltypes.ErrChannelNotFound
 	}
 
 	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
-		return nil, err
+		return rejectTx(msgs, gasMeter, err, encoding)
 	}
 
-	txMsgData := &sdk.TxMsgData{
-		Data: make([]*sdk.MsgData, len(msgs)),
+	if err := k.authorizeTx(ctx, msgs, destPort, destChannel); err != nil {
+		return rejectTx(msgs, gasMeter, err, encoding)
+	}
+
+	txResult := &icatypes.TxResult{
+		Results:  make([]icatypes.MsgResult, len(msgs)),
+		GasLimit: gasMeter.Limit(),
+	}
+
+	for i, msg := range msgs {
+		txResult.Results[i].MsgType = sdk.MsgTypeURL(msg)
 	}
 
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
 	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	success := true
 	for i, msg := range msgs {
-		if err := msg.ValidateBasic(); err != nil {
-			return nil, err
+		gasConsumed := gasMeter.GasConsumedToLimit()
+
+		var msgResponse []byte
+		// the msg is authorized outside of the gas meter of the packet, as in PARTIAL execution mode
+		err := k.validateMsg(ctx, msg, destPort, destChannel)
+		if err == nil {
+			msgResponse, err = k.executeMsg(cacheCtx, msg)
 		}
 
-		msgResponse, err := k.executeMsg(cacheCtx, msg)
+		txResult.Results[i].Data = msgResponse
+		txResult.Results[i].GasUsed = gasMeter.GasConsumedToLimit() - gasConsumed
+
 		if err != nil {
-			return nil, err
+			txResult.Results[i].Error = types.NewMsgErrorString(err)
+			success = false
+			break
+		}
+	}
+
+	if success {
+		for i := range txResult.Results {
+			txResult.Results[i].Success = true
+		}
+
+		// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+	} else {
+		// the state changes of the messages are discarded along with their responses
+		for i := range txResult.Results {
+			txResult.Results[i].Data = nil
 		}
+	}
+
+	txResult.GasUsed = gasMeter.GasConsumedToLimit()
+
+	txResponse, err := icatypes.SerializeTxResult(txResult, encoding)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal tx result")
+	}
+
+	return txResponse, nil
+}
+
+// executeTxPartial attempts to execute each message of the provided transaction independently. It begins by
+// authenticating the transaction signer and ensuring the total amounts of the transaction satisfy the message policy
+// applying to the channel. Each message is then authorized against the message policy applying to the
+// channel, validated and delivered into state on its own branch of the state, which is only committed if the message
+// succeeds. The messages are executed using the provided gas meter. The success, response data, gas consumed and
+// deterministic error description of each message are returned. Every message is reported as failed if a message
+// fails authentication. A failing message does not fail the packet so that the fee deducted for the transaction is kept.
+func (k Keeper) executeTxPartial(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg, gasMeter sdk.GasMeter, encoding string) ([]byte, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+		return rejectTx(msgs, gasMeter, err, encoding)
+	}
+
+	if err := k.authorizeTx(ctx, msgs, destPort, destChannel); err != nil {
+		return rejectTx(msgs, gasMeter, err, encoding)
+	}
 
-		txMsgData.Data[i] = &sdk.MsgData{
+	txResult := &icatypes.TxResult{
+		Results:  make([]icatypes.MsgResult, len(msgs)),
+		GasLimit: gasMeter.Limit(),
+	}
+
+	for i, msg := range msgs {
+		gasConsumed := gasMeter.GasConsumedToLimit()
+
+		msgResponse, err := k.executeMsgIsolated(ctx, msg, gasMeter, destPort, destChannel)
+
+		txResult.Results[i] = icatypes.MsgResult{
 			MsgType: sdk.MsgTypeURL(msg),
+			Success: err == nil,
 			Data:    msgResponse,
+			GasUsed: gasMeter.GasConsumedToLimit() - gasConsumed,
 		}
 
+		if err != nil {
+			txResult.Results[i].Error = types.NewMsgErrorString(err)
+		}
+	}
+
+	txResult.GasUsed = gasMeter.GasConsumedToLimit()
+
+	txResponse, err := icatypes.SerializeTxResult(txResult, encoding)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal tx result")
+	}
+
+	return txResponse, nil
+}
+
+// rejectTx returns the result of a transaction rejected prior to the execution of its messages, every message being
+// reported as failed and the message recorded by the provided MsgError carrying its error description. Other errors
+// are returned, failing the packet.
+func rejectTx(msgs []sdk.Msg, gasMeter sdk.GasMeter, err error, encoding string) ([]byte, error) {
+	var msgErr *types.MsgError
+	if !errors.As(err, &msgErr) {
+		return nil, err
+	}
+
+	txResult := &icatypes.TxResult{
+		Results:  make([]icatypes.MsgResult, len(msgs)),
+		GasLimit: gasMeter.Limit(),
+	}
+
+	for i, msg := range msgs {
+		txResult.Results[i].MsgType = sdk.MsgTypeURL(msg)
+	}
+
+	txResult.Results[msgErr.Index].Error = types.NewMsgErrorString(msgErr)
+
+	txResponse, err := icatypes.SerializeTxResult(txResult, encoding)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal tx result")
+	}
+
+	return txResponse, nil
+}
+
+// executeMsgIsolated authorizes, validates and executes the provided msg using the provided gas meter on a branch of
+// the state which is only committed if the msg succeeds
+func (k Keeper) executeMsgIsolated(ctx sdk.Context, msg sdk.Msg, gasMeter sdk.GasMeter, portID, channelID string) ([]byte, error) {
+	if err := k.validateMsg(ctx, msg, portID, channelID); err != nil {
+		return nil, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+	msgResponse, err := k.executeMsg(cacheCtx, msg)
+	if err != nil {
+		return nil, err
 	}
 
 	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	writeCache()
 
-	txResponse, err := proto.Marshal(txMsgData)
+	return msgResponse, nil
+}
+
+// validateMsg authorizes the provided msg against the message policy applying to the provided host channel and
+// does basic validation of the msg
+func (k Keeper) validateMsg(ctx sdk.Context, msg sdk.Msg, portID, channelID string) error {
+	if err := k.authorizeMsg(ctx, msg, portID, channelID); err != nil {
+		return err
+	}
+
+	return msg.ValidateBasic()
+}
+
+// getPacketGasLimit returns the gas limit the messages of a packet are executed with. The max gas per packet host
+// parameter is used if the packet does not declare a gas limit.
+func (k Keeper) getPacketGasLimit(ctx sdk.Context, gasLimit uint64) (uint64, error) {
+	maxGas := k.GetMaxGasPerPacket(ctx)
+	if gasLimit == 0 {
+		return maxGas, nil
+	}
+
+	if gasLimit > maxGas {
+		return 0, sdkerrors.Wrapf(types.ErrGasLimitExceeded, "gas limit %d exceeds the maximum gas per packet %d", gasLimit, maxGas)
+	}
+
+	return gasLimit, nil
+}
+
+// deductFee deducts the fee for the provided gas limit, computed using the gas price host parameter, from the
+// interchain account associated with the provided connection and controller port identifiers, and sends it to the
+// fee collector. No fee is deducted if the gas price is empty.
+func (k Keeper) deductFee(ctx sdk.Context, connectionID, portID string, gasLimit uint64) (sdk.Coins, error) {
+	gasPrice := k.GetGasPrice(ctx)
+	if gasPrice.IsZero() {
+		return nil, nil
+	}
+
+	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
+		return nil, err
 	}
 
-	return txResponse, nil
+	// the fee is rounded up, as for the minimum gas prices of a validator
+	limit := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasLimit))
+	fee := make(sdk.Coins, len(gasPrice))
+	for i, price := range gasPrice {
+		fee[i] = sdk.NewCoin(price.Denom, price.Amount.Mul(limit).Ceil().RoundInt())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, authtypes.FeeCollectorName, fee); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to deduct fee %s from interchain account %s", fee, interchainAccountAddr)
+	}
+
+	return fee, nil
 }
 
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
+// from state using the provided controller port identifier. The returned error records the index of the
+// unauthenticated message.
 func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
 	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
 	if !found {
 		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetAllowMessages(ctx)
-	for _, msg := range msgs {
-		if !types.ContainsMsgType(allowMsgs, msg) {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
-		}
-
+	for i, msg := range msgs {
 		for _, signer := range msg.GetSigners() {
 			if interchainAccountAddr != signer.String() {
-				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String())
+				return types.NewMsgError(i, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String()))
 			}
 		}
 	}
@@ -112,14 +361,59 @@ func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, po
 	return nil
 }
 
+// authorizeTx ensures the total amounts of the provided msgs do not exceed the max amounts of the constraints of the
+// message policy applying to the provided host channel, if any
+func (k Keeper) authorizeTx(ctx sdk.Context, msgs []sdk.Msg, portID, channelID string) error {
+	policy, found := k.GetChannelMessagePolicy(ctx, portID, channelID)
+	if !found {
+		return nil
+	}
+
+	return policy.AuthorizeTx(msgs)
+}
+
+// authorizeMsg ensures the provided msg is permitted by the message policy applying to the provided host channel.
+// If no message policy applies to the connection of the channel, the msg must be present in the allow messages host
+// parameter and, if any, permitted by the message policy applying to the counterparty chain of the channel.
+func (k Keeper) authorizeMsg(ctx sdk.Context, msg sdk.Msg, portID, channelID string) error {
+	policy, found := k.GetChannelMessagePolicy(ctx, portID, channelID)
+	if found && policy.ConnectionId != "" {
+		return policy.Authorize(msg)
+	}
+
+	// clients may be created with any chain identifier, thus a counterparty chain policy may only narrow the
+	// allow messages host parameter
+	if !types.ContainsMsgType(k.GetAllowMessages(ctx), msg) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+	}
+
+	if found {
+		return policy.Authorize(msg)
+	}
+
+	return nil
+}
+
 // Attempts to get the message handler from the router and if found will then execute the message.
 // If the message execution is successful, the proto marshaled message response will be returned.
-func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) ([]byte, error) {
+// Running out of gas is returned as an error.
+func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (_ []byte, err error) {
 	handler := k.msgRouter.Handler(msg)
 	if handler == nil {
 		return nil, icatypes.ErrInvalidRoute
 	}
 
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas executing message %s in location: %s", sdk.MsgTypeURL(msg), outOfGas.Descriptor)
+		}
+	}()
+
 	res, err := handler(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -130,3 +424,72 @@ func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) ([]byte, error) {
 
 	return res.Data, nil
 }
+
+// executeQuery attempts to execute the provided queries against the host chain gRPC query router. Each query path
+// must be present in the allow queries host parameter and the queries must target the latest state without proofs.
+// The queries are executed on a branch of the state with a gas meter limited to the max query gas host parameter,
+// the gas consumed being charged to the current context. The queries are executed atomically, the responses are
+// only returned if all queries succeed.
+func (k Keeper) executeQuery(ctx sdk.Context, reqs []abci.RequestQuery, encoding string) ([]byte, error) {
+	allowQueries := k.GetAllowQueries(ctx)
+	for _, req := range reqs {
+		if !types.ContainsQueryPath(allowQueries, req.Path) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "query path not allowed: %s", req.Path)
+		}
+
+		if req.Height != 0 || req.Prove {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "query %s must target the latest height without proof", req.Path)
+		}
+	}
+
+	// the queries are executed on a branch of the state which is never written, the gas meter of the branched
+	// context bounding the gas consumed by the queries
+	queryGasMeter := sdk.NewGasMeter(k.GetMaxQueryGas(ctx))
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(queryGasMeter)
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(queryGasMeter.GasConsumedToLimit(), "interchain account queries")
+	}()
+
+	responses := make([]abci.ResponseQuery, len(reqs))
+	for i, req := range reqs {
+		res, err := k.executeQueryRequest(cacheCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		responses[i] = res
+	}
+
+	return icatypes.SerializeCosmosResponse(responses, encoding)
+}
+
+// Attempts to get the query handler from the router and if found will then execute the query.
+// Running out of query gas is returned as an error.
+func (k Keeper) executeQueryRequest(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery, err error) {
+	handler := k.queryRouter.Route(req.Path)
+	if handler == nil {
+		return abci.ResponseQuery{}, sdkerrors.Wrapf(icatypes.ErrInvalidRoute, "no route found for query path %s", req.Path)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas executing query %s in location: %s", req.Path, outOfGas.Descriptor)
+		}
+	}()
+
+	res, err = handler(ctx, req)
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
+	res.Height = ctx.BlockHeight()
+
+	return res, nil
+}
@@ -1,14 +1,19 @@
 package keeper_test
 
 import (
+	"fmt"
+	"strconv"
 	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -22,6 +27,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	var (
 		path       *ibctesting.Path
 		packetData []byte
+		encoding   string
 	)
 
 	testCases := []struct {
@@ -41,7 +47,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -51,7 +57,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -69,7 +75,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -79,7 +85,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -103,7 +109,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msgDelegate, msgUndelegate})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msgDelegate, msgUndelegate}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -113,7 +119,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -138,7 +144,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Proposer:       interchainAccountAddr,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -148,7 +154,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -177,7 +183,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Option:     govtypes.OptionYes,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -187,7 +193,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -203,7 +209,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Depositor: interchainAccountAddr,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -213,7 +219,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -229,7 +235,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					WithdrawAddress:  suite.chainB.SenderAccount.GetAddress().String(),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -239,7 +245,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -268,7 +274,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					TimeoutTimestamp: uint64(0),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -278,7 +284,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -307,7 +313,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{
 			"invalid packet type - UNSPECIFIED",
 			func() {
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -324,7 +330,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			func() {
 				path.EndpointA.ChannelConfig.PortID = "invalid-port-id"
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -345,7 +351,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -366,7 +372,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -376,11 +382,531 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			false,
 		},
+		{
+			"message policy of connection allows message type not present in allow messages",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				msg := &stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: validatorAddr.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				policy := types.NewMessagePolicy(path.EndpointB.ConnectionID, "", []string{"/cosmos.staking.v1beta1.*"}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			true,
+		},
+		{
+			"message policy of counterparty chain allows message type",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				msg := &stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: validatorAddr.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				policy := types.NewMessagePolicy("", suite.chainA.ChainID, []string{"/cosmos.staking.v1beta1.*"}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			true,
+		},
+		{
+			"message policy of counterparty chain cannot allow message type not present in allow messages",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				msg := &stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: validatorAddr.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				policy := types.NewMessagePolicy("", suite.chainA.ChainID, []string{"*"}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			false,
+		},
+		{
+			"message policy of counterparty chain denies message type present in allow messages",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				msg := &stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: validatorAddr.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				policy := types.NewMessagePolicy("", suite.chainA.ChainID, []string{"*"}, []string{"/cosmos.staking.v1beta1.*"}, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			false,
+		},
+		{
+			"message policy of connection takes precedence over message policy of counterparty chain",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				msg := &stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: validatorAddr.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				chainPolicy := types.NewMessagePolicy("", suite.chainA.ChainID, []string{"*"}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), chainPolicy)
+
+				connectionPolicy := types.NewMessagePolicy(path.EndpointB.ConnectionID, "", []string{"/cosmos.bank.v1beta1.*"}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), connectionPolicy)
+			},
+			false,
+		},
+		{
+			"message policy takes precedence over allow messages",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				msg := &stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: validatorAddr.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				policy := types.NewMessagePolicy(path.EndpointB.ConnectionID, "", []string{"*"}, []string{"/cosmos.staking.v1beta1.*"}, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			false,
+		},
+		{
+			"message exceeds max amount of message policy constraint",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				policy := types.NewMessagePolicy(path.EndpointB.ConnectionID, "", []string{"*"}, nil, []types.MessageConstraint{
+					types.NewMessageConstraint(sdk.MsgTypeURL(msg), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))), nil),
+				})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			false,
+		},
+		{
+			"total amount of messages exceeds max amount of message policy constraint",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg, msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				policy := types.NewMessagePolicy(path.EndpointB.ConnectionID, "", []string{"*"}, nil, []types.MessageConstraint{
+					types.NewMessageConstraint(sdk.MsgTypeURL(msg), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))), nil),
+				})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			false,
+		},
+		{
+			"message references validator not allowed by message policy constraint",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				msg := &stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: validatorAddr.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				allowedValidator := sdk.ValAddress(suite.chainB.SenderAccount.GetAddress())
+				policy := types.NewMessagePolicy(path.EndpointB.ConnectionID, "", []string{"*"}, nil, []types.MessageConstraint{
+					types.NewMessageConstraint("/cosmos.staking.v1beta1.*", nil, []string{allowedValidator.String()}),
+				})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			false,
+		},
+	}
+
+	versions := map[string]string{
+		icatypes.EncodingProtobuf:   TestVersion,
+		icatypes.EncodingProto3JSON: TestProto3JSONVersion,
+	}
+
+	for _, encoding = range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(fmt.Sprintf("%s: %s", encoding, tc.msg), func() {
+				suite.SetupTest() // reset
+
+				path = NewICAPath(suite.chainA, suite.chainB)
+				path.EndpointA.ChannelConfig.Version = versions[encoding]
+				suite.coordinator.SetupConnections(path)
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				portID, err := icatypes.NewControllerPortID(TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				// Get the address of the interchain account stored in state during handshake step
+				storedAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, portID)
+				suite.Require().True(found)
+
+				icaAddr, err := sdk.AccAddressFromBech32(storedAddr)
+				suite.Require().NoError(err)
+
+				// Check if account is created
+				interchainAccount := suite.chainB.GetSimApp().AccountKeeper.GetAccount(suite.chainB.GetContext(), icaAddr)
+				suite.Require().Equal(interchainAccount.GetAddress().String(), storedAddr)
+
+				suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+				tc.malleate() // malleate mutates test data
+
+				packet := channeltypes.NewPacket(
+					packetData,
+					suite.chainA.SenderAccount.GetSequence(),
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					clienttypes.NewHeight(0, 100),
+					0,
+				)
+
+				txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+					suite.Require().NotNil(txResponse)
+
+					txResult, err := icatypes.DeserializeTxResult(txResponse, encoding)
+					suite.Require().NoError(err)
+					suite.Require().NotEmpty(txResult.Results)
+					for _, msgResult := range txResult.Results {
+						suite.Require().True(msgResult.Success)
+					}
+				} else if err == nil {
+					// messages failing after authentication are reported as failed in the transaction result
+					txResult, err := icatypes.DeserializeTxResult(txResponse, encoding)
+					suite.Require().NoError(err)
+					suite.Require().NotEmpty(txResult.Results)
+					for _, msgResult := range txResult.Results {
+						suite.Require().False(msgResult.Success)
+						suite.Require().Nil(msgResult.Data)
+					}
+				} else {
+					suite.Require().Nil(txResponse)
+				}
+			})
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketQuery() {
+	var (
+		path       *ibctesting.Path
+		reqs       []abci.RequestQuery
+		packetData []byte
+		params     types.Params
+		encoding   string
+	)
+
+	balancePath := "/cosmos.bank.v1beta1.Query/Balance"
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: multiple queries", func() {
+				reqs = append(reqs, reqs[0])
+			}, true,
+		},
+		{
+			"query path not allowed", func() {
+				params.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/AllBalances"}
+			}, false,
+		},
+		{
+			"query route not found", func() {
+				reqs[0].Path = "/cosmos.bank.v1beta1.Query/Unknown"
+				params.AllowQueries = []string{reqs[0].Path}
+			}, false,
+		},
+		{
+			"query with height", func() {
+				reqs[0].Height = suite.chainB.GetContext().BlockHeight()
+			}, false,
+		},
+		{
+			"query with proof", func() {
+				reqs[0].Prove = true
+			}, false,
+		},
+		{
+			"query request is invalid", func() {
+				reqs[0].Data = []byte("invalid")
+			}, false,
+		},
+		{
+			"queries exceed the max query gas", func() {
+				params.MaxQueryGas = 1
+			}, false,
+		},
+		{
+			"cannot deserialize query", func() {
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_QUERY,
+					Data: []byte("invalid"),
+				}.GetBytes()
+			}, false,
+		},
+	}
+
+	versions := map[string]string{
+		icatypes.EncodingProtobuf:   TestVersion,
+		icatypes.EncodingProto3JSON: TestProto3JSONVersion,
+	}
+
+	for _, encoding = range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(fmt.Sprintf("%s: %s", encoding, tc.msg), func() {
+				suite.SetupTest() // reset
+
+				path = NewICAPath(suite.chainA, suite.chainB)
+				path.EndpointA.ChannelConfig.Version = versions[encoding]
+				suite.coordinator.SetupConnections(path)
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				expBalance := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))
+				suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(expBalance))
+
+				icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+				suite.Require().NoError(err)
+
+				req := banktypes.NewQueryBalanceRequest(icaAddr, sdk.DefaultBondDenom)
+				reqData, err := req.Marshal()
+				suite.Require().NoError(err)
+
+				reqs = []abci.RequestQuery{{Path: balancePath, Data: reqData}}
+				params = types.NewParams(true, nil, []string{balancePath}, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+				packetData = nil
+
+				tc.malleate() // malleate mutates test data
+
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				if packetData == nil {
+					data, err := icatypes.SerializeCosmosQuery(reqs, encoding)
+					suite.Require().NoError(err)
+
+					packetData = icatypes.InterchainAccountPacketData{
+						Type: icatypes.EXECUTE_QUERY,
+						Data: data,
+					}.GetBytes()
+				}
+
+				packet := channeltypes.NewPacket(
+					packetData,
+					suite.chainA.SenderAccount.GetSequence(),
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					clienttypes.NewHeight(0, 100),
+					0,
+				)
+
+				ctx := suite.chainB.GetContext()
+				gasConsumed := ctx.GasMeter().GasConsumed()
+
+				queryResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+					suite.Require().Greater(ctx.GasMeter().GasConsumed(), gasConsumed)
+
+					responses, err := icatypes.DeserializeCosmosResponse(queryResponse, encoding)
+					suite.Require().NoError(err)
+					suite.Require().Len(responses, len(reqs))
+
+					for _, res := range responses {
+						suite.Require().Equal(ctx.BlockHeight(), res.Height)
+
+						var balanceResponse banktypes.QueryBalanceResponse
+						err = balanceResponse.Unmarshal(res.Value)
+						suite.Require().NoError(err)
+						suite.Require().Equal(expBalance, *balanceResponse.Balance)
+					}
+				} else {
+					suite.Require().Error(err)
+					suite.Require().Nil(queryResponse)
+				}
+			})
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketExecutionMode() {
+	testCases := []struct {
+		msg           string
+		executionMode icatypes.ExecutionMode
+		expSuccess    []bool
+		expErrors     []bool
+		expBalance    sdk.Int
+	}{
+		{
+			"partial execution mode commits the successful messages", icatypes.PARTIAL,
+			[]bool{true, false, false, true}, []bool{false, true, true, false}, sdk.NewInt(10000 - 300),
+		},
+		{
+			"atomic execution mode reverts every message and reports the failing message", icatypes.ATOMIC,
+			[]bool{false, false, false, false}, []bool{false, true, false, false}, sdk.NewInt(10000),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -389,33 +915,199 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		suite.Run(tc.msg, func() {
 			suite.SetupTest() // reset
 
-			path = NewICAPath(suite.chainA, suite.chainB)
+			path := NewICAPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupConnections(path)
 
 			err := SetupICAPath(path, TestOwnerAddress)
 			suite.Require().NoError(err)
 
-			portID, err := icatypes.NewControllerPortID(TestOwnerAddress)
-			suite.Require().NoError(err)
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
 
-			// Get the address of the interchain account stored in state during handshake step
-			storedAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, portID)
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
 			suite.Require().True(found)
 
-			icaAddr, err := sdk.AccAddressFromBech32(storedAddr)
+			msgs := []sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				},
+				// insufficient funds
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000))),
+				},
+				// message type not allowed
+				&stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: sdk.ValAddress(suite.chainB.Vals.Validators[0].Address).String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+				},
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200))),
+				},
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type:          icatypes.EXECUTE_TX,
+				Data:          data,
+				ExecutionMode: tc.executionMode,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			accAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
 			suite.Require().NoError(err)
 
-			// Check if account is created
-			interchainAccount := suite.chainB.GetSimApp().AccountKeeper.GetAccount(suite.chainB.GetContext(), icaAddr)
-			suite.Require().Equal(interchainAccount.GetAddress().String(), storedAddr)
+			ctx := suite.chainB.GetContext()
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, accAddr, sdk.DefaultBondDenom)
+
+			suite.Require().NoError(err)
+
+			txResult, err := icatypes.DeserializeTxResult(txResponse, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+			suite.Require().Len(txResult.Results, len(msgs))
+
+			for i, result := range txResult.Results {
+				suite.Require().Equal(sdk.MsgTypeURL(msgs[i]), result.MsgType)
+				suite.Require().Equal(tc.expSuccess[i], result.Success)
+				suite.Require().Equal(tc.expErrors[i], result.Error != "")
+
+				if !result.Success {
+					suite.Require().Nil(result.Data)
+				}
+			}
+
+			// the messages executed by the bank module consume gas, including the failed message
+			suite.Require().NotZero(txResult.Results[0].GasUsed)
+			suite.Require().NotZero(txResult.Results[1].GasUsed)
+
+			var gasUsed uint64
+			for _, result := range txResult.Results {
+				gasUsed += result.GasUsed
+			}
+
+			suite.Require().Equal(types.DefaultMaxGasPerPacket, txResult.GasLimit)
+			suite.Require().Equal(gasUsed, txResult.GasUsed)
+
+			suite.Require().Equal(tc.expBalance, balance.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketGasMetering() {
+	var (
+		params   types.Params
+		gasLimit uint64
+	)
+
+	testCases := []struct {
+		msg         string
+		malleate    func()
+		expGasLimit uint64
+		expFee      sdk.Coins
+		expMsgErr   error
+		expErr      error
+	}{
+		{
+			"success: max gas per packet used if no gas limit is declared", func() {}, types.DefaultMaxGasPerPacket, nil, nil, nil,
+		},
+		{
+			"success: declared gas limit", func() {
+				gasLimit = 200000
+			}, 200000, nil, nil, nil,
+		},
+		{
+			"success: fee deducted for the gas limit", func() {
+				gasLimit = 200000
+				params.GasPrice = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(15, 4)))
+			}, 200000, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300))), nil, nil,
+		},
+		{
+			"success: fee kept when the transaction fails", func() {
+				gasLimit = 1000
+				params.GasPrice = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(15, 2)))
+			}, 1000, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150))), sdkerrors.ErrOutOfGas, nil,
+		},
+		{
+			"gas limit exceeds the max gas per packet", func() {
+				gasLimit = types.DefaultMaxGasPerPacket + 1
+			}, 0, nil, nil, types.ErrGasLimitExceeded,
+		},
+		{
+			"success: message out of gas", func() {
+				gasLimit = 1000
+			}, 1000, nil, sdkerrors.ErrOutOfGas, nil,
+		},
+		{
+			"insufficient funds to pay the fee", func() {
+				params.GasPrice = sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))
+			}, 0, nil, nil, sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
 
 			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
 
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			gasLimit = 0
+			params = types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+
 			tc.malleate() // malleate mutates test data
 
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type:     icatypes.EXECUTE_TX,
+				Data:     data,
+				GasLimit: gasLimit,
+			}
+
 			packet := channeltypes.NewPacket(
-				packetData,
-				suite.chainA.SenderAccount.GetSequence(),
+				icaPacketData.GetBytes(),
+				1,
 				path.EndpointA.ChannelConfig.PortID,
 				path.EndpointA.ChannelID,
 				path.EndpointB.ChannelConfig.PortID,
@@ -424,15 +1116,72 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				0,
 			)
 
-			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			accAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			feeCollectorAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			feeCollectorBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), feeCollectorAddr, sdk.DefaultBondDenom)
 
-			if tc.expPass {
+			ctx := suite.chainB.GetContext()
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, accAddr, sdk.DefaultBondDenom)
+			expBalance := sdk.NewInt(10000).Sub(tc.expFee.AmountOf(sdk.DefaultBondDenom))
+
+			// the fee is sent to the fee collector
+			suite.Require().Equal(feeCollectorBalance.Amount.Add(tc.expFee.AmountOf(sdk.DefaultBondDenom)), suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, feeCollectorAddr, sdk.DefaultBondDenom).Amount)
+
+			if tc.expErr == nil {
 				suite.Require().NoError(err)
-				suite.Require().NotNil(txResponse)
+
+				txResult, err := icatypes.DeserializeTxResult(txResponse, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expGasLimit, txResult.GasLimit)
+				suite.Require().NotZero(txResult.GasUsed)
+
+				if tc.expMsgErr == nil {
+					suite.Require().True(txResult.Results[0].Success)
+					suite.Require().Equal(expBalance.SubRaw(100), balance.Amount)
+				} else {
+					suite.Require().False(txResult.Results[0].Success)
+					suite.Require().Equal(types.NewMsgErrorString(tc.expMsgErr), txResult.Results[0].Error)
+					suite.Require().Equal(expBalance, balance.Amount)
+				}
 			} else {
-				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
 				suite.Require().Nil(txResponse)
+
+				suite.Require().Equal(expBalance, balance.Amount)
+			}
+
+			// an event describing the gas of the transaction is emitted if its messages are executed
+			var gasEvent *abci.Event
+			for _, event := range ctx.EventManager().ABCIEvents() {
+				if event.Type == types.EventTypeExecuteTx {
+					event := event
+					gasEvent = &event
+				}
+			}
+
+			if tc.expGasLimit == 0 {
+				suite.Require().Nil(gasEvent)
+				return
 			}
+
+			suite.Require().NotNil(gasEvent)
+
+			attributes := make(map[string]string)
+			for _, attr := range gasEvent.Attributes {
+				attributes[string(attr.Key)] = string(attr.Value)
+			}
+
+			suite.Require().Equal(fmt.Sprint(tc.expGasLimit), attributes[types.AttributeKeyGasLimit])
+			suite.Require().Equal(tc.expFee.String(), attributes[types.AttributeKeyFee])
+
+			gasUsed, err := strconv.ParseUint(attributes[types.AttributeKeyGasUsed], 10, 64)
+			suite.Require().NoError(err)
+			suite.Require().NotZero(gasUsed)
+			suite.Require().LessOrEqual(gasUsed, tc.expGasLimit)
 		})
 	}
 }
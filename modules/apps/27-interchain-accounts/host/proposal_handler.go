@@ -0,0 +1,25 @@
+package host
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+// NewMessagePolicyProposalHandler defines the interchain accounts host message policy proposal handler
+func NewMessagePolicyProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetMessagePolicyProposal:
+			return k.HandleSetMessagePolicyProposal(ctx, c)
+		case *types.RemoveMessagePolicyProposal:
+			return k.HandleRemoveMessagePolicyProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized interchain accounts host proposal content type: %T", c)
+		}
+	}
+}
@@ -12,6 +12,11 @@ const (
 	// ackErrorString defines a string constant included in error acknowledgements
 	// NOTE: Changing this const is state machine breaking as acknowledgements are written into state
 	ackErrorString = "error handling packet on host chain: see events for details"
+
+	// msgErrorString defines a string constant included in the error description of the failing messages of a
+	// transaction
+	// NOTE: Changing this const is state machine breaking as acknowledgements are written into state
+	msgErrorString = "error executing message on host chain: see events for details"
 )
 
 // NewErrorAcknowledgement returns a deterministic error string which may be used in
@@ -25,3 +30,11 @@ func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
 
 	return channeltypes.NewErrorAcknowledgement(errorString)
 }
+
+// NewMsgErrorString returns a deterministic error string describing the failure of a message of a
+// transaction, which may be used in the packet acknowledgement.
+func NewMsgErrorString(err error) string {
+	_, code, _ := sdkerrors.ABCIInfo(err, false) // discard non-deterministic codespace and log values
+
+	return fmt.Sprintf("ABCI code: %d: %s", code, msgErrorString)
+}
@@ -97,5 +97,15 @@ func (suite *TypesTestSuite) TestAcknowledgementError() {
 
 	suite.Require().Equal(ack, ackSameABCICode)
 	suite.Require().NotEqual(ack, ackDifferentABCICode)
+}
+
+// TestMsgErrorString will verify that only a constant string and
+// ABCI error code are used in constructing the message error string
+func (suite *TypesTestSuite) TestMsgErrorString() {
+	err := sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "error string 1")
+	errSameABCICode := sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "error string 2")
+	errDifferentABCICode := sdkerrors.ErrNotFound
 
+	suite.Require().Equal(types.NewMsgErrorString(err), types.NewMsgErrorString(errSameABCICode))
+	suite.Require().NotEqual(types.NewMsgErrorString(err), types.NewMsgErrorString(errDifferentABCICode))
 }
@@ -1,10 +1,45 @@
 package types
 
 import (
+	"fmt"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // ICA Host sentinel errors
 var (
 	ErrHostSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrInvalidMessagePolicy  = sdkerrors.Register(SubModuleName, 3, "invalid message policy")
+	ErrMessagePolicyNotFound = sdkerrors.Register(SubModuleName, 4, "message policy not found")
+	ErrGasLimitExceeded      = sdkerrors.Register(SubModuleName, 6, "packet gas limit exceeds the maximum gas per packet")
 )
+
+// MsgError is returned when a message of a transaction fails authentication or exceeds the max amount of a message
+// policy. It records the index of the failing message, which carries the error description in the transaction result.
+type MsgError struct {
+	Index int
+	Err   error
+}
+
+// NewMsgError creates and returns a new MsgError for the message with the provided index
+func NewMsgError(index int, err error) *MsgError {
+	return &MsgError{
+		Index: index,
+		Err:   err,
+	}
+}
+
+// Error implements the error interface
+func (e *MsgError) Error() string {
+	return fmt.Sprintf("message %d: %s", e.Index, e.Err)
+}
+
+// Cause returns the error of the failing message, allowing its ABCI code to be retrieved
+func (e *MsgError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the error of the failing message
+func (e *MsgError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,15 @@
+package types
+
+// ICS27 Interchain Accounts host events
+const (
+	EventTypeSetMessagePolicy    = "set_message_policy"
+	EventTypeRemoveMessagePolicy = "remove_message_policy"
+	EventTypeExecuteTx           = "execute_tx"
+
+	AttributeKeyConnectionID        = "connection_id"
+	AttributeKeyCounterpartyChainID = "counterparty_chain_id"
+	AttributeKeyPacketSequence      = "packet_sequence"
+	AttributeKeyGasLimit            = "gas_limit"
+	AttributeKeyGasUsed             = "gas_used"
+	AttributeKeyFee                 = "fee"
+)
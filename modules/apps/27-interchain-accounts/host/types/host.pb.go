@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -30,6 +32,15 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// allow_queries defines a list of gRPC query paths allowed to be queried by a controller chain.
+	AllowQueries []string `protobuf:"bytes,3,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// max_query_gas defines the maximum gas consumed by the queries of an interchain accounts packet.
+	MaxQueryGas uint64 `protobuf:"varint,4,opt,name=max_query_gas,json=maxQueryGas,proto3" json:"max_query_gas,omitempty" yaml:"max_query_gas"`
+	// max_gas_per_packet defines the maximum gas consumed by the messages of an interchain accounts packet.
+	MaxGasPerPacket uint64 `protobuf:"varint,5,opt,name=max_gas_per_packet,json=maxGasPerPacket,proto3" json:"max_gas_per_packet,omitempty" yaml:"max_gas_per_packet"`
+	// gas_price defines the fee per unit of gas limit deducted from an interchain account prior to the execution of
+	// its transactions. No fee is deducted if empty.
+	GasPrice github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=gas_price,json=gasPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_price" yaml:"gas_price"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +90,34 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *Params) GetMaxQueryGas() uint64 {
+	if m != nil {
+		return m.MaxQueryGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasPerPacket() uint64 {
+	if m != nil {
+		return m.MaxGasPerPacket
+	}
+	return 0
+}
+
+func (m *Params) GetGasPrice() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
 }
@@ -88,26 +127,37 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3a, 0x99, 0x49, 0xc9, 0x7a, 0xc8, 0x1a, 0xf5, 0xb0, 0x68, 0xd4, 0x03, 0x6b, 0x28, 0x33,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x6b, 0xd4, 0x07, 0xb1, 0x20, 0x66, 0x28, 0xb5, 0x31,
-	0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x59, 0x71, 0xf1, 0x80, 0xd4, 0xc6, 0xa7,
-	0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x38, 0x89, 0x7f, 0xba,
-	0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x2c, 0xab, 0x14, 0xc4, 0x0d, 0xe2, 0xba,
-	0x42, 0x78, 0x42, 0x0e, 0x5c, 0x7c, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0xb9, 0xa9, 0xc5, 0xc5,
-	0x89, 0xe9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b,
-	0x42, 0x74, 0xa3, 0xca, 0x2b, 0x05, 0xf1, 0x82, 0x05, 0x7c, 0xa1, 0x7c, 0xa7, 0x94, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d,
-	0xcf, 0xd7, 0x2f, 0x33, 0xd6, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x85, 0x5f, 0xb1,
-	0xbe, 0x91, 0xb9, 0x2e, 0x22, 0x04, 0x74, 0x51, 0x83, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xc9, 0x05, 0x61, 0x74, 0x01,
-	0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x63, 0x52, 0xa2, 0xd6, 0x69, 0x01, 0x99, 0x22, 0xdc, 0x0a, 0xec, 0xc8, 0xab, 0x48,
+	0x10, 0x8f, 0xd2, 0x2e, 0x2a, 0x55, 0x20, 0xa1, 0x00, 0x8a, 0x54, 0x09, 0x29, 0x78, 0xc9, 0xc6,
+	0x7a, 0x9e, 0x8c, 0x9c, 0x51, 0x6d, 0x8f, 0xf1, 0x9b, 0x84, 0x64, 0xcb, 0x09, 0x38, 0x07, 0x27,
+	0xe9, 0xb2, 0x0b, 0x16, 0xac, 0x0c, 0x4a, 0x6e, 0xe0, 0x13, 0x20, 0xcf, 0x04, 0x92, 0x88, 0xae,
+	0x66, 0xfe, 0xf7, 0xcf, 0xf7, 0xcf, 0xe2, 0xfd, 0xe6, 0x05, 0x8f, 0x28, 0x81, 0x3c, 0x4f, 0x38,
+	0x05, 0xc9, 0x45, 0x86, 0x84, 0x67, 0x92, 0x15, 0x74, 0x02, 0x3c, 0x0b, 0x81, 0x52, 0x31, 0xcd,
+	0x24, 0x92, 0x89, 0x40, 0x49, 0x66, 0x7d, 0x75, 0xfa, 0x79, 0x21, 0xa4, 0xb0, 0x5e, 0xf2, 0x88,
+	0xfa, 0xdb, 0xa0, 0x7f, 0x07, 0xe8, 0x2b, 0x60, 0xd6, 0x3f, 0x3d, 0x8e, 0x45, 0x2c, 0x14, 0x48,
+	0xea, 0x9b, 0xce, 0x38, 0x75, 0xa8, 0xc0, 0x54, 0x20, 0x89, 0x00, 0x19, 0x99, 0xf5, 0x23, 0x26,
+	0xa1, 0x4f, 0xa8, 0xe0, 0x99, 0xf6, 0xbd, 0x1f, 0x4d, 0xb3, 0x35, 0x82, 0x02, 0x52, 0xb4, 0x2e,
+	0xcd, 0xc3, 0x3a, 0x2b, 0x64, 0x19, 0x44, 0x09, 0x1b, 0xdb, 0x46, 0xc7, 0xe8, 0xee, 0x0f, 0x9e,
+	0x56, 0xa5, 0xfb, 0x78, 0x01, 0x69, 0x72, 0xe9, 0x6d, 0xbb, 0x5e, 0xd0, 0xae, 0xe5, 0x7b, 0xad,
+	0xac, 0x37, 0xe6, 0x03, 0x48, 0x12, 0xf1, 0x25, 0x4c, 0x19, 0x22, 0xc4, 0x0c, 0xed, 0x7b, 0x9d,
+	0x66, 0xf7, 0x60, 0x70, 0x52, 0x95, 0xee, 0x13, 0x4d, 0xef, 0xfa, 0x5e, 0x70, 0xa4, 0x06, 0x1f,
+	0xd6, 0xda, 0x7a, 0x6d, 0xea, 0x41, 0xf8, 0x79, 0xca, 0x0a, 0xce, 0xd0, 0x6e, 0xaa, 0x00, 0xbb,
+	0x2a, 0xdd, 0xe3, 0xed, 0x80, 0xb5, 0xed, 0x05, 0x87, 0x4a, 0x7f, 0xd4, 0xd2, 0x7a, 0x65, 0x1e,
+	0xa5, 0x30, 0x57, 0xee, 0x22, 0x8c, 0x01, 0xed, 0xbd, 0x8e, 0xd1, 0xdd, 0xdb, 0xc6, 0x77, 0x6c,
+	0x2f, 0x68, 0xa7, 0x30, 0xaf, 0xe1, 0xc5, 0x10, 0xd0, 0xba, 0x32, 0xad, 0xda, 0x8e, 0x01, 0xc3,
+	0x9c, 0x15, 0x61, 0x0e, 0xf4, 0x9a, 0x49, 0xfb, 0xbe, 0x8a, 0x78, 0x5e, 0x95, 0xee, 0xc9, 0x26,
+	0x62, 0xf7, 0x8d, 0x17, 0x3c, 0x4c, 0x61, 0x3e, 0x04, 0x1c, 0xb1, 0x62, 0xa4, 0x26, 0xd6, 0x57,
+	0xc3, 0x3c, 0x50, 0x8f, 0x0a, 0x4e, 0x99, 0xdd, 0xea, 0x34, 0xbb, 0xed, 0xb3, 0x67, 0xbe, 0x5e,
+	0x83, 0x5f, 0xaf, 0xc1, 0x5f, 0xaf, 0xc1, 0x7f, 0xc7, 0xe8, 0x5b, 0xc1, 0xb3, 0xc1, 0xf0, 0xa6,
+	0x74, 0x1b, 0x55, 0xe9, 0x3e, 0xd2, 0xbf, 0xfc, 0x83, 0xbd, 0xef, 0xbf, 0xdc, 0x17, 0x31, 0x97,
+	0x93, 0x69, 0xe4, 0x53, 0x91, 0x92, 0xf5, 0x2a, 0xf5, 0xd1, 0xc3, 0xf1, 0x35, 0x91, 0x8b, 0x9c,
+	0xe1, 0xdf, 0x1c, 0x0c, 0xf6, 0x63, 0xc0, 0x51, 0x4d, 0x0e, 0xc6, 0x37, 0x4b, 0xc7, 0xb8, 0x5d,
+	0x3a, 0xc6, 0xef, 0xa5, 0x63, 0x7c, 0x5b, 0x39, 0x8d, 0xdb, 0x95, 0xd3, 0xf8, 0xb9, 0x72, 0x1a,
+	0x9f, 0xae, 0xfe, 0x0f, 0xe4, 0x11, 0xed, 0xc5, 0x82, 0xcc, 0xce, 0x49, 0x2a, 0xc6, 0xd3, 0x84,
+	0x61, 0xdd, 0x56, 0x24, 0x67, 0x17, 0xbd, 0x4d, 0xdf, 0x7a, 0xbb, 0x45, 0x55, 0x1f, 0x47, 0x2d,
+	0xd5, 0xa1, 0xf3, 0x3f, 0x03, 0x00, 0x34, 0xbf, 0x8b, 0xdd, 0xe2, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -130,6 +180,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasPrice) > 0 {
+		for iNdEx := len(m.GasPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxGasPerPacket != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxGasPerPacket))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxQueryGas != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxQueryGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -178,6 +261,24 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MaxQueryGas != 0 {
+		n += 1 + sovHost(uint64(m.MaxQueryGas))
+	}
+	if m.MaxGasPerPacket != 0 {
+		n += 1 + sovHost(uint64(m.MaxGasPerPacket))
+	}
+	if len(m.GasPrice) > 0 {
+		for _, e := range m.GasPrice {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -268,6 +369,110 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryGas", wireType)
+			}
+			m.MaxQueryGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacket", wireType)
+			}
+			m.MaxGasPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrice = append(m.GasPrice, types.DecCoin{})
+			if err := m.GasPrice[len(m.GasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,8 +12,39 @@ const (
 
 	// StoreKey is the store key string for the interchain accounts host module
 	StoreKey = SubModuleName
+
+	// RouterKey is the message route for the interchain accounts host module
+	RouterKey = SubModuleName
+
+	// MessagePolicyKeyPrefix defines the key prefix used to store message policies
+	MessagePolicyKeyPrefix = "messagePolicy"
+
+	// ConnectionMessagePolicyKeyPrefix defines the key prefix used to store message policies applying to a connection
+	ConnectionMessagePolicyKeyPrefix = MessagePolicyKeyPrefix + "/connection"
+
+	// ChainMessagePolicyKeyPrefix defines the key prefix used to store message policies applying to a counterparty chain
+	ChainMessagePolicyKeyPrefix = MessagePolicyKeyPrefix + "/chain"
+
+	// InterchainAccountKeyPrefix defines the key prefix used to index interchain accounts by address
+	InterchainAccountKeyPrefix = "interchainAccount"
 )
 
+// KeyInterchainAccount creates and returns a new key used to retrieve the registered interchain account of the
+// provided address
+func KeyInterchainAccount(address string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", InterchainAccountKeyPrefix, address))
+}
+
+// KeyMessagePolicy creates and returns a new key used for the message policy applying to the provided connection,
+// or to the provided counterparty chain if the connection identifier is empty
+func KeyMessagePolicy(connectionID, counterpartyChainID string) []byte {
+	if connectionID != "" {
+		return []byte(fmt.Sprintf("%s/%s", ConnectionMessagePolicyKeyPrefix, connectionID))
+	}
+
+	return []byte(fmt.Sprintf("%s/%s", ChainMessagePolicyKeyPrefix, counterpartyChainID))
+}
+
 // ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	for _, v := range allowMsgs {
@@ -22,3 +55,14 @@ func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 
 	return false
 }
+
+// ContainsQueryPath returns true if the provided gRPC query path is present in the list of allowed query paths
+func ContainsQueryPath(allowQueries []string, path string) bool {
+	for _, v := range allowQueries {
+		if v == path {
+			return true
+		}
+	}
+
+	return false
+}
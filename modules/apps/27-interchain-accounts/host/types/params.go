@@ -4,12 +4,17 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+	// DefaultMaxQueryGas is the default value for the max query gas param
+	DefaultMaxQueryGas = uint64(1000000)
+	// DefaultMaxGasPerPacket is the default value for the max gas per packet param
+	DefaultMaxGasPerPacket = uint64(1000000)
 )
 
 var (
@@ -17,6 +22,14 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyAllowQueries is the store key for the AllowQueries Params
+	KeyAllowQueries = []byte("AllowQueries")
+	// KeyMaxQueryGas is the store key for the MaxQueryGas Params
+	KeyMaxQueryGas = []byte("MaxQueryGas")
+	// KeyMaxGasPerPacket is the store key for the MaxGasPerPacket Params
+	KeyMaxGasPerPacket = []byte("MaxGasPerPacket")
+	// KeyGasPrice is the store key for the GasPrice Params
+	KeyGasPrice = []byte("GasPrice")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -25,16 +38,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the host submodule
-func NewParams(enableHost bool, allowMsgs []string) Params {
+func NewParams(enableHost bool, allowMsgs, allowQueries []string, maxQueryGas, maxGasPerPacket uint64, gasPrice sdk.DecCoins) Params {
 	return Params{
-		HostEnabled:   enableHost,
-		AllowMessages: allowMsgs,
+		HostEnabled:     enableHost,
+		AllowMessages:   allowMsgs,
+		AllowQueries:    allowQueries,
+		MaxQueryGas:     maxQueryGas,
+		MaxGasPerPacket: maxGasPerPacket,
+		GasPrice:        gasPrice,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the host submodule
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, nil)
+	return NewParams(DefaultHostEnabled, nil, nil, DefaultMaxQueryGas, DefaultMaxGasPerPacket, nil)
 }
 
 // Validate validates all host submodule parameters
@@ -47,6 +64,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateQueryAllowlist(p.AllowQueries); err != nil {
+		return err
+	}
+
+	if err := validateMaxQueryGas(p.MaxQueryGas); err != nil {
+		return err
+	}
+
+	if err := validateMaxGasPerPacket(p.MaxGasPerPacket); err != nil {
+		return err
+	}
+
+	if err := validateGasPrice(p.GasPrice); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -55,6 +88,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyAllowQueries, p.AllowQueries, validateQueryAllowlist),
+		paramtypes.NewParamSetPair(KeyMaxQueryGas, p.MaxQueryGas, validateMaxQueryGas),
+		paramtypes.NewParamSetPair(KeyMaxGasPerPacket, p.MaxGasPerPacket, validateMaxGasPerPacket),
+		paramtypes.NewParamSetPair(KeyGasPrice, p.GasPrice, validateGasPrice),
 	}
 }
 
@@ -81,3 +118,53 @@ func validateAllowlist(i interface{}) error {
 
 	return nil
 }
+
+func validateQueryAllowlist(i interface{}) error {
+	allowQueries, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, path := range allowQueries {
+		if !strings.HasPrefix(path, "/") || strings.TrimSpace(path) == "/" {
+			return fmt.Errorf("parameter must only contain gRPC query paths: %s", allowQueries)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxQueryGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxGasPerPacket(i interface{}) error {
+	maxGas, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxGas == 0 {
+		return fmt.Errorf("max gas per packet must be positive")
+	}
+
+	return nil
+}
+
+func validateGasPrice(i interface{}) error {
+	gasPrice, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := gasPrice.Validate(); err != nil {
+		return fmt.Errorf("invalid gas price: %w", err)
+	}
+
+	return nil
+}
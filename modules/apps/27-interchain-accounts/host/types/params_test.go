@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
@@ -10,5 +11,56 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false, []string{}).Validate())
+	require.NoError(t, types.NewParams(false, []string{}, nil, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil).Validate())
+}
+
+func TestValidateQueryAllowlist(t *testing.T) {
+	testCases := []struct {
+		name         string
+		allowQueries []string
+		expPass      bool
+	}{
+		{"success: empty allow list", nil, true},
+		{"success: gRPC query path", []string{"/cosmos.bank.v1beta1.Query/Balance"}, true},
+		{"empty query path", []string{""}, false},
+		{"root query path", []string{"/"}, false},
+		{"query path without leading slash", []string{"cosmos.bank.v1beta1.Query/Balance"}, false},
+	}
+
+	for _, tc := range testCases {
+		params := types.NewParams(true, nil, tc.allowQueries, types.DefaultMaxQueryGas, types.DefaultMaxGasPerPacket, nil)
+
+		err := params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidateGasParams(t *testing.T) {
+	testCases := []struct {
+		name            string
+		maxGasPerPacket uint64
+		gasPrice        sdk.DecCoins
+		expPass         bool
+	}{
+		{"success: no gas price", types.DefaultMaxGasPerPacket, nil, true},
+		{"success: gas price", types.DefaultMaxGasPerPacket, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3))), true},
+		{"zero max gas per packet", 0, nil, false},
+		{"invalid gas price denom", types.DefaultMaxGasPerPacket, sdk.DecCoins{{Denom: "", Amount: sdk.OneDec()}}, false},
+		{"negative gas price", types.DefaultMaxGasPerPacket, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(-1)}}, false},
+	}
+
+	for _, tc := range testCases {
+		params := types.NewParams(true, nil, nil, types.DefaultMaxQueryGas, tc.maxGasPerPacket, tc.gasPrice)
+
+		err := params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
 }
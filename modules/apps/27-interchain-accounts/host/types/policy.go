@@ -0,0 +1,325 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// MessageTypeWildcard is the suffix of a message pattern matching any sdk message typeURL with the given prefix
+const MessageTypeWildcard = "*"
+
+// NewMessagePolicy creates a new MessagePolicy instance applying to the provided connection or counterparty chain
+func NewMessagePolicy(connectionID, counterpartyChainID string, allowMsgs, denyMsgs []string, constraints []MessageConstraint) MessagePolicy {
+	return MessagePolicy{
+		ConnectionId:        connectionID,
+		CounterpartyChainId: counterpartyChainID,
+		AllowMessages:       allowMsgs,
+		DenyMessages:        denyMsgs,
+		Constraints:         constraints,
+	}
+}
+
+// NewMessageConstraint creates a new MessageConstraint instance
+func NewMessageConstraint(msgType string, maxAmount sdk.Coins, allowedValidators []string) MessageConstraint {
+	return MessageConstraint{
+		MessageType:       msgType,
+		MaxAmount:         maxAmount,
+		AllowedValidators: allowedValidators,
+	}
+}
+
+// Validate performs a basic validation of the message policy
+func (p MessagePolicy) Validate() error {
+	if err := ValidateMessagePolicyScope(p.ConnectionId, p.CounterpartyChainId); err != nil {
+		return err
+	}
+
+	for _, patterns := range [][]string{p.AllowMessages, p.DenyMessages} {
+		for _, pattern := range patterns {
+			if err := validateMessagePattern(pattern); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, constraint := range p.Constraints {
+		if err := constraint.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Authorize returns an error if the provided msg, or any msg it wraps such as the msgs of an authz MsgExec, is
+// denied, is not allowed or does not satisfy the constraints of the message policy
+func (p MessagePolicy) Authorize(msg sdk.Msg) error {
+	typeURL := sdk.MsgTypeURL(msg)
+
+	if matchesAnyMessageType(p.DenyMessages, typeURL) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type denied by message policy: %s", typeURL)
+	}
+
+	if !matchesAnyMessageType(p.AllowMessages, typeURL) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed by message policy: %s", typeURL)
+	}
+
+	for _, constraint := range p.Constraints {
+		if !MatchMessageType(constraint.MessageType, typeURL) {
+			continue
+		}
+
+		if err := constraint.Check(msg); err != nil {
+			return err
+		}
+	}
+
+	wrappedMsgs, err := unwrapMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	for _, wrappedMsg := range wrappedMsgs {
+		if err := p.Authorize(wrappedMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AuthorizeTx returns an error if the total amount of the provided msgs, including the msgs they wrap, matching a
+// constraint of the message policy exceeds its max amount. The returned error records the index of the msg
+// exceeding the max amount.
+func (p MessagePolicy) AuthorizeTx(msgs []sdk.Msg) error {
+	for _, constraint := range p.Constraints {
+		if len(constraint.MaxAmount) == 0 {
+			continue
+		}
+
+		var total sdk.Coins
+		for i, msg := range msgs {
+			amount, err := constraint.totalAmount(msg)
+			if err != nil {
+				return NewMsgError(i, err)
+			}
+
+			total = total.Add(amount...)
+			if !total.IsAllLTE(constraint.MaxAmount) {
+				return NewMsgError(i, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "total amount %s of message type %s exceeds the maximum amount %s", total, constraint.MessageType, constraint.MaxAmount))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the message constraint
+func (c MessageConstraint) Validate() error {
+	if err := validateMessagePattern(c.MessageType); err != nil {
+		return err
+	}
+
+	if len(c.MaxAmount) == 0 && len(c.AllowedValidators) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidMessagePolicy, "constraint on message type %s is empty", c.MessageType)
+	}
+
+	if err := c.MaxAmount.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMessagePolicy, "invalid max amount: %s", err)
+	}
+
+	for _, validator := range c.AllowedValidators {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidMessagePolicy, "invalid validator address %s: %s", validator, err)
+		}
+	}
+
+	return nil
+}
+
+// Check returns an error if the provided msg does not satisfy the message constraint
+func (c MessageConstraint) Check(msg sdk.Msg) error {
+	typeURL := sdk.MsgTypeURL(msg)
+
+	if len(c.MaxAmount) > 0 {
+		amount, ok := msgAmount(msg)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "max amount constraint not supported by message type %s", typeURL)
+		}
+
+		// NOTE: the constraint is checked prior to the stateless validation of the message
+		if err := amount.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid amount of message type %s: %s", typeURL, err)
+		}
+
+		if !amount.IsAllLTE(c.MaxAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "amount %s of message type %s exceeds the maximum amount %s", amount, typeURL, c.MaxAmount)
+		}
+	}
+
+	if len(c.AllowedValidators) > 0 {
+		validators, ok := msgValidators(msg)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "allowed validators constraint not supported by message type %s", typeURL)
+		}
+
+		for _, validator := range validators {
+			if !containsString(c.AllowedValidators, validator) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "validator %s not allowed for message type %s", validator, typeURL)
+			}
+		}
+	}
+
+	return nil
+}
+
+// totalAmount returns the total amount of the provided msg and of the msgs it wraps matching the message constraint.
+// The amounts of msgs which do not support the max amount constraint or are invalid are not counted, such msgs being
+// rejected by Check.
+func (c MessageConstraint) totalAmount(msg sdk.Msg) (sdk.Coins, error) {
+	var total sdk.Coins
+	if MatchMessageType(c.MessageType, sdk.MsgTypeURL(msg)) {
+		if amount, ok := msgAmount(msg); ok && amount.Validate() == nil {
+			total = total.Add(amount...)
+		}
+	}
+
+	wrappedMsgs, err := unwrapMsgs(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wrappedMsg := range wrappedMsgs {
+		amount, err := c.totalAmount(wrappedMsg)
+		if err != nil {
+			return nil, err
+		}
+
+		total = total.Add(amount...)
+	}
+
+	return total, nil
+}
+
+// MatchMessageType returns true if the provided sdk message typeURL matches the message pattern, otherwise false.
+// A pattern ending with the wildcard matches any typeURL with the given prefix.
+func MatchMessageType(pattern, typeURL string) bool {
+	if strings.HasSuffix(pattern, MessageTypeWildcard) {
+		return strings.HasPrefix(typeURL, strings.TrimSuffix(pattern, MessageTypeWildcard))
+	}
+
+	return pattern == typeURL
+}
+
+// ValidateMessagePolicyScope ensures exactly one of the connection identifier and counterparty chain identifier
+// of a message policy is set
+func ValidateMessagePolicyScope(connectionID, counterpartyChainID string) error {
+	switch {
+	case connectionID != "" && counterpartyChainID != "":
+		return sdkerrors.Wrap(ErrInvalidMessagePolicy, "connection ID and counterparty chain ID are mutually exclusive")
+	case connectionID != "":
+		return host.ConnectionIdentifierValidator(connectionID)
+	case strings.TrimSpace(counterpartyChainID) != "":
+		return nil
+	default:
+		return sdkerrors.Wrap(ErrInvalidMessagePolicy, "connection ID or counterparty chain ID must be set")
+	}
+}
+
+// validateMessagePattern ensures the message pattern is not empty and only contains a wildcard as its suffix
+func validateMessagePattern(pattern string) error {
+	if strings.TrimSpace(pattern) == "" {
+		return sdkerrors.Wrap(ErrInvalidMessagePolicy, "message pattern cannot be empty")
+	}
+
+	if idx := strings.Index(pattern, MessageTypeWildcard); idx != -1 && idx != len(pattern)-1 {
+		return sdkerrors.Wrapf(ErrInvalidMessagePolicy, "wildcard must be the last character of message pattern %s", pattern)
+	}
+
+	return nil
+}
+
+// matchesAnyMessageType returns true if the provided sdk message typeURL matches any of the message patterns
+func matchesAnyMessageType(patterns []string, typeURL string) bool {
+	for _, pattern := range patterns {
+		if MatchMessageType(pattern, typeURL) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unwrapMsgs returns the msgs wrapped by the provided msg, such as the msgs executed by an authz MsgExec. No msgs
+// are returned if the provided msg does not wrap any msg.
+func unwrapMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	wrapper, ok := msg.(interface{ GetMessages() ([]sdk.Msg, error) })
+	if !ok {
+		return nil, nil
+	}
+
+	msgs, err := wrapper.GetMessages()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "failed to unwrap msgs of message type %s: %s", sdk.MsgTypeURL(msg), err)
+	}
+
+	return msgs, nil
+}
+
+// msgAmount returns the amount sent, delegated, undelegated or redelegated by the provided msg. False is returned
+// if the message type is not supported by the max amount constraint.
+func msgAmount(msg sdk.Msg) (sdk.Coins, bool) {
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		return msg.Amount, true
+	case *stakingtypes.MsgDelegate:
+		return sdk.Coins{msg.Amount}, true
+	case *stakingtypes.MsgUndelegate:
+		return sdk.Coins{msg.Amount}, true
+	case *stakingtypes.MsgBeginRedelegate:
+		return sdk.Coins{msg.Amount}, true
+	case *distrtypes.MsgFundCommunityPool:
+		return msg.Amount, true
+	case *transfertypes.MsgTransfer:
+		return sdk.Coins{msg.Token}, true
+	case *transfertypes.MsgMultiTransfer:
+		return msg.Tokens, true
+	default:
+		return nil, false
+	}
+}
+
+// msgValidators returns the validator operator addresses referenced by the provided msg. False is returned if
+// the message type is not supported by the allowed validators constraint.
+func msgValidators(msg sdk.Msg) ([]string, bool) {
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		return []string{msg.ValidatorAddress}, true
+	case *stakingtypes.MsgUndelegate:
+		return []string{msg.ValidatorAddress}, true
+	case *stakingtypes.MsgBeginRedelegate:
+		return []string{msg.ValidatorSrcAddress, msg.ValidatorDstAddress}, true
+	case *distrtypes.MsgWithdrawDelegatorReward:
+		return []string{msg.ValidatorAddress}, true
+	default:
+		return nil, false
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,201 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	testAddress    = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	validatorAddr  = sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	validatorAddr2 = sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+)
+
+func TestMatchMessageType(t *testing.T) {
+	typeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name     string
+		pattern  string
+		expMatch bool
+	}{
+		{"exact match", "/cosmos.bank.v1beta1.MsgSend", true},
+		{"wildcard", "*", true},
+		{"prefix match", "/cosmos.bank.v1beta1.*", true},
+		{"partial prefix match", "/cosmos.bank.v1beta1.MsgS*", true},
+		{"prefix mismatch", "/cosmos.staking.v1beta1.*", false},
+		{"exact mismatch", "/cosmos.bank.v1beta1.MsgMultiSend", false},
+		{"prefix without wildcard", "/cosmos.bank.v1beta1.", false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expMatch, types.MatchMessageType(tc.pattern, typeURL), tc.name)
+	}
+}
+
+func TestValidateMessagePolicy(t *testing.T) {
+	maxAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	testCases := []struct {
+		name    string
+		policy  types.MessagePolicy
+		expPass bool
+	}{
+		{"success - connection policy", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"*"}, nil, nil), true},
+		{"success - counterparty chain policy", types.NewMessagePolicy("", "testchain", []string{"/cosmos.staking.v1beta1.*"}, []string{"/cosmos.staking.v1beta1.MsgUndelegate"}, nil), true},
+		{"success - empty policy", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", nil, nil, nil), true},
+		{"success - constraints", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"*"}, nil, []types.MessageConstraint{
+			types.NewMessageConstraint("/cosmos.bank.v1beta1.MsgSend", maxAmount, nil),
+			types.NewMessageConstraint("/cosmos.staking.v1beta1.*", maxAmount, []string{validatorAddr.String()}),
+		}), true},
+		{"missing connection and counterparty chain identifiers", types.NewMessagePolicy("", "", []string{"*"}, nil, nil), false},
+		{"both connection and counterparty chain identifiers", types.NewMessagePolicy(ibctesting.FirstConnectionID, "testchain", []string{"*"}, nil, nil), false},
+		{"invalid connection identifier", types.NewMessagePolicy("invalid|connection", "", []string{"*"}, nil, nil), false},
+		{"empty allowed message pattern", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{" "}, nil, nil), false},
+		{"wildcard within denied message pattern", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"*"}, []string{"/cosmos.*.v1beta1.MsgSend"}, nil), false},
+		{"empty constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"*"}, nil, []types.MessageConstraint{
+			types.NewMessageConstraint("/cosmos.bank.v1beta1.MsgSend", nil, nil),
+		}), false},
+		{"invalid constraint max amount", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"*"}, nil, []types.MessageConstraint{
+			types.NewMessageConstraint("/cosmos.bank.v1beta1.MsgSend", sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}}, nil),
+		}), false},
+		{"invalid constraint validator address", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"*"}, nil, []types.MessageConstraint{
+			types.NewMessageConstraint("/cosmos.staking.v1beta1.MsgDelegate", nil, []string{testAddress.String()}),
+		}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.policy.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestAuthorizeMessagePolicy(t *testing.T) {
+	policy := types.NewMessagePolicy(
+		ibctesting.FirstConnectionID, "",
+		[]string{"/cosmos.staking.v1beta1.*", "/cosmos.bank.v1beta1.MsgSend", "/cosmos.authz.v1beta1.MsgExec"},
+		[]string{"/cosmos.staking.v1beta1.MsgUndelegate"},
+		[]types.MessageConstraint{
+			types.NewMessageConstraint("/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), nil),
+			types.NewMessageConstraint("/cosmos.staking.v1beta1.*", nil, []string{validatorAddr.String()}),
+		},
+	)
+
+	bondAmount := func(amount int64) sdk.Coin { return sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)) }
+
+	msgExec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(testAddress, msgs)
+		return &msg
+	}
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		expPass bool
+	}{
+		{"success - send within max amount", banktypes.NewMsgSend(testAddress, testAddress, sdk.NewCoins(bondAmount(100))), true},
+		{"success - delegate to allowed validator", stakingtypes.NewMsgDelegate(testAddress, validatorAddr, bondAmount(1000)), true},
+		{"send exceeds max amount", banktypes.NewMsgSend(testAddress, testAddress, sdk.NewCoins(bondAmount(101))), false},
+		{"send of denomination without max amount", banktypes.NewMsgSend(testAddress, testAddress, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(1)))), false},
+		{"send of invalid amount", banktypes.NewMsgSend(testAddress, testAddress, sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}}), false},
+		{"delegate to validator not allowed", stakingtypes.NewMsgDelegate(testAddress, validatorAddr2, bondAmount(1000)), false},
+		{"redelegate to validator not allowed", stakingtypes.NewMsgBeginRedelegate(testAddress, validatorAddr, validatorAddr2, bondAmount(1000)), false},
+		{"denied message type", stakingtypes.NewMsgUndelegate(testAddress, validatorAddr, bondAmount(1000)), false},
+		{"message type not allowed", govtypes.NewMsgVote(testAddress, 1, govtypes.OptionYes), false},
+		{"constraint not supported by message type", &stakingtypes.MsgEditValidator{ValidatorAddress: validatorAddr.String()}, false},
+		{"success - exec of send within max amount", msgExec(banktypes.NewMsgSend(testAddress, testAddress, sdk.NewCoins(bondAmount(100)))), true},
+		{"exec of send exceeds max amount", msgExec(banktypes.NewMsgSend(testAddress, testAddress, sdk.NewCoins(bondAmount(101)))), false},
+		{"exec of delegate to validator not allowed", msgExec(stakingtypes.NewMsgDelegate(testAddress, validatorAddr2, bondAmount(1000))), false},
+		{"exec of denied message type", msgExec(stakingtypes.NewMsgUndelegate(testAddress, validatorAddr, bondAmount(1000))), false},
+		{"exec of message type not allowed", msgExec(govtypes.NewMsgVote(testAddress, 1, govtypes.OptionYes)), false},
+		{"nested exec of send exceeds max amount", msgExec(msgExec(banktypes.NewMsgSend(testAddress, testAddress, sdk.NewCoins(bondAmount(101))))), false},
+	}
+
+	for _, tc := range testCases {
+		err := policy.Authorize(tc.msg)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized, tc.name)
+		}
+	}
+}
+
+func TestAuthorizeMessagePolicyWildcard(t *testing.T) {
+	policy := types.NewMessagePolicy(
+		ibctesting.FirstConnectionID, "",
+		[]string{"*"},
+		[]string{"/cosmos.gov.v1beta1.*"},
+		[]types.MessageConstraint{
+			types.NewMessageConstraint("/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), nil),
+		},
+	)
+
+	send := banktypes.NewMsgSend(testAddress, testAddress, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(101))))
+	msgExec := authz.NewMsgExec(testAddress, []sdk.Msg{send})
+	require.ErrorIs(t, policy.Authorize(&msgExec), sdkerrors.ErrUnauthorized)
+
+	msgExec = authz.NewMsgExec(testAddress, []sdk.Msg{govtypes.NewMsgVote(testAddress, 1, govtypes.OptionYes)})
+	require.ErrorIs(t, policy.Authorize(&msgExec), sdkerrors.ErrUnauthorized)
+}
+
+func TestAuthorizeTxMessagePolicy(t *testing.T) {
+	policy := types.NewMessagePolicy(
+		ibctesting.FirstConnectionID, "",
+		[]string{"*"},
+		nil,
+		[]types.MessageConstraint{
+			types.NewMessageConstraint("/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), nil),
+			types.NewMessageConstraint("/cosmos.staking.v1beta1.MsgDelegate", nil, []string{validatorAddr.String()}),
+		},
+	)
+
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(testAddress, testAddress, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount))))
+	}
+
+	msgExec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(testAddress, msgs)
+		return &msg
+	}
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		expPass  bool
+		expIndex int
+	}{
+		{"success - total amount within max amount", []sdk.Msg{send(40), send(60)}, true, 0},
+		{"success - msgs not matching the constraint are not counted", []sdk.Msg{send(100), stakingtypes.NewMsgDelegate(testAddress, validatorAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))}, true, 0},
+		{"total amount exceeds max amount", []sdk.Msg{send(40), send(60), send(1)}, false, 2},
+		{"total amount including wrapped msgs exceeds max amount", []sdk.Msg{send(60), msgExec(send(30), send(20))}, false, 1},
+	}
+
+	for _, tc := range testCases {
+		err := policy.AuthorizeTx(tc.msgs)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized, tc.name)
+
+			var msgErr *types.MsgError
+			require.ErrorAs(t, err, &msgErr, tc.name)
+			require.Equal(t, tc.expIndex, msgErr.Index, tc.name)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetMessagePolicy defines the type for a SetMessagePolicyProposal
+	ProposalTypeSetMessagePolicy = "SetMessagePolicy"
+	// ProposalTypeRemoveMessagePolicy defines the type for a RemoveMessagePolicyProposal
+	ProposalTypeRemoveMessagePolicy = "RemoveMessagePolicy"
+)
+
+var (
+	_ govtypes.Content = &SetMessagePolicyProposal{}
+	_ govtypes.Content = &RemoveMessagePolicyProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetMessagePolicy)
+	govtypes.RegisterProposalType(ProposalTypeRemoveMessagePolicy)
+}
+
+// NewSetMessagePolicyProposal creates a new set message policy proposal.
+func NewSetMessagePolicyProposal(title, description string, policy MessagePolicy) govtypes.Content {
+	return &SetMessagePolicyProposal{
+		Title:       title,
+		Description: description,
+		Policy:      policy,
+	}
+}
+
+// GetTitle returns the title of a set message policy proposal.
+func (p *SetMessagePolicyProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set message policy proposal.
+func (p *SetMessagePolicyProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set message policy proposal.
+func (p *SetMessagePolicyProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set message policy proposal.
+func (p *SetMessagePolicyProposal) ProposalType() string { return ProposalTypeSetMessagePolicy }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetMessagePolicyProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Policy.Validate()
+}
+
+// NewRemoveMessagePolicyProposal creates a new remove message policy proposal.
+func NewRemoveMessagePolicyProposal(title, description, connectionID, counterpartyChainID string) govtypes.Content {
+	return &RemoveMessagePolicyProposal{
+		Title:               title,
+		Description:         description,
+		ConnectionId:        connectionID,
+		CounterpartyChainId: counterpartyChainID,
+	}
+}
+
+// GetTitle returns the title of a remove message policy proposal.
+func (p *RemoveMessagePolicyProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove message policy proposal.
+func (p *RemoveMessagePolicyProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove message policy proposal.
+func (p *RemoveMessagePolicyProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove message policy proposal.
+func (p *RemoveMessagePolicyProposal) ProposalType() string { return ProposalTypeRemoveMessagePolicy }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveMessagePolicyProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return ValidateMessagePolicyScope(p.ConnectionId, p.CounterpartyChainId)
+}
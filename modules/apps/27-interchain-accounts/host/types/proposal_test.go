@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestSetMessagePolicyProposalValidateBasic(t *testing.T) {
+	policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"*"}, nil, nil)
+
+	testCases := []struct {
+		name     string
+		proposal *types.SetMessagePolicyProposal
+		expPass  bool
+	}{
+		{"success", types.NewSetMessagePolicyProposal(ibctesting.Title, ibctesting.Description, policy).(*types.SetMessagePolicyProposal), true},
+		{"empty title", types.NewSetMessagePolicyProposal("", ibctesting.Description, policy).(*types.SetMessagePolicyProposal), false},
+		{"invalid message policy", types.NewSetMessagePolicyProposal(ibctesting.Title, ibctesting.Description, types.MessagePolicy{}).(*types.SetMessagePolicyProposal), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestRemoveMessagePolicyProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *types.RemoveMessagePolicyProposal
+		expPass  bool
+	}{
+		{"success - connection policy", types.NewRemoveMessagePolicyProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstConnectionID, "").(*types.RemoveMessagePolicyProposal), true},
+		{"success - counterparty chain policy", types.NewRemoveMessagePolicyProposal(ibctesting.Title, ibctesting.Description, "", "testchain").(*types.RemoveMessagePolicyProposal), true},
+		{"empty description", types.NewRemoveMessagePolicyProposal(ibctesting.Title, "", ibctesting.FirstConnectionID, "").(*types.RemoveMessagePolicyProposal), false},
+		{"missing connection and counterparty chain identifiers", types.NewRemoveMessagePolicyProposal(ibctesting.Title, ibctesting.Description, "", "").(*types.RemoveMessagePolicyProposal), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	controllerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	ibcsims "github.com/cosmos/ibc-go/v3/modules/core/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgRegisterInterchainAccount = "op_weight_msg_register_interchain_account"
+	OpWeightMsgChannelOpenAck            = "op_weight_msg_interchain_accounts_channel_open_ack"
+	OpWeightMsgSendTx                    = "op_weight_msg_send_tx"
+)
+
+// Default simulation operation weights
+const (
+	DefaultWeightMsgRegisterInterchainAccount = 10
+	DefaultWeightMsgChannelOpenAck            = 20
+	DefaultWeightMsgSendTx                    = 50
+)
+
+// WeightedOperations returns all the operations from the interchain accounts controller submodule with their
+// respective weights. The operations use solo machines controlled by the simulation accounts as host chains.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, k *controllerkeeper.Keeper, ibcKeeper *ibckeeper.Keeper,
+	ak types.AccountKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgRegisterInterchainAccount int
+		weightMsgChannelOpenAck            int
+		weightMsgSendTx                    int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterInterchainAccount, &weightMsgRegisterInterchainAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterInterchainAccount = DefaultWeightMsgRegisterInterchainAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenAck, &weightMsgChannelOpenAck, nil,
+		func(_ *rand.Rand) {
+			weightMsgChannelOpenAck = DefaultWeightMsgChannelOpenAck
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendTx, &weightMsgSendTx, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendTx = DefaultWeightMsgSendTx
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgRegisterInterchainAccount,
+			SimulateMsgRegisterInterchainAccount(k, ibcKeeper, ak),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChannelOpenAck,
+			SimulateMsgChannelOpenAck(k, ibcKeeper, ak),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSendTx,
+			SimulateMsgSendTx(k, ibcKeeper, ak),
+		),
+	}
+}
+
+// SimulateMsgRegisterInterchainAccount generates a MsgRegisterInterchainAccount registering an interchain account
+// owned by a random account either on the localhost connection or on a random connection with a solo machine
+// counterparty. The channels initialized on the localhost connection are opened by the core IBC operations with
+// the host submodule of the simulated chain.
+func SimulateMsgRegisterInterchainAccount(k *controllerkeeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&controllertypes.MsgRegisterInterchainAccount{})
+
+		if !k.IsControllerEnabled(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "controller submodule is disabled"), nil, nil
+		}
+
+		connection, found := ibcsims.RandomCounterpartyConnection(r, ctx, ibcKeeper, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no connection with a solo machine or localhost counterparty found"), nil, nil
+		}
+
+		owner, _ := simtypes.RandomAcc(r, accs)
+		portID, err := types.NewControllerPortID(owner.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate port identifier"), nil, err
+		}
+
+		if _, found := k.GetOpenActiveChannel(ctx, connection.Id, portID); found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "interchain account already registered"), nil, nil
+		}
+
+		// the default metadata is used by the controller submodule if no version is provided
+		var version string
+		if r.Intn(2) == 0 {
+			encoding := types.EncodingProtobuf
+			if r.Intn(2) == 0 {
+				encoding = types.EncodingProto3JSON
+			}
+
+			metadata := types.NewMetadata(
+				types.Version, connection.Id, connection.Counterparty.ConnectionId, "", encoding, types.TxTypeSDKMultiMsg,
+			)
+			version = string(types.ModuleCdc.MustMarshalJSON(&metadata))
+		}
+
+		ordering := channeltypes.ORDERED
+		if r.Intn(2) == 0 {
+			ordering = channeltypes.UNORDERED
+		}
+
+		// a closed active channel is reopened with the metadata and ordering of the closed channel
+		if activeChannelID, found := k.GetActiveChannelID(ctx, connection.Id, portID); found {
+			channel, _ := ibcKeeper.ChannelKeeper.GetChannel(ctx, portID, activeChannelID)
+			version, ordering = channel.Version, channel.Ordering
+		}
+
+		msg := controllertypes.NewMsgRegisterInterchainAccount(connection.Id, owner.Address.String(), version, ordering)
+
+		if _, err := ibcsims.DeliverTx(app, ctx, ak, owner, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return ibcsims.NewOperationMsg(types.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgChannelOpenAck generates a MsgChannelOpenAck opening a random interchain accounts channel in INIT
+// state with a solo machine counterparty. The solo machine acts as host chain and provides the address of the
+// interchain account in the counterparty version.
+func SimulateMsgChannelOpenAck(k *controllerkeeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelOpenAck{})
+
+		if !k.IsControllerEnabled(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "controller submodule is disabled"), nil, nil
+		}
+
+		channel, found := randomSolomachineChannel(r, ctx, ibcKeeper, accs, channeltypes.INIT)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no interchain accounts channel in INIT state with a solo machine counterparty found"), nil, nil
+		}
+
+		connection, solo, _ := ibcsims.GetChannelSolomachine(ctx, ibcKeeper, accs, channel.PortId, channel.ChannelId)
+
+		if _, found := k.GetOpenActiveChannel(ctx, channel.ConnectionHops[0], channel.PortId); found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "interchain account already has an open active channel"), nil, nil
+		}
+
+		metadata, err := types.MetadataFromVersion(channel.Version)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to parse channel metadata"), nil, err
+		}
+
+		metadata.Address = types.GenerateAddress(
+			authtypes.NewModuleAddress(types.ModuleName), connection.Counterparty.ConnectionId, channel.PortId,
+		).String()
+		counterpartyVersion := string(types.ModuleCdc.MustMarshalJSON(&metadata))
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		counterpartyChannelID := channeltypes.FormatChannelIdentifier(uint64(r.Intn(100)))
+		msg, err := ibcsims.GenMsgChannelOpenAck(solo, connection, channel, counterpartyChannelID, counterpartyVersion, signer.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create msg"), nil, err
+		}
+
+		if _, err := ibcsims.DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return ibcsims.NewOperationMsg(types.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgSendTx generates a MsgSendTx sending a bank transfer to be executed by a random interchain account
+// whose owner is a simulation account. The packets sent to solo machines are acknowledged either successfully or
+// with an error in the next block, keeping the acknowledgements of ordered channels in sequence. The packets
+// sent over the localhost connection are timed out in the next block, closing ordered channels.
+func SimulateMsgSendTx(k *controllerkeeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&controllertypes.MsgSendTx{})
+
+		if !k.IsControllerEnabled(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "controller submodule is disabled"), nil, nil
+		}
+
+		channel, found := randomSolomachineChannel(r, ctx, ibcKeeper, accs, channeltypes.OPEN)
+		if localhostChannel, ok := randomLocalhostChannel(r, ctx, ibcKeeper); ok && (!found || r.Intn(2) == 0) {
+			channel, found = localhostChannel, true
+		}
+
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open interchain accounts channel with a solo machine or localhost counterparty found"), nil, nil
+		}
+
+		connectionID := channel.ConnectionHops[0]
+		if activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, channel.PortId); !found || activeChannelID != channel.ChannelId {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "channel is not the active channel of the interchain account"), nil, nil
+		}
+
+		if k.IsMiddlewareEnabled(ctx, channel.PortId, connectionID) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "interchain account is owned by an authentication module"), nil, nil
+		}
+
+		ownerAddress, err := sdk.AccAddressFromBech32(strings.TrimPrefix(channel.PortId, types.PortPrefix))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "interchain account owner is not an account address"), nil, nil
+		}
+
+		owner, found := simtypes.FindAccount(accs, ownerAddress)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "interchain account owner is not a simulation account"), nil, nil
+		}
+
+		address, found := k.GetInterchainAccountAddress(ctx, connectionID, channel.PortId)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "interchain account address not found"), nil, nil
+		}
+
+		metadata, err := types.MetadataFromVersion(channel.Version)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to parse channel metadata"), nil, err
+		}
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 1000000))))
+		bankMsg := &banktypes.MsgSend{
+			FromAddress: address,
+			ToAddress:   recipient.Address.String(),
+			Amount:      amount,
+		}
+
+		data, err := types.SerializeCosmosTx(ibcKeeper.Codec(), []sdk.Msg{bankMsg}, metadata.Encoding)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to serialize cosmos tx"), nil, err
+		}
+
+		packetData := types.InterchainAccountPacketData{
+			Type: types.EXECUTE_TX,
+			Data: data,
+		}
+
+		relativeTimeout := uint64(time.Duration(simtypes.RandIntBetween(r, 1, 3600)) * time.Second)
+		msg := controllertypes.NewMsgSendTx(owner.Address.String(), connectionID, relativeTimeout, packetData)
+
+		res, err := ibcsims.DeliverTx(app, ctx, ak, owner, msg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		packet, err := ibcsims.ParsePacketFromEvents(res.Events)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to parse packet"), nil, err
+		}
+
+		op := ibcsims.SimulateMsgTimeout(ak, ibcKeeper, packet)
+		if !ibcsims.IsLocalhostChannel(channel.ConnectionHops) {
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			if r.Intn(2) == 0 {
+				ack = channeltypes.NewErrorAcknowledgement("simulated error")
+			}
+
+			op = ibcsims.SimulateMsgAcknowledgement(ak, ibcKeeper, packet, ack.Acknowledgement())
+		}
+
+		// future operations scheduled for the same block are executed in order, before any new packet is sent
+		futureOps := []simtypes.FutureOperation{
+			{
+				BlockHeight: int(ctx.BlockHeight()) + 1,
+				Op:          op,
+			},
+		}
+
+		return ibcsims.NewOperationMsg(types.ModuleName, msg), futureOps, nil
+	}
+}
+
+// randomSolomachineChannel returns a random interchain accounts controller channel in the provided state whose
+// counterparty is a solo machine controlled by one of the simulation accounts
+func randomSolomachineChannel(
+	r *rand.Rand, ctx sdk.Context, ibcKeeper *ibckeeper.Keeper, accs []simtypes.Account, state channeltypes.State,
+) (channeltypes.IdentifiedChannel, bool) {
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range ibcKeeper.ChannelKeeper.GetAllChannels(ctx) {
+		if strings.HasPrefix(channel.PortId, types.PortPrefix) && channel.State == state {
+			channels = append(channels, channel)
+		}
+	}
+
+	r.Shuffle(len(channels), func(i, j int) {
+		channels[i], channels[j] = channels[j], channels[i]
+	})
+
+	for _, channel := range channels {
+		if _, _, found := ibcsims.GetChannelSolomachine(ctx, ibcKeeper, accs, channel.PortId, channel.ChannelId); found {
+			return channel, true
+		}
+	}
+
+	return channeltypes.IdentifiedChannel{}, false
+}
+
+// randomLocalhostChannel returns a random open interchain accounts controller channel opened over the localhost
+// connection
+func randomLocalhostChannel(r *rand.Rand, ctx sdk.Context, ibcKeeper *ibckeeper.Keeper) (channeltypes.IdentifiedChannel, bool) {
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range ibcKeeper.ChannelKeeper.GetAllChannels(ctx) {
+		if strings.HasPrefix(channel.PortId, types.PortPrefix) && channel.State == channeltypes.OPEN && ibcsims.IsLocalhostChannel(channel.ConnectionHops) {
+			channels = append(channels, channel)
+		}
+	}
+
+	if len(channels) == 0 {
+		return channeltypes.IdentifiedChannel{}, false
+	}
+
+	return channels[r.Intn(len(channels))], true
+}
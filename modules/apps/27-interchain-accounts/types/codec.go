@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 var (
@@ -25,11 +26,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 }
 
 // SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
-// packed into Any's and inserted into the Messages field of a CosmosTx. The proto marshaled CosmosTx
-// bytes are returned. Only the ProtoCodec is supported for serializing messages.
-func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err error) {
+// packed into Any's and inserted into the Messages field of a CosmosTx. The CosmosTx is marshaled
+// using the provided encoding, either proto3 or proto3json, and the resulting bytes are returned.
+// Only the ProtoCodec is supported for serializing messages.
+func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg, encoding string) (bz []byte, err error) {
 	// only ProtoCodec is supported
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
@@ -46,7 +49,7 @@ func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err er
 		Messages: msgAnys,
 	}
 
-	bz, err = cdc.Marshal(cosmosTx)
+	bz, err = marshalWithEncoding(protoCdc, cosmosTx, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -54,17 +57,18 @@ func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err er
 	return bz, nil
 }
 
-// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes
-// into a slice of sdk.Msg's. Only the ProtoCodec is supported for message
-// deserialization.
-func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error) {
+// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes encoded
+// using the provided encoding, either proto3 or proto3json, into a slice of sdk.Msg's.
+// Only the ProtoCodec is supported for message deserialization.
+func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte, encoding string) ([]sdk.Msg, error) {
 	// only ProtoCodec is supported
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
 	var cosmosTx CosmosTx
-	if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
+	if err := unmarshalWithEncoding(protoCdc, data, &cosmosTx, encoding); err != nil {
 		return nil, err
 	}
 
@@ -83,3 +87,86 @@ func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error)
 
 	return msgs, nil
 }
+
+// SerializeTxResult marshals the results of the messages of an executed transaction using the provided
+// encoding, either proto3 or proto3json.
+func SerializeTxResult(txResult *TxResult, encoding string) ([]byte, error) {
+	return marshalWithEncoding(ModuleCdc, txResult, encoding)
+}
+
+// DeserializeTxResult unmarshals the results of the messages of an executed transaction encoded using the
+// provided encoding, either proto3 or proto3json.
+func DeserializeTxResult(data []byte, encoding string) (*TxResult, error) {
+	var txResult TxResult
+	if err := unmarshalWithEncoding(ModuleCdc, data, &txResult, encoding); err != nil {
+		return nil, err
+	}
+
+	return &txResult, nil
+}
+
+// SerializeCosmosQuery serializes a slice of abci.RequestQuery's using the CosmosQuery type.
+// The CosmosQuery bytes marshaled using the provided encoding, either proto3 or proto3json, are returned.
+func SerializeCosmosQuery(reqs []abci.RequestQuery, encoding string) ([]byte, error) {
+	cosmosQuery := &CosmosQuery{
+		Requests: reqs,
+	}
+
+	return marshalWithEncoding(ModuleCdc, cosmosQuery, encoding)
+}
+
+// DeserializeCosmosQuery unmarshals a slice of query bytes encoded using the provided encoding,
+// either proto3 or proto3json, into a slice of abci.RequestQuery's.
+func DeserializeCosmosQuery(data []byte, encoding string) ([]abci.RequestQuery, error) {
+	var cosmosQuery CosmosQuery
+	if err := unmarshalWithEncoding(ModuleCdc, data, &cosmosQuery, encoding); err != nil {
+		return nil, err
+	}
+
+	return cosmosQuery.Requests, nil
+}
+
+// SerializeCosmosResponse serializes a slice of abci.ResponseQuery's using the CosmosResponse type.
+// The CosmosResponse bytes marshaled using the provided encoding, either proto3 or proto3json, are returned.
+func SerializeCosmosResponse(responses []abci.ResponseQuery, encoding string) ([]byte, error) {
+	cosmosResponse := &CosmosResponse{
+		Responses: responses,
+	}
+
+	return marshalWithEncoding(ModuleCdc, cosmosResponse, encoding)
+}
+
+// DeserializeCosmosResponse unmarshals a slice of response bytes encoded using the provided encoding,
+// either proto3 or proto3json, into a slice of abci.ResponseQuery's.
+func DeserializeCosmosResponse(data []byte, encoding string) ([]abci.ResponseQuery, error) {
+	var cosmosResponse CosmosResponse
+	if err := unmarshalWithEncoding(ModuleCdc, data, &cosmosResponse, encoding); err != nil {
+		return nil, err
+	}
+
+	return cosmosResponse.Responses, nil
+}
+
+// marshalWithEncoding marshals the provided message using the proto3 or proto3json encoding
+func marshalWithEncoding(cdc *codec.ProtoCodec, msg codec.ProtoMarshaler, encoding string) ([]byte, error) {
+	switch encoding {
+	case EncodingProtobuf:
+		return cdc.Marshal(msg)
+	case EncodingProto3JSON:
+		return cdc.MarshalJSON(msg)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
+
+// unmarshalWithEncoding unmarshals the provided bytes into the message using the proto3 or proto3json encoding
+func unmarshalWithEncoding(cdc *codec.ProtoCodec, bz []byte, msg codec.ProtoMarshaler, encoding string) error {
+	switch encoding {
+	case EncodingProtobuf:
+		return cdc.Unmarshal(bz, msg)
+	case EncodingProto3JSON:
+		return cdc.UnmarshalJSON(bz, msg)
+	default:
+		return sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
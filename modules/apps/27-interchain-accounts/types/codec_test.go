@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v3/testing/simapp"
@@ -109,28 +110,46 @@ func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTx() {
 		},
 	}
 
-	testCasesAny := []caseRawBytes{}
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
 
-	for _, tc := range testCases {
-		bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs)
-		suite.Require().NoError(err, tc.name)
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		testCasesAny := []caseRawBytes{}
 
-		testCasesAny = append(testCasesAny, caseRawBytes{tc.name, bz, tc.expPass})
-	}
+		for _, tc := range testCases {
+			bz, err := types.SerializeCosmosTx(cdc, tc.msgs, encoding)
+			if encoding == types.EncodingProto3JSON && !tc.expPass {
+				// unregistered msg types cannot be marshaled using the proto3json encoding
+				suite.Require().Error(err, tc.name)
+			} else {
+				suite.Require().NoError(err, tc.name)
+			}
+
+			testCasesAny = append(testCasesAny, caseRawBytes{tc.name, bz, tc.expPass})
+		}
 
-	for i, tc := range testCasesAny {
-		msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.bz)
-		if tc.expPass {
-			suite.Require().NoError(err, tc.name)
-			suite.Require().Equal(testCases[i].msgs, msgs, tc.name)
-		} else {
-			suite.Require().Error(err, tc.name)
+		for i, tc := range testCasesAny {
+			msgs, err := types.DeserializeCosmosTx(cdc, tc.bz, encoding)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(testCases[i].msgs, msgs, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
 		}
+
+		// test deserializing unknown bytes
+		msgs, err := types.DeserializeCosmosTx(cdc, []byte("invalid"), encoding)
+		suite.Require().Error(err)
+		suite.Require().Empty(msgs)
 	}
 
-	// test deserializing unknown bytes
-	msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"))
-	suite.Require().Error(err)
+	// test unsupported encoding
+	bz, err := types.SerializeCosmosTx(cdc, testCases[0].msgs, "invalid")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(bz)
+
+	msgs, err := types.DeserializeCosmosTx(cdc, []byte{}, "invalid")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
 	suite.Require().Empty(msgs)
 }
 
@@ -141,12 +160,109 @@ func (suite *TypesTestSuite) TestDeserializeAndSerializeCosmosTxWithAmino() {
 	cdc := codec.NewLegacyAmino()
 	marshaler := codec.NewAminoCodec(cdc)
 
-	msgs, err := types.SerializeCosmosTx(marshaler, []sdk.Msg{&banktypes.MsgSend{}})
+	msgs, err := types.SerializeCosmosTx(marshaler, []sdk.Msg{&banktypes.MsgSend{}}, types.EncodingProtobuf)
 	suite.Require().Error(err)
 	suite.Require().Empty(msgs)
 
-	bz, err := types.DeserializeCosmosTx(marshaler, []byte{0x10, 0})
+	bz, err := types.DeserializeCosmosTx(marshaler, []byte{0x10, 0}, types.EncodingProtobuf)
 	suite.Require().Error(err)
 	suite.Require().Empty(bz)
 
 }
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosQuery() {
+	reqs := []abci.RequestQuery{
+		{
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+			Data: []byte("request"),
+		},
+		{
+			Path: "/cosmos.staking.v1beta1.Query/Params",
+		},
+	}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		bz, err := types.SerializeCosmosQuery(reqs, encoding)
+		suite.Require().NoError(err, encoding)
+
+		res, err := types.DeserializeCosmosQuery(bz, encoding)
+		suite.Require().NoError(err, encoding)
+		suite.Require().Equal(reqs, res, encoding)
+
+		// test deserializing unknown bytes
+		res, err = types.DeserializeCosmosQuery([]byte("invalid"), encoding)
+		suite.Require().Error(err, encoding)
+		suite.Require().Empty(res, encoding)
+	}
+
+	// test unsupported encoding
+	_, err := types.SerializeCosmosQuery(reqs, "invalid")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+}
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosResponse() {
+	responses := []abci.ResponseQuery{
+		{
+			Value:  []byte("response"),
+			Height: 10,
+		},
+		{
+			Code: 1,
+			Log:  "failed",
+		},
+	}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		bz, err := types.SerializeCosmosResponse(responses, encoding)
+		suite.Require().NoError(err, encoding)
+
+		res, err := types.DeserializeCosmosResponse(bz, encoding)
+		suite.Require().NoError(err, encoding)
+		suite.Require().Equal(responses, res, encoding)
+
+		// test deserializing unknown bytes
+		res, err = types.DeserializeCosmosResponse([]byte("invalid"), encoding)
+		suite.Require().Error(err, encoding)
+		suite.Require().Empty(res, encoding)
+	}
+
+	// test unsupported encoding
+	_, err := types.SerializeCosmosResponse(responses, "invalid")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+}
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeTxResult() {
+	txResult := &types.TxResult{
+		Results: []types.MsgResult{
+			{
+				MsgType: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+				Success: true,
+				Data:    []byte("response"),
+				GasUsed: 100,
+			},
+			{
+				MsgType: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+				GasUsed: 50,
+				Error:   "ABCI code: 5: error executing message on host chain: see events for details",
+			},
+		},
+	}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		bz, err := types.SerializeTxResult(txResult, encoding)
+		suite.Require().NoError(err, encoding)
+
+		res, err := types.DeserializeTxResult(bz, encoding)
+		suite.Require().NoError(err, encoding)
+		suite.Require().Equal(txResult, res, encoding)
+
+		// test deserializing unknown bytes
+		res, err = types.DeserializeTxResult([]byte("invalid"), encoding)
+		suite.Require().Error(err, encoding)
+		suite.Require().Nil(res, encoding)
+	}
+
+	// test unsupported encoding
+	_, err := types.SerializeTxResult(txResult, "invalid")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -36,6 +39,18 @@ var (
 
 	// PortKeyPrefix defines the key prefix used to store ports
 	PortKeyPrefix = "port"
+
+	// IsMiddlewareEnabledPrefix defines the key prefix used to store whether the channels of a port and connection
+	// are owned by an authentication module or by the controller Msg service
+	IsMiddlewareEnabledPrefix = "isMiddlewareEnabled"
+)
+
+var (
+	// MiddlewareEnabled is the value stored for ports and connections whose channels are owned by an authentication module
+	MiddlewareEnabled = []byte{0x01}
+
+	// MiddlewareDisabled is the value stored for ports and connections whose channels are owned by the controller Msg service
+	MiddlewareDisabled = []byte{0x00}
 )
 
 // KeyActiveChannel creates and returns a new key used for active channels store operations
@@ -52,3 +67,19 @@ func KeyOwnerAccount(portID, connectionID string) []byte {
 func KeyPort(portID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", PortKeyPrefix, portID))
 }
+
+// KeyIsMiddlewareEnabled creates and returns a new key used for middleware enabled store operations
+func KeyIsMiddlewareEnabled(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", IsMiddlewareEnabledPrefix, portID, connectionID))
+}
+
+// ParsePortAndConnectionID parses the port and connection identifiers from a store key with its prefix
+// removed, e.g. "icacontroller-cosmos1.../connection-0"
+func ParsePortAndConnectionID(key []byte) (string, string, error) {
+	keySplit := strings.Split(string(key), "/")
+	if len(keySplit) != 2 {
+		return "", "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "key %s must contain a port and connection identifier", key)
+	}
+
+	return keySplit[0], keySplit[1], nil
+}
@@ -13,3 +13,13 @@ func (suite *TypesTestSuite) TestKeyOwnerAccount() {
 	key := types.KeyOwnerAccount("port-id", "connection-id")
 	suite.Require().Equal("owner/port-id/connection-id", string(key))
 }
+
+func (suite *TypesTestSuite) TestParsePortAndConnectionID() {
+	portID, connectionID, err := types.ParsePortAndConnectionID([]byte("port-id/connection-id"))
+	suite.Require().NoError(err)
+	suite.Require().Equal("port-id", portID)
+	suite.Require().Equal("connection-id", connectionID)
+
+	_, _, err = types.ParsePortAndConnectionID([]byte("owner/port-id/connection-id"))
+	suite.Require().Error(err)
+}
@@ -10,7 +10,7 @@ import (
 const MaxMemoCharLength = 256
 
 // ValidateBasic performs basic validation of the interchain account packet data.
-// The memo may be empty.
+// The memo may be empty. Only EXECUTE_TX packets may use the PARTIAL execution mode.
 func (iapd InterchainAccountPacketData) ValidateBasic() error {
 	if iapd.Type == UNSPECIFIED {
 		return sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data type cannot be unspecified")
@@ -24,6 +24,20 @@ func (iapd InterchainAccountPacketData) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
 	}
 
+	switch iapd.ExecutionMode {
+	case ATOMIC:
+	case PARTIAL:
+		if iapd.Type != EXECUTE_TX {
+			return sdkerrors.Wrapf(ErrInvalidOutgoingData, "execution mode %s is not supported by packet data type %s", iapd.ExecutionMode, iapd.Type)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "invalid execution mode %s", iapd.ExecutionMode)
+	}
+
+	if iapd.GasLimit != 0 && iapd.Type != EXECUTE_TX {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "gas limit is not supported by packet data type %s", iapd.Type)
+	}
+
 	return nil
 }
 
@@ -29,6 +29,51 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			true,
 		},
+		{
+			"success, partial execution mode",
+			types.InterchainAccountPacketData{
+				Type:          types.EXECUTE_TX,
+				Data:          []byte("data"),
+				ExecutionMode: types.PARTIAL,
+			},
+			true,
+		},
+		{
+			"partial execution mode of queries",
+			types.InterchainAccountPacketData{
+				Type:          types.EXECUTE_QUERY,
+				Data:          []byte("data"),
+				ExecutionMode: types.PARTIAL,
+			},
+			false,
+		},
+		{
+			"success, gas limit",
+			types.InterchainAccountPacketData{
+				Type:     types.EXECUTE_TX,
+				Data:     []byte("data"),
+				GasLimit: 100000,
+			},
+			true,
+		},
+		{
+			"gas limit of queries",
+			types.InterchainAccountPacketData{
+				Type:     types.EXECUTE_QUERY,
+				Data:     []byte("data"),
+				GasLimit: 100000,
+			},
+			false,
+		},
+		{
+			"invalid execution mode",
+			types.InterchainAccountPacketData{
+				Type:          types.EXECUTE_TX,
+				Data:          []byte("data"),
+				ExecutionMode: 10,
+			},
+			false,
+		},
 		{
 			"type unspecified",
 			types.InterchainAccountPacketData{
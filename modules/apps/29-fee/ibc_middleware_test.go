@@ -0,0 +1,329 @@
+package fee_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+var (
+	defaultRecvFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(200)}}
+	defaultTimeoutFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(300)}}
+)
+
+// feeVersion returns the fee version metadata wrapping the provided application version
+func feeVersion(feeVersion, appVersion string) string {
+	return string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: feeVersion, AppVersion: appVersion}))
+}
+
+// TestFeeHandshake tests the channel opening handshake through the fee middleware
+func (suite *FeeTestSuite) TestFeeHandshake() {
+	testCases := []struct {
+		name          string
+		version       string
+		expFeeEnabled bool
+		expPass       bool
+	}{
+		{
+			"success: fee enabled", feeVersion(types.Version, ibcmock.Version), true, true,
+		},
+		{
+			"success: fee disabled, version passed through to the underlying application", ibcmock.Version, false, true,
+		},
+		{
+			"invalid fee version", feeVersion("invalid-ics29-1", ibcmock.Version), false, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.coordinator.SetupConnections(suite.path)
+
+			suite.path.EndpointA.ChannelConfig.Version = tc.version
+			suite.path.EndpointB.ChannelConfig.Version = tc.version
+
+			if !tc.expPass {
+				module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), ibctesting.MockFeePort)
+				suite.Require().NoError(err)
+
+				cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
+				suite.Require().True(ok)
+
+				chanCap, err := suite.chainA.App.GetScopedIBCKeeper().NewCapability(suite.chainA.GetContext(), fmt.Sprintf("capabilities/ports/%s/channels/%s", ibctesting.MockFeePort, ibctesting.FirstChannelID))
+				suite.Require().NoError(err)
+
+				counterparty := channeltypes.NewCounterparty(suite.path.EndpointB.ChannelConfig.PortID, "")
+				err = cbs.OnChanOpenInit(
+					suite.chainA.GetContext(), channeltypes.UNORDERED, []string{suite.path.EndpointA.ConnectionID},
+					ibctesting.MockFeePort, ibctesting.FirstChannelID, chanCap, counterparty, tc.version,
+				)
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(suite.path.EndpointA.ChanOpenInit())
+			suite.Require().NoError(suite.path.EndpointB.ChanOpenTry())
+			suite.Require().NoError(suite.path.EndpointA.ChanOpenAck())
+			suite.Require().NoError(suite.path.EndpointB.ChanOpenConfirm())
+
+			suite.Require().Equal(tc.version, suite.path.EndpointA.GetChannel().Version)
+			suite.Require().Equal(tc.version, suite.path.EndpointB.GetChannel().Version)
+
+			isFeeEnabledA := suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			isFeeEnabledB := suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+			suite.Require().Equal(tc.expFeeEnabled, isFeeEnabledA)
+			suite.Require().Equal(tc.expFeeEnabled, isFeeEnabledB)
+		})
+	}
+}
+
+// TestOnChanOpenTryVersionWrapping tests that the version returned by the underlying application
+// on OnChanOpenTry is wrapped in the fee version metadata
+func (suite *FeeTestSuite) TestOnChanOpenTryVersionWrapping() {
+	suite.coordinator.SetupConnections(suite.path)
+	suite.Require().NoError(suite.path.EndpointA.ChanOpenInit())
+
+	module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), ibctesting.MockFeePort)
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+	suite.Require().True(ok)
+
+	chanCap, err := suite.chainB.App.GetScopedIBCKeeper().NewCapability(suite.chainB.GetContext(), fmt.Sprintf("capabilities/ports/%s/channels/%s", ibctesting.MockFeePort, ibctesting.FirstChannelID))
+	suite.Require().NoError(err)
+
+	counterparty := channeltypes.NewCounterparty(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	version, err := cbs.OnChanOpenTry(
+		suite.chainB.GetContext(), channeltypes.UNORDERED, []string{suite.path.EndpointB.ConnectionID},
+		ibctesting.MockFeePort, ibctesting.FirstChannelID, chanCap, counterparty, feeVersion(types.Version, "counterparty-version"),
+	)
+	suite.Require().NoError(err)
+
+	// the mock application always selects its own version
+	suite.Require().Equal(feeVersion(types.Version, ibcmock.Version), version)
+}
+
+// TestPacketLifecycleAcknowledgement tests that the forward and reverse relayers are paid and the
+// timeout fee is refunded once an incentivized packet is acknowledged
+func (suite *FeeTestSuite) TestPacketLifecycleAcknowledgement() {
+	suite.coordinator.Setup(suite.path)
+
+	forwardPayee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	reversePayee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// the relayer on chainB registers the address it should be paid at on chainA
+	_, err := suite.chainB.SendMsgs(types.NewMsgRegisterCounterpartyPayee(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		suite.chainB.SenderAccount.GetAddress().String(), forwardPayee.String(),
+	))
+	suite.Require().NoError(err)
+
+	// the relayer on chainA registers a payee for reverse relaying
+	_, err = suite.chainA.SendMsgs(types.NewMsgRegisterPayee(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.chainA.SenderAccount.GetAddress().String(), reversePayee.String(),
+	))
+	suite.Require().NoError(err)
+
+	// escrow the fee for the next packet and send it
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	_, err = suite.chainA.SendMsgs(types.NewMsgPayPacketFee(
+		fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(),
+	))
+	suite.Require().NoError(err)
+
+	packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+
+	refundBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	packet := channeltypes.NewPacket(
+		ibcmock.MockPacketData, packetID.Sequence,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100), 0,
+	)
+	suite.Require().NoError(suite.path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// fees are no longer in escrow
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress()).Empty())
+
+	// forward relayer is paid the recv fee at the registered counterparty payee
+	forwardBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forwardPayee, sdk.DefaultBondDenom)
+	suite.Require().Equal(defaultRecvFee[0], forwardBal)
+
+	// reverse relayer is paid the ack fee at the registered payee
+	reverseBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reversePayee, sdk.DefaultBondDenom)
+	suite.Require().Equal(defaultAckFee[0], reverseBal)
+
+	// timeout fee is refunded
+	expRefundBal := refundBal.Add(defaultTimeoutFee[0])
+	suite.Require().Equal(expRefundBal, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
+
+// TestPacketLifecycleAcknowledgementNoCounterpartyPayee tests that the recv fee is refunded
+// when the forward relayer has not registered a counterparty payee
+func (suite *FeeTestSuite) TestPacketLifecycleAcknowledgementNoCounterpartyPayee() {
+	suite.coordinator.Setup(suite.path)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	_, err := suite.chainA.SendMsgs(types.NewMsgPayPacketFee(
+		fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(),
+	))
+	suite.Require().NoError(err)
+
+	refundBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	packet := channeltypes.NewPacket(
+		ibcmock.MockPacketData, 1,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100), 0,
+	)
+	suite.Require().NoError(suite.path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// the reverse relayer is also the refund account: it is paid the ack fee and refunded the recv and timeout fees
+	expRefundBal := refundBal.Add(defaultRecvFee[0]).Add(defaultAckFee[0]).Add(defaultTimeoutFee[0])
+	suite.Require().Equal(expRefundBal, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress()).Empty())
+}
+
+// TestPacketLifecycleTimeout tests that the timeout relayer is paid and the recv and ack fees are
+// refunded once an incentivized packet times out
+func (suite *FeeTestSuite) TestPacketLifecycleTimeout() {
+	suite.coordinator.Setup(suite.path)
+
+	timeoutPayee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err := suite.chainA.SendMsgs(types.NewMsgRegisterPayee(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.chainA.SenderAccount.GetAddress().String(), timeoutPayee.String(),
+	))
+	suite.Require().NoError(err)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	_, err = suite.chainA.SendMsgs(types.NewMsgPayPacketFee(
+		fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(),
+	))
+	suite.Require().NoError(err)
+
+	refundBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	packet := channeltypes.NewPacket(
+		ibcmock.MockPacketData, 1,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0,
+	)
+	suite.Require().NoError(suite.path.EndpointA.SendPacket(packet))
+
+	// need to update chainA's client representing chainB to prove missing ack
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+
+	// timeout relayer is paid the timeout fee at the registered payee
+	suite.Require().Equal(defaultTimeoutFee[0], suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutPayee, sdk.DefaultBondDenom))
+
+	// recv and ack fees are refunded
+	expRefundBal := refundBal.Add(defaultRecvFee[0]).Add(defaultAckFee[0])
+	suite.Require().Equal(expRefundBal, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
+
+// TestOnChanCloseInit tests that all fees escrowed on a channel are refunded on channel closure
+func (suite *FeeTestSuite) TestOnChanCloseInit() {
+	suite.coordinator.Setup(suite.path)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	refundBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	_, err := suite.chainA.SendMsgs(types.NewMsgPayPacketFee(
+		fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(),
+	))
+	suite.Require().NoError(err)
+
+	module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), ibctesting.MockFeePort)
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
+	suite.Require().True(ok)
+
+	err = cbs.OnChanCloseInit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+
+	packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+	suite.Require().Equal(refundBal, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
+
+// TestChannelUpgrade tests enabling and disabling fees on an existing channel using the channel upgrade handshake
+func (suite *FeeTestSuite) TestChannelUpgrade() {
+	// open a channel without fees
+	suite.path.EndpointA.ChannelConfig.Version = ibcmock.Version
+	suite.path.EndpointB.ChannelConfig.Version = ibcmock.Version
+	suite.coordinator.Setup(suite.path)
+
+	upgradeChannel := func(version string) {
+		timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+		timeoutHeight.RevisionHeight += 1000
+
+		upgrade := channeltypes.NewUpgrade(channeltypes.UNORDERED, []string{suite.path.EndpointA.ConnectionID}, version, timeoutHeight, 0, 0)
+		suite.Require().NoError(suite.path.EndpointA.ChanUpgradeInit(upgrade))
+		suite.Require().NoError(suite.path.EndpointB.ChanUpgradeTry())
+		suite.Require().NoError(suite.path.EndpointA.ChanUpgradeAck())
+		suite.Require().NoError(suite.path.EndpointB.ChanUpgradeConfirm())
+	}
+
+	isFeeEnabled := func() (bool, bool) {
+		return suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID),
+			suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+	}
+
+	enabledA, enabledB := isFeeEnabled()
+	suite.Require().False(enabledA)
+	suite.Require().False(enabledB)
+
+	// upgrade the channel to use fees
+	upgradeChannel(feeVersion(types.Version, ibcmock.Version))
+
+	enabledA, enabledB = isFeeEnabled()
+	suite.Require().True(enabledA)
+	suite.Require().True(enabledB)
+	suite.Require().Equal(feeVersion(types.Version, ibcmock.Version), suite.path.EndpointA.GetChannel().Version)
+	suite.Require().Equal(feeVersion(types.Version, ibcmock.Version), suite.path.EndpointB.GetChannel().Version)
+
+	// fees escrowed on chainB are refunded once fees are disabled on the channel
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	refundBal := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	_, err := suite.chainB.SendMsgs(types.NewMsgPayPacketFee(
+		fee, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, suite.chainB.SenderAccount.GetAddress().String(),
+	))
+	suite.Require().NoError(err)
+
+	// upgrade the channel to no longer use fees
+	upgradeChannel(ibcmock.Version)
+
+	enabledA, enabledB = isFeeEnabled()
+	suite.Require().False(enabledA)
+	suite.Require().False(enabledB)
+	suite.Require().Equal(ibcmock.Version, suite.path.EndpointA.GetChannel().Version)
+	suite.Require().Equal(ibcmock.Version, suite.path.EndpointB.GetChannel().Version)
+
+	suite.Require().Empty(suite.chainB.GetSimApp().IBCFeeKeeper.GetIdentifiedPacketFeesForChannel(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID))
+	suite.Require().Equal(refundBal, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
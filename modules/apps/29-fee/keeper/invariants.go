@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+)
+
+// RegisterInvariants registers all fee middleware invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-escrowed-fees", TotalEscrowedFeesInvariant(k))
+}
+
+// TotalEscrowedFeesInvariant checks that the sum of all packet fees held in escrow
+// is equal to the balance of the fee module account
+func TotalEscrowedFeesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalEscrowed := k.GetTotalEscrowedFees(ctx)
+		moduleBalance := k.bankKeeper.GetAllBalances(ctx, k.GetFeeModuleAddress())
+
+		// sdk.Coins.IsEqual panics on differing denominations, compare in both directions instead
+		broken := !totalEscrowed.IsAllLTE(moduleBalance) || !moduleBalance.IsAllLTE(totalEscrowed)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total escrowed fees",
+			fmt.Sprintf("\ttotal fees in escrow: %s\n\tfee module account balance: %s\n", totalEscrowed, moduleBalance),
+		), broken
+	}
+}
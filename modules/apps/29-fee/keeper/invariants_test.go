@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestTotalEscrowedFeesInvariant() {
+	var fee types.Fee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no fees in escrow",
+			func() {},
+			true,
+		},
+		{
+			"success: escrowed fees match module account balance",
+			func() {
+				for i := 1; i < 4; i++ {
+					packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, uint64(i))
+					packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())
+
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+					err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+					suite.Require().NoError(err)
+				}
+			},
+			true,
+		},
+		{
+			"failure: escrowed fees exceed module account balance",
+			func() {
+				packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			},
+			false,
+		},
+		{
+			"failure: module account balance exceeds escrowed fees",
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			tc.malleate()
+
+			msg, broken := keeper.TotalEscrowedFeesInvariant(suite.chainA.GetSimApp().IBCFeeKeeper)(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().False(broken, msg)
+			} else {
+				suite.Require().True(broken, msg)
+			}
+		})
+	}
+}
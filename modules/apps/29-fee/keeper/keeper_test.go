@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+var (
+	defaultRecvFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(200)}}
+	defaultTimeoutFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(300)}}
+	invalidCoins      = sdk.Coins{sdk.Coin{Denom: "invalidDenom", Amount: sdk.NewInt(100)}}
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	path     *ibctesting.Path
+	pathAToC *ibctesting.Path
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.path = NewFeePath(suite.chainA, suite.chainB)
+	suite.pathAToC = NewFeePath(suite.chainA, suite.chainC)
+
+	suite.coordinator.Setup(suite.path)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().IBCFeeKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// NewFeePath returns a path between the fee enabled mock applications on the provided chains
+func NewFeePath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	feeVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: ibcmock.Version}))
+	path.EndpointA.ChannelConfig.Version = feeVersion
+	path.EndpointB.ChannelConfig.Version = feeVersion
+	path.EndpointA.ChannelConfig.PortID = ibctesting.MockFeePort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.MockFeePort
+
+	return path
+}
+
+func (suite *KeeperTestSuite) TestEscrowAccountHasBalance() {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.EscrowAccountHasBalance(suite.chainA.GetContext(), fee.Total()))
+
+	// set fee in escrow account
+	err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+	suite.Require().Nil(err)
+
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.EscrowAccountHasBalance(suite.chainA.GetContext(), fee.Total()))
+
+	// increase ack fee
+	fee.AckFee = fee.AckFee.Add(defaultAckFee...)
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.EscrowAccountHasBalance(suite.chainA.GetContext(), fee.Total()))
+}
+
+func (suite *KeeperTestSuite) TestFeesInEscrow() {
+	// escrow five fees for packet sequence 1
+	packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())
+	packetFees := []types.PacketFee{packetFee, packetFee, packetFee, packetFee, packetFee}
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
+
+	// retrieve the fees in escrow and assert the length of PacketFees
+	feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().True(found)
+	suite.Require().Len(feesInEscrow.PacketFees, 5, fmt.Sprintf("expected length 5, but got %d", len(feesInEscrow.PacketFees)))
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+
+	// delete fees for packet sequence 1
+	suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeesInEscrow(suite.chainA.GetContext(), packetID)
+	hasFeesInEscrow := suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().False(hasFeesInEscrow)
+}
+
+func (suite *KeeperTestSuite) TestGetTotalEscrowedFees() {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	refundAcc := suite.chainA.SenderAccount.GetAddress().String()
+
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.GetTotalEscrowedFees(suite.chainA.GetContext()).Empty())
+
+	for i := 1; i < 4; i++ {
+		packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, uint64(i))
+		packetFees := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAcc), types.NewPacketFee(fee, refundAcc)})
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, packetFees)
+	}
+
+	expectedTotal := sdk.NewCoins()
+	for i := 0; i < 6; i++ {
+		expectedTotal = expectedTotal.Add(fee.Total()...)
+	}
+
+	suite.Require().Equal(expectedTotal, suite.chainA.GetSimApp().IBCFeeKeeper.GetTotalEscrowedFees(suite.chainA.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestIsFeeEnabled() {
+	// fee enabled flags are stored per port and channel
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID))
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, "channel-100"))
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID))
+}
+
+func (suite *KeeperTestSuite) TestGetAllIdentifiedPacketFees() {
+	// escrow a fee
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	// escrow the packet fee
+	packetFee := types.NewPacketFee(fee, refundAcc.String())
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+	expectedFees := []types.IdentifiedPacketFees{
+		{
+			PacketId: packetID,
+			PacketFees: []types.PacketFee{
+				{
+					Fee:           fee,
+					RefundAddress: refundAcc.String(),
+				},
+			},
+		},
+	}
+
+	identifiedFees := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllIdentifiedPacketFees(suite.chainA.GetContext())
+	suite.Require().Len(identifiedFees, len(expectedFees))
+	suite.Require().Equal(identifiedFees, expectedFees)
+}
+
+func (suite *KeeperTestSuite) TestGetAllFeeEnabledChannels() {
+	validPortId := "ibcmoduleport"
+	// set two channels enabled
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), validPortId, ibctesting.FirstChannelID)
+
+	expectedCh := []types.FeeEnabledChannel{
+		{
+			PortId:    validPortId,
+			ChannelId: ibctesting.FirstChannelID,
+		},
+		{
+			PortId:    ibctesting.MockFeePort,
+			ChannelId: ibctesting.FirstChannelID,
+		},
+	}
+
+	ch := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllFeeEnabledChannels(suite.chainA.GetContext())
+	suite.Require().Len(ch, len(expectedCh))
+	suite.Require().Equal(ch, expectedCh)
+}
+
+func (suite *KeeperTestSuite) TestGetAllPayees() {
+	var expectedPayees []types.RegisteredPayee
+
+	for i := 0; i < 3; i++ {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(
+			suite.chainA.GetContext(),
+			suite.chainA.SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccount.GetAddress().String(),
+			ibctesting.FirstChannelID,
+		)
+
+		registeredPayee := types.RegisteredPayee{
+			Relayer:   suite.chainA.SenderAccount.GetAddress().String(),
+			Payee:     suite.chainB.SenderAccount.GetAddress().String(),
+			ChannelId: ibctesting.FirstChannelID,
+		}
+
+		expectedPayees = append(expectedPayees, registeredPayee)
+	}
+
+	registeredPayees := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllPayees(suite.chainA.GetContext())
+	suite.Require().Len(registeredPayees, 1)
+	suite.Require().Equal(expectedPayees[0], registeredPayees[0])
+}
+
+func (suite *KeeperTestSuite) TestGetAllCounterpartyPayees() {
+	relayerAddr := suite.chainA.SenderAccount.GetAddress().String()
+	counterpartyPayee := "cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh"
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(suite.chainA.GetContext(), relayerAddr, counterpartyPayee, ibctesting.FirstChannelID)
+
+	expectedCounterpartyPayee := []types.RegisteredCounterpartyPayee{
+		{
+			Relayer:           relayerAddr,
+			CounterpartyPayee: counterpartyPayee,
+			ChannelId:         ibctesting.FirstChannelID,
+		},
+	}
+
+	counterpartyPayeeAddr := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllCounterpartyPayees(suite.chainA.GetContext())
+	suite.Require().Len(counterpartyPayeeAddr, len(expectedCounterpartyPayee))
+	suite.Require().Equal(counterpartyPayeeAddr, expectedCounterpartyPayee)
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// 29-fee sentinel errors
+var (
+	ErrInvalidVersion                = sdkerrors.Register(ModuleName, 2, "invalid ICS29 middleware version")
+	ErrRefundAccNotFound             = sdkerrors.Register(ModuleName, 3, "no account found for given refund address")
+	ErrBalanceNotFound               = sdkerrors.Register(ModuleName, 4, "balance not found for given account address")
+	ErrFeeNotFound                   = sdkerrors.Register(ModuleName, 5, "there is no fee escrowed for the given packetID")
+	ErrCounterpartyPayeeEmpty        = sdkerrors.Register(ModuleName, 6, "counterparty payee must not be empty")
+	ErrForwardRelayerAddressNotFound = sdkerrors.Register(ModuleName, 7, "forward relayer address not found")
+	ErrFeeNotEnabled                 = sdkerrors.Register(ModuleName, 8, "fee module is not enabled for this channel. If this error occurs after channel setup, fee module may not be enabled")
+	ErrRelayerNotFoundForAsyncAck    = sdkerrors.Register(ModuleName, 9, "relayer address must be stored for async WriteAcknowledgement")
+	ErrPacketNotSent                 = sdkerrors.Register(ModuleName, 10, "packet has not been sent")
+)
@@ -0,0 +1,156 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	defaultAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	payeeAddr   = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	defaultFee = types.NewFee(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	)
+)
+
+func TestMsgRegisterPayeeValidateBasic(t *testing.T) {
+	var msg *types.MsgRegisterPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"invalid portID", func() { msg.PortId = "" }, false},
+		{"invalid channelID", func() { msg.ChannelId = "" }, false},
+		{"relayer and payee are equal", func() { msg.Payee = msg.Relayer }, false},
+		{"invalid relayer address", func() { msg.Relayer = "invalid-address" }, false},
+		{"invalid payee address", func() { msg.Payee = "invalid-address" }, false},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgRegisterPayee(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAddr, payeeAddr)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterCounterpartyPayeeValidateBasic(t *testing.T) {
+	var msg *types.MsgRegisterCounterpartyPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: counterparty payee is not a valid address on this chain", func() { msg.CounterpartyPayee = "cosmos-counterparty-payee" }, true},
+		{"invalid portID", func() { msg.PortId = "" }, false},
+		{"invalid channelID", func() { msg.ChannelId = "" }, false},
+		{"invalid relayer address", func() { msg.Relayer = "invalid-address" }, false},
+		{"empty counterparty payee", func() { msg.CounterpartyPayee = "  " }, false},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgRegisterCounterpartyPayee(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAddr, payeeAddr)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPayPacketFeeValidateBasic(t *testing.T) {
+	var msg *types.MsgPayPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"invalid portID", func() { msg.SourcePortId = "" }, false},
+		{"invalid channelID", func() { msg.SourceChannelId = "" }, false},
+		{"invalid signer address", func() { msg.Signer = "invalid-address" }, false},
+		{"all fees are zero", func() { msg.Fee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{}) }, false},
+		{"invalid recv fee", func() { msg.Fee.RecvFee = sdk.Coins{sdk.Coin{Denom: "0stake", Amount: sdk.NewInt(100)}} }, false},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgPayPacketFee(defaultFee, ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAddr)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPayPacketFeeAsyncValidateBasic(t *testing.T) {
+	var msg *types.MsgPayPacketFeeAsync
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"invalid packet sequence", func() { msg.PacketId.Sequence = 0 }, false},
+		{"invalid packet portID", func() { msg.PacketId.PortId = "" }, false},
+		{"invalid refund address", func() { msg.PacketFee.RefundAddress = "invalid-address" }, false},
+		{"all fees are zero", func() { msg.PacketFee.Fee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{}) }, false},
+	}
+
+	for _, tc := range testCases {
+		packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+		msg = types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(defaultFee, defaultAddr))
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgGetSigners(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(defaultAddr)
+	require.NoError(t, err)
+
+	packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+
+	require.Equal(t, []sdk.AccAddress{addr}, types.NewMsgRegisterPayee(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAddr, payeeAddr).GetSigners())
+	require.Equal(t, []sdk.AccAddress{addr}, types.NewMsgRegisterCounterpartyPayee(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAddr, payeeAddr).GetSigners())
+	require.Equal(t, []sdk.AccAddress{addr}, types.NewMsgPayPacketFee(defaultFee, ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAddr).GetSigners())
+	require.Equal(t, []sdk.AccAddress{addr}, types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(defaultFee, defaultAddr)).GetSigners())
+}
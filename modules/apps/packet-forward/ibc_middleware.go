@@ -0,0 +1,293 @@
+package packetforward
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ porttypes.Middleware = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the packet forward middleware given the
+// packet forward keeper and the underlying ICS-20 transfer application.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddlware given the keeper and underlying application
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnChanUpgradeInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) error {
+	return im.app.OnChanUpgradeInit(ctx, portID, channelID, order, connectionHops, version)
+}
+
+// OnChanUpgradeTry implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanUpgradeTry(ctx, portID, channelID, order, connectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanUpgradeConfirm(ctx, portID, channelID)
+}
+
+// OnChanUpgradeRestore implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanUpgradeRestore(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+	im.app.OnChanUpgradeRestore(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCMiddleware interface.
+// If the receiver of the ICS-20 packet contains a forwarding instruction, the tokens are received by an
+// intermediate account and forwarded to the next hop. The acknowledgement is written asynchronously once
+// the forwarded packet is acknowledged or times out. Otherwise the packet is passed to the underlying application.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, err := types.ParseForwardMetadata(data.Receiver)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	if metadata == nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	inFlightPacket, err := newInFlightPacket(packet, data, *metadata)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	// the underlying application credits the intermediate account in place of the forwarding instruction
+	data.Receiver = inFlightPacket.IntermediateAddress
+	recvPacket := packet
+	recvPacket.Data = data.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, recvPacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	// NOTE: state changes made by the underlying application are discarded by core IBC upon an error acknowledgement
+	if err := im.keeper.ForwardTransferPacket(ctx, inFlightPacket); err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	// the acknowledgement is written once the forwarded packet is acknowledged or times out
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCMiddleware interface
+// If the acknowledged packet was forwarded by this middleware, its acknowledgement is written
+// as the acknowledgement of the original packet.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	return im.keeper.OnForwardedPacketAcknowledged(ctx, inFlightPacket, ack)
+}
+
+// OnTimeoutPacket implements the IBCMiddleware interface
+// If the timed out packet was forwarded by this middleware, it is either resent or the original
+// packet is acknowledged with an error.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	return im.keeper.OnForwardedPacketTimeout(ctx, inFlightPacket)
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+) error {
+	return im.keeper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return im.keeper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the underlying application
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.keeper.GetAppVersion(ctx, portID, channelID)
+}
+
+// newInFlightPacket constructs the in-flight packet for a received packet containing the given forwarding instruction
+func newInFlightPacket(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, metadata types.ForwardMetadata) (types.InFlightPacket, error) {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return types.InFlightPacket{}, sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	receiver, err := metadata.GetNextReceiver()
+	if err != nil {
+		return types.InFlightPacket{}, err
+	}
+
+	timeout, err := metadata.GetTimeout()
+	if err != nil {
+		return types.InFlightPacket{}, err
+	}
+
+	return types.InFlightPacket{
+		OriginalPacket:      packet,
+		ForwardPacketId:     channeltypes.NewPacketId(metadata.Port, metadata.Channel, 0),
+		IntermediateAddress: types.GetIntermediateAddress(packet.GetDestChannel(), data.Sender).String(),
+		Token:               sdk.NewCoin(transfertypes.GetReceivedDenom(packet, data.Denom), amount),
+		Receiver:            receiver,
+		Timeout:             uint64(timeout),
+		RetriesRemaining:    metadata.GetRetries(),
+	}, nil
+}
@@ -0,0 +1,423 @@
+package packetforward_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	timeoutHeight = clienttypes.NewHeight(0, 1000)
+	amount        = sdk.NewInt(100)
+)
+
+type PacketForwardTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	pathAToB *ibctesting.Path
+	pathBToC *ibctesting.Path
+}
+
+func (suite *PacketForwardTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.pathAToB = NewTransferPath(suite.chainA, suite.chainB)
+	suite.pathBToC = NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(suite.pathAToB)
+	suite.coordinator.Setup(suite.pathBToC)
+}
+
+func TestPacketForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(PacketForwardTestSuite))
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+// forwardReceiver returns the JSON encoded forwarding instruction used as the receiver of a transfer
+func forwardReceiver(forward types.ForwardMetadata) string {
+	bz, err := json.Marshal(types.PacketMetadata{Forward: &forward})
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bz)
+}
+
+// sendTransfer sends the default amount of the bond denom from the sender on chainA to chainB and returns the sent packet
+func (suite *PacketForwardTestSuite) sendTransfer(receiver string) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		suite.pathAToB.EndpointA.ChannelConfig.PortID, suite.pathAToB.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, timeoutHeight, 0, "",
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// recvPacket receives the packet on the counterparty of the path and returns the result
+func recvPacket(path *ibctesting.Path, packet channeltypes.Packet) *sdk.Result {
+	if err := path.EndpointB.UpdateClient(); err != nil {
+		panic(err)
+	}
+
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// acknowledgePacket acknowledges the packet on the sending chain of the path and returns the result
+func acknowledgePacket(path *ibctesting.Path, packet channeltypes.Packet, ack []byte) *sdk.Result {
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, path.EndpointA.Chain.SenderAccount.GetAddress().String())
+	res, err := path.EndpointA.Chain.SendMsgs(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// timeoutPacket times out the packet on the sending chain of the path and returns the result
+func timeoutPacket(path *ibctesting.Path, packet channeltypes.Packet) *sdk.Result {
+	if err := path.EndpointA.UpdateClient(); err != nil {
+		panic(err)
+	}
+
+	packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgTimeout(packet, 1, proof, proofHeight, path.EndpointA.Chain.SenderAccount.GetAddress().String())
+	res, err := path.EndpointA.Chain.SendMsgs(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// voucherDenom returns the denomination of the bond denom after being transferred over the given
+// port and channel pairs, each pair being the destination of a hop
+func voucherDenom(hops ...string) string {
+	path := ""
+	for i := 0; i < len(hops); i += 2 {
+		path = transfertypes.GetPrefixedDenom(hops[i], hops[i+1], path)
+	}
+
+	return transfertypes.ParseDenomTrace(path + sdk.DefaultBondDenom).IBCDenom()
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacket() {
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	receiver := suite.chainC.SenderAccount.GetAddress().String()
+	packet := suite.sendTransfer(forwardReceiver(types.ForwardMetadata{
+		Receiver: receiver,
+		Port:     suite.pathBToC.EndpointA.ChannelConfig.PortID,
+		Channel:  suite.pathBToC.EndpointA.ChannelID,
+	}))
+
+	// the packet is received on chainB and forwarded to chainC without writing an acknowledgement
+	res := recvPacket(suite.pathAToB, packet)
+	_, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.pathBToC.EndpointA.ChannelID, forwardPacket.GetSourceChannel())
+
+	inFlightPacket, found := suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.SourcePort, forwardPacket.SourceChannel, forwardPacket.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(packet, inFlightPacket.OriginalPacket)
+
+	// the forwarded packet is received on chainC and acknowledged on chainB
+	res = recvPacket(suite.pathBToC, forwardPacket)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = acknowledgePacket(suite.pathBToC, forwardPacket, ack)
+
+	// the acknowledgement of the forwarded packet is written for the original packet
+	originalAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(ack, originalAck)
+
+	_, found = suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.SourcePort, forwardPacket.SourceChannel, forwardPacket.Sequence)
+	suite.Require().False(found)
+
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+	acknowledgePacket(suite.pathAToB, packet, originalAck)
+
+	// tokens arrive on chainC
+	denomOnC := voucherDenom(
+		suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID,
+		suite.pathBToC.EndpointB.ChannelConfig.PortID, suite.pathBToC.EndpointB.ChannelID,
+	)
+	balance := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), denomOnC)
+	suite.Require().Equal(amount, balance.Amount)
+
+	// the intermediate account holds nothing
+	denomOnB := voucherDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID)
+	intermediateAddr := types.GetIntermediateAddress(packet.GetDestChannel(), suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), intermediateAddr).Empty())
+	suite.Require().Equal(amount, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), transfertypes.GetEscrowAddress(forwardPacket.SourcePort, forwardPacket.SourceChannel), denomOnB).Amount)
+
+	// the sender is not refunded
+	expBalance := senderBalance.Sub(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+	suite.Require().Equal(expBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketMultiHop() {
+	// forward from chainB to chainC and back to chainB, unwinding the voucher minted on chainC
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+	packet := suite.sendTransfer(forwardReceiver(types.ForwardMetadata{
+		Port:    suite.pathBToC.EndpointA.ChannelConfig.PortID,
+		Channel: suite.pathBToC.EndpointA.ChannelID,
+		Next: &types.PacketMetadata{
+			Forward: &types.ForwardMetadata{
+				Receiver: receiver,
+				Port:     suite.pathBToC.EndpointB.ChannelConfig.PortID,
+				Channel:  suite.pathBToC.EndpointB.ChannelID,
+			},
+		},
+	}))
+
+	res := recvPacket(suite.pathAToB, packet)
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// chainC forwards the packet back to chainB
+	res = recvPacket(suite.pathBToC, forwardPacket)
+	returnPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.pathBToC.EndpointB.ChannelID, returnPacket.GetSourceChannel())
+
+	// chainB receives the returned tokens, completing the acknowledgements on chainC and chainB
+	suite.Require().NoError(suite.pathBToC.EndpointA.UpdateClient())
+	res, err = suite.pathBToC.EndpointA.RecvPacketWithResult(returnPacket)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	packetKey := host.PacketAcknowledgementKey(returnPacket.GetDestPort(), returnPacket.GetDestChannel(), returnPacket.GetSequence())
+	proof, proofHeight := suite.pathBToC.EndpointA.QueryProof(packetKey)
+	res, err = suite.chainC.SendMsgs(channeltypes.NewMsgAcknowledgement(returnPacket, ack, proof, proofHeight, suite.chainC.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	forwardAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.pathBToC.EndpointA.UpdateClient())
+	res = acknowledgePacket(suite.pathBToC, forwardPacket, forwardAck)
+	originalAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), originalAck)
+
+	// the voucher minted on chainB is held by the final receiver
+	denomOnB := voucherDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID)
+	suite.Require().Equal(amount, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), denomOnB).Amount)
+	suite.Require().Empty(suite.chainB.GetSimApp().PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext()))
+	suite.Require().Empty(suite.chainC.GetSimApp().PacketForwardKeeper.GetAllInFlightPackets(suite.chainC.GetContext()))
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketErrorAcknowledgement() {
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	// the receiver on chainC is invalid and chainC returns an error acknowledgement
+	packet := suite.sendTransfer(forwardReceiver(types.ForwardMetadata{
+		Receiver: "invalid-address",
+		Port:     suite.pathBToC.EndpointA.ChannelConfig.PortID,
+		Channel:  suite.pathBToC.EndpointA.ChannelID,
+	}))
+
+	res := recvPacket(suite.pathAToB, packet)
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = recvPacket(suite.pathBToC, forwardPacket)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = acknowledgePacket(suite.pathBToC, forwardPacket, ack)
+	originalAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(ack, originalAck)
+
+	// the voucher minted on chainB is burned
+	denomOnB := voucherDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomOnB).IsZero())
+
+	// the sender on chainA is refunded
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+	acknowledgePacket(suite.pathAToB, packet, originalAck)
+	suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketTimeout() {
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	retries := uint32(0)
+	packet := suite.sendTransfer(forwardReceiver(types.ForwardMetadata{
+		Receiver: suite.chainC.SenderAccount.GetAddress().String(),
+		Port:     suite.pathBToC.EndpointA.ChannelConfig.PortID,
+		Channel:  suite.pathBToC.EndpointA.ChannelID,
+		Timeout:  "10s",
+		Retries:  &retries,
+	}))
+
+	res := recvPacket(suite.pathAToB, packet)
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the forwarded packet times out on chainC
+	suite.coordinator.IncrementTimeBy(time.Minute)
+	suite.coordinator.CommitBlock(suite.chainC)
+
+	res = timeoutPacket(suite.pathBToC, forwardPacket)
+
+	// an error acknowledgement is written for the original packet
+	originalAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(transfertypes.NewErrorAcknowledgement(types.ErrForwardedPacketTimeout).Acknowledgement(), originalAck)
+	suite.Require().Empty(suite.chainB.GetSimApp().PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext()))
+
+	denomOnB := voucherDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomOnB).IsZero())
+
+	// the sender on chainA is refunded
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+	acknowledgePacket(suite.pathAToB, packet, originalAck)
+	suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketTimeoutRetry() {
+	packet := suite.sendTransfer(forwardReceiver(types.ForwardMetadata{
+		Receiver: suite.chainC.SenderAccount.GetAddress().String(),
+		Port:     suite.pathBToC.EndpointA.ChannelConfig.PortID,
+		Channel:  suite.pathBToC.EndpointA.ChannelID,
+		Timeout:  "1m",
+	}))
+
+	res := recvPacket(suite.pathAToB, packet)
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+	suite.coordinator.CommitBlock(suite.chainC)
+
+	// the forwarded packet is resent instead of acknowledging the original packet
+	res = timeoutPacket(suite.pathBToC, forwardPacket)
+	_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	retryPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(forwardPacket.Sequence+1, retryPacket.Sequence)
+
+	inFlightPacket, found := suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), retryPacket.SourcePort, retryPacket.SourceChannel, retryPacket.Sequence)
+	suite.Require().True(found)
+	suite.Require().Zero(inFlightPacket.RetriesRemaining)
+
+	// the retried packet succeeds
+	res = recvPacket(suite.pathBToC, retryPacket)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = acknowledgePacket(suite.pathBToC, retryPacket, ack)
+	originalAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(ack, originalAck)
+
+	denomOnC := voucherDenom(
+		suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID,
+		suite.pathBToC.EndpointB.ChannelConfig.PortID, suite.pathBToC.EndpointB.ChannelID,
+	)
+	suite.Require().Equal(amount, suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), denomOnC).Amount)
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketFailure() {
+	testCases := []struct {
+		name     string
+		receiver string
+	}{
+		{
+			"channel does not exist",
+			forwardReceiver(types.ForwardMetadata{
+				Receiver: suite.chainC.SenderAccount.GetAddress().String(),
+				Port:     ibctesting.TransferPort,
+				Channel:  "channel-100",
+			}),
+		},
+		{
+			"invalid forward metadata",
+			forwardReceiver(types.ForwardMetadata{
+				Port:    ibctesting.TransferPort,
+				Channel: "channel-0",
+			}),
+		},
+		{
+			"malformed forward metadata",
+			`{"forward":{"port":`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packet := suite.sendTransfer(tc.receiver)
+
+			// an error acknowledgement is written immediately and no tokens are held on chainB
+			res := recvPacket(suite.pathAToB, packet)
+			ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var acknowledgement channeltypes.Acknowledgement
+			suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+			suite.Require().False(acknowledgement.Success())
+
+			denomOnB := voucherDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID)
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomOnB).IsZero())
+			suite.Require().Empty(suite.chainB.GetSimApp().PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext()))
+		})
+	}
+}
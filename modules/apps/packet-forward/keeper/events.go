@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+)
+
+// EmitForwardPacketEvent emits an event of the provided type describing the in-flight packet
+func EmitForwardPacketEvent(ctx sdk.Context, eventType string, inFlightPacket types.InFlightPacket) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyOriginalPort, inFlightPacket.OriginalPacket.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyOriginalChannel, inFlightPacket.OriginalPacket.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyOriginalSequence, strconv.FormatUint(inFlightPacket.OriginalPacket.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, inFlightPacket.ForwardPacketId.PortId),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlightPacket.ForwardPacketId.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(inFlightPacket.ForwardPacketId.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, inFlightPacket.Receiver),
+			sdk.NewAttribute(types.AttributeKeyRetriesRemaining, strconv.FormatUint(uint64(inFlightPacket.RetriesRemaining), 10)),
+			sdk.NewAttribute(types.AttributeKeyIntermediateAddr, inFlightPacket.IntermediateAddress),
+		),
+	)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Middleware must implement the ICS4Wrapper so that it can wrap the packet sending and
+// acknowledgement writing of the underlying application.
+var _ types.ICS4Wrapper = Keeper{}
+
+// Keeper defines the packet forward middleware keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	transferKeeper types.TransferKeeper
+	ics4Wrapper    types.ICS4Wrapper
+	channelKeeper  types.ChannelKeeper
+	bankKeeper     types.BankKeeper
+	scopedKeeper   types.ScopedKeeper
+}
+
+// NewKeeper creates a new packet forward middleware Keeper instance. The scoped keeper must be the
+// scoped keeper of the wrapped transfer application as it owns the channel capabilities used to
+// write acknowledgements.
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey,
+	transferKeeper types.TransferKeeper, ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper,
+	bankKeeper types.BankKeeper, scopedKeeper types.ScopedKeeper,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		transferKeeper: transferKeeper,
+		ics4Wrapper:    ics4Wrapper,
+		channelKeeper:  channelKeeper,
+		bankKeeper:     bankKeeper,
+		scopedKeeper:   scopedKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// SendPacket wraps IBC ChannelKeeper's SendPacket function
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement wraps IBC ChannelKeeper's WriteAcknowledgement function
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetAppVersion wraps IBC ChannelKeeper's GetAppVersion function
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// SetInFlightPacket stores the in-flight packet indexed by the identifier of the forwarded packet
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	packetID := inFlightPacket.ForwardPacketId
+	store.Set(types.KeyInFlightPacket(packetID.PortId, packetID.ChannelId, packetID.Sequence), k.cdc.MustMarshal(&inFlightPacket))
+}
+
+// GetInFlightPacket retrieves the in-flight packet for the given forwarded packet identifier
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyInFlightPacket(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	var inFlightPacket types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &inFlightPacket)
+
+	return inFlightPacket, true
+}
+
+// DeleteInFlightPacket removes the in-flight packet for the given forwarded packet identifier
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyInFlightPacket(portID, channelID, sequence))
+}
+
+// GetAllInFlightPackets returns all packets which have been forwarded and are awaiting completion
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.InFlightPacketKeyPrefix))
+	defer iterator.Close()
+
+	var inFlightPackets []types.InFlightPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var inFlightPacket types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &inFlightPacket)
+
+		inFlightPackets = append(inFlightPackets, inFlightPacket)
+	}
+
+	return inFlightPackets
+}
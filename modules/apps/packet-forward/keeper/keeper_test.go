@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func newInFlightPacket(sequence uint64) types.InFlightPacket {
+	return types.InFlightPacket{
+		OriginalPacket: channeltypes.NewPacket(
+			[]byte("data"), sequence, ibctesting.TransferPort, ibctesting.FirstChannelID, ibctesting.TransferPort, ibctesting.FirstChannelID,
+			clienttypes.NewHeight(0, 100), 0,
+		),
+		ForwardPacketId:     channeltypes.NewPacketId(ibctesting.TransferPort, "channel-1", sequence),
+		IntermediateAddress: types.GetIntermediateAddress(ibctesting.FirstChannelID, "cosmos1sender").String(),
+		Token:               sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+		Receiver:            "cosmos1receiver",
+		Timeout:             uint64(types.DefaultForwardTimeout),
+		RetriesRemaining:    types.DefaultForwardRetries,
+	}
+}
+
+func (suite *KeeperTestSuite) TestInFlightPacket() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().PacketForwardKeeper
+
+	_, found := keeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 1)
+	suite.Require().False(found)
+
+	expInFlightPackets := []types.InFlightPacket{newInFlightPacket(1), newInFlightPacket(2)}
+	for _, inFlightPacket := range expInFlightPackets {
+		keeper.SetInFlightPacket(ctx, inFlightPacket)
+	}
+
+	inFlightPacket, found := keeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(expInFlightPackets[0], inFlightPacket)
+	suite.Require().Equal(expInFlightPackets, keeper.GetAllInFlightPackets(ctx))
+
+	keeper.DeleteInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 1)
+
+	_, found = keeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 1)
+	suite.Require().False(found)
+	suite.Require().Equal(expInFlightPackets[1:], keeper.GetAllInFlightPackets(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	genesisState := types.NewGenesisState([]types.InFlightPacket{newInFlightPacket(1), newInFlightPacket(2)})
+
+	suite.chainA.GetSimApp().PacketForwardKeeper.InitGenesis(suite.chainA.GetContext(), *genesisState)
+
+	exported := suite.chainA.GetSimApp().PacketForwardKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(genesisState, exported)
+}
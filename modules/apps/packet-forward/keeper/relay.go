@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// ForwardTransferPacket sends the tokens held by the intermediate account to the next hop. The in-flight
+// packet is stored under the identifier of the forwarded packet so that the original packet may be
+// acknowledged once the forwarded packet is acknowledged or times out.
+func (k Keeper) ForwardTransferPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket) error {
+	portID := inFlightPacket.ForwardPacketId.PortId
+	channelID := inFlightPacket.ForwardPacketId.ChannelId
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	intermediateAddr, err := sdk.AccAddressFromBech32(inFlightPacket.IntermediateAddress)
+	if err != nil {
+		return err
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + inFlightPacket.Timeout
+	if err := k.transferKeeper.SendTransfer(
+		ctx, portID, channelID, inFlightPacket.Token, intermediateAddr, inFlightPacket.Receiver, clienttypes.ZeroHeight(), timeoutTimestamp, "",
+	); err != nil {
+		return err
+	}
+
+	inFlightPacket.ForwardPacketId = channeltypes.NewPacketId(portID, channelID, sequence)
+	k.SetInFlightPacket(ctx, inFlightPacket)
+
+	EmitForwardPacketEvent(ctx, types.EventTypeForwardPacket, inFlightPacket)
+
+	return nil
+}
+
+// OnForwardedPacketAcknowledged writes the acknowledgement of the forwarded packet as the acknowledgement
+// of the original packet. If the forwarded packet failed on the next hop, the tokens received from the
+// previous hop are returned to where they came from so that the previous hop may refund the sender.
+func (k Keeper) OnForwardedPacketAcknowledged(ctx sdk.Context, inFlightPacket types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	packetID := inFlightPacket.ForwardPacketId
+	k.DeleteInFlightPacket(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence)
+
+	if !ack.Success() {
+		if err := k.refundOriginalPacket(ctx, inFlightPacket); err != nil {
+			return err
+		}
+	} else {
+		EmitForwardPacketEvent(ctx, types.EventTypeForwardPacketSuccess, inFlightPacket)
+	}
+
+	return k.writeOriginalAcknowledgement(ctx, inFlightPacket, ack)
+}
+
+// OnForwardedPacketTimeout resends the forwarded packet if any retries remain. Otherwise the tokens received
+// from the previous hop are returned to where they came from and an error acknowledgement is written for the
+// original packet.
+func (k Keeper) OnForwardedPacketTimeout(ctx sdk.Context, inFlightPacket types.InFlightPacket) error {
+	packetID := inFlightPacket.ForwardPacketId
+	k.DeleteInFlightPacket(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence)
+
+	if inFlightPacket.RetriesRemaining > 0 {
+		inFlightPacket.RetriesRemaining--
+
+		// cache context so that a failed retry does not leave behind partial state
+		cacheCtx, writeFn := ctx.CacheContext()
+		err := k.ForwardTransferPacket(cacheCtx, inFlightPacket)
+		if err == nil {
+			writeFn()
+
+			// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			EmitForwardPacketEvent(ctx, types.EventTypeForwardPacketRetry, inFlightPacket)
+
+			return nil
+		}
+
+		k.Logger(ctx).Error("failed to retry forwarded packet", "port-id", packetID.PortId, "channel-id", packetID.ChannelId, "error", err.Error())
+	}
+
+	if err := k.refundOriginalPacket(ctx, inFlightPacket); err != nil {
+		return err
+	}
+
+	return k.writeOriginalAcknowledgement(ctx, inFlightPacket, transfertypes.NewErrorAcknowledgement(types.ErrForwardedPacketTimeout))
+}
+
+// refundOriginalPacket reverses the receipt of the original packet on this chain. Tokens which were unescrowed
+// are escrowed again and vouchers which were minted are burned, such that the error acknowledgement written for
+// the original packet allows the previous hop to refund the sender.
+func (k Keeper) refundOriginalPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.OriginalPacket.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	intermediateAddr, err := sdk.AccAddressFromBech32(inFlightPacket.IntermediateAddress)
+	if err != nil {
+		return err
+	}
+
+	originalPacket := inFlightPacket.OriginalPacket
+	tokens := sdk.NewCoins(inFlightPacket.Token)
+
+	if transfertypes.ReceiverChainIsSource(originalPacket.GetSourcePort(), originalPacket.GetSourceChannel(), data.Denom) {
+		// tokens were unescrowed upon receiving the original packet, escrow them again
+		escrowAddress := transfertypes.GetEscrowAddress(originalPacket.GetDestPort(), originalPacket.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, intermediateAddr, escrowAddress, tokens); err != nil {
+			return sdkerrors.Wrap(err, "failed to escrow tokens of the original packet")
+		}
+
+		currentTotalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, inFlightPacket.Token.Denom)
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Add(inFlightPacket.Token))
+	} else {
+		// vouchers were minted upon receiving the original packet, burn them
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediateAddr, transfertypes.ModuleName, tokens); err != nil {
+			return sdkerrors.Wrap(err, "failed to send vouchers of the original packet to the transfer module")
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, tokens); err != nil {
+			// NOTE: should not happen as the module account was
+			// retrieved on the step above and it has enough balance
+			// to burn.
+			panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+		}
+	}
+
+	EmitForwardPacketEvent(ctx, types.EventTypeForwardPacketRefund, inFlightPacket)
+
+	return nil
+}
+
+// writeOriginalAcknowledgement asynchronously writes the acknowledgement of the original packet
+func (k Keeper) writeOriginalAcknowledgement(ctx sdk.Context, inFlightPacket types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	originalPacket := inFlightPacket.OriginalPacket
+
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(originalPacket.GetDestPort(), originalPacket.GetDestChannel()))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	return k.WriteAcknowledgement(ctx, chanCap, originalPacket, ack)
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// packet forward middleware sentinel errors
+var (
+	ErrInvalidForwardMetadata = sdkerrors.Register(ModuleName, 2, "invalid forward metadata")
+	ErrForwardedPacketTimeout = sdkerrors.Register(ModuleName, 3, "forwarded packet timed out")
+)
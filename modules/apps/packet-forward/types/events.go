@@ -0,0 +1,19 @@
+package types
+
+// packet forward middleware events
+const (
+	EventTypeForwardPacket        = "forward_packet"
+	EventTypeForwardPacketRetry   = "forward_packet_retry"
+	EventTypeForwardPacketRefund  = "forward_packet_refund"
+	EventTypeForwardPacketSuccess = "forward_packet_success"
+
+	AttributeKeyOriginalPort     = "original_port"
+	AttributeKeyOriginalChannel  = "original_channel"
+	AttributeKeyOriginalSequence = "original_sequence"
+	AttributeKeyForwardPort      = "forward_port"
+	AttributeKeyForwardChannel   = "forward_channel"
+	AttributeKeyForwardSequence  = "forward_sequence"
+	AttributeKeyReceiver         = "receiver"
+	AttributeKeyRetriesRemaining = "retries_remaining"
+	AttributeKeyIntermediateAddr = "intermediate_address"
+)
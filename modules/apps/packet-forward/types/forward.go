@@ -0,0 +1,145 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// DefaultForwardTimeout is the relative timeout used for forwarded packets when none is specified
+	DefaultForwardTimeout = 5 * time.Minute
+
+	// DefaultForwardRetries is the number of times a forwarded packet is resent after timing out
+	// when no retry count is specified
+	DefaultForwardRetries = uint32(1)
+)
+
+// PacketMetadata defines the forwarding instruction which may be provided in place of the receiver
+// of an ICS-20 fungible token packet. The receiver field is expected to contain the JSON encoding of
+// the metadata, for example:
+//
+//	{"forward":{"receiver":"cosmos1...","port":"transfer","channel":"channel-1","timeout":"10m","retries":2}}
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata defines the next hop a received packet should be forwarded to. If Next is set, the
+// forwarded packet carries Next as its receiver so that the next hop forwards the tokens again and
+// Receiver is ignored.
+type ForwardMetadata struct {
+	Receiver string          `json:"receiver,omitempty"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Timeout  string          `json:"timeout,omitempty"`
+	Retries  *uint32         `json:"retries,omitempty"`
+	Next     *PacketMetadata `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata parses the forwarding instruction from the receiver of an ICS-20 fungible token
+// packet. A nil metadata is returned without error if the receiver does not contain a forwarding instruction.
+func ParseForwardMetadata(receiver string) (*ForwardMetadata, error) {
+	if !strings.HasPrefix(strings.TrimSpace(receiver), "{") {
+		return nil, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(receiver), &metadata); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "failed to unmarshal forward metadata: %s", err)
+	}
+
+	if metadata.Forward == nil {
+		return nil, nil
+	}
+
+	if err := metadata.Forward.Validate(); err != nil {
+		return nil, err
+	}
+
+	return metadata.Forward, nil
+}
+
+// Validate performs basic stateless validation of the forward metadata
+func (m ForwardMetadata) Validate() error {
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid port ID: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid channel ID: %s", err)
+	}
+
+	if m.Next == nil && strings.TrimSpace(m.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "receiver cannot be empty")
+	}
+
+	if m.Next != nil {
+		if m.Next.Forward == nil {
+			return sdkerrors.Wrap(ErrInvalidForwardMetadata, "next forward metadata cannot be empty")
+		}
+
+		if err := m.Next.Forward.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := m.GetTimeout(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTimeout returns the relative timeout used for the forwarded packet
+func (m ForwardMetadata) GetTimeout() (time.Duration, error) {
+	if m.Timeout == "" {
+		return DefaultForwardTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(m.Timeout)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid timeout: %s", err)
+	}
+
+	if timeout <= 0 {
+		return 0, sdkerrors.Wrap(ErrInvalidForwardMetadata, "timeout must be positive")
+	}
+
+	return timeout, nil
+}
+
+// GetRetries returns the number of times the forwarded packet may be resent after timing out
+func (m ForwardMetadata) GetRetries() uint32 {
+	if m.Retries == nil {
+		return DefaultForwardRetries
+	}
+
+	return *m.Retries
+}
+
+// GetNextReceiver returns the receiver used for the forwarded packet
+func (m ForwardMetadata) GetNextReceiver() (string, error) {
+	if m.Next == nil {
+		return m.Receiver, nil
+	}
+
+	bz, err := json.Marshal(m.Next)
+	if err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidForwardMetadata, "failed to marshal next forward metadata: %s", err)
+	}
+
+	return string(bz), nil
+}
+
+// GetIntermediateAddress returns the account which holds received tokens on this chain before they are
+// forwarded to the next hop. It is derived from the channel the original packet was received on and the
+// original sender, and no private key exists for it.
+func GetIntermediateAddress(channelID, originalSender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(ModuleName, []byte(channelID+"/"+originalSender)))
+}
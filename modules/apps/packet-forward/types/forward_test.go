@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name        string
+		receiver    string
+		expMetadata *types.ForwardMetadata
+		expPass     bool
+	}{
+		{
+			"success: receiver address", "cosmos1receiver", nil, true,
+		},
+		{
+			"success: JSON without forward instruction", `{"other":{}}`, nil, true,
+		},
+		{
+			"success: forward instruction",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}`,
+			&types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel-1"},
+			true,
+		},
+		{
+			"success: forward instruction with next hop",
+			`{"forward":{"port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-2"}}}}`,
+			&types.ForwardMetadata{Port: "transfer", Channel: "channel-1", Next: &types.PacketMetadata{
+				Forward: &types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel-2"},
+			}},
+			true,
+		},
+		{
+			"malformed JSON", `{"forward":`, nil, false,
+		},
+		{
+			"invalid port", `{"forward":{"receiver":"cosmos1receiver","port":"(invalid)","channel":"channel-1"}}`, nil, false,
+		},
+		{
+			"invalid channel", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":""}}`, nil, false,
+		},
+		{
+			"empty receiver", `{"forward":{"port":"transfer","channel":"channel-1"}}`, nil, false,
+		},
+		{
+			"invalid timeout", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":"soon"}}`, nil, false,
+		},
+		{
+			"negative timeout", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":"-1m"}}`, nil, false,
+		},
+		{
+			"empty next hop", `{"forward":{"port":"transfer","channel":"channel-1","next":{}}}`, nil, false,
+		},
+		{
+			"invalid next hop", `{"forward":{"port":"transfer","channel":"channel-1","next":{"forward":{"port":"transfer","channel":"channel-2"}}}}`, nil, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		metadata, err := types.ParseForwardMetadata(tc.receiver)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expMetadata, metadata, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestForwardMetadataDefaults(t *testing.T) {
+	metadata := types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel-1"}
+
+	timeout, err := metadata.GetTimeout()
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultForwardTimeout, timeout)
+	require.Equal(t, types.DefaultForwardRetries, metadata.GetRetries())
+
+	retries := uint32(3)
+	metadata.Timeout = "10m"
+	metadata.Retries = &retries
+
+	timeout, err = metadata.GetTimeout()
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Minute, timeout)
+	require.Equal(t, retries, metadata.GetRetries())
+}
+
+func TestGetNextReceiver(t *testing.T) {
+	metadata := types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel-1"}
+
+	receiver, err := metadata.GetNextReceiver()
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1receiver", receiver)
+
+	next := types.ForwardMetadata{Receiver: "cosmos1final", Port: "transfer", Channel: "channel-2"}
+	metadata.Next = &types.PacketMetadata{Forward: &next}
+
+	receiver, err = metadata.GetNextReceiver()
+	require.NoError(t, err)
+
+	// the next hop is able to parse the forwarding instruction from the receiver
+	nextMetadata, err := types.ParseForwardMetadata(receiver)
+	require.NoError(t, err)
+	require.Equal(t, &next, nextMetadata)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a packet forward middleware GenesisState instance.
+func NewGenesisState(inFlightPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the packet forward middleware GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		InFlightPackets: []InFlightPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, inFlightPacket := range gs.InFlightPackets {
+		if err := inFlightPacket.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyInFlightPacket(inFlightPacket.ForwardPacketId.PortId, inFlightPacket.ForwardPacketId.ChannelId, inFlightPacket.ForwardPacketId.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate in-flight packet for forwarded packet %v", inFlightPacket.ForwardPacketId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Validate performs basic stateless validation of an InFlightPacket
+func (p InFlightPacket) Validate() error {
+	if err := p.OriginalPacket.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid original packet")
+	}
+
+	if err := p.ForwardPacketId.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid forward packet ID")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.IntermediateAddress); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert intermediate address into sdk.AccAddress")
+	}
+
+	if err := p.Token.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid forwarded token")
+	}
+
+	if p.Receiver == "" {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "receiver cannot be empty")
+	}
+
+	return nil
+}
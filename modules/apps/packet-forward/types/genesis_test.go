@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	newInFlightPacket := func(sequence uint64) types.InFlightPacket {
+		return types.InFlightPacket{
+			OriginalPacket: channeltypes.NewPacket(
+				[]byte("data"), 1, ibctesting.TransferPort, ibctesting.FirstChannelID, ibctesting.TransferPort, ibctesting.FirstChannelID,
+				clienttypes.NewHeight(0, 100), 0,
+			),
+			ForwardPacketId:     channeltypes.NewPacketId(ibctesting.TransferPort, "channel-1", sequence),
+			IntermediateAddress: types.GetIntermediateAddress(ibctesting.FirstChannelID, "cosmos1sender").String(),
+			Token:               sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+			Receiver:            "cosmos1receiver",
+			Timeout:             uint64(types.DefaultForwardTimeout),
+			RetriesRemaining:    types.DefaultForwardRetries,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: default genesis", func() { genState = types.DefaultGenesisState() }, true},
+		{"success: valid in-flight packets", func() {}, true},
+		{"duplicate in-flight packet", func() {
+			genState.InFlightPackets = append(genState.InFlightPackets, newInFlightPacket(1))
+		}, false},
+		{"invalid original packet", func() { genState.InFlightPackets[0].OriginalPacket.Sequence = 0 }, false},
+		{"invalid forward packet ID", func() { genState.InFlightPackets[0].ForwardPacketId.Sequence = 0 }, false},
+		{"invalid intermediate address", func() { genState.InFlightPackets[0].IntermediateAddress = "invalid-address" }, false},
+		{"invalid token", func() { genState.InFlightPackets[0].Token = sdk.Coin{Denom: "0stake", Amount: sdk.NewInt(100)} }, false},
+		{"empty receiver", func() { genState.InFlightPackets[0].Receiver = "" }, false},
+	}
+
+	for _, tc := range testCases {
+		genState = types.NewGenesisState([]types.InFlightPacket{newInFlightPacket(1), newInFlightPacket(2)})
+
+		tc.malleate()
+
+		err := genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
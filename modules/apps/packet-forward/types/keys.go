@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the packet forward middleware name
+	ModuleName = "packetforward"
+
+	// StoreKey is the store key string for the packet forward middleware
+	StoreKey = ModuleName
+
+	// InFlightPacketKeyPrefix is the key prefix for packets which have been forwarded and are awaiting completion
+	InFlightPacketKeyPrefix = "inFlightPacket"
+)
+
+// KeyInFlightPacket returns the key under which an in-flight packet is stored, indexed by the identifier
+// of the packet sent to the next hop
+func KeyInFlightPacket(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", InFlightPacketKeyPrefix, portID, channelID, sequence))
+}
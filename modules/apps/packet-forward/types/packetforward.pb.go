@@ -0,0 +1,627 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/packetforward/v1/packetforward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket defines a packet which has been received and forwarded to the next hop. The acknowledgement
+// of the original packet is written once the forwarded packet is acknowledged or times out.
+type InFlightPacket struct {
+	// the packet received from the previous hop
+	OriginalPacket types.Packet `protobuf:"bytes,1,opt,name=original_packet,json=originalPacket,proto3" json:"original_packet" yaml:"original_packet"`
+	// the identifier of the packet sent to the next hop
+	ForwardPacketId types.PacketId `protobuf:"bytes,2,opt,name=forward_packet_id,json=forwardPacketId,proto3" json:"forward_packet_id" yaml:"forward_packet_id"`
+	// the intermediate account holding the tokens on this chain
+	IntermediateAddress string `protobuf:"bytes,3,opt,name=intermediate_address,json=intermediateAddress,proto3" json:"intermediate_address,omitempty" yaml:"intermediate_address"`
+	// the tokens forwarded to the next hop
+	Token types1.Coin `protobuf:"bytes,4,opt,name=token,proto3" json:"token"`
+	// the receiver on the next hop
+	Receiver string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// the relative timeout in nanoseconds used when forwarding the packet
+	Timeout uint64 `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// the number of times the forwarded packet may be resent after timing out
+	RetriesRemaining uint32 `protobuf:"varint,7,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty" yaml:"retries_remaining"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5037a5358f18ac2, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetOriginalPacket() types.Packet {
+	if m != nil {
+		return m.OriginalPacket
+	}
+	return types.Packet{}
+}
+
+func (m *InFlightPacket) GetForwardPacketId() types.PacketId {
+	if m != nil {
+		return m.ForwardPacketId
+	}
+	return types.PacketId{}
+}
+
+func (m *InFlightPacket) GetIntermediateAddress() string {
+	if m != nil {
+		return m.IntermediateAddress
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetToken() types1.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types1.Coin{}
+}
+
+func (m *InFlightPacket) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "ibc.applications.packetforward.v1.InFlightPacket")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/packetforward/v1/packetforward.proto", fileDescriptor_a5037a5358f18ac2)
+}
+
+var fileDescriptor_a5037a5358f18ac2 = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0x8c, 0x69, 0xda, 0x82, 0x11, 0x2d, 0x35, 0x15, 0x32, 0x29, 0x38, 0xc1, 0xa7, 0x5c, 0xba,
+	0x2b, 0x53, 0xf5, 0x82, 0xc4, 0x01, 0x23, 0x21, 0xe5, 0x86, 0x7c, 0x41, 0xe2, 0x12, 0xad, 0xd7,
+	0x0f, 0xe7, 0x29, 0xf6, 0xae, 0xb5, 0xde, 0x18, 0xf5, 0x2f, 0xf8, 0xac, 0x1e, 0x7b, 0xe4, 0x14,
+	0xa1, 0xe4, 0x03, 0x90, 0xfa, 0x05, 0xc8, 0xde, 0x35, 0xb4, 0x14, 0xf5, 0xb6, 0x3b, 0x9e, 0x37,
+	0x33, 0xde, 0x37, 0xee, 0x39, 0xa6, 0x9c, 0xb2, 0xaa, 0x2a, 0x90, 0x33, 0x8d, 0x52, 0xd4, 0xb4,
+	0x62, 0x7c, 0x09, 0xfa, 0xab, 0x54, 0xdf, 0x98, 0xca, 0x68, 0x13, 0xdd, 0x06, 0x48, 0xa5, 0xa4,
+	0x96, 0xde, 0x6b, 0x4c, 0x39, 0xb9, 0x39, 0x46, 0x6e, 0xb3, 0x9a, 0x68, 0x74, 0x9c, 0xcb, 0x5c,
+	0x76, 0x6c, 0xda, 0x9e, 0xcc, 0xe0, 0x28, 0xe0, 0xb2, 0x2e, 0x65, 0x4d, 0x53, 0x56, 0x03, 0x6d,
+	0xa2, 0x14, 0x34, 0x8b, 0x28, 0x97, 0x28, 0xec, 0xf7, 0x56, 0x98, 0x72, 0xa9, 0x80, 0xf2, 0x05,
+	0x13, 0x02, 0x8a, 0x36, 0x81, 0x3d, 0x1a, 0x4a, 0xf8, 0x6b, 0xc7, 0x3d, 0x98, 0x89, 0x8f, 0x05,
+	0xe6, 0x0b, 0xfd, 0xa9, 0x73, 0xf5, 0x32, 0xf7, 0x50, 0x2a, 0xcc, 0x51, 0xb0, 0x62, 0x6e, 0x82,
+	0xf8, 0xce, 0xc4, 0x99, 0x3e, 0x7e, 0x73, 0x42, 0xda, 0xa0, 0xad, 0x1e, 0xe9, 0x45, 0x9a, 0x88,
+	0x98, 0xa9, 0x38, 0xb8, 0x5c, 0x8f, 0x07, 0xd7, 0xeb, 0xf1, 0xf3, 0x0b, 0x56, 0x16, 0x6f, 0xc3,
+	0x7f, 0x14, 0xc2, 0xe4, 0xa0, 0x47, 0xac, 0xcb, 0xd2, 0x3d, 0xb2, 0xff, 0x67, 0x29, 0x73, 0xcc,
+	0xfc, 0x07, 0x9d, 0xcf, 0xab, 0x7b, 0x7c, 0x66, 0x59, 0x3c, 0xb1, 0x4e, 0xbe, 0x71, 0xba, 0xa3,
+	0x12, 0x26, 0x87, 0x16, 0xeb, 0x47, 0xbc, 0xc4, 0x3d, 0x46, 0xa1, 0x41, 0x95, 0x90, 0x21, 0xd3,
+	0x30, 0x67, 0x59, 0xa6, 0xa0, 0xae, 0xfd, 0x9d, 0x89, 0x33, 0x7d, 0x14, 0x8f, 0xaf, 0xd7, 0xe3,
+	0x13, 0x23, 0xf6, 0x3f, 0x56, 0x98, 0x3c, 0xbb, 0x09, 0xbf, 0x37, 0xa8, 0x77, 0xee, 0xee, 0x6a,
+	0xb9, 0x04, 0xe1, 0x0f, 0xbb, 0xd0, 0x2f, 0x88, 0x59, 0x06, 0x69, 0x97, 0x41, 0xec, 0x32, 0xc8,
+	0x07, 0x89, 0x22, 0x1e, 0xb6, 0x81, 0x13, 0xc3, 0xf6, 0x46, 0xee, 0x43, 0x05, 0x1c, 0xb0, 0x01,
+	0xe5, 0xef, 0xb6, 0xf6, 0xc9, 0x9f, 0xbb, 0xe7, 0xbb, 0xfb, 0x1a, 0x4b, 0x90, 0x2b, 0xed, 0xef,
+	0x4d, 0x9c, 0xe9, 0x30, 0xe9, 0xaf, 0xde, 0xcc, 0x3d, 0x52, 0xa0, 0x15, 0x42, 0x3d, 0x57, 0x50,
+	0x32, 0x14, 0x28, 0x72, 0x7f, 0x7f, 0xe2, 0x4c, 0x9f, 0xc4, 0x2f, 0xff, 0x3e, 0xc5, 0x1d, 0x4a,
+	0x98, 0x3c, 0xb5, 0x58, 0xd2, 0x43, 0xf1, 0xe7, 0xcb, 0x4d, 0xe0, 0x5c, 0x6d, 0x02, 0xe7, 0xe7,
+	0x26, 0x70, 0xbe, 0x6f, 0x83, 0xc1, 0xd5, 0x36, 0x18, 0xfc, 0xd8, 0x06, 0x83, 0x2f, 0xef, 0x72,
+	0xd4, 0x8b, 0x55, 0x4a, 0xb8, 0x2c, 0xa9, 0x6d, 0x16, 0xa6, 0xfc, 0x34, 0x97, 0xb4, 0x39, 0xa3,
+	0xa5, 0xcc, 0x56, 0x05, 0xd4, 0x6d, 0xbd, 0xfb, 0x5a, 0x9f, 0xf6, 0xbd, 0xd6, 0x17, 0x15, 0xd4,
+	0xe9, 0x5e, 0xd7, 0xa8, 0xb3, 0xdf, 0x03, 0x00, 0x71, 0xd0, 0x72, 0xf4, 0x06, 0x03, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketforward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.IntermediateAddress) > 0 {
+		i -= len(m.IntermediateAddress)
+		copy(dAtA[i:], m.IntermediateAddress)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.IntermediateAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.ForwardPacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketforward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OriginalPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketforward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacketforward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacketforward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OriginalPacket.Size()
+	n += 1 + l + sovPacketforward(uint64(l))
+	l = m.ForwardPacketId.Size()
+	n += 1 + l + sovPacketforward(uint64(l))
+	l = len(m.IntermediateAddress)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovPacketforward(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovPacketforward(uint64(m.Timeout))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovPacketforward(uint64(m.RetriesRemaining))
+	}
+	return n
+}
+
+func sovPacketforward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacketforward(x uint64) (n int) {
+	return sovPacketforward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketforward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginalPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForwardPacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediateAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketforward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacketforward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacketforward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacketforward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacketforward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacketforward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacketforward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacketforward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacketforward = fmt.Errorf("proto: unexpected end of group")
+)
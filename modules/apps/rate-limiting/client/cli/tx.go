@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+const (
+	flagMaxPercentSend = "max-percent-send"
+	flagMaxPercentRecv = "max-percent-recv"
+	flagMaxAmountSend  = "max-amount-send"
+	flagMaxAmountRecv  = "max-amount-recv"
+	flagWindow         = "window"
+)
+
+// NewCmdSubmitSetRateLimitProposal implements a command handler for submitting a set rate limit proposal transaction.
+func NewCmdSubmitSetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to set the rate limit of a denomination over a transfer channel",
+		Long: "Submit a proposal to add or replace the rate limit of a denomination over a transfer channel along with an initial deposit.\n" +
+			"Limits may be expressed as a percentage of the supply of the denomination and/or as an absolute amount. A zero value disables the limit.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal set-rate-limit channel-0 stake --max-percent-send 10 --max-amount-recv 1000000 --window 24h", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			quota, err := parseQuotaFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetRateLimitProposal(title, description, args[0], args[1], quota)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	cmd.Flags().Uint64(flagMaxPercentSend, 0, "maximum net outflow as a percentage of the supply")
+	cmd.Flags().Uint64(flagMaxPercentRecv, 0, "maximum net inflow as a percentage of the supply")
+	cmd.Flags().String(flagMaxAmountSend, "0", "maximum net outflow as an absolute amount")
+	cmd.Flags().String(flagMaxAmountRecv, "0", "maximum net inflow as an absolute amount")
+	cmd.Flags().Duration(flagWindow, 24*time.Hour, "duration of the window over which the flow is measured")
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveRateLimitProposal implements a command handler for submitting a remove rate limit proposal transaction.
+func NewCmdSubmitRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to remove the rate limit of a denomination over a transfer channel",
+		Long:  "Submit a proposal to remove the rate limit of a denomination over a transfer channel along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveRateLimitProposal(title, description, args[0], args[1])
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// addProposalFlags adds the common governance proposal flags to the command
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+// parseProposalFlags returns the title, description and deposit of the proposal
+func parseProposalFlags(fs *pflag.FlagSet) (string, string, sdk.Coins, error) {
+	title, err := fs.GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	description, err := fs.GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	depositStr, err := fs.GetString(govcli.FlagDeposit)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return title, description, deposit, nil
+}
+
+// parseQuotaFlags returns the quota specified by the flags
+func parseQuotaFlags(fs *pflag.FlagSet) (types.Quota, error) {
+	maxPercentSend, err := fs.GetUint64(flagMaxPercentSend)
+	if err != nil {
+		return types.Quota{}, err
+	}
+
+	maxPercentRecv, err := fs.GetUint64(flagMaxPercentRecv)
+	if err != nil {
+		return types.Quota{}, err
+	}
+
+	maxAmounts := make([]sdk.Int, 2)
+	for i, flag := range []string{flagMaxAmountSend, flagMaxAmountRecv} {
+		amountStr, err := fs.GetString(flag)
+		if err != nil {
+			return types.Quota{}, err
+		}
+
+		amount, ok := sdk.NewIntFromString(amountStr)
+		if !ok {
+			return types.Quota{}, fmt.Errorf("invalid %s: %s", flag, amountStr)
+		}
+		maxAmounts[i] = amount
+	}
+
+	window, err := fs.GetDuration(flagWindow)
+	if err != nil {
+		return types.Quota{}, err
+	}
+
+	return types.NewQuota(maxPercentSend, maxPercentRecv, maxAmounts[0], maxAmounts[1], window), nil
+}
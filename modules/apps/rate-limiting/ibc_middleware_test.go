@@ -0,0 +1,203 @@
+package ratelimiting_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	timeoutHeight = clienttypes.NewHeight(0, 1000)
+	amount        = sdk.NewInt(100)
+)
+
+type RateLimitingTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *RateLimitingTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestRateLimitingTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitingTestSuite))
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+// setRateLimit sets the rate limit of the denomination over the channel of the endpoint through governance
+func setRateLimit(endpoint *ibctesting.Endpoint, denom string, quota types.Quota) {
+	proposal := types.NewSetRateLimitProposal(ibctesting.Title, ibctesting.Description, endpoint.ChannelID, denom, quota).(*types.SetRateLimitProposal)
+	if err := endpoint.Chain.GetSimApp().RateLimitingKeeper.HandleSetRateLimitProposal(endpoint.Chain.GetContext(), proposal); err != nil {
+		panic(err)
+	}
+}
+
+// sendTransfer sends the default amount of the bond denom from the sender on chainA to chainB and returns the sent packet
+func (suite *RateLimitingTestSuite) sendTransfer(timeoutTimestamp uint64) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		timeoutHeight, timeoutTimestamp, "",
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// recvPacket receives the packet on chainB and returns the result
+func (suite *RateLimitingTestSuite) recvPacket(packet channeltypes.Packet) *sdk.Result {
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+
+	res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	return res
+}
+
+// acknowledgePacket acknowledges the packet on chainA
+func (suite *RateLimitingTestSuite) acknowledgePacket(packet channeltypes.Packet, ack []byte) {
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := suite.path.EndpointB.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+}
+
+// outflow returns the outflow of the bond denom over the channel on chainA
+func (suite *RateLimitingTestSuite) outflow() sdk.Int {
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+
+	return rateLimit.Flow.Outflow
+}
+
+func (suite *RateLimitingTestSuite) TestSendPacketQuotaExceeded() {
+	setRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, types.NewQuota(0, 0, sdk.NewInt(150), sdk.ZeroInt(), time.Hour))
+
+	packet := suite.sendTransfer(0)
+	suite.Require().Equal(amount, suite.outflow())
+
+	_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	// the second transfer would exceed the quota
+	err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+		suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount),
+		suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "",
+	)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	// a successful acknowledgement keeps the outflow
+	res := suite.recvPacket(packet)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.acknowledgePacket(packet, ack)
+	suite.Require().Equal(amount, suite.outflow())
+
+	_, found = suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// the quota is available again once the window elapses
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.sendTransfer(0)
+	suite.Require().Equal(amount, suite.outflow())
+}
+
+func (suite *RateLimitingTestSuite) TestRecvPacketQuotaExceeded() {
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+
+	setRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, types.NewQuota(0, 0, sdk.NewInt(1000), sdk.ZeroInt(), time.Hour))
+	setRateLimit(suite.path.EndpointB, voucherDenom, types.NewQuota(0, 0, sdk.ZeroInt(), sdk.NewInt(50), time.Hour))
+
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	packet := suite.sendTransfer(0)
+	suite.Require().Equal(amount, suite.outflow())
+
+	// chainB rejects the packet with an error acknowledgement
+	res := suite.recvPacket(packet)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(transfertypes.NewErrorAcknowledgement(types.ErrQuotaExceeded).Acknowledgement(), ack)
+
+	var emitted bool
+	for _, event := range res.GetEvents() {
+		emitted = emitted || event.Type == types.EventTypeQuotaExceeded
+	}
+	suite.Require().True(emitted)
+
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom).IsZero())
+
+	rateLimit, found := suite.chainB.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainB.GetContext(), suite.path.EndpointB.ChannelID, voucherDenom)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+
+	// the error acknowledgement refunds the sender and undoes the outflow
+	suite.acknowledgePacket(packet, ack)
+	suite.Require().True(suite.outflow().IsZero())
+	suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
+
+func (suite *RateLimitingTestSuite) TestTimeoutPacket() {
+	setRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, types.NewQuota(0, 0, sdk.NewInt(1000), sdk.ZeroInt(), time.Hour))
+
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	packet := suite.sendTransfer(timeoutTimestamp)
+	suite.Require().Equal(amount, suite.outflow())
+
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := suite.path.EndpointB.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgTimeout(packet, 1, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.outflow().IsZero())
+	suite.Require().Empty(suite.chainA.GetSimApp().RateLimitingKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+}
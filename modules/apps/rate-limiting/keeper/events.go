@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+// EmitQuotaExceededEvent emits an event signalling that a transfer was rejected for exceeding the quota of a rate limit
+func EmitQuotaExceededEvent(ctx sdk.Context, rateLimit types.RateLimit, direction types.Direction, amount, netFlow, threshold sdk.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuotaExceeded,
+			sdk.NewAttribute(types.AttributeKeyChannelID, rateLimit.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyNetFlow, netFlow.String()),
+			sdk.NewAttribute(types.AttributeKeyThreshold, threshold.String()),
+		),
+	)
+}
+
+// EmitRateLimitProposalEvent emits an event of the provided type describing the rate limit updated by governance
+func EmitRateLimitProposalEvent(ctx sdk.Context, eventType, channelID, denom string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+}
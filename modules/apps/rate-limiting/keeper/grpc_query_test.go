@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	res, err := keeper.RateLimits(sdk.WrapSDKContext(ctx), &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.RateLimits)
+
+	// the window of the first rate limit has elapsed and its flow is reported as reset
+	expired := newRateLimit(ibctesting.FirstChannelID, "atom")
+	expired.Flow.Outflow = sdk.NewInt(100)
+	current := newRateLimit(ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	current.Flow.WindowStart = ctx.BlockTime()
+	current.Flow.Outflow = sdk.NewInt(100)
+
+	keeper.SetRateLimit(ctx, expired)
+	keeper.SetRateLimit(ctx, current)
+
+	res, err = keeper.RateLimits(sdk.WrapSDKContext(ctx), &types.QueryRateLimitsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RateLimits, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().True(res.RateLimits[0].Flow.Outflow.IsZero())
+	suite.Require().Equal(ctx.BlockTime(), res.RateLimits[0].Flow.WindowStart)
+
+	res, err = keeper.RateLimits(sdk.WrapSDKContext(ctx), &types.QueryRateLimitsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RateLimit{current}, res.RateLimits)
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	req := &types.QueryRateLimitRequest{ChannelId: ibctesting.FirstChannelID, Denom: sdk.DefaultBondDenom}
+
+	_, err := keeper.RateLimit(sdk.WrapSDKContext(ctx), req)
+	suite.Require().Error(err)
+
+	rateLimit := newRateLimit(ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	rateLimit.Flow.WindowStart = ctx.BlockTime().Add(-time.Minute)
+	keeper.SetRateLimit(ctx, rateLimit)
+
+	res, err := keeper.RateLimit(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rateLimit, res.RateLimit)
+
+	_, err = keeper.RateLimit(sdk.WrapSDKContext(ctx), nil)
+	suite.Require().Error(err)
+}
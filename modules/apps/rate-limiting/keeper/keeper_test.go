@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func newRateLimit(channelID, denom string) types.RateLimit {
+	return types.NewRateLimit(
+		channelID, denom,
+		types.NewQuota(10, 10, sdk.ZeroInt(), sdk.ZeroInt(), time.Hour),
+		types.NewFlow(sdk.NewInt(10000), time.Unix(1000, 0).UTC()),
+	)
+}
+
+func (suite *KeeperTestSuite) TestRateLimit() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	_, found := keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	expRateLimits := []types.RateLimit{newRateLimit(ibctesting.FirstChannelID, "atom"), newRateLimit(ibctesting.FirstChannelID, sdk.DefaultBondDenom)}
+	for _, rateLimit := range expRateLimits {
+		keeper.SetRateLimit(ctx, rateLimit)
+	}
+
+	rateLimit, found := keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(expRateLimits[1], rateLimit)
+	suite.Require().Equal(expRateLimits, keeper.GetAllRateLimits(ctx))
+
+	keeper.DeleteRateLimit(ctx, ibctesting.FirstChannelID, "atom")
+
+	_, found = keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, "atom")
+	suite.Require().False(found)
+	suite.Require().Equal(expRateLimits[1:], keeper.GetAllRateLimits(ctx))
+}
+
+func (suite *KeeperTestSuite) TestPendingSendPacket() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	_, found := keeper.GetPendingSendPacket(ctx, ibctesting.FirstChannelID, 1)
+	suite.Require().False(found)
+
+	expPendingPackets := []types.PendingSendPacket{
+		types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, time.Unix(1000, 0).UTC()),
+		types.NewPendingSendPacket(ibctesting.FirstChannelID, 2, time.Unix(1000, 0).UTC()),
+	}
+	for _, pendingPacket := range expPendingPackets {
+		keeper.SetPendingSendPacket(ctx, pendingPacket)
+	}
+
+	pendingPacket, found := keeper.GetPendingSendPacket(ctx, ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(expPendingPackets[0], pendingPacket)
+	suite.Require().Equal(expPendingPackets, keeper.GetAllPendingSendPackets(ctx))
+
+	keeper.DeletePendingSendPacket(ctx, ibctesting.FirstChannelID, 1)
+
+	_, found = keeper.GetPendingSendPacket(ctx, ibctesting.FirstChannelID, 1)
+	suite.Require().False(found)
+	suite.Require().Equal(expPendingPackets[1:], keeper.GetAllPendingSendPackets(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	genesisState := types.NewGenesisState(
+		[]types.RateLimit{newRateLimit(ibctesting.FirstChannelID, sdk.DefaultBondDenom)},
+		[]types.PendingSendPacket{types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, time.Unix(1000, 0).UTC())},
+	)
+
+	suite.chainA.GetSimApp().RateLimitingKeeper.InitGenesis(suite.chainA.GetContext(), *genesisState)
+
+	exported := suite.chainA.GetSimApp().RateLimitingKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(genesisState, exported)
+}
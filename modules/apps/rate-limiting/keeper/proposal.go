@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// HandleSetRateLimitProposal will try to add or replace the rate limit of a denomination over a
+// transfer channel. The flow of the rate limit is reset, starting a new window at the current
+// block time. A rate limit expressed as a percentage of the supply may not be set for a
+// denomination with no supply, as it would prevent any transfer.
+func (k Keeper) HandleSetRateLimitProposal(ctx sdk.Context, p *types.SetRateLimitProposal) error {
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, p.ChannelId); !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", transfertypes.PortID, p.ChannelId)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, p.Denom).Amount
+	if p.Quota.HasPercentLimit() && supply.IsZero() {
+		return sdkerrors.Wrapf(types.ErrZeroSupply, "cannot set a percentage limit for denomination %s", p.Denom)
+	}
+
+	rateLimit := types.NewRateLimit(p.ChannelId, p.Denom, p.Quota, types.NewFlow(supply, ctx.BlockTime()))
+	k.SetRateLimit(ctx, rateLimit)
+
+	k.Logger(ctx).Info("rate limit set by governance", "channel-id", p.ChannelId, "denom", p.Denom)
+
+	EmitRateLimitProposalEvent(ctx, types.EventTypeSetRateLimit, p.ChannelId, p.Denom)
+
+	return nil
+}
+
+// HandleRemoveRateLimitProposal will try to remove the rate limit of a denomination over a transfer channel
+func (k Keeper) HandleRemoveRateLimitProposal(ctx sdk.Context, p *types.RemoveRateLimitProposal) error {
+	if _, found := k.GetRateLimit(ctx, p.ChannelId, p.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "channel ID (%s) denomination (%s)", p.ChannelId, p.Denom)
+	}
+
+	k.DeleteRateLimit(ctx, p.ChannelId, p.Denom)
+
+	k.Logger(ctx).Info("rate limit removed by governance", "channel-id", p.ChannelId, "denom", p.Denom)
+
+	EmitRateLimitProposalEvent(ctx, types.EventTypeRemoveRateLimit, p.ChannelId, p.Denom)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestHandleSetRateLimitProposal() {
+	var proposal *types.SetRateLimitProposal
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: amount limit for a denomination with no supply", func() {
+			proposal.Denom = "atom"
+			proposal.Quota = types.NewQuota(0, 0, sdk.NewInt(100), sdk.ZeroInt(), time.Hour)
+		}, true},
+		{"channel not found", func() { proposal.ChannelId = "channel-100" }, false},
+		{"percentage limit for a denomination with no supply", func() { proposal.Denom = "atom" }, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			proposal = types.NewSetRateLimitProposal(
+				ibctesting.Title, ibctesting.Description, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom,
+				types.NewQuota(10, 10, sdk.ZeroInt(), sdk.ZeroInt(), time.Hour),
+			).(*types.SetRateLimitProposal)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+			err := keeper.HandleSetRateLimitProposal(ctx, proposal)
+
+			rateLimit, found := keeper.GetRateLimit(ctx, proposal.ChannelId, proposal.Denom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+
+				supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, proposal.Denom).Amount
+				expRateLimit := types.NewRateLimit(proposal.ChannelId, proposal.Denom, proposal.Quota, types.NewFlow(supply, ctx.BlockTime()))
+				suite.Require().Equal(expRateLimit, rateLimit)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleRemoveRateLimitProposal() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	proposal := types.NewRemoveRateLimitProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstChannelID, sdk.DefaultBondDenom).(*types.RemoveRateLimitProposal)
+
+	err := keeper.HandleRemoveRateLimitProposal(ctx, proposal)
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	keeper.SetRateLimit(ctx, newRateLimit(ibctesting.FirstChannelID, sdk.DefaultBondDenom))
+
+	err = keeper.HandleRemoveRateLimitProposal(ctx, proposal)
+	suite.Require().NoError(err)
+
+	_, found := keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// OnSendPacket adds the tokens sent by the packet to the outflow of the rate limits of its source
+// channel. An error is returned if any of the quotas would be exceeded. Packets sent over rate
+// limited channels are stored until they are acknowledged or time out.
+func (k Keeper) OnSendPacket(ctx sdk.Context, packet ibcexported.PacketI) error {
+	tokens := k.getPacketTokens(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+
+	var rateLimited bool
+	for _, token := range tokens {
+		amount, ok := sdk.NewIntFromString(token.Amount)
+		if !ok {
+			return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", token.Amount)
+		}
+
+		// the denomination of the tokens sent is the full denomination path on this chain
+		denom := transfertypes.ParseDenomTrace(token.Denom).IBCDenom()
+
+		found, err := k.updateFlow(ctx, packet.GetSourceChannel(), denom, amount, types.DirectionSend)
+		if err != nil {
+			return err
+		}
+
+		rateLimited = rateLimited || found
+	}
+
+	if rateLimited {
+		k.SetPendingSendPacket(ctx, types.NewPendingSendPacket(packet.GetSourceChannel(), packet.GetSequence(), ctx.BlockTime()))
+	}
+
+	return nil
+}
+
+// OnRecvPacket adds the tokens received by the packet to the inflow of the rate limits of its
+// destination channel. An error is returned if any of the quotas would be exceeded.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet ibcexported.PacketI) error {
+	tokens := k.getPacketTokens(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+
+	for _, token := range tokens {
+		amount, ok := sdk.NewIntFromString(token.Amount)
+		if !ok {
+			return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", token.Amount)
+		}
+
+		denom := transfertypes.GetReceivedDenom(packet, token.Denom)
+		if _, err := k.updateFlow(ctx, packet.GetDestChannel(), denom, amount, types.DirectionRecv); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OnPacketAcknowledged removes the packet from the pending send packets once it has been
+// successfully received by the counterparty chain.
+func (k Keeper) OnPacketAcknowledged(ctx sdk.Context, packet ibcexported.PacketI) {
+	k.DeletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// OnPacketRefunded undoes the outflow of a packet which has been refunded to the sender upon an
+// error acknowledgement or timeout. The outflow is only undone for the rate limits whose window has
+// not been restarted since the packet was sent.
+func (k Keeper) OnPacketRefunded(ctx sdk.Context, packet ibcexported.PacketI) {
+	pendingPacket, found := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+
+	k.DeletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
+	tokens := k.getPacketTokens(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	for _, token := range tokens {
+		amount, ok := sdk.NewIntFromString(token.Amount)
+		if !ok {
+			continue
+		}
+
+		denom := transfertypes.ParseDenomTrace(token.Denom).IBCDenom()
+		rateLimit, found := k.GetRateLimit(ctx, packet.GetSourceChannel(), denom)
+		if !found || rateLimit.Flow.WindowStart.After(pendingPacket.SendTime) {
+			continue
+		}
+
+		rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Sub(amount)
+		if rateLimit.Flow.Outflow.IsNegative() {
+			rateLimit.Flow.Outflow = sdk.ZeroInt()
+		}
+
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// updateFlow adds the amount to the flow in the given direction of the rate limit of the denomination
+// over the channel, restarting its window if it has elapsed. An error is returned if the net flow
+// would exceed the quota. False is returned if the denomination is not rate limited over the channel.
+func (k Keeper) updateFlow(ctx sdk.Context, channelID, denom string, amount sdk.Int, direction types.Direction) (bool, error) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return false, nil
+	}
+
+	rateLimit = k.currentRateLimit(ctx, rateLimit)
+
+	if direction == types.DirectionSend {
+		rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Add(amount)
+	} else {
+		rateLimit.Flow.Inflow = rateLimit.Flow.Inflow.Add(amount)
+	}
+
+	netFlow := rateLimit.Flow.NetFlow(direction)
+	if threshold, limited := rateLimit.Quota.Threshold(direction, rateLimit.Flow.Supply); limited && netFlow.GT(threshold) {
+		EmitQuotaExceededEvent(ctx, rateLimit, direction, amount, netFlow, threshold)
+		k.Logger(ctx).Info("rate limit quota exceeded", "channel-id", channelID, "denom", denom, "direction", direction.String(), "net-flow", netFlow.String(), "threshold", threshold.String())
+
+		return true, sdkerrors.Wrapf(types.ErrQuotaExceeded, "net %s flow of %s over channel %s would be %s, exceeding %s", direction, denom, channelID, netFlow, threshold)
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+
+	return true, nil
+}
+
+// currentRateLimit returns the rate limit with its window restarted at the current block time if it has elapsed
+func (k Keeper) currentRateLimit(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimit {
+	if rateLimit.IsWindowExpired(ctx.BlockTime()) {
+		rateLimit.Flow = types.NewFlow(k.bankKeeper.GetSupply(ctx, rateLimit.Denom).Amount, ctx.BlockTime())
+	}
+
+	return rateLimit
+}
+
+// getPacketTokens returns the tokens transferred by the packet data, decoded according to the
+// version of the given channel. No tokens are returned for packet data which cannot be decoded,
+// as it is rejected by the transfer application.
+func (k Keeper) getPacketTokens(ctx sdk.Context, portID, channelID string, bz []byte) []transfertypes.Token {
+	version, found := k.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return nil
+	}
+
+	if version == transfertypes.Version3 {
+		data, err := transfertypes.UnmarshalMultiTokenPacketData(bz, version)
+		if err != nil {
+			return nil
+		}
+
+		return data.Tokens
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(bz, version)
+	if err != nil {
+		return nil
+	}
+
+	return []transfertypes.Token{transfertypes.NewToken(data.Denom, data.Amount)}
+}
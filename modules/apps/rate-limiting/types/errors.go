@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// rate limiting middleware sentinel errors
+var (
+	ErrInvalidQuota      = sdkerrors.Register(ModuleName, 2, "invalid quota")
+	ErrInvalidFlow       = sdkerrors.Register(ModuleName, 3, "invalid flow")
+	ErrRateLimitNotFound = sdkerrors.Register(ModuleName, 4, "rate limit not found")
+	ErrQuotaExceeded     = sdkerrors.Register(ModuleName, 5, "quota exceeded")
+	ErrZeroSupply        = sdkerrors.Register(ModuleName, 6, "zero supply")
+)
@@ -0,0 +1,15 @@
+package types
+
+// rate limiting middleware events
+const (
+	EventTypeQuotaExceeded   = "rate_limit_quota_exceeded"
+	EventTypeSetRateLimit    = "set_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyDirection = "direction"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyNetFlow   = "net_flow"
+	AttributeKeyThreshold = "threshold"
+)
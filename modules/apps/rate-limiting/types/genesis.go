@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a rate limiting middleware GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the rate limiting middleware GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyRateLimit(rateLimit.ChannelId, rateLimit.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit for channel %s and denomination %s", rateLimit.ChannelId, rateLimit.Denom)
+		}
+		seen[key] = true
+	}
+
+	for _, packet := range gs.PendingSendPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyPendingSendPacket(packet.ChannelId, packet.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate pending send packet for channel %s and sequence %d", packet.ChannelId, packet.Sequence)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
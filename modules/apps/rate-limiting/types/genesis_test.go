@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	newRateLimit := func(denom string) types.RateLimit {
+		return types.NewRateLimit(
+			ibctesting.FirstChannelID, denom,
+			types.NewQuota(10, 10, sdk.ZeroInt(), sdk.ZeroInt(), time.Hour),
+			types.NewFlow(sdk.NewInt(10000), time.Unix(1000, 0)),
+		)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: default genesis", func() { genState = types.DefaultGenesisState() }, true},
+		{"success: valid rate limits and pending send packets", func() {}, true},
+		{"duplicate rate limit", func() {
+			genState.RateLimits = append(genState.RateLimits, newRateLimit(sdk.DefaultBondDenom))
+		}, false},
+		{"duplicate pending send packet", func() {
+			genState.PendingSendPackets = append(genState.PendingSendPackets, types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, time.Unix(1000, 0)))
+		}, false},
+		{"invalid channel identifier", func() { genState.RateLimits[0].ChannelId = "" }, false},
+		{"invalid denomination", func() { genState.RateLimits[0].Denom = "0stake" }, false},
+		{"invalid quota", func() { genState.RateLimits[0].Quota.MaxPercentRecv = 101 }, false},
+		{"negative flow", func() { genState.RateLimits[0].Flow.Outflow = sdk.NewInt(-1) }, false},
+		{"zero pending send packet sequence", func() { genState.PendingSendPackets[0].Sequence = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		genState = types.NewGenesisState(
+			[]types.RateLimit{newRateLimit(sdk.DefaultBondDenom), newRateLimit("atom")},
+			[]types.PendingSendPacket{types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, time.Unix(1000, 0))},
+		)
+
+		tc.malleate()
+
+		err := genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
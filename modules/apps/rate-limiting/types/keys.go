@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the rate limiting middleware name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate limiting middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate limiting middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate limiting middleware
+	QuerierRoute = ModuleName
+
+	// RateLimitKeyPrefix is the key prefix for rate limits
+	RateLimitKeyPrefix = "rateLimit"
+
+	// PendingSendPacketKeyPrefix is the key prefix for packets sent over rate limited channels which are
+	// awaiting an acknowledgement or timeout
+	PendingSendPacketKeyPrefix = "pendingSendPacket"
+)
+
+// KeyRateLimit returns the key under which the rate limit of a denomination over a channel is stored
+func KeyRateLimit(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RateLimitKeyPrefix, channelID, denom))
+}
+
+// KeyPendingSendPacket returns the key under which a pending send packet is stored
+func KeyPendingSendPacket(channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", PendingSendPacketKeyPrefix, channelID, sequence))
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestSetRateLimitProposalValidateBasic(t *testing.T) {
+	quota := types.NewQuota(10, 10, sdk.ZeroInt(), sdk.ZeroInt(), time.Hour)
+
+	testCases := []struct {
+		name     string
+		proposal *types.SetRateLimitProposal
+		expPass  bool
+	}{
+		{"success", types.NewSetRateLimitProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstChannelID, sdk.DefaultBondDenom, quota).(*types.SetRateLimitProposal), true},
+		{"empty title", types.NewSetRateLimitProposal("", ibctesting.Description, ibctesting.FirstChannelID, sdk.DefaultBondDenom, quota).(*types.SetRateLimitProposal), false},
+		{"invalid channel identifier", types.NewSetRateLimitProposal(ibctesting.Title, ibctesting.Description, "channel", sdk.DefaultBondDenom, quota).(*types.SetRateLimitProposal), false},
+		{"invalid denomination", types.NewSetRateLimitProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstChannelID, "", quota).(*types.SetRateLimitProposal), false},
+		{"invalid quota", types.NewSetRateLimitProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstChannelID, sdk.DefaultBondDenom, types.Quota{}).(*types.SetRateLimitProposal), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestRemoveRateLimitProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *types.RemoveRateLimitProposal
+		expPass  bool
+	}{
+		{"success", types.NewRemoveRateLimitProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstChannelID, sdk.DefaultBondDenom).(*types.RemoveRateLimitProposal), true},
+		{"empty description", types.NewRemoveRateLimitProposal(ibctesting.Title, "", ibctesting.FirstChannelID, sdk.DefaultBondDenom).(*types.RemoveRateLimitProposal), false},
+		{"invalid channel identifier", types.NewRemoveRateLimitProposal(ibctesting.Title, ibctesting.Description, "", sdk.DefaultBondDenom).(*types.RemoveRateLimitProposal), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
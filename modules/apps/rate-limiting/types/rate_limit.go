@@ -0,0 +1,181 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// Direction defines the direction in which tokens flow over a channel
+type Direction int
+
+const (
+	// DirectionSend is the direction of tokens sent from this chain
+	DirectionSend Direction = iota
+	// DirectionRecv is the direction of tokens received by this chain
+	DirectionRecv
+)
+
+// String returns the name of the direction as emitted in events
+func (d Direction) String() string {
+	if d == DirectionSend {
+		return "send"
+	}
+	return "recv"
+}
+
+// NewQuota creates a new Quota instance
+func NewQuota(maxPercentSend, maxPercentRecv uint64, maxAmountSend, maxAmountRecv sdk.Int, window time.Duration) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		MaxAmountSend:  maxAmountSend,
+		MaxAmountRecv:  maxAmountRecv,
+		Window:         window,
+	}
+}
+
+// Validate performs basic stateless validation of a Quota
+func (q Quota) Validate() error {
+	if q.MaxPercentSend > 100 || q.MaxPercentRecv > 100 {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "percentages cannot exceed 100 (send: %d, recv: %d)", q.MaxPercentSend, q.MaxPercentRecv)
+	}
+
+	if q.MaxAmountSend.IsNil() || q.MaxAmountSend.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "maximum send amount must be non-negative (%s)", q.MaxAmountSend)
+	}
+
+	if q.MaxAmountRecv.IsNil() || q.MaxAmountRecv.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "maximum receive amount must be non-negative (%s)", q.MaxAmountRecv)
+	}
+
+	if q.MaxPercentSend == 0 && q.MaxPercentRecv == 0 && q.MaxAmountSend.IsZero() && q.MaxAmountRecv.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidQuota, "at least one limit must be set")
+	}
+
+	if q.Window <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "window must be positive (%s)", q.Window)
+	}
+
+	return nil
+}
+
+// HasPercentLimit returns true if the quota limits the flow as a percentage of the supply
+func (q Quota) HasPercentLimit() bool {
+	return q.MaxPercentSend != 0 || q.MaxPercentRecv != 0
+}
+
+// Threshold returns the maximum net flow in the given direction given the supply of the denomination.
+// If both a percentage and an absolute amount are set, the lower of the two applies. False is returned
+// if the flow is not limited in the given direction.
+func (q Quota) Threshold(direction Direction, supply sdk.Int) (sdk.Int, bool) {
+	maxPercent, maxAmount := q.MaxPercentSend, q.MaxAmountSend
+	if direction == DirectionRecv {
+		maxPercent, maxAmount = q.MaxPercentRecv, q.MaxAmountRecv
+	}
+
+	switch {
+	case maxPercent == 0 && maxAmount.IsZero():
+		return sdk.Int{}, false
+	case maxPercent == 0:
+		return maxAmount, true
+	}
+
+	percentThreshold := supply.MulRaw(int64(maxPercent)).QuoRaw(100)
+	if !maxAmount.IsZero() && maxAmount.LT(percentThreshold) {
+		return maxAmount, true
+	}
+
+	return percentThreshold, true
+}
+
+// NewFlow creates a new Flow instance starting a window at the given time with no inflow or outflow
+func NewFlow(supply sdk.Int, windowStart time.Time) Flow {
+	return Flow{
+		Inflow:      sdk.ZeroInt(),
+		Outflow:     sdk.ZeroInt(),
+		Supply:      supply,
+		WindowStart: windowStart,
+	}
+}
+
+// Validate performs basic stateless validation of a Flow
+func (f Flow) Validate() error {
+	for name, amount := range map[string]sdk.Int{"inflow": f.Inflow, "outflow": f.Outflow, "supply": f.Supply} {
+		if amount.IsNil() || amount.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidFlow, "%s must be non-negative (%s)", name, amount)
+		}
+	}
+
+	return nil
+}
+
+// NetFlow returns the net flow in the given direction, which may be negative
+func (f Flow) NetFlow(direction Direction) sdk.Int {
+	if direction == DirectionSend {
+		return f.Outflow.Sub(f.Inflow)
+	}
+	return f.Inflow.Sub(f.Outflow)
+}
+
+// NewRateLimit creates a new RateLimit instance
+func NewRateLimit(channelID, denom string, quota Quota, flow Flow) RateLimit {
+	return RateLimit{
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+		Flow:      flow,
+	}
+}
+
+// Validate performs basic stateless validation of a RateLimit
+func (rl RateLimit) Validate() error {
+	if err := validateChannelAndDenom(rl.ChannelId, rl.Denom); err != nil {
+		return err
+	}
+
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+
+	return rl.Flow.Validate()
+}
+
+// IsWindowExpired returns true if the window of the rate limit has elapsed at the given time
+func (rl RateLimit) IsWindowExpired(blockTime time.Time) bool {
+	return !blockTime.Before(rl.Flow.WindowStart.Add(rl.Quota.Window))
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance
+func NewPendingSendPacket(channelID string, sequence uint64, sendTime time.Time) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelId: channelID,
+		Sequence:  sequence,
+		SendTime:  sendTime,
+	}
+}
+
+// Validate performs basic stateless validation of a PendingSendPacket
+func (p PendingSendPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return err
+	}
+
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidSequence, "packet sequence cannot be 0")
+	}
+
+	return nil
+}
+
+// validateChannelAndDenom validates the channel identifier and denomination of a rate limit
+func validateChannelAndDenom(channelID, denom string) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+
+	return sdk.ValidateDenom(denom)
+}
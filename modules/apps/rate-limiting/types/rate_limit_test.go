@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	var quota types.Quota
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: percentage and amount limits", func() {}, true},
+		{"success: only a percentage limit", func() { quota.MaxAmountSend, quota.MaxAmountRecv = sdk.ZeroInt(), sdk.ZeroInt() }, true},
+		{"success: only an amount limit", func() { quota.MaxPercentSend, quota.MaxPercentRecv = 0, 0 }, true},
+		{"percentage exceeds 100", func() { quota.MaxPercentSend = 101 }, false},
+		{"negative amount", func() { quota.MaxAmountRecv = sdk.NewInt(-1) }, false},
+		{"nil amount", func() { quota.MaxAmountSend = sdk.Int{} }, false},
+		{"no limit", func() {
+			quota = types.NewQuota(0, 0, sdk.ZeroInt(), sdk.ZeroInt(), time.Hour)
+		}, false},
+		{"zero window", func() { quota.Window = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		quota = types.NewQuota(10, 20, sdk.NewInt(1000), sdk.NewInt(2000), time.Hour)
+
+		tc.malleate()
+
+		err := quota.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestQuotaThreshold(t *testing.T) {
+	supply := sdk.NewInt(10000)
+
+	testCases := []struct {
+		name         string
+		quota        types.Quota
+		direction    types.Direction
+		expThreshold sdk.Int
+		expLimited   bool
+	}{
+		{"percentage of supply", types.NewQuota(10, 0, sdk.ZeroInt(), sdk.ZeroInt(), time.Hour), types.DirectionSend, sdk.NewInt(1000), true},
+		{"absolute amount", types.NewQuota(0, 0, sdk.ZeroInt(), sdk.NewInt(500), time.Hour), types.DirectionRecv, sdk.NewInt(500), true},
+		{"lower amount applies", types.NewQuota(10, 0, sdk.NewInt(500), sdk.ZeroInt(), time.Hour), types.DirectionSend, sdk.NewInt(500), true},
+		{"lower percentage applies", types.NewQuota(0, 1, sdk.ZeroInt(), sdk.NewInt(500), time.Hour), types.DirectionRecv, sdk.NewInt(100), true},
+		{"not limited in direction", types.NewQuota(10, 0, sdk.NewInt(500), sdk.ZeroInt(), time.Hour), types.DirectionRecv, sdk.Int{}, false},
+	}
+
+	for _, tc := range testCases {
+		threshold, limited := tc.quota.Threshold(tc.direction, supply)
+		require.Equal(t, tc.expLimited, limited, tc.name)
+		if tc.expLimited {
+			require.Equal(t, tc.expThreshold, threshold, tc.name)
+		}
+	}
+}
+
+func TestFlowNetFlow(t *testing.T) {
+	flow := types.NewFlow(sdk.NewInt(10000), time.Now())
+	flow.Inflow = sdk.NewInt(300)
+	flow.Outflow = sdk.NewInt(100)
+
+	require.Equal(t, sdk.NewInt(-200), flow.NetFlow(types.DirectionSend))
+	require.Equal(t, sdk.NewInt(200), flow.NetFlow(types.DirectionRecv))
+}
+
+func TestRateLimitIsWindowExpired(t *testing.T) {
+	windowStart := time.Unix(1000, 0)
+	rateLimit := types.NewRateLimit(
+		"channel-0", sdk.DefaultBondDenom,
+		types.NewQuota(10, 10, sdk.ZeroInt(), sdk.ZeroInt(), time.Hour),
+		types.NewFlow(sdk.NewInt(10000), windowStart),
+	)
+
+	require.False(t, rateLimit.IsWindowExpired(windowStart))
+	require.False(t, rateLimit.IsWindowExpired(windowStart.Add(time.Hour-time.Second)))
+	require.True(t, rateLimit.IsWindowExpired(windowStart.Add(time.Hour)))
+}
@@ -22,6 +22,7 @@ const (
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagMemo                   = "memo"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
@@ -57,61 +58,76 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				coin.Denom = denomTrace.IBCDenom()
 			}
 
-			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			timeoutHeight, timeoutTimestamp, err := getTimeouts(cmd, clientCtx, srcPort, srcChannel)
 			if err != nil {
 				return err
 			}
-			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+
+			memo, err := cmd.Flags().GetString(flagMemo)
 			if err != nil {
 				return err
 			}
 
-			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			msg := types.NewMsgTransfer(
+				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet. A memo may not be sent over ics20-1 channels.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMultiTransferTxCmd returns the command to create a NewMsgMultiTransfer transaction
+func NewMultiTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-transfer [src-port] [src-channel] [receiver] [amounts]",
+		Short: "Transfer several fungible tokens within a single packet through IBC",
+		Long: strings.TrimSpace(`Transfer several fungible tokens within a single packet through IBC. The tokens
+are provided as a comma separated list of coins and may only be sent over ics20-3 channels. Either all tokens are
+received on the counterparty chain, or all tokens are refunded. Timeouts are specified as for the transfer command.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer multi-transfer [src-port] [src-channel] [receiver] 10uatom,20transfer/channel-1/uosmo", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
+			sender := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+			receiver := args[2]
 
-			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			coins, err := sdk.ParseCoinsNormalized(args[3])
 			if err != nil {
 				return err
 			}
 
-			// if the timeouts are not absolute, retrieve latest block height and block timestamp
-			// for the consensus state connected to the destination port/channel
-			if !absoluteTimeouts {
-				consensusState, height, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
-				if err != nil {
-					return err
+			for i, coin := range coins {
+				if !strings.HasPrefix(coin.Denom, "ibc/") {
+					denomTrace := types.ParseDenomTrace(coin.Denom)
+					coins[i].Denom = denomTrace.IBCDenom()
 				}
+			}
 
-				if !timeoutHeight.IsZero() {
-					absoluteHeight := height
-					absoluteHeight.RevisionNumber += timeoutHeight.RevisionNumber
-					absoluteHeight.RevisionHeight += timeoutHeight.RevisionHeight
-					timeoutHeight = absoluteHeight
-				}
+			timeoutHeight, timeoutTimestamp, err := getTimeouts(cmd, clientCtx, srcPort, srcChannel)
+			if err != nil {
+				return err
+			}
 
-				if timeoutTimestamp != 0 {
-					// use local clock time as reference time if it is later than the
-					// consensus state timestamp of the counter party chain, otherwise
-					// still use consensus state timestamp as reference
-					now := time.Now().UnixNano()
-					consensusStateTimestamp := consensusState.GetTimestamp()
-					if now > 0 {
-						now := uint64(now)
-						if now > consensusStateTimestamp {
-							timeoutTimestamp = now + timeoutTimestamp
-						} else {
-							timeoutTimestamp = consensusStateTimestamp + timeoutTimestamp
-						}
-					} else {
-						return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
-					}
-				}
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
 			}
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp,
+			msg := types.NewMsgMultiTransfer(
+				srcPort, srcChannel, coins.Sort(), sender, receiver, timeoutHeight, timeoutTimestamp, memo,
 			)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -120,7 +136,68 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// getTimeouts returns the packet timeout height and timestamp set by the timeout flags. Relative
+// timeouts are converted into absolute timeouts using the latest consensus state of the client
+// connected to the source channel.
+func getTimeouts(cmd *cobra.Command, clientCtx client.Context, srcPort, srcChannel string) (clienttypes.Height, uint64, error) {
+	timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+	timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	// if the timeouts are not absolute, retrieve latest block height and block timestamp
+	// for the consensus state connected to the destination port/channel
+	if !absoluteTimeouts {
+		consensusState, height, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+		if err != nil {
+			return clienttypes.Height{}, 0, err
+		}
+
+		if !timeoutHeight.IsZero() {
+			absoluteHeight := height
+			absoluteHeight.RevisionNumber += timeoutHeight.RevisionNumber
+			absoluteHeight.RevisionHeight += timeoutHeight.RevisionHeight
+			timeoutHeight = absoluteHeight
+		}
+
+		if timeoutTimestamp != 0 {
+			// use local clock time as reference time if it is later than the
+			// consensus state timestamp of the counter party chain, otherwise
+			// still use consensus state timestamp as reference
+			now := time.Now().UnixNano()
+			consensusStateTimestamp := consensusState.GetTimestamp()
+			if now > 0 {
+				now := uint64(now)
+				if now > consensusStateTimestamp {
+					timeoutTimestamp = now + timeoutTimestamp
+				} else {
+					timeoutTimestamp = consensusStateTimestamp + timeoutTimestamp
+				}
+			} else {
+				return clienttypes.Height{}, 0, errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+			}
+		}
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
@@ -75,8 +75,8 @@ func (im IBCModule) OnChanOpenInit(
 		return err
 	}
 
-	if version != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	if !types.IsSupportedVersion(version) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %s", version, types.SupportedVersions)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -87,7 +87,8 @@ func (im IBCModule) OnChanOpenInit(
 	return nil
 }
 
-// OnChanOpenTry implements the IBCModule interface.
+// OnChanOpenTry implements the IBCModule interface. The version proposed by the
+// counterparty is selected if it is supported.
 func (im IBCModule) OnChanOpenTry(
 	ctx sdk.Context,
 	order channeltypes.Order,
@@ -102,8 +103,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
 	}
 
 	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
@@ -117,7 +118,7 @@ func (im IBCModule) OnChanOpenTry(
 		}
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -127,8 +128,8 @@ func (im IBCModule) OnChanOpenAck(
 	channelID string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
 	}
 	return nil
 }
@@ -161,6 +162,77 @@ func (im IBCModule) OnChanCloseConfirm(
 	return nil
 }
 
+// OnChanUpgradeInit implements the IBCModule interface. The upgraded transfer channel
+// must satisfy the same requirements as a newly created transfer channel.
+func (im IBCModule) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) error {
+	if err := ValidateTransferChannelParams(ctx, im.keeper, order, portID, channelID); err != nil {
+		return err
+	}
+
+	if !types.IsSupportedVersion(version) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %s", version, types.SupportedVersions)
+	}
+
+	return nil
+}
+
+// OnChanUpgradeTry implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	if err := ValidateTransferChannelParams(ctx, im.keeper, order, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
+	}
+
+	return counterpartyVersion, nil
+}
+
+// OnChanUpgradeAck implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
+	}
+	return nil
+}
+
+// OnChanUpgradeConfirm implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanUpgradeRestore implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeRestore(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+}
+
 // OnRecvPacket implements the IBCModule interface. A successful acknowledgement
 // is returned if the packet data is succesfully decoded and the receive application
 // logic returns without error.
@@ -169,10 +241,15 @@ func (im IBCModule) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
+	version, _ := im.keeper.GetAppVersion(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if version == types.Version3 {
+		return im.onRecvMultiTokenPacket(ctx, packet, version)
+	}
+
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := types.UnmarshalPacketData(packet.GetData(), version)
+	if err != nil {
 		ack = channeltypes.NewErrorAcknowledgement("cannot unmarshal ICS-20 transfer packet data")
 	}
 
@@ -192,6 +269,7 @@ func (im IBCModule) OnRecvPacket(
 			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
 			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
 			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
+			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
 			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
 		),
 	)
@@ -211,9 +289,14 @@ func (im IBCModule) OnAcknowledgementPacket(
 	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	version, _ := im.keeper.GetAppVersion(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if version == types.Version3 {
+		return im.onAcknowledgementMultiTokenPacket(ctx, packet, version, ack)
+	}
+
+	data, err := types.UnmarshalPacketData(packet.GetData(), version)
+	if err != nil {
+		return err
 	}
 
 	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
@@ -227,6 +310,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
 			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
 			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
+			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
 			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
 		),
 	)
@@ -257,9 +341,14 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	version, _ := im.keeper.GetAppVersion(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if version == types.Version3 {
+		return im.onTimeoutMultiTokenPacket(ctx, packet, version)
+	}
+
+	data, err := types.UnmarshalPacketData(packet.GetData(), version)
+	if err != nil {
+		return err
 	}
 	// refund tokens
 	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
@@ -273,8 +362,139 @@ func (im IBCModule) OnTimeoutPacket(
 			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
 			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
 			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
+			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
 		),
 	)
 
 	return nil
 }
+
+// onRecvMultiTokenPacket handles the receipt of a packet sent over an ics20-3 channel. A successful
+// acknowledgement is returned only if every token in the packet is received.
+func (im IBCModule) onRecvMultiTokenPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	version string,
+) ibcexported.Acknowledgement {
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	data, err := types.UnmarshalMultiTokenPacketData(packet.GetData(), version)
+	if err != nil {
+		ack = channeltypes.NewErrorAcknowledgement("cannot unmarshal ICS-20 transfer packet data")
+	}
+
+	// only attempt the application logic if the packet data
+	// was successfully decoded
+	if ack.Success() {
+		err := im.keeper.OnRecvMultiTokenPacket(ctx, packet, data)
+		if err != nil {
+			ack = types.NewErrorAcknowledgement(err)
+		}
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+	}
+	attributes = append(attributes, tokenAttributes(data.Tokens, types.AttributeKeyDenom, types.AttributeKeyAmount)...)
+	attributes = append(attributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+	)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypePacket, attributes...))
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return ack
+}
+
+// onAcknowledgementMultiTokenPacket handles the acknowledgement of a packet sent over an ics20-3
+// channel. All tokens sent in the packet are refunded upon an error acknowledgement.
+func (im IBCModule) onAcknowledgementMultiTokenPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	version string,
+	ack channeltypes.Acknowledgement,
+) error {
+	data, err := types.UnmarshalMultiTokenPacketData(packet.GetData(), version)
+	if err != nil {
+		return err
+	}
+
+	if err := im.keeper.OnAcknowledgementMultiTokenPacket(ctx, packet, data, ack); err != nil {
+		return err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+	}
+	attributes = append(attributes, tokenAttributes(data.Tokens, types.AttributeKeyDenom, types.AttributeKeyAmount)...)
+	attributes = append(attributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+	)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypePacket, attributes...))
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacket,
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, string(resp.Result)),
+			),
+		)
+	case *channeltypes.Acknowledgement_Error:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacket,
+				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+			),
+		)
+	}
+
+	return nil
+}
+
+// onTimeoutMultiTokenPacket handles the timeout of a packet sent over an ics20-3 channel.
+// All tokens sent in the packet are refunded.
+func (im IBCModule) onTimeoutMultiTokenPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	version string,
+) error {
+	data, err := types.UnmarshalMultiTokenPacketData(packet.GetData(), version)
+	if err != nil {
+		return err
+	}
+
+	// refund tokens
+	if err := im.keeper.OnTimeoutMultiTokenPacket(ctx, packet, data); err != nil {
+		return err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+	}
+	attributes = append(attributes, tokenAttributes(data.Tokens, types.AttributeKeyRefundDenom, types.AttributeKeyRefundAmount)...)
+	attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyMemo, data.Memo))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeTimeout, attributes...))
+
+	return nil
+}
+
+// tokenAttributes returns a denomination and amount attribute pair for each token
+func tokenAttributes(tokens []types.Token, denomKey, amountKey string) []sdk.Attribute {
+	attributes := make([]sdk.Attribute, 0, 2*len(tokens))
+	for _, token := range tokens {
+		attributes = append(attributes,
+			sdk.NewAttribute(denomKey, token.Denom),
+			sdk.NewAttribute(amountKey, token.Amount),
+		)
+	}
+
+	return attributes
+}
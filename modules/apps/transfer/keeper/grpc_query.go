@@ -108,3 +108,21 @@ func (q Keeper) DenomHash(c context.Context, req *types.QueryDenomHashRequest) (
 		Hash: denomHash.String(),
 	}, nil
 }
+
+// TotalEscrowForDenom implements the Query/TotalEscrowForDenom gRPC method
+func (q Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscrowForDenomRequest) (*types.QueryTotalEscrowForDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	amount := q.GetTotalEscrowForDenom(ctx, req.Denom)
+
+	return &types.QueryTotalEscrowForDenomResponse{
+		Amount: amount,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: escrow balance exceeds the total escrow",
+			func() {
+				escrow := types.GetEscrowAddress(ibctesting.TransferPort, ibctesting.FirstChannelID)
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			},
+			true,
+		},
+		{
+			"failure: total escrow exceeds the escrow balance",
+			func() {
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200))
+
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// escrow tokens on chainA
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.NewHeight(0, 110), 0, "",
+			)
+			_, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			invariantMsg, broken := keeper.TotalEscrowPerDenomInvariants(&transferKeeper)(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().False(broken, invariantMsg)
+			} else {
+				suite.Require().True(broken)
+			}
+		})
+	}
+}
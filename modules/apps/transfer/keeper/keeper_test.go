@@ -59,3 +59,70 @@ func (suite *KeeperTestSuite) TestGetTransferAccount() {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func (suite *KeeperTestSuite) TestSetGetTotalEscrowForDenom() {
+	const denom = "atom"
+	var expAmount sdk.Int
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: with non-zero escrow amount",
+			func() {},
+			true,
+		},
+		{
+			"success: with escrow amount > 2^63",
+			func() {
+				expAmount, _ = sdk.NewIntFromString("100000000000000000000")
+			},
+			true,
+		},
+		{
+			"success: with zero escrow amount",
+			func() {
+				expAmount = sdk.ZeroInt()
+			},
+			true,
+		},
+		{
+			"failure: with negative escrow amount",
+			func() {
+				expAmount = sdk.NewInt(-1)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			expAmount = sdk.NewInt(100)
+			ctx := suite.chainA.GetContext()
+
+			tc.malleate()
+
+			if tc.expPass {
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.Coin{Denom: denom, Amount: expAmount})
+				total := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, denom)
+				suite.Require().Equal(expAmount, total.Amount)
+
+				if expAmount.IsZero() {
+					suite.Require().Empty(suite.chainA.GetSimApp().TransferKeeper.GetAllTotalEscrowed(ctx))
+				} else {
+					suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, expAmount)), suite.chainA.GetSimApp().TransferKeeper.GetAllTotalEscrowed(ctx))
+				}
+			} else {
+				suite.Require().PanicsWithValue("amount cannot be negative: -1", func() {
+					suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.Coin{Denom: denom, Amount: expAmount})
+				})
+				total := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, denom)
+				suite.Require().Equal(sdk.ZeroInt(), total.Amount)
+			}
+		})
+	}
+}
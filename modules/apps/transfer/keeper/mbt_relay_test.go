@@ -27,11 +27,18 @@ type TlaBalance struct {
 	Amount  int64    `json:"amount"`
 }
 
+type TlaToken struct {
+	Amount string   `json:"amount"`
+	Denom  []string `json:"denom"`
+}
+
 type TlaFungibleTokenPacketData struct {
 	Sender   string   `json:"sender"`
 	Receiver string   `json:"receiver"`
 	Amount   string   `json:"amount"`
 	Denom    []string `json:"denom"`
+	// Tokens is only set for multi-token packets, in which case Amount and Denom are empty
+	Tokens []TlaToken `json:"tokens"`
 }
 
 type TlaFungibleTokenPacket struct {
@@ -61,6 +68,28 @@ type FungibleTokenPacket struct {
 	DestChannel   string
 	DestPort      string
 	Data          types.FungibleTokenPacketData
+	MultiData     types.MultiTokenPacketData
+	MultiToken    bool
+}
+
+// GetBytes returns the bytes of the packet data in the format of the packet
+func (packet FungibleTokenPacket) GetBytes() []byte {
+	if packet.MultiToken {
+		return packet.MultiData.GetBytes()
+	}
+	return packet.Data.GetBytes()
+}
+
+// Denoms returns the full denomination paths of all the tokens in the packet
+func (packet FungibleTokenPacket) Denoms() []string {
+	if !packet.MultiToken {
+		return []string{packet.Data.Denom}
+	}
+	denoms := make([]string, 0, len(packet.MultiData.Tokens))
+	for _, token := range packet.MultiData.Tokens {
+		denoms = append(denoms, token.Denom)
+	}
+	return denoms
 }
 
 type OnRecvPacketTestCase = struct {
@@ -136,6 +165,24 @@ func BalancesFromTla(tla []TlaBalance) []Balance {
 }
 
 func FungibleTokenPacketFromTla(packet TlaFungibleTokenPacket) FungibleTokenPacket {
+	if len(packet.Data.Tokens) > 0 {
+		tokens := make([]types.Token, 0, len(packet.Data.Tokens))
+		for _, token := range packet.Data.Tokens {
+			tokens = append(tokens, types.NewToken(DenomFromTla(token.Denom), token.Amount))
+		}
+		return FungibleTokenPacket{
+			SourceChannel: packet.SourceChannel,
+			SourcePort:    packet.SourcePort,
+			DestChannel:   packet.DestChannel,
+			DestPort:      packet.DestPort,
+			MultiData: types.NewMultiTokenPacketData(
+				tokens,
+				AddressFromString(packet.Data.Sender),
+				AddressFromString(packet.Data.Receiver),
+				""),
+			MultiToken: true,
+		}
+	}
 	return FungibleTokenPacket{
 		SourceChannel: packet.SourceChannel,
 		SourcePort:    packet.SourcePort,
@@ -145,7 +192,8 @@ func FungibleTokenPacketFromTla(packet TlaFungibleTokenPacket) FungibleTokenPack
 			DenomFromTla(packet.Data.Denom),
 			packet.Data.Amount,
 			AddressFromString(packet.Data.Sender),
-			AddressFromString(packet.Data.Receiver)),
+			AddressFromString(packet.Data.Receiver),
+			""),
 	}
 }
 
@@ -296,23 +344,46 @@ func (suite *KeeperTestSuite) TestModelBasedRelay() {
 		suite.SetupTest()
 		pathAtoB := NewTransferPath(suite.chainA, suite.chainB)
 		pathBtoC := NewTransferPath(suite.chainB, suite.chainC)
+		// multi-token packets require ics20-3 channels
+		for _, tlaTc := range tlaTestCases {
+			if len(tlaTc.Packet.Data.Tokens) > 0 {
+				for _, path := range []*ibctesting.Path{pathAtoB, pathBtoC} {
+					path.EndpointA.ChannelConfig.Version = types.Version3
+					path.EndpointB.ChannelConfig.Version = types.Version3
+				}
+				break
+			}
+		}
 		suite.coordinator.Setup(pathAtoB)
 		suite.coordinator.Setup(pathBtoC)
 
 		for i, tlaTc := range tlaTestCases {
 			tc := OnRecvPacketTestCaseFromTla(tlaTc)
 			registerDenom := func() {
-				denomTrace := types.ParseDenomTrace(tc.packet.Data.Denom)
-				traceHash := denomTrace.Hash()
-				if !suite.chainB.GetSimApp().TransferKeeper.HasDenomTrace(suite.chainB.GetContext(), traceHash) {
-					suite.chainB.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainB.GetContext(), denomTrace)
+				for _, denom := range tc.packet.Denoms() {
+					denomTrace := types.ParseDenomTrace(denom)
+					traceHash := denomTrace.Hash()
+					if !suite.chainB.GetSimApp().TransferKeeper.HasDenomTrace(suite.chainB.GetContext(), traceHash) {
+						suite.chainB.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainB.GetContext(), denomTrace)
+					}
+				}
+			}
+
+			// state changes of a failed multi-token handler are discarded, as they are by the
+			// failed transaction or, for packet receives, by core IBC writing an error acknowledgement
+			runAtomic := func(handler func(ctx sdk.Context) error) error {
+				cacheCtx, writeFn := suite.chainB.GetContext().CacheContext()
+				if err := handler(cacheCtx); err != nil {
+					return err
 				}
+				writeFn()
+				return nil
 			}
 
 			description := file_info.Name() + " # " + strconv.Itoa(i+1)
 			suite.Run(fmt.Sprintf("Case %s", description), func() {
 				seq := uint64(1)
-				packet := channeltypes.NewPacket(tc.packet.Data.GetBytes(), seq, tc.packet.SourcePort, tc.packet.SourceChannel, tc.packet.DestPort, tc.packet.DestChannel, clienttypes.NewHeight(0, 100), 0)
+				packet := channeltypes.NewPacket(tc.packet.GetBytes(), seq, tc.packet.SourcePort, tc.packet.SourceChannel, tc.packet.DestPort, tc.packet.DestChannel, clienttypes.NewHeight(0, 100), 0)
 				bankBefore := BankFromBalances(tc.bankBefore)
 				realBankBefore := BankOfChain(suite.chainB)
 				// First validate the packet itself (mimics what happens when the packet is being sent and/or received)
@@ -345,7 +416,8 @@ func (suite *KeeperTestSuite) TestModelBasedRelay() {
 							sender,
 							tc.packet.Data.Receiver,
 							clienttypes.NewHeight(0, 110),
-							0)
+							0,
+							"")
 					}
 				case "OnRecvPacket":
 					err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, tc.packet.Data)
@@ -361,6 +433,59 @@ func (suite *KeeperTestSuite) TestModelBasedRelay() {
 					err = suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementPacket(
 						suite.chainB.GetContext(), packet, tc.packet.Data,
 						channeltypes.NewErrorAcknowledgement("MBT Error Acknowledgement"))
+				case "SendMultiTransfer":
+					var sender sdk.AccAddress
+					sender, err = sdk.AccAddressFromBech32(tc.packet.MultiData.Sender)
+					if err != nil {
+						panic("MBT failed to convert sender address")
+					}
+					registerDenom()
+					tokens := sdk.NewCoins()
+					for _, token := range tc.packet.MultiData.Tokens {
+						denom := types.ParseDenomTrace(token.Denom).IBCDenom()
+						if err = sdk.ValidateDenom(denom); err != nil {
+							break
+						}
+						amount, ok := sdk.NewIntFromString(token.Amount)
+						if !ok {
+							panic("MBT failed to parse amount from string")
+						}
+						tokens = tokens.Add(sdk.NewCoin(denom, amount))
+					}
+					if err == nil {
+						err = runAtomic(func(ctx sdk.Context) error {
+							return suite.chainB.GetSimApp().TransferKeeper.SendMultiTransfer(
+								ctx,
+								tc.packet.SourcePort,
+								tc.packet.SourceChannel,
+								tokens,
+								sender,
+								tc.packet.MultiData.Receiver,
+								clienttypes.NewHeight(0, 110),
+								0,
+								"")
+						})
+					}
+				case "OnRecvMultiTokenPacket":
+					err = runAtomic(func(ctx sdk.Context) error {
+						return suite.chainB.GetSimApp().TransferKeeper.OnRecvMultiTokenPacket(ctx, packet, tc.packet.MultiData)
+					})
+				case "OnTimeoutMultiTokenPacket":
+					registerDenom()
+					err = runAtomic(func(ctx sdk.Context) error {
+						return suite.chainB.GetSimApp().TransferKeeper.OnTimeoutMultiTokenPacket(ctx, packet, tc.packet.MultiData)
+					})
+				case "OnRecvMultiAcknowledgementResult":
+					err = suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementMultiTokenPacket(
+						suite.chainB.GetContext(), packet, tc.packet.MultiData,
+						channeltypes.NewResultAcknowledgement(nil))
+				case "OnRecvMultiAcknowledgementError":
+					registerDenom()
+					err = runAtomic(func(ctx sdk.Context) error {
+						return suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementMultiTokenPacket(
+							ctx, packet, tc.packet.MultiData,
+							channeltypes.NewErrorAcknowledgement("MBT Error Acknowledgement"))
+					})
 				default:
 					err = fmt.Errorf("Unknown handler:  %s", tc.handler)
 				}
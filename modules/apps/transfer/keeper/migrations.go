@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateTotalEscrowForDenom migrates the total amount of source chain tokens in escrow.
+// The total escrow of each denomination is set to the sum of the balances of the escrow
+// accounts of all the channels bound to the transfer port.
+func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
+	var totalEscrowed sdk.Coins
+
+	portID := m.keeper.GetPort(ctx)
+	for _, channel := range m.keeper.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := types.GetEscrowAddress(portID, channel.ChannelId)
+		escrowBalances := m.keeper.bankKeeper.GetAllBalances(ctx, escrowAddress)
+
+		totalEscrowed = totalEscrowed.Add(escrowBalances...)
+	}
+
+	for _, totalEscrow := range totalEscrowed {
+		m.keeper.SetTotalEscrowForDenom(ctx, totalEscrow)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully set total escrow for denominations", "total escrowed", totalEscrowed.String())
+
+	return nil
+}
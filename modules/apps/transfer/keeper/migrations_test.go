@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func (suite *KeeperTestSuite) TestMigrateTotalEscrowForDenom() {
+	pathAToB := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(pathAToB)
+	pathAToC := NewTransferPath(suite.chainA, suite.chainC)
+	suite.coordinator.Setup(pathAToC)
+
+	ctx := suite.chainA.GetContext()
+	denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(ibctesting.TransferPort, "channel-5", sdk.DefaultBondDenom))
+
+	// fund the escrow accounts of both channels, as if tokens were escrowed before the migration
+	escrowAToB := types.GetEscrowAddress(pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID)
+	suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), ctx, escrowAToB, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))))
+
+	escrowAToC := types.GetEscrowAddress(pathAToC.EndpointA.ChannelConfig.PortID, pathAToC.EndpointA.ChannelID)
+	suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), ctx, escrowAToC, sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)),
+		sdk.NewCoin(denomTrace.IBCDenom(), sdk.NewInt(10)),
+	)))
+
+	suite.Require().Empty(suite.chainA.GetSimApp().TransferKeeper.GetAllTotalEscrowed(ctx))
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	suite.Require().NoError(migrator.MigrateTotalEscrowForDenom(ctx))
+
+	expTotalEscrowed := sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150)),
+		sdk.NewCoin(denomTrace.IBCDenom(), sdk.NewInt(10)),
+	)
+	suite.Require().Equal(expTotalEscrowed, suite.chainA.GetSimApp().TransferKeeper.GetAllTotalEscrowed(ctx))
+}
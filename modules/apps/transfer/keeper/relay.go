@@ -57,6 +57,44 @@ func (k Keeper) SendTransfer(
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+	memo string,
+) error {
+	return k.sendTransfer(ctx, sourcePort, sourceChannel, sdk.Coins{token}, sender, receiver, timeoutHeight, timeoutTimestamp, memo, false)
+}
+
+// SendMultiTransfer handles the sending logic of a transfer of several tokens within
+// a single packet. The tokens are escrowed or burned following the same rules as
+// SendTransfer. Multi-token transfers may only be sent over ics20-3 channels.
+func (k Keeper) SendMultiTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	tokens sdk.Coins,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) error {
+	return k.sendTransfer(ctx, sourcePort, sourceChannel, tokens, sender, receiver, timeoutHeight, timeoutTimestamp, memo, true)
+}
+
+// sendTransfer escrows or burns each of the tokens and sends a single packet transferring
+// all of them. The packet data format is selected by the version of the source channel:
+// ics20-3 channels use the multi-token packet data, while ics20-1 and ics20-2 channels
+// use the single token packet data. If any token fails to be escrowed or burned the
+// transaction fails and no state changes are persisted.
+func (k Keeper) sendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	tokens sdk.Coins,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+	multiToken bool,
 ) error {
 
 	if !k.GetSendEnabled(ctx) {
@@ -68,6 +106,19 @@ func (k Keeper) SendTransfer(
 		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	if multiToken && appVersion != types.Version3 {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "multi-token transfers require an %s channel, got %s", types.Version3, appVersion)
+	}
+
+	if memo != "" && appVersion == types.Version {
+		return sdkerrors.Wrapf(types.ErrInvalidMemo, "memo is not supported on %s channels", types.Version)
+	}
+
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
 	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
 
@@ -87,6 +138,59 @@ func (k Keeper) SendTransfer(
 		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
+	labels := []metrics.Label{
+		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
+		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+	}
+
+	packetTokens := make([]types.Token, len(tokens))
+	for i, token := range tokens {
+		fullDenomPath, err := k.sendToken(ctx, sourcePort, sourceChannel, token, sender, labels)
+		if err != nil {
+			return err
+		}
+
+		packetTokens[i] = types.NewToken(fullDenomPath, token.Amount.String())
+	}
+
+	var packetData []byte
+	if appVersion == types.Version3 {
+		packetData = types.NewMultiTokenPacketData(packetTokens, sender.String(), receiver, memo).GetBytes()
+	} else {
+		// NOTE: only a single token may be sent over ics20-1 and ics20-2 channels
+		packetData = types.NewFungibleTokenPacketData(
+			packetTokens[0].Denom, packetTokens[0].Amount, sender.String(), receiver, memo,
+		).GetBytes()
+	}
+
+	packet := channeltypes.NewPacket(
+		packetData,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// sendToken escrows the token if the sender chain is the source of the token, otherwise
+// the token is burned. The full denomination path of the token is returned.
+func (k Keeper) sendToken(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+	labels []metrics.Label,
+) (string, error) {
 	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
 	fullDenomPath := token.Denom
 
@@ -97,15 +201,10 @@ func (k Keeper) SendTransfer(
 	if strings.HasPrefix(token.Denom, "ibc/") {
 		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
 
-	labels := []metrics.Label{
-		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
-		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
-	}
-
 	// NOTE: SendTransfer simply sends the denomination as it exists on its own
 	// chain inside the packet data. The receiving chain will perform denom
 	// prefixing as necessary.
@@ -117,10 +216,8 @@ func (k Keeper) SendTransfer(
 		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
 
 		// escrow source tokens. It fails if balance insufficient.
-		if err := k.bankKeeper.SendCoins(
-			ctx, sender, escrowAddress, sdk.NewCoins(token),
-		); err != nil {
-			return err
+		if err := k.escrowToken(ctx, sender, escrowAddress, token); err != nil {
+			return "", err
 		}
 
 	} else {
@@ -130,7 +227,7 @@ func (k Keeper) SendTransfer(
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(
 			ctx, sender, types.ModuleName, sdk.NewCoins(token),
 		); err != nil {
-			return err
+			return "", err
 		}
 
 		if err := k.bankKeeper.BurnCoins(
@@ -143,25 +240,6 @@ func (k Keeper) SendTransfer(
 		}
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver,
-	)
-
-	packet := channeltypes.NewPacket(
-		packetData.GetBytes(),
-		sequence,
-		sourcePort,
-		sourceChannel,
-		destinationPort,
-		destinationChannel,
-		timeoutHeight,
-		timeoutTimestamp,
-	)
-
-	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
-		return err
-	}
-
 	defer func() {
 		if token.Amount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
@@ -178,7 +256,7 @@ func (k Keeper) SendTransfer(
 		)
 	}()
 
-	return nil
+	return fullDenomPath, nil
 }
 
 // OnRecvPacket processes a cross chain fungible token transfer. If the
@@ -202,6 +280,41 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return err
 	}
 
+	return k.receiveToken(ctx, packet, types.NewToken(data.Denom, data.Amount), receiver)
+}
+
+// OnRecvMultiTokenPacket processes a cross chain transfer of several fungible tokens.
+// Each token is received following the same rules as OnRecvPacket. If any token fails
+// to be received an error is returned and core IBC discards the state changes made for
+// the previously received tokens when writing the error acknowledgement.
+func (k Keeper) OnRecvMultiTokenPacket(ctx sdk.Context, packet channeltypes.Packet, data types.MultiTokenPacketData) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !k.GetReceiveEnabled(ctx) {
+		return types.ErrReceiveDisabled
+	}
+
+	// decode the receiver address
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range data.Tokens {
+		if err := k.receiveToken(ctx, packet, token, receiver); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// receiveToken unescrows the token if this chain is the source of the token, otherwise
+// vouchers are minted. The tokens are sent to the receiver.
+func (k Keeper) receiveToken(ctx sdk.Context, packet channeltypes.Packet, data types.Token, receiver sdk.AccAddress) error {
 	// parse the transfer amount
 	transferAmount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
@@ -241,12 +354,8 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 
 		// unescrow tokens
 		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
-			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
-			// counterparty module. The bug may occur in bank or any part of the code that allows
-			// the escrow address to be drained. A malicious counterparty module could drain the
-			// escrow address by allowing more tokens to be sent back then were escrowed.
-			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+		if err := k.unescrowToken(ctx, escrowAddress, receiver, token); err != nil {
+			return err
 		}
 
 		defer func() {
@@ -337,7 +446,21 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		return k.refundPacketToken(ctx, packet, types.NewToken(data.Denom, data.Amount), data.Sender)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// OnAcknowledgementMultiTokenPacket responds to the success or failure of a multi-token
+// packet acknowledgement written on the receiving chain. If the acknowledgement failed,
+// the sender is refunded all of the tokens sent in the packet.
+func (k Keeper) OnAcknowledgementMultiTokenPacket(ctx sdk.Context, packet channeltypes.Packet, data types.MultiTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketTokens(ctx, packet, data)
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
 		// needs to be executed and no error needs to be returned
@@ -348,14 +471,33 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 // OnTimeoutPacket refunds the sender since the original packet sent was
 // never received and has been timed out.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	return k.refundPacketToken(ctx, packet, types.NewToken(data.Denom, data.Amount), data.Sender)
+}
+
+// OnTimeoutMultiTokenPacket refunds the sender all of the tokens sent in the multi-token
+// packet since the original packet sent was never received and has been timed out.
+func (k Keeper) OnTimeoutMultiTokenPacket(ctx sdk.Context, packet channeltypes.Packet, data types.MultiTokenPacketData) error {
+	return k.refundPacketTokens(ctx, packet, data)
+}
+
+// refundPacketTokens refunds each of the tokens sent in the multi-token packet. If any
+// refund fails the error is returned, failing the transaction such that either all or
+// none of the tokens are refunded.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.MultiTokenPacketData) error {
+	for _, token := range data.Tokens {
+		if err := k.refundPacketToken(ctx, packet, token, data.Sender); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
 // the sending address.
-func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.Token, senderAddr string) error {
 	// NOTE: packet data type already checked in handler.go
 
 	// parse the denomination from the full denom path
@@ -369,7 +511,7 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
 
 	// decode the sender address
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	sender, err := sdk.AccAddressFromBech32(senderAddr)
 	if err != nil {
 		return err
 	}
@@ -377,12 +519,8 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		// unescrow tokens back to sender
 		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(token)); err != nil {
-			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
-			// counterparty module. The bug may occur in bank or any part of the code that allows
-			// the escrow address to be drained. A malicious counterparty module could drain the
-			// escrow address by allowing more tokens to be sent back then were escrowed.
-			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+		if err := k.unescrowToken(ctx, escrowAddress, sender, token); err != nil {
+			return err
 		}
 
 		return nil
@@ -76,14 +76,14 @@ WellFormedPacket(packet) ==
 BankWithAccount(abank, account, denom) ==
     IF <<account, denom>> \in DOMAIN abank
     THEN abank
-    ELSE [x \in DOMAIN bank \union { <<account, denom>> }
+    ELSE [x \in DOMAIN abank \union { <<account, denom>> }
           |-> IF x = <<account, denom>>
               THEN 0
-              ELSE bank[x] ]
+              ELSE abank[x] ]
 
-IsKnownDenomTrace(trace) ==
+IsKnownDenomTrace(trace, abank) ==
   \E account \in Accounts :
-     <<account, trace>> \in DOMAIN bank
+     <<account, trace>> \in DOMAIN abank
 
 
 SendTransferPre(packet, pbank) ==
@@ -95,43 +95,49 @@ SendTransferPre(packet, pbank) ==
    IN
    /\ WellFormedPacket(packet)
    /\ IsValidSendChannel(packet)
-   /\ IsNativeDenomTrace(trace) \/ (IsValidDenomTrace(trace) /\ IsKnownDenomTrace(trace))
+   /\ IsNativeDenomTrace(trace) \/ (IsValidDenomTrace(trace) /\ IsKnownDenomTrace(trace, pbank))
    /\ data.sender /= NullId
    /\ <<escrow, data.denomTrace>> \in DOMAIN pbank  
    /\ \/ amount = 0  \* SendTrasfer actually allows for 0 amount
-      \/ <<MakeAccount(sender), trace>> \in DOMAIN pbank /\ bank[MakeAccount(sender), trace] >= amount
+      \/ <<MakeAccount(sender), trace>> \in DOMAIN pbank /\ pbank[MakeAccount(sender), trace] >= amount
 
-SendTransferNext(packet) ==
+\* The bank escrowing or burning the tokens of the packet, given the bank abank before sending
+SendTransferWithEscrow(packet, abank) ==
+   BankWithAccount(abank, GetSourceEscrowAccount(packet), packet.data.denomTrace)
+
+SendTransferBank(packet, abank) ==
    LET data == packet.data IN
-   LET denom == GetDenom(data.denomTrace) IN
    LET amount == data.amount IN
    LET sender == data.sender IN
    LET escrow == GetSourceEscrowAccount(packet) IN
-   LET bankwithescrow == BankWithAccount(bank, escrow, data.denomTrace) IN
-   IF SendTransferPre(packet,bankwithescrow)
+   LET bankwithescrow == SendTransferWithEscrow(packet, abank) IN
+   IF ~IsSource(packet)
+   \* This is how the check is encoded in ICS20 and the implementation.
+   \* The meaning is "IF denom = AsAddress(NativeDenom)" because of the following argument:
+   \* observe that due to the disjunction in SendTransferPre(packet), we have
+   \* ~IsSource(packet) /\ SendTransferPre(packet) => denom = AsAddress(NativeDenom)
+   THEN
+        \* tokens are from this chain
+        \* transfer tokens from sender into escrow account
+        [bankwithescrow EXCEPT ![MakeAccount(sender), data.denomTrace] = @ - amount,
+                               ![escrow, data.denomTrace] = @ + amount]
+   ELSE
+        \* tokens are from other chain. We forward them.
+        \* burn sender's money
+        [bankwithescrow EXCEPT ![MakeAccount(sender), data.denomTrace] = @ - amount]
+
+SendTransferNext(packet) ==
+   IF SendTransferPre(packet, SendTransferWithEscrow(packet, bank))
    THEN
         /\ error' = FALSE
         \*/\ IBCsend(chain, packet)
-        /\ IF ~IsSource(packet)
-           \* This is how the check is encoded in ICS20 and the implementation.
-           \* The meaning is "IF denom = AsAddress(NativeDenom)" because of the following argument:
-           \* observe that due to the disjunction in SendTransferPre(packet), we have
-           \* ~IsSource(packet) /\ SendTransferPre(packet) => denom = AsAddress(NativeDenom)
-           THEN
-                \* tokens are from this chain
-                \* transfer tokens from sender into escrow account
-                bank' = [bankwithescrow EXCEPT ![MakeAccount(sender), data.denomTrace] = @ - amount,
-                                     ![escrow, data.denomTrace] = @ + amount]
-           ELSE
-                \* tokens are from other chain. We forward them.
-                \* burn sender's money
-                bank' = [bankwithescrow EXCEPT ![MakeAccount(sender), data.denomTrace] = @ - amount]
+        /\ bank' = SendTransferBank(packet, bank)
   ELSE
        /\ error' = TRUE
        /\ UNCHANGED bank
 
 
-OnRecvPacketPre(packet) ==
+OnRecvPacketPre(packet, abank) ==
   LET data == packet.data
       trace == data.denomTrace
       denom == GetDenom(trace)
@@ -146,43 +152,47 @@ OnRecvPacketPre(packet) ==
   /\ IsSource(packet) =>
        LET escrow == GetDestEscrowAccount(packet) IN
        LET denomTrace == ReduceDenomTrace(trace) IN
-           /\ <<escrow, denomTrace>> \in DOMAIN bank
-           /\ bank[escrow, denomTrace] >= amount
+           /\ <<escrow, denomTrace>> \in DOMAIN abank
+           /\ abank[escrow, denomTrace] >= amount
 
+\* This condition is necessary so that denomination traces do not exceed the maximum length
+OnRecvPacketTraceLenOK(packet) ==
+  IsSource(packet) \/ TraceLen(packet.data.denomTrace) < MaxDenomLength
 
-OnRecvPacketNext(packet) ==
+OnRecvPacketBank(packet, abank) ==
    LET data == packet.data IN
    LET trace == data.denomTrace IN
-   LET denom == GetDenom(trace) IN
    LET amount == data.amount IN
    LET receiver == data.receiver IN
-   /\ IF OnRecvPacketPre(packet)
+   IF IsSource(packet)
+   THEN
+        \* transfer from the escrow account to the receiver account
+        LET denomTrace == ReduceDenomTrace(trace) IN
+        LET escrow == GetDestEscrowAccount(packet) IN
+        LET bankwithreceiver == BankWithAccount(abank, MakeAccount(receiver), denomTrace) IN
+        [bankwithreceiver
+            EXCEPT ![MakeAccount(receiver), denomTrace] = @ + amount,
+                   ![escrow, denomTrace] = @ - amount]
+   ELSE
+        \* create new tokens with new denomination and transfer it to the receiver account
+        LET denomTrace == ExtendDenomTrace(packet.destPort, packet.destChannel, trace) IN
+        LET bankwithreceiver ==
+            BankWithAccount(abank, MakeAccount(receiver), denomTrace) IN
+        [bankwithreceiver
+            EXCEPT ![MakeAccount(receiver), denomTrace] = @ + amount]
+
+OnRecvPacketNext(packet) ==
+   /\ IF OnRecvPacketPre(packet, bank)
       THEN
-        \* This condition is necessary so that denomination traces do not exceed the maximum length
-        /\ (IsSource(packet) \/ TraceLen(trace) < MaxDenomLength)
+        /\ OnRecvPacketTraceLenOK(packet)
         /\ error' = FALSE
-        /\ IF IsSource(packet)
-           THEN
-                \* transfer from the escrow account to the receiver account
-                LET denomTrace == ReduceDenomTrace(trace) IN
-                LET escrow == GetDestEscrowAccount(packet) IN
-                LET bankwithreceiver == BankWithAccount(bank, MakeAccount(receiver), denomTrace) IN
-                bank' = [bankwithreceiver
-                    EXCEPT ![MakeAccount(receiver), denomTrace] = @ + amount,
-                           ![escrow, denomTrace] = @ - amount]
-           ELSE
-                \* create new tokens with new denomination and transfer it to the receiver account
-                LET denomTrace == ExtendDenomTrace(packet.destPort, packet.destChannel, trace) IN
-                LET bankwithreceiver ==
-                    BankWithAccount(bank, MakeAccount(receiver), denomTrace) IN
-                bank' = [bankwithreceiver
-                    EXCEPT ![MakeAccount(receiver), denomTrace] = @ + amount]
+        /\ bank' = OnRecvPacketBank(packet, bank)
       ELSE
        /\ error' = TRUE
        /\ UNCHANGED bank
 
        
-OnTimeoutPacketPre(packet) ==  
+OnTimeoutPacketPre(packet, abank) ==  
   LET data == packet.data
       trace == data.denomTrace
       denom == GetDenom(trace)
@@ -194,32 +204,31 @@ OnTimeoutPacketPre(packet) ==
   /\ data.sender /= NullId
   /\ ~IsSource(packet) =>
        LET escrow == GetSourceEscrowAccount(packet)
-       IN  /\ <<escrow, trace>> \in DOMAIN bank
-           /\ bank[escrow, trace] >= amount
+       IN  /\ <<escrow, trace>> \in DOMAIN abank
+           /\ abank[escrow, trace] >= amount
  
-
-OnTimeoutPacketNext(packet) == 
+OnTimeoutPacketBank(packet, abank) ==
    LET data == packet.data IN
    LET trace == data.denomTrace IN
-   LET denom == GetDenom(data.denomTrace) IN
    LET amount == data.amount IN
    LET sender == data.sender IN
-   LET bankwithsender == BankWithAccount(bank, MakeAccount(sender), trace) IN
-   IF OnTimeoutPacketPre(packet) 
+   LET bankwithsender == BankWithAccount(abank, MakeAccount(sender), trace) IN
+   IF ~IsSource(packet)
+   THEN 
+        \* transfer from the escrow acount to the sender account
+        LET escrow == GetSourceEscrowAccount(packet) IN
+        [bankwithsender
+            EXCEPT ![MakeAccount(sender), trace] = @ + amount,
+                   ![escrow, trace] = @ - amount]
+   ELSE 
+        \* mint back the money  
+        [bankwithsender EXCEPT ![MakeAccount(sender), trace] = @ + amount]
+
+OnTimeoutPacketNext(packet) == 
+   IF OnTimeoutPacketPre(packet, bank) 
    THEN  
         /\ error' = FALSE
-        /\ IF ~IsSource(packet)
-            THEN 
-            \* transfer from the escrow acount to the sender account
-                \* LET denomsuffix == SubSeq(denom, 3, Len(denom)) IN
-               LET escrow == GetSourceEscrowAccount(packet) IN
-                bank' = [bankwithsender
-                    EXCEPT ![MakeAccount(sender), trace] = @ + amount,
-                           ![escrow, trace] = @ - amount]
-            ELSE 
-            \* mint back the money  
-             bank' = [bankwithsender EXCEPT ![MakeAccount(sender), trace] = @ + amount]
-   
+        /\ bank' = OnTimeoutPacketBank(packet, bank)
    ELSE 
        /\ error' = TRUE
        /\ UNCHANGED bank
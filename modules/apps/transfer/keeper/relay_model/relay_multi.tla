@@ -0,0 +1,170 @@
+-------------------------- MODULE relay_multi ----------------------------
+(**
+ * An extension of the relay model to multi-token packets,
+ * as sent over ics20-3 channels.
+ *
+ * A multi-token packet carries several tokens of distinct denominations.
+ * Each handler applies the single token handler of relay.tla to every token in turn,
+ * and succeeds only if it succeeds for all of the tokens:
+ * if any of the tokens fails, the handler fails and the bank is left unchanged.
+ *)
+
+EXTENDS relay
+
+VARIABLE
+  mp  \* the multi-token packet
+
+\* The model is restricted to packets of exactly two tokens
+MaxTokens == 2
+
+TokenIndices == 1..MaxTokens
+
+Tokens == [
+  denomTrace: DenomTraces,
+  amount: Amounts
+]
+
+MultiTokenPacketData == [
+  sender: AccountIds,
+  receiver: AccountIds,
+  tokens: [TokenIndices -> Tokens]
+]
+
+MultiTokenPackets == [
+  sourcePort: Identifiers,
+  sourceChannel: Identifiers,
+  destPort: Identifiers,
+  destChannel: Identifiers,
+  data: MultiTokenPacketData
+]
+
+\* The single token packet transferring the i-th token of the multi-token packet
+TokenPacket(packet, i) == [
+  sourcePort |-> packet.sourcePort,
+  sourceChannel |-> packet.sourceChannel,
+  destPort |-> packet.destPort,
+  destChannel |-> packet.destChannel,
+  data |-> [
+    sender |-> packet.data.sender,
+    receiver |-> packet.data.receiver,
+    denomTrace |-> packet.data.tokens[i].denomTrace,
+    amount |-> packet.data.tokens[i].amount
+  ]
+]
+
+\* Models the check for duplicate denominations in the packet data validation
+DistinctTokens(packet) ==
+  packet.data.tokens[1].denomTrace /= packet.data.tokens[2].denomTrace
+
+PositiveTokens(packet) ==
+  \A i \in TokenIndices : packet.data.tokens[i].amount > 0
+
+
+SendMultiTransferBank(packet, abank) ==
+  SendTransferBank(TokenPacket(packet, 2), SendTransferBank(TokenPacket(packet, 1), abank))
+
+SendMultiTransferPre(packet, abank) ==
+  LET t1 == TokenPacket(packet, 1)
+      t2 == TokenPacket(packet, 2)
+  IN
+  /\ DistinctTokens(packet)
+  /\ PositiveTokens(packet)
+  /\ SendTransferPre(t1, SendTransferWithEscrow(t1, abank))
+  /\ SendTransferPre(t2, SendTransferWithEscrow(t2, SendTransferBank(t1, abank)))
+
+SendMultiTransferNext(packet) ==
+  IF SendMultiTransferPre(packet, bank)
+  THEN
+       /\ error' = FALSE
+       /\ bank' = SendMultiTransferBank(packet, bank)
+  ELSE
+       /\ error' = TRUE
+       /\ UNCHANGED bank
+
+
+OnRecvMultiTokenPacketBank(packet, abank) ==
+  OnRecvPacketBank(TokenPacket(packet, 2), OnRecvPacketBank(TokenPacket(packet, 1), abank))
+
+OnRecvMultiTokenPacketPre(packet, abank) ==
+  LET t1 == TokenPacket(packet, 1)
+      t2 == TokenPacket(packet, 2)
+  IN
+  /\ DistinctTokens(packet)
+  /\ OnRecvPacketPre(t1, abank)
+  /\ OnRecvPacketPre(t2, OnRecvPacketBank(t1, abank))
+
+OnRecvMultiTokenPacketNext(packet) ==
+  IF OnRecvMultiTokenPacketPre(packet, bank)
+  THEN
+       \* see OnRecvPacketNext
+       /\ \A i \in TokenIndices : OnRecvPacketTraceLenOK(TokenPacket(packet, i))
+       /\ error' = FALSE
+       /\ bank' = OnRecvMultiTokenPacketBank(packet, bank)
+  ELSE
+       /\ error' = TRUE
+       /\ UNCHANGED bank
+
+
+OnTimeoutMultiTokenPacketBank(packet, abank) ==
+  OnTimeoutPacketBank(TokenPacket(packet, 2), OnTimeoutPacketBank(TokenPacket(packet, 1), abank))
+
+OnTimeoutMultiTokenPacketPre(packet, abank) ==
+  LET t1 == TokenPacket(packet, 1)
+      t2 == TokenPacket(packet, 2)
+  IN
+  /\ DistinctTokens(packet)
+  /\ OnTimeoutPacketPre(t1, abank)
+  /\ OnTimeoutPacketPre(t2, OnTimeoutPacketBank(t1, abank))
+
+OnTimeoutMultiTokenPacketNext(packet) ==
+  IF OnTimeoutMultiTokenPacketPre(packet, bank)
+  THEN
+       /\ error' = FALSE
+       /\ bank' = OnTimeoutMultiTokenPacketBank(packet, bank)
+  ELSE
+       /\ error' = TRUE
+       /\ UNCHANGED bank
+
+
+OnAcknowledgementMultiTokenPacketResultNext(packet) ==
+  OnAcknowledgementPacketResultNext(packet)
+
+\* All of the tokens are refunded, as on timeout
+OnAcknowledgementMultiTokenPacketErrorNext(packet) ==
+  OnTimeoutMultiTokenPacketNext(packet)
+
+
+MultiInit ==
+  /\ p \in Packets
+  /\ mp \in MultiTokenPackets
+  /\ bank = [ x \in {<<NullAccount, NullDenomTrace>>} |-> 0  ]
+  /\ count = 0
+  /\ history = [
+       n \in {0} |-> [
+          error |-> FALSE,
+          packet |-> mp,
+          handler |-> "",
+          bankBefore |-> bank,
+          bankAfter |-> bank
+       ]
+     ]
+  /\ error = FALSE
+  /\ handler = ""
+
+MultiNext ==
+  /\ mp' \in MultiTokenPackets
+  /\ UNCHANGED p
+  /\ count'= count + 1
+  /\
+     \/ (SendMultiTransferNext(mp) /\ handler' = "SendMultiTransfer")
+     \/ (OnRecvMultiTokenPacketNext(mp) /\ handler' = "OnRecvMultiTokenPacket")
+     \/ (OnTimeoutMultiTokenPacketNext(mp) /\ handler' = "OnTimeoutMultiTokenPacket")
+     \/ (OnAcknowledgementMultiTokenPacketResultNext(mp) /\ handler' = "OnRecvMultiAcknowledgementResult")
+     \/ (OnAcknowledgementMultiTokenPacketErrorNext(mp) /\ handler' = "OnRecvMultiAcknowledgementError")
+  /\ history' = [ n \in DOMAIN history \union {count'} |->
+       IF n = count' THEN
+         [ packet |-> mp, handler |-> handler', error |-> error', bankBefore |-> bank, bankAfter |-> bank' ]
+       ELSE history[n]
+     ]
+
+=============================================================================
@@ -0,0 +1,73 @@
+-------------------------- MODULE relay_multi_tests ----------------------------
+
+EXTENDS Integers, FiniteSets
+
+Identifiers == {"", "transfer", "channel-0", "channel-1", "cosmos-hub", "ethereum-hub", "bitcoin-hub"}
+NullId == ""
+MaxAmount == 5
+Denoms == {"", "atom", "eth", "btc" }
+AccountIds == {"", "a1", "a2", "a3" }
+
+VARIABLES error, bank, p, mp, count, history, handler
+
+INSTANCE relay_multi
+
+Init == MultiInit
+Next == MultiNext
+
+\************************** Tests ******************************
+
+\* Generic test for handler pass
+TestHandlerPass(handlerName) ==
+  \E s \in DOMAIN history :
+    /\ history[s].handler = handlerName
+    /\ history[s].error = FALSE
+    /\ \A i \in TokenIndices : history[s].packet.data.tokens[i].amount > 0
+
+\* Generic test for handler fail
+TestHandlerFail(handlerName) ==
+  \E s \in DOMAIN history :
+    /\ history[s].handler = handlerName
+    /\ history[s].error = TRUE
+    /\ \A i \in TokenIndices : history[s].packet.data.tokens[i].amount > 0
+
+TestSendMultiTransferPass == TestHandlerPass("SendMultiTransfer")
+TestSendMultiTransferPassInv == ~TestSendMultiTransferPass
+
+TestSendMultiTransferFail == TestHandlerFail("SendMultiTransfer")
+TestSendMultiTransferFailInv == ~TestSendMultiTransferFail
+
+TestOnRecvMultiTokenPacketPass == TestHandlerPass("OnRecvMultiTokenPacket")
+TestOnRecvMultiTokenPacketPassInv == ~TestOnRecvMultiTokenPacketPass
+
+TestOnRecvMultiTokenPacketFail == TestHandlerFail("OnRecvMultiTokenPacket")
+TestOnRecvMultiTokenPacketFailInv == ~TestOnRecvMultiTokenPacketFail
+
+TestOnTimeoutMultiTokenPacketPass == TestHandlerPass("OnTimeoutMultiTokenPacket")
+TestOnTimeoutMultiTokenPacketPassInv == ~TestOnTimeoutMultiTokenPacketPass
+
+TestOnTimeoutMultiTokenPacketFail == TestHandlerFail("OnTimeoutMultiTokenPacket")
+TestOnTimeoutMultiTokenPacketFailInv == ~TestOnTimeoutMultiTokenPacketFail
+
+TestOnRecvMultiAcknowledgementErrorPass == TestHandlerPass("OnRecvMultiAcknowledgementError")
+TestOnRecvMultiAcknowledgementErrorPassInv == ~TestOnRecvMultiAcknowledgementErrorPass
+
+\* A multi-token packet where the first token succeeds and the second fails
+\* must fail as a whole, leaving the bank unchanged
+TestOnRecvMultiTokenPacketPartialFail ==
+  \E s \in DOMAIN history :
+    /\ history[s].handler = "OnRecvMultiTokenPacket"
+    /\ history[s].error = TRUE
+    /\ OnRecvPacketPre(TokenPacket(history[s].packet, 1), history[s].bankBefore)
+    /\ history[s].bankAfter = history[s].bankBefore
+TestOnRecvMultiTokenPacketPartialFailInv == ~TestOnRecvMultiTokenPacketPartialFail
+
+\* Unescrow both tokens of a multi-token packet on the receiving chain
+TestUnescrowMultiTokens ==
+  \E s \in DOMAIN history :
+     /\ \A i \in TokenIndices : IsSource(TokenPacket(history[s].packet, i))
+     /\ history[s].handler = "OnRecvMultiTokenPacket"
+     /\ history[s].error = FALSE
+TestUnescrowMultiTokensInv == ~TestUnescrowMultiTokens
+
+=============================================================================
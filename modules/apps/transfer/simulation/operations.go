@@ -0,0 +1,368 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	ibcsims "github.com/cosmos/ibc-go/v3/modules/core/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgChannelOpenInit = "op_weight_msg_transfer_channel_open_init"
+	OpWeightMsgChannelOpenTry  = "op_weight_msg_transfer_channel_open_try"
+	OpWeightMsgChannelOpenAck  = "op_weight_msg_transfer_channel_open_ack"
+	OpWeightMsgTransfer        = "op_weight_msg_transfer"
+	OpWeightMsgRecvPacket      = "op_weight_msg_transfer_recv_packet"
+)
+
+// Default simulation operation weights
+const (
+	DefaultWeightMsgChannelOpenInit = 10
+	DefaultWeightMsgChannelOpenTry  = 10
+	DefaultWeightMsgChannelOpenAck  = 20
+	DefaultWeightMsgTransfer        = 50
+	DefaultWeightMsgRecvPacket      = 50
+)
+
+// maxFutureBlocks is the maximum number of blocks after which a packet sent is acknowledged or timed out
+const maxFutureBlocks = 5
+
+// WeightedOperations returns all the operations from the transfer module with their respective weights. The
+// operations use solo machines controlled by the simulation accounts as counterparties.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper,
+	ak types.AccountKeeper, bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgChannelOpenInit int
+		weightMsgChannelOpenTry  int
+		weightMsgChannelOpenAck  int
+		weightMsgTransfer        int
+		weightMsgRecvPacket      int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenInit, &weightMsgChannelOpenInit, nil,
+		func(_ *rand.Rand) {
+			weightMsgChannelOpenInit = DefaultWeightMsgChannelOpenInit
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenTry, &weightMsgChannelOpenTry, nil,
+		func(_ *rand.Rand) {
+			weightMsgChannelOpenTry = DefaultWeightMsgChannelOpenTry
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenAck, &weightMsgChannelOpenAck, nil,
+		func(_ *rand.Rand) {
+			weightMsgChannelOpenAck = DefaultWeightMsgChannelOpenAck
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgTransfer, &weightMsgTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransfer = DefaultWeightMsgTransfer
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRecvPacket, &weightMsgRecvPacket, nil,
+		func(_ *rand.Rand) {
+			weightMsgRecvPacket = DefaultWeightMsgRecvPacket
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgChannelOpenInit,
+			SimulateMsgChannelOpenInit(k, ibcKeeper, ak),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChannelOpenTry,
+			SimulateMsgChannelOpenTry(k, ibcKeeper, ak),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChannelOpenAck,
+			SimulateMsgChannelOpenAck(k, ibcKeeper, ak),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgTransfer,
+			SimulateMsgTransfer(k, ibcKeeper, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRecvPacket,
+			SimulateMsgRecvPacket(k, ibcKeeper, ak),
+		),
+	}
+}
+
+// SimulateMsgChannelOpenInit generates a MsgChannelOpenInit initializing a transfer channel either on the
+// localhost connection or on a random connection with a solo machine counterparty. The channels initialized
+// on the localhost connection are opened by the core IBC operations.
+func SimulateMsgChannelOpenInit(k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelOpenInit{})
+
+		connection, found := ibcsims.RandomCounterpartyConnection(r, ctx, ibcKeeper, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no connection with a solo machine or localhost counterparty found"), nil, nil
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgChannelOpenInit(
+			k.GetPort(ctx), types.Version, channeltypes.UNORDERED, []string{connection.Id}, types.PortID, signer.Address.String(),
+		)
+
+		if _, err := ibcsims.DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return ibcsims.NewOperationMsg(types.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgChannelOpenTry generates a MsgChannelOpenTry opening a transfer channel in TRYOPEN state on a random
+// connection with a solo machine counterparty. The channel is opened by the core IBC channel open confirm
+// operation.
+func SimulateMsgChannelOpenTry(k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelOpenTry{})
+
+		connection, solo, found := ibcsims.RandomSolomachineConnection(r, ctx, ibcKeeper, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no connection with a solo machine counterparty found"), nil, nil
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		counterpartyChannelID := channeltypes.FormatChannelIdentifier(uint64(r.Intn(100)))
+		msg, err := ibcsims.GenMsgChannelOpenTry(
+			solo, connection, k.GetPort(ctx), channeltypes.UNORDERED, types.PortID, counterpartyChannelID, types.Version, signer.Address.String(),
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create msg"), nil, err
+		}
+
+		if _, err := ibcsims.DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return ibcsims.NewOperationMsg(types.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgChannelOpenAck generates a MsgChannelOpenAck opening a random transfer channel in INIT state with
+// a solo machine counterparty
+func SimulateMsgChannelOpenAck(k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelOpenAck{})
+
+		channel, found := randomSolomachineChannel(r, ctx, k, ibcKeeper, accs, channeltypes.INIT)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no transfer channel in INIT state with a solo machine counterparty found"), nil, nil
+		}
+
+		connection, solo, _ := ibcsims.GetChannelSolomachine(ctx, ibcKeeper, accs, channel.PortId, channel.ChannelId)
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		counterpartyChannelID := channeltypes.FormatChannelIdentifier(uint64(r.Intn(100)))
+		msg, err := ibcsims.GenMsgChannelOpenAck(solo, connection, channel, counterpartyChannelID, types.Version, signer.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create msg"), nil, err
+		}
+
+		if _, err := ibcsims.DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return ibcsims.NewOperationMsg(types.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgTransfer generates a MsgTransfer sending a random amount of the spendable coins of a random
+// account over a random transfer channel either opened over the localhost connection or with a solo machine
+// counterparty. The packets sent to solo machines are acknowledged either successfully or with an error in a
+// future operation, while the packets sent over the localhost connection are timed out.
+func SimulateMsgTransfer(k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgTransfer{})
+
+		if !k.GetSendEnabled(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "transfers are disabled"), nil, nil
+		}
+
+		channel, found := randomSolomachineChannel(r, ctx, k, ibcKeeper, accs, channeltypes.OPEN)
+		if localhostChannel, ok := randomLocalhostChannel(r, ctx, k, ibcKeeper); ok && (!found || r.Intn(2) == 0) {
+			channel, found = localhostChannel, true
+		}
+
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open transfer channel with a solo machine or localhost counterparty found"), nil, nil
+		}
+
+		sender, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, sender.Address)
+		if spendable.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender has no spendable coins"), nil, nil
+		}
+
+		coin := spendable[r.Intn(len(spendable))]
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+
+		timeoutTimestamp := uint64(ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 3600)) * time.Second).UnixNano())
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgTransfer(
+			channel.PortId, channel.ChannelId, sdk.NewCoin(coin.Denom, amount), sender.Address.String(),
+			receiver.Address.String(), clienttypes.ZeroHeight(), timeoutTimestamp, "",
+		)
+
+		res, err := ibcsims.DeliverTx(app, ctx, ak, sender, msg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		packet, err := ibcsims.ParsePacketFromEvents(res.Events)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to parse packet"), nil, err
+		}
+
+		op := ibcsims.SimulateMsgTimeout(ak, ibcKeeper, packet)
+		if !ibcsims.IsLocalhostChannel(channel.ConnectionHops) {
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			if r.Intn(2) == 0 {
+				ack = channeltypes.NewErrorAcknowledgement("simulated error")
+			}
+
+			op = ibcsims.SimulateMsgAcknowledgement(ak, ibcKeeper, packet, ack.Acknowledgement())
+		}
+
+		futureOps := []simtypes.FutureOperation{
+			{
+				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 1, maxFutureBlocks+1),
+				Op:          op,
+			},
+		}
+
+		return ibcsims.NewOperationMsg(types.ModuleName, msg), futureOps, nil
+	}
+}
+
+// SimulateMsgRecvPacket generates a MsgRecvPacket receiving a random amount of tokens sent by a solo machine
+// to a random account over a random transfer channel
+func SimulateMsgRecvPacket(k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{})
+
+		if !k.GetReceiveEnabled(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "receiving transfers is disabled"), nil, nil
+		}
+
+		channel, found := randomSolomachineChannel(r, ctx, k, ibcKeeper, accs, channeltypes.OPEN)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open transfer channel with a solo machine counterparty found"), nil, nil
+		}
+
+		// the packet sequences of unordered channels only need to be unique
+		sequence := uint64(simtypes.RandIntBetween(r, 1, 1000000))
+		if _, found := ibcKeeper.ChannelKeeper.GetPacketReceipt(ctx, channel.PortId, channel.ChannelId, sequence); found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "packet already received"), nil, nil
+		}
+
+		_, solo, _ := ibcsims.GetChannelSolomachine(ctx, ibcKeeper, accs, channel.PortId, channel.ChannelId)
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		data := types.NewFungibleTokenPacketData(
+			sdk.DefaultBondDenom, sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000000))).String(),
+			simtypes.RandomAccounts(r, 1)[0].Address.String(), receiver.Address.String(), "",
+		)
+
+		packet := channeltypes.NewPacket(
+			data.GetBytes(), sequence, channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+			channel.PortId, channel.ChannelId, clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(time.Hour).UnixNano()),
+		)
+
+		proofHeight := solo.GetHeight()
+		proof, err := solo.PacketCommitmentProof(packet)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, relayer.Address.String())
+
+		if _, err := ibcsims.DeliverTx(app, ctx, ak, relayer, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return ibcsims.NewOperationMsg(types.ModuleName, msg), nil, nil
+	}
+}
+
+// randomSolomachineChannel returns a random transfer channel in the provided state whose counterparty is a solo
+// machine controlled by one of the simulation accounts
+func randomSolomachineChannel(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, accs []simtypes.Account, state channeltypes.State,
+) (channeltypes.IdentifiedChannel, bool) {
+	portID := k.GetPort(ctx)
+
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range ibcKeeper.ChannelKeeper.GetAllChannels(ctx) {
+		if channel.PortId == portID && channel.State == state {
+			channels = append(channels, channel)
+		}
+	}
+
+	r.Shuffle(len(channels), func(i, j int) {
+		channels[i], channels[j] = channels[j], channels[i]
+	})
+
+	for _, channel := range channels {
+		if _, _, found := ibcsims.GetChannelSolomachine(ctx, ibcKeeper, accs, channel.PortId, channel.ChannelId); found {
+			return channel, true
+		}
+	}
+
+	return channeltypes.IdentifiedChannel{}, false
+}
+
+// randomLocalhostChannel returns a random open transfer channel opened over the localhost connection
+func randomLocalhostChannel(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper) (channeltypes.IdentifiedChannel, bool) {
+	portID := k.GetPort(ctx)
+
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range ibcKeeper.ChannelKeeper.GetAllChannels(ctx) {
+		if channel.PortId == portID && channel.State == channeltypes.OPEN && ibcsims.IsLocalhostChannel(channel.ConnectionHops) {
+			channels = append(channels, channel)
+		}
+	}
+
+	if len(channels) == 0 {
+		return channeltypes.IdentifiedChannel{}, false
+	}
+
+	return channels[r.Intn(len(channels))], true
+}
@@ -0,0 +1,82 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/simulation"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+
+	header := tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+}
+
+// TestWeightedOperations tests the weights, routes and msg types of the operations
+func (suite *SimTestSuite) TestWeightedOperations() {
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(
+		appParams, suite.app.AppCodec(), suite.app.TransferKeeper, suite.app.IBCKeeper, suite.app.AccountKeeper, suite.app.BankKeeper,
+	)
+
+	r := rand.New(rand.NewSource(1))
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight  int
+		msgType string
+	}{
+		{simulation.DefaultWeightMsgChannelOpenInit, sdk.MsgTypeURL(&channeltypes.MsgChannelOpenInit{})},
+		{simulation.DefaultWeightMsgChannelOpenTry, sdk.MsgTypeURL(&channeltypes.MsgChannelOpenTry{})},
+		{simulation.DefaultWeightMsgChannelOpenAck, sdk.MsgTypeURL(&channeltypes.MsgChannelOpenAck{})},
+		{simulation.DefaultWeightMsgTransfer, sdk.MsgTypeURL(&types.MsgTransfer{})},
+		{simulation.DefaultWeightMsgRecvPacket, sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{})},
+	}
+
+	suite.Require().Len(weightedOps, len(expected))
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+
+		// the expected operations are dependent on the ordering of the output given by WeightedOperations
+		suite.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		suite.Require().Equal(types.ModuleName, operationMsg.Route, "route should be the same")
+		suite.Require().Equal(expected[i].msgType, operationMsg.Name, "operation msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	return accounts
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
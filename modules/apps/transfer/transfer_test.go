@@ -2,12 +2,14 @@ package transfer_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -54,7 +56,7 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
 
 	// send from chainA to chainB
-	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coinToSendToB, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coinToSendToB, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
 	res, err := suite.chainA.SendMsgs(msg)
 	suite.Require().NoError(err) // message committed
 
@@ -80,7 +82,7 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	suite.coordinator.Setup(pathBtoC)
 
 	// send from chainB to chainC
-	msg = types.NewMsgTransfer(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID, coinSentFromAToB, suite.chainB.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	msg = types.NewMsgTransfer(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID, coinSentFromAToB, suite.chainB.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
 	res, err = suite.chainB.SendMsgs(msg)
 	suite.Require().NoError(err) // message committed
 
@@ -104,7 +106,7 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Zero(balance.Amount.Int64())
 
 	// send from chainC back to chainB
-	msg = types.NewMsgTransfer(pathBtoC.EndpointB.ChannelConfig.PortID, pathBtoC.EndpointB.ChannelID, coinSentFromBToC, suite.chainC.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	msg = types.NewMsgTransfer(pathBtoC.EndpointB.ChannelConfig.PortID, pathBtoC.EndpointB.ChannelID, coinSentFromBToC, suite.chainC.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
 	res, err = suite.chainC.SendMsgs(msg)
 	suite.Require().NoError(err) // message committed
 
@@ -129,6 +131,273 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Zero(balance.Amount.Int64())
 }
 
+// sends a coin between two transfer channels opened on chainA over the localhost client and
+// sends the voucher back, then times out a transfer over the same channels.
+func (suite *TransferTestSuite) TestHandleMsgTransferLocalhost() {
+	path := ibctesting.NewLocalhostPath(suite.chainA)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+	suite.coordinator.Setup(path)
+
+	// the sender receives the vouchers since both channel ends are on chainA
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := sender
+	amount := sdk.NewInt(100)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+
+	// send from the first localhost channel to the second localhost channel
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), receiver.String(), timeoutHeight, 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// check that the voucher exists on chainA and the coin is escrowed by the first channel
+	voucher := types.GetTransferCoin(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom, amount)
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, voucher.Denom)
+	suite.Require().Equal(voucher, balance)
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(coin, balance)
+
+	// send the voucher back from the second localhost channel to the first localhost channel
+	msg = types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucher, receiver.String(), sender.String(), timeoutHeight, 0, "")
+	res, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// check that the voucher is burned and the escrow is empty
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, voucher.Denom)
+	suite.Require().Zero(balance.Amount.Int64())
+
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Zero(balance.Amount.Int64())
+
+	// send a transfer which times out before it is received
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	timeoutHeight = clienttypes.NewHeight(0, uint64(suite.chainA.GetContext().BlockHeight())+1)
+	msg = types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), receiver.String(), timeoutHeight, 0, "")
+	res, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the localhost client height is past the timeout height of the packet
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	// check that the escrowed coin is refunded to the sender
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance, balance)
+}
+
+// sends a transfer with a memo over an ics20-2 channel and checks that the memo is
+// included in the packet data and emitted upon receiving the packet.
+func (suite *TransferTestSuite) TestHandleMsgTransferWithMemo() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = types.Version2
+	path.EndpointB.ChannelConfig.Version = types.Version2
+	suite.coordinator.Setup(path)
+
+	memo := `{"wasm":{"contract":"cosmos1contract"}}`
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coinToSendToB, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, memo)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	data, err := types.UnmarshalPacketData(packet.GetData(), types.Version2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(memo, data.Memo)
+
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err = path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	var found bool
+	for _, event := range res.GetEvents() {
+		if event.Type != types.EventTypePacket {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyMemo {
+				suite.Require().Equal(memo, string(attr.Value))
+				found = true
+			}
+		}
+	}
+	suite.Require().True(found)
+
+	voucherDenomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenomTrace.IBCDenom())
+	suite.Require().Equal(coinToSendToB.Amount, balance.Amount)
+}
+
+// sends a coin from chainB to chainA over an ics20-3 channel and then sends the voucher
+// received on chainA along with a native coin of chainA back to chainB within a single packet.
+func (suite *TransferTestSuite) TestHandleMsgMultiTransfer() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = types.Version3
+	path.EndpointB.ChannelConfig.Version = types.Version3
+	suite.coordinator.Setup(path)
+
+	amount := sdk.NewInt(100)
+	voucher := suite.sendVoucherToChainA(path, amount)
+
+	tokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount), voucher)
+	msg := types.NewMsgMultiTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, tokens, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	data, err := types.UnmarshalMultiTokenPacketData(packet.GetData(), types.Version3)
+	suite.Require().NoError(err)
+	suite.Require().Len(data.Tokens, 2)
+
+	// the native coin is escrowed and the voucher is burned on chainA
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(amount, escrowBalance.Amount)
+
+	voucherBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucher.Denom)
+	suite.Require().True(voucherBalance.IsZero())
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// chainB receives a voucher for the native coin of chainA and the coin it previously escrowed
+	voucherDenomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenomTrace.IBCDenom())
+	suite.Require().Equal(amount, balance.Amount)
+
+	escrowAddress = types.GetEscrowAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	escrowBalance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().True(escrowBalance.IsZero())
+}
+
+// tests that all tokens sent within a single packet are refunded upon an error
+// acknowledgement or a timeout.
+func (suite *TransferTestSuite) TestMultiTransferRefund() {
+	testCases := []struct {
+		name    string
+		timeout bool
+	}{
+		{"refund on error acknowledgement", false},
+		{"refund on timeout", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = types.Version3
+			path.EndpointB.ChannelConfig.Version = types.Version3
+			suite.coordinator.Setup(path)
+
+			amount := sdk.NewInt(100)
+			voucher := suite.sendVoucherToChainA(path, amount)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			balancesBefore := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender)
+
+			// the receiver address is invalid on chainB such that an error acknowledgement is written
+			receiver := "invalid address"
+			timeoutTimestamp := uint64(0)
+			if tc.timeout {
+				receiver = suite.chainB.SenderAccount.GetAddress().String()
+				timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano())
+			}
+
+			tokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount), voucher)
+			msg := types.NewMsgMultiTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, tokens, sender.String(), receiver, clienttypes.NewHeight(0, 110), timeoutTimestamp, "")
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			balancesAfterSend := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender)
+			suite.Require().Equal(balancesBefore.Sub(tokens), balancesAfterSend)
+
+			if tc.timeout {
+				suite.coordinator.IncrementTimeBy(2 * time.Minute)
+				err = path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.TimeoutPacket(packet)
+				suite.Require().NoError(err)
+			} else {
+				err = path.EndpointB.UpdateClient()
+				suite.Require().NoError(err)
+
+				res, err = path.EndpointB.RecvPacketWithResult(packet)
+				suite.Require().NoError(err)
+
+				ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+
+				var acknowledgement channeltypes.Acknowledgement
+				err = types.ModuleCdc.UnmarshalJSON(ack, &acknowledgement)
+				suite.Require().NoError(err)
+				suite.Require().False(acknowledgement.Success())
+
+				err = path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.AcknowledgePacket(packet, ack)
+				suite.Require().NoError(err)
+			}
+
+			// all tokens are refunded
+			balancesAfterRefund := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender)
+			suite.Require().Equal(balancesBefore, balancesAfterRefund)
+		})
+	}
+}
+
+// sendVoucherToChainA sends the native coin of chainB to chainA and returns the voucher
+// received on chainA.
+func (suite *TransferTestSuite) sendVoucherToChainA(path *ibctesting.Path, amount sdk.Int) sdk.Coin {
+	msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	voucherDenomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	return sdk.NewCoin(voucherDenomTrace.IBCDenom(), amount)
+}
+
 func TestTransferTestSuite(t *testing.T) {
 	suite.Run(t, new(TransferTestSuite))
 }
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
 // SenderChainIsSource returns false if the denomination originally came
@@ -40,6 +42,21 @@ func GetPrefixedDenom(portID, channelID, baseDenom string) string {
 	return fmt.Sprintf("%s/%s/%s", portID, channelID, baseDenom)
 }
 
+// GetReceivedDenom returns the denomination of the tokens credited on the receiving
+// chain upon receiving a packet transferring tokens of the provided denomination.
+func GetReceivedDenom(packet ibcexported.PacketI, denom string) string {
+	if ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// remove prefix added by sender chain
+		voucherPrefix := GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := denom[len(voucherPrefix):]
+
+		return ParseDenomTrace(unprefixedDenom).IBCDenom()
+	}
+
+	sourcePrefix := GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	return ParseDenomTrace(sourcePrefix + denom).IBCDenom()
+}
+
 // GetTransferCoin creates a transfer coin with the port ID and channel ID
 // prefixed to the base denom.
 func GetTransferCoin(portID, channelID, baseDenom string, amount sdk.Int) sdk.Coin {
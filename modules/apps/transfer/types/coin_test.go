@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestGetReceivedDenom(t *testing.T) {
+	packet := channeltypes.NewPacket(nil, 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+
+	testCases := []struct {
+		name     string
+		denom    string
+		expDenom string
+	}{
+		{"native denom of the sender chain", "uatom", ParseDenomTrace("transfer/channel-1/uatom").IBCDenom()},
+		{"voucher of the sender chain", "transfer/channel-2/uatom", ParseDenomTrace("transfer/channel-1/transfer/channel-2/uatom").IBCDenom()},
+		{"native denom of the receiver chain", "transfer/channel-0/uatom", "uatom"},
+		{"voucher returning to the receiver chain", "transfer/channel-0/transfer/channel-3/uatom", ParseDenomTrace("transfer/channel-3/uatom").IBCDenom()},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expDenom, GetReceivedDenom(packet, tc.denom), tc.name)
+	}
+}
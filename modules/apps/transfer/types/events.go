@@ -18,4 +18,6 @@ const (
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyMemo           = "memo"
+	AttributeKeyTokens         = "tokens"
 )
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
@@ -33,6 +34,21 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid total escrow",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))),
+			true,
+		},
+		{
+			"invalid total escrow: duplicate denom",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))}),
+			false,
+		},
+		{
+			"invalid total escrow: zero amount",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())}),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -15,6 +15,14 @@ const (
 	// module supports
 	Version = "ics20-1"
 
+	// Version2 defines the IBC transfer version which supports
+	// a memo in the packet data
+	Version2 = "ics20-2"
+
+	// Version3 defines the IBC transfer version which supports
+	// transferring several tokens within a single packet
+	Version3 = "ics20-3"
+
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
 
@@ -32,10 +40,16 @@ const (
 )
 
 var (
+	// SupportedVersions defines the IBC transfer versions the module
+	// supports in order of preference
+	SupportedVersions = []string{Version3, Version2, Version}
+
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// TotalEscrowKey defines the key prefix to store the total amount of tokens in escrow per denomination
+	TotalEscrowKey = []byte{0x03}
 )
 
 // GetEscrowAddress returns the escrow address for the specified channel.
@@ -53,3 +67,20 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	hash := sha256.Sum256(preImage)
 	return hash[:20]
 }
+
+// TotalEscrowForDenomKey returns the store key under which the total amount of tokens
+// of the given denomination in escrow is stored
+func TotalEscrowForDenomKey(denom string) []byte {
+	return append(TotalEscrowKey, []byte(denom)...)
+}
+
+// IsSupportedVersion returns true if the provided version is a supported IBC transfer version
+func IsSupportedVersion(version string) bool {
+	for _, supportedVersion := range SupportedVersions {
+		if version == supportedVersion {
+			return true
+		}
+	}
+
+	return false
+}
@@ -12,7 +12,8 @@ import (
 
 // msg types
 const (
-	TypeMsgTransfer = "transfer"
+	TypeMsgTransfer      = "transfer"
+	TypeMsgMultiTransfer = "multi_transfer"
 )
 
 // NewMsgTransfer creates a new MsgTransfer instance
@@ -21,6 +22,7 @@ func NewMsgTransfer(
 	sourcePort, sourceChannel string,
 	token sdk.Coin, sender, receiver string,
 	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
 ) *MsgTransfer {
 	return &MsgTransfer{
 		SourcePort:       sourcePort,
@@ -30,6 +32,7 @@ func NewMsgTransfer(
 		Receiver:         receiver,
 		TimeoutHeight:    timeoutHeight,
 		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
 	}
 }
 
@@ -68,6 +71,9 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if strings.TrimSpace(msg.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
+	if err := ValidateMemo(msg.Memo); err != nil {
+		return err
+	}
 	return ValidateIBCDenom(msg.Token.Denom)
 }
 
@@ -84,3 +90,84 @@ func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// NewMsgMultiTransfer creates a new MsgMultiTransfer instance
+//nolint:interfacer
+func NewMsgMultiTransfer(
+	sourcePort, sourceChannel string,
+	tokens sdk.Coins, sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
+) *MsgMultiTransfer {
+	return &MsgMultiTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Tokens:           tokens,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgMultiTransfer) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgMultiTransfer) Type() string {
+	return TypeMsgMultiTransfer
+}
+
+// ValidateBasic performs a basic check of the MsgMultiTransfer fields.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+// NOTE: The recipient addresses format is not validated as the format defined by
+// the chain is not known to IBC.
+func (msg MsgMultiTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if msg.Tokens.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "tokens cannot be empty")
+	}
+	// NOTE: Coins.IsValid checks that the coins are sorted, positive and do not contain duplicate denominations
+	if !msg.Tokens.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Tokens.String())
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if err := ValidateMemo(msg.Memo); err != nil {
+		return err
+	}
+	for _, token := range msg.Tokens {
+		if err := ValidateIBCDenom(token.Denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgMultiTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgMultiTransfer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewToken constructs a new Token instance
+func NewToken(denom, amount string) Token {
+	return Token{
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+// Validate performs a basic check of the token fields
+func (t Token) Validate() error {
+	amount, ok := sdk.NewIntFromString(t.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", t.Amount)
+	}
+	if !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	}
+	return ValidatePrefixedDenom(t.Denom)
+}
+
+// NewMultiTokenPacketData constructs a new MultiTokenPacketData instance
+func NewMultiTokenPacketData(
+	tokens []Token,
+	sender, receiver string,
+	memo string,
+) MultiTokenPacketData {
+	return MultiTokenPacketData{
+		Tokens:   tokens,
+		Sender:   sender,
+		Receiver: receiver,
+		Memo:     memo,
+	}
+}
+
+// ValidateBasic is used for validating the token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (mtpd MultiTokenPacketData) ValidateBasic() error {
+	if len(mtpd.Tokens) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAmount, "tokens cannot be empty")
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, token := range mtpd.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenoms[token.Denom] {
+			return sdkerrors.Wrap(ErrDuplicateDenom, token.Denom)
+		}
+		seenDenoms[token.Denom] = true
+	}
+
+	if strings.TrimSpace(mtpd.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(mtpd.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	return ValidateMemo(mtpd.Memo)
+}
+
+// GetBytes is a helper for serialising
+func (mtpd MultiTokenPacketData) GetBytes() []byte {
+	buf := new(bytes.Buffer)
+	if err := packetDataMarshaler.Marshal(buf, &mtpd); err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(buf.Bytes())
+}
+
+// UnmarshalMultiTokenPacketData decodes the packet data of a packet sent over an ics20-3 channel
+func UnmarshalMultiTokenPacketData(bz []byte, version string) (MultiTokenPacketData, error) {
+	if version != Version3 {
+		return MultiTokenPacketData{}, sdkerrors.Wrapf(ErrInvalidVersion, "got %s, expected %s", version, Version3)
+	}
+
+	var data MultiTokenPacketData
+	if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return MultiTokenPacketData{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 multi-token packet data: %s", err.Error())
+	}
+
+	return data, nil
+}
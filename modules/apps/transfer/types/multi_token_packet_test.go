@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMultiTokenPacketDataValidateBasic tests ValidateBasic for MultiTokenPacketData
+func TestMultiTokenPacketDataValidateBasic(t *testing.T) {
+	token := NewToken(denom, amount)
+	otherToken := NewToken("transfer/gaiachannel/osmo", amount)
+
+	testCases := []struct {
+		name       string
+		packetData MultiTokenPacketData
+		expPass    bool
+	}{
+		{"valid packet", NewMultiTokenPacketData([]Token{token}, addr1, addr2, ""), true},
+		{"valid packet with multiple tokens", NewMultiTokenPacketData([]Token{token, otherToken}, addr1, addr2, ""), true},
+		{"valid packet with large amount", NewMultiTokenPacketData([]Token{NewToken(denom, largeAmount)}, addr1, addr2, ""), true},
+		{"valid packet with memo", NewMultiTokenPacketData([]Token{token}, addr1, addr2, "memo"), true},
+		{"empty tokens", NewMultiTokenPacketData(nil, addr1, addr2, ""), false},
+		{"duplicate denom", NewMultiTokenPacketData([]Token{token, token}, addr1, addr2, ""), false},
+		{"invalid denom", NewMultiTokenPacketData([]Token{token, NewToken("", amount)}, addr1, addr2, ""), false},
+		{"invalid empty amount", NewMultiTokenPacketData([]Token{NewToken(denom, "")}, addr1, addr2, ""), false},
+		{"invalid zero amount", NewMultiTokenPacketData([]Token{NewToken(denom, "0")}, addr1, addr2, ""), false},
+		{"invalid negative amount", NewMultiTokenPacketData([]Token{NewToken(denom, "-1")}, addr1, addr2, ""), false},
+		{"invalid large amount", NewMultiTokenPacketData([]Token{NewToken(denom, invalidLargeAmount)}, addr1, addr2, ""), false},
+		{"missing sender address", NewMultiTokenPacketData([]Token{token}, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewMultiTokenPacketData([]Token{token}, addr1, emptyAddr, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestUnmarshalMultiTokenPacketData tests decoding the multi-token packet data according to the channel version
+func TestUnmarshalMultiTokenPacketData(t *testing.T) {
+	packetData := NewMultiTokenPacketData([]Token{NewToken(denom, amount)}, addr1, addr2, "memo")
+	fungibleTokenPacketData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "")
+
+	testCases := []struct {
+		name    string
+		bz      []byte
+		version string
+		expPass bool
+	}{
+		{"multi-token packet data on ics20-3 channel", packetData.GetBytes(), Version3, true},
+		{"multi-token packet data on ics20-2 channel", packetData.GetBytes(), Version2, false},
+		{"fungible token packet data on ics20-3 channel", fungibleTokenPacketData.GetBytes(), Version3, false},
+		{"invalid packet data", []byte("invalid"), Version3, false},
+	}
+
+	for _, tc := range testCases {
+		data, err := UnmarshalMultiTokenPacketData(tc.bz, tc.version)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, packetData, data, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -1,11 +1,18 @@
 package types
 
 import (
+	"bytes"
 	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/jsonpb"
+)
+
+const (
+	// MaximumMemoLength defines the maximum length in bytes of the memo included in the packet data
+	MaximumMemoLength = 32768
 )
 
 var (
@@ -19,18 +26,24 @@ var (
 	// state. The timeout is disabled when set to 0. The default is currently set to a 10 minute
 	// timeout.
 	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+	// packetDataMarshaler omits empty fields such that packet data without a memo is encoded
+	// identically to the ics20-1 packet data
+	packetDataMarshaler = &jsonpb.Marshaler{OrigName: true, EmitDefaults: false}
 )
 
 // NewFungibleTokenPacketData contructs a new FungibleTokenPacketData instance
 func NewFungibleTokenPacketData(
 	denom string, amount string,
 	sender, receiver string,
+	memo string,
 ) FungibleTokenPacketData {
 	return FungibleTokenPacketData{
 		Denom:    denom,
 		Amount:   amount,
 		Sender:   sender,
 		Receiver: receiver,
+		Memo:     memo,
 	}
 }
 
@@ -51,10 +64,49 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	if strings.TrimSpace(ftpd.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
 	}
+	if err := ValidateMemo(ftpd.Memo); err != nil {
+		return err
+	}
 	return ValidatePrefixedDenom(ftpd.Denom)
 }
 
-// GetBytes is a helper for serialising
+// GetBytes is a helper for serialising. Empty fields are omitted such that packet data
+// without a memo remains compatible with ics20-1 channels.
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ftpd))
+	buf := new(bytes.Buffer)
+	if err := packetDataMarshaler.Marshal(buf, &ftpd); err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(buf.Bytes())
+}
+
+// UnmarshalPacketData decodes the packet data according to the IBC transfer version of the
+// channel. Packet data encoded for ics20-1 is accepted on ics20-1 and ics20-2 channels, while
+// a memo is only accepted on ics20-2 channels. Packets sent over ics20-3 channels must be
+// decoded using UnmarshalMultiTokenPacketData.
+func UnmarshalPacketData(bz []byte, version string) (FungibleTokenPacketData, error) {
+	if version != Version && version != Version2 {
+		return FungibleTokenPacketData{}, sdkerrors.Wrapf(ErrInvalidVersion, "got %s, expected %s or %s", version, Version, Version2)
+	}
+
+	var data FungibleTokenPacketData
+	if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return FungibleTokenPacketData{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	if data.Memo != "" && version == Version {
+		return FungibleTokenPacketData{}, sdkerrors.Wrapf(ErrInvalidMemo, "memo is not supported on %s channels", Version)
+	}
+
+	return data, nil
+}
+
+// ValidateMemo returns an error if the memo exceeds the maximum memo length.
+func ValidateMemo(memo string) error {
+	if len(memo) > MaximumMemoLength {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes: got %d", MaximumMemoLength, len(memo))
+	}
+
+	return nil
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,15 +21,17 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		packetData FungibleTokenPacketData
 		expPass    bool
 	}{
-		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2), true},
-		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2), true},
-		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2), false},
-		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2), false},
-		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2), false},
-		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2), false},
-		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2), false},
-		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2), false},
-		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr), false},
+		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2, ""), true},
+		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2, ""), true},
+		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2, ""), false},
+		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2, ""), false},
+		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2, ""), false},
+		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2, ""), false},
+		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr, ""), false},
+		{"valid packet with memo", NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo"), true},
+		{"memo too long", NewFungibleTokenPacketData(denom, amount, addr1, addr2, strings.Repeat("a", MaximumMemoLength+1)), false},
 	}
 
 	for i, tc := range testCases {
@@ -40,3 +43,42 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataGetBytes tests that the memo is omitted from the encoding when empty
+func TestFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "")
+	require.NotContains(t, string(packetData.GetBytes()), "memo")
+
+	packetData = NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo")
+	require.Contains(t, string(packetData.GetBytes()), `"memo":"memo"`)
+}
+
+// TestUnmarshalPacketData tests decoding the packet data according to the channel version
+func TestUnmarshalPacketData(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "")
+	packetDataWithMemo := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo")
+
+	testCases := []struct {
+		name    string
+		bz      []byte
+		version string
+		expPass bool
+	}{
+		{"ics20-1 packet data on ics20-1 channel", packetData.GetBytes(), Version, true},
+		{"ics20-1 packet data on ics20-2 channel", packetData.GetBytes(), Version2, true},
+		{"packet data with memo on ics20-2 channel", packetDataWithMemo.GetBytes(), Version2, true},
+		{"packet data with memo on ics20-1 channel", packetDataWithMemo.GetBytes(), Version, false},
+		{"unsupported version", packetData.GetBytes(), "ics20-100", false},
+		{"invalid packet data", []byte("invalid"), Version2, false},
+	}
+
+	for _, tc := range testCases {
+		data, err := UnmarshalPacketData(tc.bz, tc.version)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, string(tc.bz), string(data.GetBytes()), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
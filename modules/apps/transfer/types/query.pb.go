@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -404,6 +405,100 @@ func (m *QueryDenomHashResponse) GetHash() string {
 	return ""
 }
 
+// QueryTotalEscrowForDenomRequest is the request type for the Query/TotalEscrowForDenom RPC
+// method.
+type QueryTotalEscrowForDenomRequest struct {
+	// denom is the denomination of the escrowed tokens
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryTotalEscrowForDenomRequest) Reset()         { *m = QueryTotalEscrowForDenomRequest{} }
+func (m *QueryTotalEscrowForDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalEscrowForDenomRequest) ProtoMessage()    {}
+func (*QueryTotalEscrowForDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{8}
+}
+func (m *QueryTotalEscrowForDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalEscrowForDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalEscrowForDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalEscrowForDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalEscrowForDenomRequest.Merge(m, src)
+}
+func (m *QueryTotalEscrowForDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalEscrowForDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalEscrowForDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalEscrowForDenomRequest proto.InternalMessageInfo
+
+func (m *QueryTotalEscrowForDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryTotalEscrowForDenomResponse is the response type for the Query/TotalEscrowForDenom RPC
+// method.
+type QueryTotalEscrowForDenomResponse struct {
+	// amount is the total amount of tokens of the denomination held in the escrow accounts
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Reset()         { *m = QueryTotalEscrowForDenomResponse{} }
+func (m *QueryTotalEscrowForDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalEscrowForDenomResponse) ProtoMessage()    {}
+func (*QueryTotalEscrowForDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{9}
+}
+func (m *QueryTotalEscrowForDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalEscrowForDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalEscrowForDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalEscrowForDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalEscrowForDenomResponse.Merge(m, src)
+}
+func (m *QueryTotalEscrowForDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalEscrowForDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalEscrowForDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalEscrowForDenomResponse proto.InternalMessageInfo
+
+func (m *QueryTotalEscrowForDenomResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryDenomTraceRequest)(nil), "ibc.applications.transfer.v1.QueryDenomTraceRequest")
 	proto.RegisterType((*QueryDenomTraceResponse)(nil), "ibc.applications.transfer.v1.QueryDenomTraceResponse")
@@ -413,6 +508,8 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.transfer.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryDenomHashRequest)(nil), "ibc.applications.transfer.v1.QueryDenomHashRequest")
 	proto.RegisterType((*QueryDenomHashResponse)(nil), "ibc.applications.transfer.v1.QueryDenomHashResponse")
+	proto.RegisterType((*QueryTotalEscrowForDenomRequest)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomRequest")
+	proto.RegisterType((*QueryTotalEscrowForDenomResponse)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomResponse")
 }
 
 func init() {
@@ -420,45 +517,51 @@ func init() {
 }
 
 var fileDescriptor_a638e2800a01538c = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0xad, 0x07, 0xab, 0x34, 0x17, 0x71, 0x30, 0x05, 0xaa, 0xa8, 0xca, 0xa6, 0xa8, 0x82, 0xd2,
-	0x6d, 0x36, 0x69, 0x07, 0x5c, 0x38, 0x4d, 0x88, 0x3f, 0xb7, 0xad, 0x70, 0x82, 0x03, 0x72, 0x52,
-	0x93, 0x46, 0x6a, 0xe3, 0x2c, 0x4e, 0x2b, 0x4d, 0x68, 0x17, 0x3e, 0x01, 0xd2, 0xbe, 0x02, 0x07,
-	0x34, 0xf1, 0x21, 0x38, 0xee, 0x38, 0x89, 0x0b, 0x27, 0x40, 0x2d, 0x1f, 0x04, 0xc5, 0x76, 0xda,
-	0x84, 0x56, 0xdd, 0x72, 0x73, 0xdd, 0xdf, 0xfb, 0xfd, 0xde, 0x7b, 0xbf, 0x17, 0xc3, 0xa6, 0xef,
-	0xb8, 0x84, 0x86, 0xe1, 0xc0, 0x77, 0x69, 0xec, 0xf3, 0x40, 0x90, 0x38, 0xa2, 0x81, 0xf8, 0xc0,
-	0x22, 0x32, 0xb6, 0xc9, 0xd1, 0x88, 0x45, 0xc7, 0x38, 0x8c, 0x78, 0xcc, 0x51, 0xdd, 0x77, 0x5c,
-	0x9c, 0xad, 0xc4, 0x69, 0x25, 0x1e, 0xdb, 0x46, 0xd5, 0xe3, 0x1e, 0x97, 0x85, 0x24, 0x39, 0x29,
-	0x8c, 0xd1, 0x72, 0xb9, 0x18, 0x72, 0x41, 0x1c, 0x2a, 0x98, 0x6a, 0x46, 0xc6, 0xb6, 0xc3, 0x62,
-	0x6a, 0x93, 0x90, 0x7a, 0x7e, 0x20, 0x1b, 0xe9, 0xda, 0xed, 0x95, 0x4c, 0x66, 0xb3, 0x54, 0x71,
-	0xdd, 0xe3, 0xdc, 0x1b, 0x30, 0x42, 0x43, 0x9f, 0xd0, 0x20, 0xe0, 0xb1, 0xa6, 0x24, 0xff, 0xb5,
-	0x76, 0xe0, 0x9d, 0xc3, 0x64, 0xd8, 0x33, 0x16, 0xf0, 0xe1, 0x9b, 0x88, 0xba, 0xac, 0xcb, 0x8e,
-	0x46, 0x4c, 0xc4, 0x08, 0xc1, 0xeb, 0x7d, 0x2a, 0xfa, 0x35, 0xb0, 0x05, 0x9a, 0x1b, 0x5d, 0x79,
-	0xb6, 0x7a, 0xf0, 0xee, 0x42, 0xb5, 0x08, 0x79, 0x20, 0x18, 0x7a, 0x05, 0x2b, 0xbd, 0xe4, 0xf6,
-	0x7d, 0x9c, 0x5c, 0x4b, 0x54, 0xa5, 0xdd, 0xc4, 0xab, 0x9c, 0xc0, 0x99, 0x36, 0xb0, 0x37, 0x3b,
-	0x5b, 0x74, 0x61, 0x8a, 0x48, 0x49, 0x3d, 0x87, 0x70, 0xee, 0x86, 0x1e, 0x72, 0x0f, 0x2b, 0xeb,
-	0x70, 0x62, 0x1d, 0x56, 0x7b, 0xd0, 0xd6, 0xe1, 0x03, 0xea, 0xa5, 0x82, 0xba, 0x19, 0xa4, 0xf5,
-	0x1d, 0xc0, 0xda, 0xe2, 0x0c, 0x2d, 0xe5, 0x1d, 0xbc, 0x91, 0x91, 0x22, 0x6a, 0x60, 0xeb, 0x5a,
-	0x11, 0x2d, 0xfb, 0x37, 0xcf, 0x7f, 0x6d, 0x96, 0xce, 0x7e, 0x6f, 0x96, 0x75, 0xdf, 0xca, 0x5c,
-	0x9b, 0x40, 0x2f, 0x72, 0x0a, 0xd6, 0xa4, 0x82, 0xfb, 0x97, 0x2a, 0x50, 0xcc, 0x72, 0x12, 0xaa,
-	0x10, 0x49, 0x05, 0x07, 0x34, 0xa2, 0xc3, 0xd4, 0x20, 0xeb, 0x35, 0xbc, 0x95, 0xbb, 0xd5, 0x92,
-	0x9e, 0xc2, 0x72, 0x28, 0x6f, 0xb4, 0x67, 0x8d, 0xd5, 0x62, 0x34, 0x5a, 0x63, 0xac, 0x5d, 0x78,
-	0x7b, 0x6e, 0xd6, 0x4b, 0x2a, 0xfa, 0xe9, 0x3a, 0xaa, 0x70, 0x7d, 0xbe, 0xee, 0x8d, 0xae, 0xfa,
-	0x91, 0xcf, 0x94, 0x2a, 0xd7, 0x34, 0x96, 0x64, 0xaa, 0xfd, 0x65, 0x1d, 0xae, 0xcb, 0x72, 0xf4,
-	0x0d, 0x40, 0x38, 0xb7, 0x11, 0xed, 0xad, 0xe6, 0xb8, 0x3c, 0xb6, 0xc6, 0xa3, 0x82, 0x28, 0xc5,
-	0xcc, 0xb2, 0x3f, 0xfd, 0xf8, 0x7b, 0xba, 0xb6, 0x8d, 0x1e, 0x10, 0xfd, 0x6d, 0xe5, 0xbf, 0xa9,
-	0x6c, 0x1e, 0xc8, 0xc7, 0x84, 0xf7, 0x09, 0xfa, 0x0a, 0x60, 0x25, 0x13, 0x1f, 0x54, 0x6c, 0x72,
-	0xba, 0x31, 0xe3, 0x71, 0x51, 0x98, 0x66, 0xdc, 0x92, 0x8c, 0x1b, 0xc8, 0xba, 0x9c, 0x31, 0x3a,
-	0x05, 0xb0, 0xac, 0x76, 0x8a, 0x1e, 0x5e, 0x61, 0x5c, 0x2e, 0x52, 0x86, 0x5d, 0x00, 0xa1, 0xb9,
-	0x35, 0x24, 0x37, 0x13, 0xd5, 0x97, 0x73, 0x53, 0xb1, 0x42, 0x67, 0x00, 0x6e, 0xcc, 0x32, 0x82,
-	0x3a, 0x57, 0xf5, 0x21, 0x13, 0x40, 0x63, 0xaf, 0x18, 0x48, 0xd3, 0x6b, 0x4b, 0x7a, 0x3b, 0xa8,
-	0xb5, 0xca, 0xba, 0x64, 0xc9, 0xc9, 0xb2, 0xa5, 0x85, 0x27, 0xfb, 0x87, 0xe7, 0x13, 0x13, 0x5c,
-	0x4c, 0x4c, 0xf0, 0x67, 0x62, 0x82, 0xcf, 0x53, 0xb3, 0x74, 0x31, 0x35, 0x4b, 0x3f, 0xa7, 0x66,
-	0xe9, 0xed, 0x13, 0xcf, 0x8f, 0xfb, 0x23, 0x07, 0xbb, 0x7c, 0x48, 0xf4, 0x23, 0xee, 0x3b, 0xee,
-	0xae, 0xc7, 0xc9, 0xb8, 0x43, 0x86, 0xbc, 0x37, 0x1a, 0x30, 0xf1, 0xdf, 0x90, 0xf8, 0x38, 0x64,
-	0xc2, 0x29, 0xcb, 0x27, 0xb8, 0xf3, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x16, 0x01, 0x88, 0xe2, 0x59,
-	0x06, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4e, 0x13, 0x41,
+	0x18, 0xef, 0x20, 0x34, 0xe1, 0xab, 0xf1, 0x30, 0xa0, 0xe2, 0x86, 0x2c, 0x64, 0x43, 0x14, 0x0b,
+	0xec, 0x58, 0x40, 0xb8, 0x80, 0x07, 0x44, 0xd4, 0x1b, 0x54, 0x4e, 0x72, 0x20, 0xb3, 0xdb, 0x71,
+	0xbb, 0x49, 0xbb, 0xb3, 0xec, 0x4c, 0x6b, 0x08, 0xe1, 0xe2, 0x13, 0x98, 0xf0, 0x12, 0x86, 0xf8,
+	0x10, 0x1e, 0x39, 0x12, 0xbd, 0x78, 0x52, 0x03, 0xbe, 0x84, 0x37, 0xb3, 0xb3, 0xb3, 0x74, 0x57,
+	0x4a, 0xa1, 0x9e, 0x3a, 0x9d, 0xf9, 0x7e, 0xf3, 0xfb, 0xf3, 0x7d, 0x93, 0x85, 0x69, 0xdf, 0x71,
+	0x09, 0x0d, 0xc3, 0x86, 0xef, 0x52, 0xe9, 0xf3, 0x40, 0x10, 0x19, 0xd1, 0x40, 0xbc, 0x63, 0x11,
+	0x69, 0x57, 0xc8, 0x5e, 0x8b, 0x45, 0xfb, 0x76, 0x18, 0x71, 0xc9, 0xf1, 0xb8, 0xef, 0xb8, 0x76,
+	0xb6, 0xd2, 0x4e, 0x2b, 0xed, 0x76, 0xc5, 0x18, 0xf5, 0xb8, 0xc7, 0x55, 0x21, 0x89, 0x57, 0x09,
+	0xc6, 0x30, 0x5d, 0x2e, 0x9a, 0x5c, 0x10, 0x87, 0x0a, 0x46, 0xda, 0x15, 0x87, 0x49, 0x5a, 0x21,
+	0x2e, 0xf7, 0x03, 0x7d, 0x5e, 0xce, 0x9e, 0x2b, 0xb2, 0x8b, 0xaa, 0x90, 0x7a, 0x7e, 0xa0, 0x88,
+	0x74, 0xed, 0x4c, 0x4f, 0xa5, 0xe9, 0x5a, 0x17, 0x8f, 0x7b, 0x9c, 0x7b, 0x0d, 0x46, 0x68, 0xe8,
+	0x13, 0x1a, 0x04, 0x5c, 0x6a, 0xc9, 0xea, 0xd4, 0x9a, 0x85, 0x7b, 0x5b, 0x31, 0xd9, 0x3a, 0x0b,
+	0x78, 0x73, 0x3b, 0xa2, 0x2e, 0xab, 0xb2, 0xbd, 0x16, 0x13, 0x12, 0x63, 0x18, 0xac, 0x53, 0x51,
+	0x1f, 0x43, 0x93, 0x68, 0x7a, 0xb8, 0xaa, 0xd6, 0x56, 0x0d, 0xee, 0x5f, 0xaa, 0x16, 0x21, 0x0f,
+	0x04, 0xc3, 0xaf, 0xa1, 0x54, 0x8b, 0x77, 0x77, 0x65, 0xbc, 0xad, 0x50, 0xa5, 0xf9, 0x69, 0xbb,
+	0x57, 0x52, 0x76, 0xe6, 0x1a, 0xa8, 0x5d, 0xac, 0x2d, 0x7a, 0x89, 0x45, 0xa4, 0xa2, 0x36, 0x00,
+	0x3a, 0x69, 0x68, 0x92, 0x87, 0x76, 0x12, 0x9d, 0x1d, 0x47, 0x67, 0x27, 0x7d, 0xd2, 0xd1, 0xd9,
+	0x9b, 0xd4, 0x4b, 0x0d, 0x55, 0x33, 0x48, 0xeb, 0x0b, 0x82, 0xb1, 0xcb, 0x1c, 0xda, 0xca, 0x0e,
+	0xdc, 0xce, 0x58, 0x11, 0x63, 0x68, 0xf2, 0x56, 0x3f, 0x5e, 0xd6, 0xee, 0x9c, 0xfc, 0x98, 0x28,
+	0x1c, 0xff, 0x9c, 0x28, 0xea, 0x7b, 0x4b, 0x1d, 0x6f, 0x02, 0xbf, 0xcc, 0x39, 0x18, 0x50, 0x0e,
+	0x1e, 0x5d, 0xeb, 0x20, 0x51, 0x96, 0xb3, 0x30, 0x0a, 0x58, 0x39, 0xd8, 0xa4, 0x11, 0x6d, 0xa6,
+	0x01, 0x59, 0x6f, 0x60, 0x24, 0xb7, 0xab, 0x2d, 0xad, 0x40, 0x31, 0x54, 0x3b, 0x3a, 0xb3, 0xa9,
+	0xde, 0x66, 0x34, 0x5a, 0x63, 0xac, 0x39, 0xb8, 0xdb, 0x09, 0xeb, 0x15, 0x15, 0xf5, 0xb4, 0x1d,
+	0xa3, 0x30, 0xd4, 0x69, 0xf7, 0x70, 0x35, 0xf9, 0x93, 0x9f, 0xa9, 0xa4, 0x5c, 0xcb, 0xe8, 0x36,
+	0x53, 0xcb, 0x30, 0xa1, 0xaa, 0xb7, 0xb9, 0xa4, 0x8d, 0x17, 0xc2, 0x8d, 0xf8, 0xfb, 0x0d, 0x1e,
+	0x29, 0x6c, 0x86, 0x46, 0x45, 0x98, 0xd2, 0xa8, 0x3f, 0xd6, 0x0e, 0x4c, 0x5e, 0x0d, 0xd4, 0x84,
+	0xcb, 0x50, 0xa4, 0x4d, 0xde, 0x0a, 0xa4, 0xf6, 0xfd, 0x20, 0x97, 0x74, 0x9a, 0xf1, 0x73, 0xee,
+	0x07, 0x6b, 0x83, 0x71, 0xd7, 0xaa, 0xba, 0x7c, 0xfe, 0x4f, 0x11, 0x86, 0xd4, 0xed, 0xf8, 0x33,
+	0x02, 0xe8, 0x34, 0x17, 0x2f, 0xf6, 0x4e, 0xae, 0xfb, 0x63, 0x32, 0x9e, 0xf6, 0x89, 0x4a, 0xe4,
+	0x5b, 0x95, 0x0f, 0xdf, 0x7e, 0x1f, 0x0d, 0xcc, 0xe0, 0xc7, 0x44, 0xbf, 0xf8, 0xfc, 0x4b, 0xcf,
+	0x4e, 0x29, 0x39, 0x88, 0xd3, 0x3c, 0xc4, 0x9f, 0x10, 0x94, 0xd6, 0x33, 0xf3, 0xd6, 0x1f, 0x73,
+	0x3a, 0x47, 0xc6, 0x52, 0xbf, 0x30, 0xad, 0xb8, 0xac, 0x14, 0x4f, 0x61, 0xeb, 0x7a, 0xc5, 0xf8,
+	0x08, 0x41, 0x31, 0x99, 0x34, 0xfc, 0xe4, 0x06, 0x74, 0xb9, 0x41, 0x37, 0x2a, 0x7d, 0x20, 0xb4,
+	0xb6, 0x29, 0xa5, 0xcd, 0xc4, 0xe3, 0xdd, 0xb5, 0x25, 0xc3, 0x8e, 0x8f, 0x11, 0x0c, 0x5f, 0x4c,
+	0x2e, 0x5e, 0xb8, 0x69, 0x0e, 0x99, 0x67, 0x61, 0x2c, 0xf6, 0x07, 0xd2, 0xf2, 0xe6, 0x95, 0xbc,
+	0x59, 0x5c, 0xee, 0x15, 0x5d, 0xdc, 0xe4, 0xb8, 0xd9, 0x2a, 0xc2, 0x43, 0xfc, 0x15, 0xc1, 0x48,
+	0x97, 0xf9, 0xc7, 0xab, 0x37, 0x50, 0x70, 0xf5, 0x83, 0x33, 0x9e, 0xfd, 0x2f, 0x5c, 0x5b, 0x59,
+	0x51, 0x56, 0x96, 0xf0, 0x62, 0x0f, 0x2b, 0x82, 0x1c, 0xa8, 0xdf, 0xd5, 0x72, 0xf9, 0x90, 0xc8,
+	0xf8, 0xb2, 0x5d, 0xa6, 0x6e, 0x5b, 0xdb, 0x3a, 0x39, 0x33, 0xd1, 0xe9, 0x99, 0x89, 0x7e, 0x9d,
+	0x99, 0xe8, 0xe3, 0xb9, 0x59, 0x38, 0x3d, 0x37, 0x0b, 0xdf, 0xcf, 0xcd, 0xc2, 0xdb, 0x65, 0xcf,
+	0x97, 0xf5, 0x96, 0x63, 0xbb, 0xbc, 0x49, 0xf4, 0xf7, 0xd2, 0x77, 0xdc, 0x39, 0x8f, 0x93, 0xf6,
+	0x02, 0x69, 0xf2, 0x5a, 0xab, 0xc1, 0xc4, 0x3f, 0x74, 0x72, 0x3f, 0x64, 0xc2, 0x29, 0xaa, 0xaf,
+	0xdd, 0xc2, 0xdf, 0x01, 0x00, 0xec, 0xbd, 0xbc, 0x83, 0xe4, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -481,6 +584,8 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// DenomHash queries a denomination hash information.
 	DenomHash(ctx context.Context, in *QueryDenomHashRequest, opts ...grpc.CallOption) (*QueryDenomHashResponse, error)
+	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
+	TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error)
 }
 
 type queryClient struct {
@@ -527,6 +632,15 @@ func (c *queryClient) DenomHash(ctx context.Context, in *QueryDenomHashRequest,
 	return out, nil
 }
 
+func (c *queryClient) TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error) {
+	out := new(QueryTotalEscrowForDenomResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// DenomTrace queries a denomination trace information.
@@ -537,6 +651,8 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// DenomHash queries a denomination hash information.
 	DenomHash(context.Context, *QueryDenomHashRequest) (*QueryDenomHashResponse, error)
+	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
+	TotalEscrowForDenom(context.Context, *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -555,6 +671,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) DenomHash(ctx context.Context, req *QueryDenomHashRequest) (*QueryDenomHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomHash not implemented")
 }
+func (*UnimplementedQueryServer) TotalEscrowForDenom(ctx context.Context, req *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalEscrowForDenom not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -632,6 +751,24 @@ func _Query_DenomHash_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalEscrowForDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalEscrowForDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalEscrowForDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalEscrowForDenom(ctx, req.(*QueryTotalEscrowForDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -652,6 +789,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomHash",
 			Handler:    _Query_DenomHash_Handler,
 		},
+		{
+			MethodName: "TotalEscrowForDenom",
+			Handler:    _Query_TotalEscrowForDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/query.proto",
@@ -924,6 +1065,69 @@ func (m *QueryDenomHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTotalEscrowForDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalEscrowForDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalEscrowForDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalEscrowForDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalEscrowForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1041,6 +1245,30 @@ func (m *QueryDenomHashResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTotalEscrowForDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1721,6 +1949,171 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
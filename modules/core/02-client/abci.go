@@ -8,7 +8,8 @@ import (
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 )
 
-// BeginBlocker updates an existing localhost client with the latest block height.
+// BeginBlocker updates an existing localhost client with the latest block height. If the localhost
+// client does not exist but is registered on the allowlist, it is created.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	plan, found := k.GetUpgradePlan(ctx)
 	if found {
@@ -32,6 +33,12 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	_, found = k.GetClientState(ctx, exported.Localhost)
 	if !found {
+		if k.GetParams(ctx).IsAllowedClient(exported.Localhost) {
+			if err := k.CreateLocalhostClient(ctx); err != nil {
+				panic(err)
+			}
+		}
+
 		return
 	}
 
@@ -63,6 +63,24 @@ func (suite *ClientTestSuite) TestBeginBlocker() {
 	}
 }
 
+func (suite *ClientTestSuite) TestBeginBlockerCreateLocalhost() {
+	clientKeeper := suite.chainB.App.GetIBCKeeper().ClientKeeper
+
+	// localhost client is not created if it is not registered on the allowlist
+	client.BeginBlocker(suite.chainB.GetContext(), clientKeeper)
+	_, found := clientKeeper.GetClientState(suite.chainB.GetContext(), exported.Localhost)
+	suite.Require().False(found)
+
+	params := clientKeeper.GetParams(suite.chainB.GetContext())
+	params.AllowedClients = append(params.AllowedClients, exported.Localhost)
+	clientKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	client.BeginBlocker(suite.chainB.GetContext(), clientKeeper)
+	localHostClient, found := clientKeeper.GetClientState(suite.chainB.GetContext(), exported.Localhost)
+	suite.Require().True(found)
+	suite.Require().Equal(types.GetSelfHeight(suite.chainB.GetContext()), localHostClient.GetLatestHeight())
+}
+
 func (suite *ClientTestSuite) TestBeginBlockerConsensusState() {
 	plan := &upgradetypes.Plan{
 		Name:   "test",
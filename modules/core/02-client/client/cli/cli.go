@@ -23,6 +23,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClientStatus(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusState(),
+		GetCmdQueryPrunableConsensusStates(),
 		GetCmdQueryHeader(),
 		GetCmdSelfConsensusState(),
 		GetCmdParams(),
@@ -46,6 +47,7 @@ func NewTxCmd() *cobra.Command {
 		NewUpdateClientCmd(),
 		NewSubmitMisbehaviourCmd(),
 		NewUpgradeClientCmd(),
+		NewPruneExpiredConsensusStatesCmd(),
 	)
 
 	return txCmd
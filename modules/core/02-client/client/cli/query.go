@@ -16,6 +16,7 @@ import (
 
 const (
 	flagLatestHeight = "latest-height"
+	flagLimit        = "limit"
 )
 
 // GetCmdQueryClientStates defines the command to query all the light clients
@@ -122,6 +123,48 @@ func GetCmdQueryClientStatus() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPrunableConsensusStates defines the command to query the heights of the consensus
+// states of a client which can be pruned.
+func GetCmdQueryPrunableConsensusStates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "prunable-consensus-states [client-id]",
+		Short:   "Query the heights of the prunable consensus states of a client",
+		Long:    "Query the heights of the consensus states of a client which are expired or exceed the maximum number of consensus states kept by a client",
+		Example: fmt.Sprintf("%s query %s %s prunable-consensus-states [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPrunableConsensusStatesRequest{
+				ClientId: args[0],
+				Limit:    limit,
+			}
+
+			res, err := queryClient.PrunableConsensusStates(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagLimit, 0, "maximum number of heights returned, all heights are returned if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
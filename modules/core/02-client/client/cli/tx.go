@@ -166,6 +166,38 @@ func NewSubmitMisbehaviourCmd() *cobra.Command {
 	}
 }
 
+// NewPruneExpiredConsensusStatesCmd defines the command to prune the expired consensus states of an IBC light client.
+func NewPruneExpiredConsensusStatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-consensus-states [client-id] [limit]",
+		Short: "prune the expired consensus states of an IBC client",
+		Long: `prune at most [limit] expired consensus states of an IBC client, starting from the lowest height.
+The oldest consensus states exceeding the maximum number of consensus states kept by a client are pruned as well.
+The signer must be the authority of the IBC keeper, typically the gov module account.`,
+		Example: fmt.Sprintf("%s tx ibc %s prune-consensus-states [client-id] [limit] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPruneExpiredConsensusStates(args[0], limit, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewUpgradeClientCmd defines the command to upgrade an IBC light client.
 func NewUpgradeClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -47,8 +47,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
 
-	// NOTE: localhost creation is specifically disallowed for the time being.
-	// Issue: https://github.com/cosmos/cosmos-sdk/issues/7871
+	if gs.CreateLocalhost {
+		if err := k.CreateLocalhostClient(ctx); err != nil {
+			panic(fmt.Sprintf("failed to create localhost client: %s", err))
+		}
+	}
 }
 
 // ExportGenesis returns the ibc client submodule's exported genesis.
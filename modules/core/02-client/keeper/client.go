@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	localhosttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost/types"
 )
 
 // CreateClient creates a new client state and populates it with a given consensus
@@ -56,6 +57,38 @@ func (k Keeper) CreateClient(
 	return clientID, nil
 }
 
+// CreateLocalhostClient creates the localhost client using the chain ID and height of the
+// executing chain. The localhost client is stored under the localhost client identifier and
+// does not store any consensus states. It returns an error if the localhost client type is not
+// registered on the allowlist.
+func (k Keeper) CreateLocalhostClient(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if !params.IsAllowedClient(exported.Localhost) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidClientType,
+			"client state type %s is not registered in the allowlist", exported.Localhost,
+		)
+	}
+
+	revision := types.ParseChainID(ctx.ChainID())
+	clientState := localhosttypes.NewClientState(ctx.ChainID(), types.NewHeight(revision, uint64(ctx.BlockHeight())))
+
+	k.SetClientState(ctx, exported.Localhost, clientState)
+	k.Logger(ctx).Info("client created at height", "client-id", exported.Localhost, "height", clientState.GetLatestHeight().String())
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", "client", "create"},
+			1,
+			[]metrics.Label{telemetry.NewLabel(types.LabelClientType, exported.Localhost)},
+		)
+	}()
+
+	EmitCreateClientEvent(ctx, exported.Localhost, clientState)
+
+	return nil
+}
+
 // UpdateClient updates the consensus state and the state root from a provided header.
 func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.Header) error {
 	clientState, found := k.GetClientState(ctx, clientID)
@@ -107,6 +140,15 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 
 		k.Logger(ctx).Info("client state updated", "client-id", clientID, "height", consensusHeight.String())
 
+		// prune expired consensus states, bounding the number of consensus states pruned by a single update
+		if _, ok := newClientState.(exported.ConsensusStatePruner); ok {
+			if limit := k.GetConsensusStatePruneLimit(ctx); limit != 0 {
+				if _, err := k.PruneConsensusStates(ctx, clientID, limit); err != nil {
+					return err
+				}
+			}
+		}
+
 		defer func() {
 			telemetry.IncrCounterWithLabels(
 				[]string{"ibc", "client", "update"},
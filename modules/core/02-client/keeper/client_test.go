@@ -41,6 +41,25 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCreateClientSolomachineV3() {
+	solomachine := ibctesting.NewMultiPartySolomachine(suite.T(), suite.chainA.Codec, "solomachine", "testing", []uint64{1, 1, 1}, 2)
+
+	// the v3 solo machine is not allowed by the 06-solomachine client type
+	suite.Require().True(suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext()).IsAllowedClient(exported.Solomachine))
+
+	clientID, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(suite.chainA.GetContext(), solomachine.ClientStateV3(), solomachine.ConsensusStateV3())
+	suite.Require().ErrorIs(err, types.ErrInvalidClientType)
+	suite.Require().Empty(clientID)
+
+	params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	params.AllowedClients = append(params.AllowedClients, exported.SolomachineV3)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	clientID, err = suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(suite.chainA.GetContext(), solomachine.ClientStateV3(), solomachine.ConsensusStateV3())
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FormatClientIdentifier(exported.SolomachineV3, 0), clientID)
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	var (
 		path         *ibctesting.Path
@@ -91,3 +91,15 @@ func EmitSubmitMisbehaviourEventOnUpdate(ctx sdk.Context, clientID string, clien
 		),
 	)
 }
+
+// EmitPruneConsensusStatesEvent emits a prune consensus states event
+func EmitPruneConsensusStatesEvent(ctx sdk.Context, clientID string, clientState exported.ClientState, heights []exported.Height) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePruneConsensusStates,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeights, formatHeights(heights)),
+		),
+	)
+}
@@ -215,6 +215,39 @@ func (q Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequ
 	}, nil
 }
 
+// PrunableConsensusStates implements the Query/PrunableConsensusStates gRPC method
+func (q Keeper) PrunableConsensusStates(c context.Context, req *types.QueryPrunableConsensusStatesRequest) (*types.QueryPrunableConsensusStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := q.GetClientState(ctx, req.ClientId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+		)
+	}
+
+	heights, err := q.GetPrunableConsensusStateHeights(ctx, req.ClientId, req.Limit)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	consensusHeights := make([]types.Height, len(heights))
+	for i, height := range heights {
+		consensusHeights[i] = types.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight())
+	}
+
+	return &types.QueryPrunableConsensusStatesResponse{
+		ConsensusHeights: consensusHeights,
+	}, nil
+}
+
 // ClientParams implements the Query/ClientParams gRPC method
 func (q Keeper) ClientParams(c context.Context, _ *types.QueryClientParamsRequest) (*types.QueryClientParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
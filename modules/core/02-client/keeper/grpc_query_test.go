@@ -491,6 +491,93 @@ func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryPrunableConsensusStates() {
+	var (
+		req        *types.QueryPrunableConsensusStatesRequest
+		expHeights []types.Height
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{"invalid clientID",
+			func() {
+				req = &types.QueryPrunableConsensusStatesRequest{}
+			},
+			false,
+		},
+		{"client not found",
+			func() {
+				req = &types.QueryPrunableConsensusStatesRequest{
+					ClientId: ibctesting.InvalidID,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path, heights := suite.setupExpiredClient()
+				req = &types.QueryPrunableConsensusStatesRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+				expHeights = []types.Height{heights[0].(types.Height), heights[1].(types.Height)}
+			},
+			true,
+		},
+		{
+			"success with limit",
+			func() {
+				path, heights := suite.setupExpiredClient()
+				req = &types.QueryPrunableConsensusStatesRequest{
+					ClientId: path.EndpointA.ClientID,
+					Limit:    1,
+				}
+				expHeights = []types.Height{heights[0].(types.Height)}
+			},
+			true,
+		},
+		{
+			"no prunable consensus states",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				req = &types.QueryPrunableConsensusStatesRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+				expHeights = []types.Height{}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.PrunableConsensusStates(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expHeights, res.ConsensusHeights)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryUpgradedConsensusStates() {
 	var (
 		req               *types.QueryUpgradedConsensusStateRequest
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v100 "github.com/cosmos/ibc-go/v3/modules/core/02-client/legacy/v100"
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -25,3 +26,12 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v100.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration sets the consensus state pruning parameters to their default values.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyConsensusStatePruneLimit, types.DefaultConsensusStatePruneLimit)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxConsensusStates, types.DefaultMaxConsensusStates)
+
+	return nil
+}
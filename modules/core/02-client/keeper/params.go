@@ -13,9 +13,27 @@ func (k Keeper) GetAllowedClients(ctx sdk.Context) []string {
 	return res
 }
 
+// GetConsensusStatePruneLimit retrieves the maximum number of consensus states pruned on client updates from the paramstore
+func (k Keeper) GetConsensusStatePruneLimit(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyConsensusStatePruneLimit, &res)
+	return res
+}
+
+// GetMaxConsensusStates retrieves the maximum number of consensus states kept by a client from the paramstore
+func (k Keeper) GetMaxConsensusStates(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxConsensusStates, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-client parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetAllowedClients(ctx)...)
+	params := types.NewParams(k.GetAllowedClients(ctx)...)
+	params.ConsensusStatePruneLimit = k.GetConsensusStatePruneLimit(ctx)
+	params.MaxConsensusStates = k.GetMaxConsensusStates(ctx)
+
+	return params
 }
 
 // SetParams sets the total set of ibc-client parameters.
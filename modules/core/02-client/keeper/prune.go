@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// GetPrunableConsensusStateHeights returns, in ascending order, the heights of at most limit consensus states
+// of the client which can be pruned. These are the expired consensus states and the oldest consensus states
+// exceeding the maximum number of consensus states kept by a client. All prunable heights are returned if
+// limit is zero.
+func (k Keeper) GetPrunableConsensusStateHeights(ctx sdk.Context, clientID string, limit uint64) ([]exported.Height, error) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrClientNotFound, "cannot get prunable consensus states of client with ID %s", clientID)
+	}
+
+	pruner, ok := clientState.(exported.ConsensusStatePruner)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrPruningNotSupported, "client type %s", clientState.ClientType())
+	}
+
+	return pruner.GetPrunableConsensusStateHeights(ctx, k.cdc, k.ClientStore(ctx, clientID), k.GetMaxConsensusStates(ctx), limit)
+}
+
+// PruneConsensusStates prunes at most limit consensus states of the client, starting from the lowest height.
+// The expired consensus states and the oldest consensus states exceeding the maximum number of consensus states
+// kept by a client are pruned. The heights of the pruned consensus states are returned.
+func (k Keeper) PruneConsensusStates(ctx sdk.Context, clientID string, limit uint64) ([]exported.Height, error) {
+	if limit == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPruneLimit, "prune limit cannot be zero")
+	}
+
+	heights, err := k.GetPrunableConsensusStateHeights(ctx, clientID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(heights) == 0 {
+		return nil, nil
+	}
+
+	// the client state is known to exist and to support pruning
+	clientState, _ := k.GetClientState(ctx, clientID)
+	pruner := clientState.(exported.ConsensusStatePruner)
+
+	clientStore := k.ClientStore(ctx, clientID)
+	for _, height := range heights {
+		pruner.PruneConsensusState(clientStore, height)
+	}
+
+	k.Logger(ctx).Info("consensus states pruned", "client-id", clientID, "count", len(heights), "heights", formatHeights(heights))
+
+	EmitPruneConsensusStatesEvent(ctx, clientID, clientState, heights)
+
+	return heights, nil
+}
+
+// formatHeights returns the heights as a comma separated string
+func formatHeights(heights []exported.Height) string {
+	strs := make([]string, len(heights))
+	for i, height := range heights {
+		strs[i] = height.String()
+	}
+
+	return strings.Join(strs, ",")
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	localhosttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// setupExpiredClient creates a client on chainA with pruning on updates disabled. It returns the heights of the
+// consensus states of the client, the first two of which are expired.
+func (suite *KeeperTestSuite) setupExpiredClient() (*ibctesting.Path, []exported.Height) {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	params.ConsensusStatePruneLimit = 0
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	// consensus states are created every 10 days, the trusting period being 14 days
+	heights := []exported.Height{path.EndpointA.GetClientState().GetLatestHeight()}
+	for i := 0; i < 3; i++ {
+		suite.coordinator.IncrementTimeBy(10 * 24 * time.Hour)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		heights = append(heights, path.EndpointA.GetClientState().GetLatestHeight())
+	}
+
+	return path, heights
+}
+
+func (suite *KeeperTestSuite) TestPruneConsensusStates() {
+	var (
+		path     *ibctesting.Path
+		heights  []exported.Height
+		clientID string
+		limit    uint64
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expPass    bool
+		expHeights func() []exported.Height
+	}{
+		{
+			"success", func() {}, true, func() []exported.Height { return heights[:2] },
+		},
+		{
+			"limit applied", func() {
+				limit = 1
+			}, true, func() []exported.Height { return heights[:1] },
+		},
+		{
+			"oldest consensus states exceeding max consensus states", func() {
+				params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+				params.MaxConsensusStates = 1
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, true, func() []exported.Height { return heights[:3] },
+		},
+		{
+			"nothing to prune", func() {
+				suite.coordinator.IncrementTimeBy(-30 * 24 * time.Hour)
+			}, true, func() []exported.Height { return nil },
+		},
+		{
+			"zero limit", func() {
+				limit = 0
+			}, false, nil,
+		},
+		{
+			"client not found", func() {
+				clientID = ibctesting.InvalidID
+			}, false, nil,
+		},
+		{
+			"pruning not supported", func() {
+				clientID = exported.Localhost
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(
+					suite.chainA.GetContext(), clientID, localhosttypes.NewClientState(suite.chainA.ChainID, clienttypes.NewHeight(0, 1)),
+				)
+			}, false, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path, heights = suite.setupExpiredClient()
+			clientID = path.EndpointA.ClientID
+			limit = 10
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+			prunedHeights, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.PruneConsensusStates(ctx, clientID, limit)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expHeights(), prunedHeights)
+
+				for _, height := range heights {
+					_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(ctx, clientID, height)
+					suite.Require().Equal(!containsHeight(prunedHeights, height), found)
+				}
+
+				if len(prunedHeights) == 0 {
+					suite.Require().Empty(ctx.EventManager().Events())
+				} else {
+					suite.Require().Len(ctx.EventManager().Events(), 1)
+					suite.Require().Equal(clienttypes.EventTypePruneConsensusStates, ctx.EventManager().Events()[0].Type)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(prunedHeights)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateClientPrunesConsensusStates() {
+	testCases := []struct {
+		name               string
+		pruneLimit         uint64
+		maxConsensusStates uint64
+		expPruned          int
+	}{
+		{"pruning on updates disabled", 0, 0, 0},
+		{"prune limit applied", 1, 0, 1},
+		{"expired consensus states pruned", 10, 0, 2},
+		{"oldest consensus states exceeding max consensus states pruned", 10, 2, 3},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path, heights := suite.setupExpiredClient()
+
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.ConsensusStatePruneLimit = tc.pruneLimit
+			params.MaxConsensusStates = tc.maxConsensusStates
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			heights = append(heights, path.EndpointA.GetClientState().GetLatestHeight())
+
+			for i, height := range heights {
+				_, found := suite.chainA.GetConsensusState(path.EndpointA.ClientID, height)
+				suite.Require().Equal(i >= tc.expPruned, found, "consensus state at height %s", height)
+			}
+		})
+	}
+}
+
+// containsHeight returns true if the height is contained in the heights
+func containsHeight(heights []exported.Height, height exported.Height) bool {
+	for _, h := range heights {
+		if h.EQ(height) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -151,6 +151,22 @@ func (cs ClientState) VerifyChannelState(
 	panic("legacy solo machine is deprecated!")
 }
 
+// VerifyChannelUpgrade panics!
+func (cs ClientState) VerifyChannelUpgrade(
+	sdk.KVStore, codec.BinaryCodec, exported.Height, exported.Prefix,
+	[]byte, string, string, exported.UpgradeI,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
+// VerifyChannelUpgradeError panics!
+func (cs ClientState) VerifyChannelUpgradeError(
+	sdk.KVStore, codec.BinaryCodec, exported.Height, exported.Prefix,
+	[]byte, string, string, exported.ErrorReceiptI,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
 // VerifyPacketCommitment panics!
 func (cs ClientState) VerifyPacketCommitment(
 	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
@@ -160,6 +176,15 @@ func (cs ClientState) VerifyPacketCommitment(
 	panic("legacy solo machine is deprecated!")
 }
 
+// VerifyPacketCommitments panics!
+func (cs ClientState) VerifyPacketCommitments(
+	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
+	uint64, uint64, exported.Prefix, []byte,
+	string, string, map[uint64][]byte,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
 // VerifyPacketAcknowledgement panics!
 func (cs ClientState) VerifyPacketAcknowledgement(
 	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
@@ -169,6 +194,15 @@ func (cs ClientState) VerifyPacketAcknowledgement(
 	panic("legacy solo machine is deprecated!")
 }
 
+// VerifyPacketReceipt panics!
+func (cs ClientState) VerifyPacketReceipt(
+	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
+	uint64, uint64, exported.Prefix, []byte,
+	string, string, uint64, []byte,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
 // VerifyPacketReceiptAbsence panics!
 func (cs ClientState) VerifyPacketReceiptAbsence(
 	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
@@ -6,9 +6,14 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
-// GenClientGenesis returns the default client genesis state.
+// GenClientGenesis returns the default client genesis state with the localhost client allowed. The localhost
+// client is created at the first BeginBlock and lets the simulation open channels over the localhost connection.
 func GenClientGenesis(_ *rand.Rand, _ []simtypes.Account) types.GenesisState {
-	return types.DefaultGenesisState()
+	genesis := types.DefaultGenesisState()
+	genesis.Params.AllowedClients = append([]string{exported.Localhost}, genesis.Params.AllowedClients...)
+
+	return genesis
 }
@@ -342,6 +342,15 @@ var xxx_messageInfo_Height proto.InternalMessageInfo
 type Params struct {
 	// allowed_clients defines the list of allowed client state types.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty" yaml:"allowed_clients"`
+	// consensus_state_prune_limit defines the maximum number of consensus states
+	// pruned when a client is updated. No consensus state is pruned on updates
+	// if zero.
+	ConsensusStatePruneLimit uint64 `protobuf:"varint,2,opt,name=consensus_state_prune_limit,json=consensusStatePruneLimit,proto3" json:"consensus_state_prune_limit,omitempty" yaml:"consensus_state_prune_limit"`
+	// max_consensus_states defines the maximum number of consensus states kept
+	// by a client. The oldest consensus states exceeding it are pruned along
+	// with the expired consensus states. The number of consensus states is not
+	// capped if zero.
+	MaxConsensusStates uint64 `protobuf:"varint,3,opt,name=max_consensus_states,json=maxConsensusStates,proto3" json:"max_consensus_states,omitempty" yaml:"max_consensus_states"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -384,6 +393,20 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetConsensusStatePruneLimit() uint64 {
+	if m != nil {
+		return m.ConsensusStatePruneLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxConsensusStates() uint64 {
+	if m != nil {
+		return m.MaxConsensusStates
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
@@ -397,52 +420,55 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8e, 0xdb, 0xfc, 0xa2, 0xe6, 0x52, 0x35, 0xfd, 0xb9, 0x29, 0x0d, 0xa1, 0xca, 0x45, 0x27,
-	0x86, 0x0c, 0xd4, 0x26, 0xa9, 0x84, 0xaa, 0x6c, 0x24, 0x4b, 0x3b, 0x80, 0x82, 0x51, 0x85, 0x60,
-	0x89, 0xfc, 0xe7, 0xea, 0x5c, 0xe5, 0xf8, 0x22, 0xdf, 0x39, 0x90, 0x6f, 0xc0, 0xc8, 0xc8, 0xc0,
-	0xd0, 0x6f, 0xc0, 0x97, 0x60, 0xe8, 0xd8, 0x91, 0xc9, 0x42, 0xed, 0xc2, 0x4a, 0x56, 0x16, 0x94,
-	0xbb, 0x73, 0x1b, 0xf7, 0x0f, 0x42, 0xb0, 0xdd, 0x3d, 0xf7, 0xdc, 0x73, 0xcf, 0xf3, 0xda, 0xef,
-	0x0b, 0x20, 0x71, 0x5c, 0xd3, 0xa5, 0x11, 0x36, 0xdd, 0x80, 0xe0, 0x90, 0x9b, 0x93, 0x96, 0x5a,
-	0x19, 0xe3, 0x88, 0x72, 0xaa, 0xeb, 0xc4, 0x71, 0x8d, 0x39, 0xc1, 0x50, 0xf0, 0xa4, 0x55, 0xab,
-	0xf8, 0xd4, 0xa7, 0xe2, 0xd8, 0x9c, 0xaf, 0x24, 0xb3, 0x76, 0xdf, 0xa7, 0xd4, 0x0f, 0xb0, 0x29,
-	0x76, 0x4e, 0x7c, 0x64, 0xda, 0xe1, 0x54, 0x1d, 0x3d, 0x74, 0x29, 0x1b, 0x51, 0x66, 0xc6, 0x63,
-	0x3f, 0xb2, 0x3d, 0x6c, 0x4e, 0x5a, 0x0e, 0xe6, 0x76, 0x2b, 0xdd, 0x4b, 0x16, 0xfa, 0xa4, 0x81,
-	0xcd, 0x03, 0x0f, 0x87, 0x9c, 0x1c, 0x11, 0xec, 0xf5, 0xc4, 0x73, 0x2f, 0xb9, 0xcd, 0xb1, 0xde,
-	0x02, 0x45, 0xf9, 0xfa, 0x80, 0x78, 0x55, 0xad, 0xa1, 0x35, 0x8b, 0xdd, 0xca, 0x2c, 0x81, 0xeb,
-	0x53, 0x7b, 0x14, 0x74, 0xd0, 0xe5, 0x11, 0xb2, 0x56, 0xe4, 0xfa, 0xc0, 0xd3, 0xfb, 0x60, 0x55,
-	0xe1, 0x6c, 0x2e, 0x51, 0x5d, 0x6a, 0x68, 0xcd, 0x52, 0xbb, 0x62, 0x48, 0x93, 0x46, 0x6a, 0xd2,
-	0x78, 0x1a, 0x4e, 0xbb, 0x5b, 0xb3, 0x04, 0x6e, 0x64, 0xb4, 0xc4, 0x1d, 0x64, 0x95, 0xdc, 0x2b,
-	0x13, 0xe8, 0xb3, 0x06, 0xaa, 0x3d, 0x1a, 0x32, 0x1c, 0xb2, 0x98, 0x09, 0xe8, 0x15, 0xe1, 0xc3,
-	0x7d, 0x4c, 0xfc, 0x21, 0xd7, 0xf7, 0x40, 0x61, 0x28, 0x56, 0xc2, 0x5e, 0xa9, 0x5d, 0x33, 0x6e,
-	0xd6, 0xcd, 0x90, 0xdc, 0x6e, 0xfe, 0x34, 0x81, 0x39, 0x4b, 0xf1, 0xf5, 0xd7, 0xa0, 0xec, 0xa6,
-	0xaa, 0x7f, 0xe0, 0xb5, 0x36, 0x4b, 0xe0, 0x3d, 0xe5, 0x35, 0x7b, 0x0d, 0x59, 0x6b, 0x6e, 0xc6,
-	0x1e, 0xfa, 0xa2, 0x81, 0x4d, 0x59, 0xc6, 0xac, 0x6f, 0xf6, 0x37, 0x05, 0x7d, 0x07, 0xd6, 0xaf,
-	0x3d, 0xc8, 0xaa, 0x4b, 0x8d, 0xe5, 0x66, 0xa9, 0xfd, 0xe8, 0xb6, 0xac, 0x77, 0x55, 0xaa, 0x0b,
-	0xe7, 0xe9, 0x67, 0x09, 0xdc, 0xba, 0x35, 0x04, 0x43, 0x56, 0x39, 0x9b, 0x82, 0xa1, 0x1f, 0x1a,
-	0xa8, 0xc8, 0x18, 0x87, 0x63, 0xcf, 0xe6, 0xb8, 0x1f, 0xd1, 0x31, 0x65, 0x76, 0xa0, 0x57, 0xc0,
-	0x7f, 0x9c, 0xf0, 0x00, 0xcb, 0x04, 0x96, 0xdc, 0xe8, 0x0d, 0x50, 0xf2, 0x30, 0x73, 0x23, 0x32,
-	0xe6, 0x84, 0x86, 0xa2, 0x98, 0x45, 0x6b, 0x11, 0xd2, 0xf7, 0xc1, 0xff, 0x2c, 0x76, 0x8e, 0xb1,
-	0xcb, 0x07, 0x57, 0x55, 0x58, 0x16, 0x55, 0xd8, 0x9e, 0x25, 0xb0, 0x2a, 0x9d, 0xdd, 0xa0, 0x20,
-	0xab, 0xac, 0xb0, 0x5e, 0x5a, 0x94, 0x17, 0xa0, 0xc2, 0x62, 0x87, 0x71, 0xc2, 0x63, 0x8e, 0x17,
-	0xc4, 0xf2, 0x42, 0x0c, 0xce, 0x12, 0xf8, 0xe0, 0x52, 0xec, 0x06, 0x0b, 0x59, 0xfa, 0x15, 0x9c,
-	0x4a, 0x76, 0xf2, 0xef, 0x4f, 0x60, 0x0e, 0xfd, 0xd4, 0x40, 0xf9, 0x50, 0x76, 0xc7, 0x3f, 0xc7,
-	0x7d, 0x02, 0xf2, 0xe3, 0xc0, 0x0e, 0x45, 0xc2, 0x52, 0x7b, 0xdb, 0x90, 0xcd, 0x68, 0xa4, 0xcd,
-	0xa7, 0x9a, 0xd1, 0xe8, 0x07, 0x76, 0xa8, 0xfe, 0x4d, 0xc1, 0xd7, 0x8f, 0xc1, 0xa6, 0xe2, 0x78,
-	0x83, 0x4c, 0x2f, 0xe5, 0x7f, 0xf3, 0x7f, 0x36, 0x66, 0x09, 0xdc, 0x96, 0x99, 0x6f, 0xbd, 0x8c,
-	0xac, 0x8d, 0x14, 0x5f, 0xe8, 0xf0, 0xce, 0xea, 0x3c, 0xf5, 0xc7, 0x13, 0x98, 0xfb, 0x7e, 0x02,
-	0xb5, 0xf9, 0x24, 0x28, 0xa8, 0xc6, 0xea, 0x81, 0x72, 0x84, 0x27, 0x84, 0x11, 0x1a, 0x0e, 0xc2,
-	0x78, 0xe4, 0xe0, 0x48, 0xc4, 0xcf, 0x2f, 0x36, 0xc2, 0x35, 0x02, 0xb2, 0xd6, 0x52, 0xe4, 0xb9,
-	0x00, 0x32, 0x22, 0xaa, 0x4d, 0x97, 0xee, 0x14, 0x91, 0x84, 0x05, 0x11, 0xe9, 0xa4, 0xb3, 0x92,
-	0x5a, 0x44, 0xcf, 0x40, 0xa1, 0x6f, 0x47, 0xf6, 0x88, 0xcd, 0x85, 0xed, 0x20, 0xa0, 0x6f, 0x2f,
-	0x43, 0xb2, 0xaa, 0xd6, 0x58, 0x6e, 0x16, 0x17, 0x85, 0xaf, 0x11, 0x90, 0xb5, 0xa6, 0x10, 0x99,
-	0x9f, 0x75, 0xad, 0xd3, 0xf3, 0xba, 0x76, 0x76, 0x5e, 0xd7, 0xbe, 0x9d, 0xd7, 0xb5, 0x0f, 0x17,
-	0xf5, 0xdc, 0xd9, 0x45, 0x3d, 0xf7, 0xf5, 0xa2, 0x9e, 0x7b, 0xb3, 0xe7, 0x13, 0x3e, 0x8c, 0x1d,
-	0xc3, 0xa5, 0x23, 0x53, 0x8d, 0x50, 0xe2, 0xb8, 0x3b, 0x3e, 0x35, 0x27, 0xbb, 0xe6, 0x88, 0x7a,
-	0x71, 0x80, 0x99, 0x9c, 0xde, 0x8f, 0xdb, 0x3b, 0x6a, 0x80, 0xf3, 0xe9, 0x18, 0x33, 0xa7, 0x20,
-	0x3e, 0xca, 0xee, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x6f, 0x16, 0x77, 0xe0, 0x05, 0x00,
-	0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x3f, 0x6f, 0xf3, 0x44,
+	0x1c, 0x8e, 0x93, 0x10, 0xbd, 0xb9, 0xbc, 0x6a, 0x8a, 0x9b, 0xd2, 0x90, 0x56, 0x71, 0x74, 0x42,
+	0x28, 0x03, 0xb5, 0x49, 0x2a, 0xa1, 0x2a, 0x1b, 0xc9, 0xd2, 0x4a, 0x08, 0x05, 0xa3, 0x0a, 0xc1,
+	0x12, 0xf9, 0xcf, 0xd5, 0xb9, 0xca, 0xf6, 0x59, 0xbe, 0x73, 0x68, 0x3e, 0x01, 0x8c, 0x8c, 0x0c,
+	0x0c, 0xfd, 0x06, 0x7c, 0x09, 0x86, 0x8e, 0x1d, 0x99, 0x2c, 0xd4, 0x2e, 0xac, 0x78, 0x65, 0x41,
+	0xbe, 0xb3, 0x5b, 0x3b, 0x49, 0x11, 0xe2, 0xdd, 0xce, 0xbf, 0x7b, 0xee, 0xb9, 0xe7, 0xf9, 0xe9,
+	0xf7, 0x9c, 0x81, 0x82, 0x4d, 0x4b, 0xb3, 0x48, 0x88, 0x34, 0xcb, 0xc5, 0xc8, 0x67, 0xda, 0x6a,
+	0x94, 0xad, 0xd4, 0x20, 0x24, 0x8c, 0xc8, 0x32, 0x36, 0x2d, 0x35, 0x05, 0xa8, 0x59, 0x79, 0x35,
+	0xea, 0x75, 0x1c, 0xe2, 0x10, 0xbe, 0xad, 0xa5, 0x2b, 0x81, 0xec, 0x7d, 0xe8, 0x10, 0xe2, 0xb8,
+	0x48, 0xe3, 0x5f, 0x66, 0x74, 0xad, 0x19, 0xfe, 0x3a, 0xdb, 0xfa, 0xc8, 0x22, 0xd4, 0x23, 0x54,
+	0x8b, 0x02, 0x27, 0x34, 0x6c, 0xa4, 0xad, 0x46, 0x26, 0x62, 0xc6, 0x28, 0xff, 0x16, 0x28, 0xf8,
+	0x8b, 0x04, 0x0e, 0x2f, 0x6d, 0xe4, 0x33, 0x7c, 0x8d, 0x91, 0x3d, 0xe3, 0xd7, 0x7d, 0xcd, 0x0c,
+	0x86, 0xe4, 0x11, 0x68, 0x8a, 0xdb, 0x17, 0xd8, 0xee, 0x4a, 0x03, 0x69, 0xd8, 0x9c, 0x76, 0x92,
+	0x58, 0xd9, 0x5f, 0x1b, 0x9e, 0x3b, 0x81, 0xcf, 0x5b, 0x50, 0x7f, 0x23, 0xd6, 0x97, 0xb6, 0x3c,
+	0x07, 0x6f, 0xb3, 0x3a, 0x4d, 0x29, 0xba, 0xd5, 0x81, 0x34, 0x6c, 0x8d, 0x3b, 0xaa, 0x10, 0xa9,
+	0xe6, 0x22, 0xd5, 0xcf, 0xfd, 0xf5, 0xf4, 0x28, 0x89, 0x95, 0x83, 0x12, 0x17, 0x3f, 0x03, 0xf5,
+	0x96, 0xf5, 0x22, 0x02, 0xfe, 0x2a, 0x81, 0xee, 0x8c, 0xf8, 0x14, 0xf9, 0x34, 0xa2, 0xbc, 0xf4,
+	0x0d, 0x66, 0xcb, 0x0b, 0x84, 0x9d, 0x25, 0x93, 0xcf, 0x41, 0x63, 0xc9, 0x57, 0x5c, 0x5e, 0x6b,
+	0xdc, 0x53, 0xb7, 0xfb, 0xa6, 0x0a, 0xec, 0xb4, 0x7e, 0x1f, 0x2b, 0x15, 0x3d, 0xc3, 0xcb, 0xdf,
+	0x82, 0xb6, 0x95, 0xb3, 0xfe, 0x07, 0xad, 0xbd, 0x24, 0x56, 0x3e, 0xc8, 0xb4, 0x96, 0x8f, 0x41,
+	0x7d, 0xcf, 0x2a, 0xc9, 0x83, 0xbf, 0x49, 0xe0, 0x50, 0xb4, 0xb1, 0xac, 0x9b, 0xfe, 0x9f, 0x86,
+	0xde, 0x82, 0xfd, 0x8d, 0x0b, 0x69, 0xb7, 0x3a, 0xa8, 0x0d, 0x5b, 0xe3, 0x4f, 0x76, 0x79, 0x7d,
+	0xad, 0x53, 0x53, 0x25, 0x75, 0x9f, 0xc4, 0xca, 0xd1, 0x4e, 0x13, 0x14, 0xea, 0xed, 0xb2, 0x0b,
+	0x0a, 0xff, 0x92, 0x40, 0x47, 0xd8, 0xb8, 0x0a, 0x6c, 0x83, 0xa1, 0x79, 0x48, 0x02, 0x42, 0x0d,
+	0x57, 0xee, 0x80, 0xf7, 0x18, 0x66, 0x2e, 0x12, 0x0e, 0x74, 0xf1, 0x21, 0x0f, 0x40, 0xcb, 0x46,
+	0xd4, 0x0a, 0x71, 0xc0, 0x30, 0xf1, 0x79, 0x33, 0x9b, 0x7a, 0xb1, 0x24, 0x5f, 0x80, 0xf7, 0x69,
+	0x64, 0xde, 0x20, 0x8b, 0x2d, 0x5e, 0xba, 0x50, 0xe3, 0x5d, 0x38, 0x49, 0x62, 0xa5, 0x2b, 0x94,
+	0x6d, 0x41, 0xa0, 0xde, 0xce, 0x6a, 0xb3, 0xbc, 0x29, 0x5f, 0x81, 0x0e, 0x8d, 0x4c, 0xca, 0x30,
+	0x8b, 0x18, 0x2a, 0x90, 0xd5, 0x39, 0x99, 0x92, 0xc4, 0xca, 0xf1, 0x33, 0xd9, 0x16, 0x0a, 0xea,
+	0xf2, 0x4b, 0x39, 0xa7, 0x9c, 0xd4, 0x7f, 0xbc, 0x53, 0x2a, 0xf0, 0x6f, 0x09, 0xb4, 0xaf, 0x44,
+	0x3a, 0xde, 0xd9, 0xee, 0x67, 0xa0, 0x1e, 0xb8, 0x86, 0xcf, 0x1d, 0xb6, 0xc6, 0x27, 0xaa, 0x08,
+	0xa3, 0x9a, 0x87, 0x2f, 0x0b, 0xa3, 0x3a, 0x77, 0x0d, 0x3f, 0x9b, 0x4d, 0x8e, 0x97, 0x6f, 0xc0,
+	0x61, 0x86, 0xb1, 0x17, 0xa5, 0x2c, 0xd5, 0xff, 0x65, 0x3e, 0x07, 0x49, 0xac, 0x9c, 0x08, 0xcf,
+	0x3b, 0x0f, 0x43, 0xfd, 0x20, 0xaf, 0x17, 0x12, 0x3e, 0x79, 0x9b, 0xba, 0xfe, 0xf9, 0x4e, 0xa9,
+	0xfc, 0x79, 0xa7, 0x48, 0xe9, 0x4b, 0xd0, 0xc8, 0x82, 0x35, 0x03, 0xed, 0x10, 0xad, 0x30, 0xc5,
+	0xc4, 0x5f, 0xf8, 0x91, 0x67, 0xa2, 0x90, 0xdb, 0xaf, 0x17, 0x83, 0xb0, 0x01, 0x80, 0xfa, 0x5e,
+	0x5e, 0xf9, 0x92, 0x17, 0x4a, 0x24, 0x59, 0x4c, 0xab, 0xaf, 0x92, 0x08, 0x40, 0x81, 0x44, 0x28,
+	0x99, 0xbc, 0xc9, 0x25, 0xc2, 0x1f, 0xaa, 0xa0, 0x31, 0x37, 0x42, 0xc3, 0xa3, 0x29, 0xb3, 0xe1,
+	0xba, 0xe4, 0xfb, 0x67, 0x97, 0xb4, 0x2b, 0x0d, 0x6a, 0xc3, 0x66, 0x91, 0x79, 0x03, 0x00, 0xf5,
+	0xbd, 0xac, 0x22, 0x1a, 0x40, 0x65, 0x04, 0x8e, 0x37, 0x62, 0xb0, 0x08, 0xc2, 0xc8, 0x47, 0x0b,
+	0x17, 0x7b, 0x38, 0x97, 0xfa, 0x71, 0x12, 0x2b, 0x70, 0x67, 0x66, 0x8a, 0x60, 0xa8, 0x77, 0xcb,
+	0xf1, 0x99, 0xa7, 0x7b, 0x5f, 0xa4, 0x5b, 0xe9, 0xb0, 0x7a, 0xc6, 0xed, 0x62, 0x2b, 0xc5, 0x35,
+	0xce, 0x5f, 0x18, 0xd6, 0x5d, 0x28, 0xa8, 0xcb, 0x9e, 0x71, 0xbb, 0xf1, 0x8e, 0x4c, 0xf5, 0xfb,
+	0xc7, 0xbe, 0xf4, 0xf0, 0xd8, 0x97, 0xfe, 0x78, 0xec, 0x4b, 0x3f, 0x3d, 0xf5, 0x2b, 0x0f, 0x4f,
+	0xfd, 0xca, 0xef, 0x4f, 0xfd, 0xca, 0x77, 0xe7, 0x0e, 0x66, 0xcb, 0xc8, 0x54, 0x2d, 0xe2, 0x69,
+	0xd9, 0xeb, 0x8f, 0x4d, 0xeb, 0xd4, 0x21, 0xda, 0xea, 0x4c, 0xf3, 0x88, 0x1d, 0xb9, 0x88, 0x8a,
+	0x1f, 0xcf, 0xa7, 0xe3, 0xd3, 0xec, 0xdf, 0xc3, 0xd6, 0x01, 0xa2, 0x66, 0x83, 0xcf, 0xd3, 0xd9,
+	0x3f, 0x03, 0x00, 0x8d, 0x63, 0xee, 0xf1, 0x9b, 0x06, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -772,6 +798,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxConsensusStates != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.MaxConsensusStates))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsensusStatePruneLimit != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.ConsensusStatePruneLimit))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -921,6 +957,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	if m.ConsensusStatePruneLimit != 0 {
+		n += 1 + sovClient(uint64(m.ConsensusStatePruneLimit))
+	}
+	if m.MaxConsensusStates != 0 {
+		n += 1 + sovClient(uint64(m.MaxConsensusStates))
+	}
 	return n
 }
 
@@ -1793,6 +1835,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStatePruneLimit", wireType)
+			}
+			m.ConsensusStatePruneLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusStatePruneLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsensusStates", wireType)
+			}
+			m.MaxConsensusStates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsensusStates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
@@ -34,4 +34,7 @@ var (
 	ErrInvalidSubstitute                      = sdkerrors.Register(SubModuleName, 27, "invalid client state substitute")
 	ErrInvalidUpgradeProposal                 = sdkerrors.Register(SubModuleName, 28, "invalid upgrade proposal")
 	ErrClientNotActive                        = sdkerrors.Register(SubModuleName, 29, "client is not active")
+	ErrFailedChannelUpgradeVerification       = sdkerrors.Register(SubModuleName, 30, "channel upgrade verification failed")
+	ErrInvalidPruneLimit                      = sdkerrors.Register(SubModuleName, 31, "invalid consensus state prune limit")
+	ErrPruningNotSupported                    = sdkerrors.Register(SubModuleName, 32, "consensus state pruning not supported")
 )
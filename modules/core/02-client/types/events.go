@@ -8,11 +8,12 @@ import (
 
 // IBC client events
 const (
-	AttributeKeyClientID        = "client_id"
-	AttributeKeySubjectClientID = "subject_client_id"
-	AttributeKeyClientType      = "client_type"
-	AttributeKeyConsensusHeight = "consensus_height"
-	AttributeKeyHeader          = "header"
+	AttributeKeyClientID         = "client_id"
+	AttributeKeySubjectClientID  = "subject_client_id"
+	AttributeKeyClientType       = "client_type"
+	AttributeKeyConsensusHeight  = "consensus_height"
+	AttributeKeyHeader           = "header"
+	AttributeKeyConsensusHeights = "consensus_heights"
 )
 
 // IBC client events vars
@@ -22,6 +23,7 @@ var (
 	EventTypeUpgradeClient        = "upgrade_client"
 	EventTypeSubmitMisbehaviour   = "client_misbehaviour"
 	EventTypeUpdateClientProposal = "update_client_proposal"
+	EventTypePruneConsensusStates = "prune_consensus_states"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
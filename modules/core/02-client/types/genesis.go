@@ -135,6 +135,16 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("invalid client %v index %d: %w", client, i, err)
 		}
 
+		// the localhost client is stored under its client type rather than a generated client identifier
+		if client.ClientId == exported.Localhost {
+			if clientState.ClientType() != exported.Localhost {
+				return fmt.Errorf("client state type %s does not equal client identifier %s", clientState.ClientType(), client.ClientId)
+			}
+
+			validClients[client.ClientId] = clientState.ClientType()
+			continue
+		}
+
 		clientType, sequence, err := ParseClientIdentifier(client.ClientId)
 		if err != nil {
 			return err
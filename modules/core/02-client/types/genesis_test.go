@@ -107,6 +107,38 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			),
 			expPass: true,
 		},
+		{
+			name: "valid genesis with localhost client",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						exported.Localhost, localhosttypes.NewClientState("chainID", clientHeight),
+					),
+				},
+				nil,
+				nil,
+				types.NewParams(exported.Tendermint, exported.Localhost),
+				false,
+				0,
+			),
+			expPass: true,
+		},
+		{
+			name: "invalid localhost client identifier",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						exported.Localhost, ibctmtypes.NewClientState(chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false),
+					),
+				},
+				nil,
+				nil,
+				types.NewParams(exported.Tendermint, exported.Localhost),
+				false,
+				0,
+			),
+			expPass: false,
+		},
 		{
 			name: "invalid clientid",
 			genState: types.NewGenesisState(
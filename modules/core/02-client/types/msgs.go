@@ -15,13 +15,19 @@ const (
 	TypeMsgUpdateClient       string = "update_client"
 	TypeMsgUpgradeClient      string = "upgrade_client"
 	TypeMsgSubmitMisbehaviour string = "submit_misbehaviour"
+
+	TypeMsgPruneExpiredConsensusStates string = "prune_expired_consensus_states"
 )
 
+// MaxPruneLimit is the maximum number of consensus states pruned by a single MsgPruneExpiredConsensusStates.
+const MaxPruneLimit uint64 = 1000
+
 var (
 	_ sdk.Msg = &MsgCreateClient{}
 	_ sdk.Msg = &MsgUpdateClient{}
 	_ sdk.Msg = &MsgSubmitMisbehaviour{}
 	_ sdk.Msg = &MsgUpgradeClient{}
+	_ sdk.Msg = &MsgPruneExpiredConsensusStates{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgCreateClient{}
 	_ codectypes.UnpackInterfacesMessage = MsgUpdateClient{}
@@ -280,3 +286,34 @@ func (msg MsgSubmitMisbehaviour) UnpackInterfaces(unpacker codectypes.AnyUnpacke
 	var misbehaviour exported.Misbehaviour
 	return unpacker.UnpackAny(msg.Misbehaviour, &misbehaviour)
 }
+
+// NewMsgPruneExpiredConsensusStates creates a new MsgPruneExpiredConsensusStates instance.
+func NewMsgPruneExpiredConsensusStates(clientID string, limit uint64, signer string) *MsgPruneExpiredConsensusStates {
+	return &MsgPruneExpiredConsensusStates{
+		ClientId: clientID,
+		Limit:    limit,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgPruneExpiredConsensusStates.
+func (msg MsgPruneExpiredConsensusStates) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if msg.Limit == 0 || msg.Limit > MaxPruneLimit {
+		return sdkerrors.Wrapf(ErrInvalidPruneLimit, "prune limit must be between 1 and %d, got %d", MaxPruneLimit, msg.Limit)
+	}
+
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
+
+// GetSigners returns the single expected signer for a MsgPruneExpiredConsensusStates.
+func (msg MsgPruneExpiredConsensusStates) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
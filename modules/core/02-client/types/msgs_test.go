@@ -617,3 +617,67 @@ func (suite *TypesTestSuite) TestMsgSubmitMisbehaviour_ValidateBasic() {
 		}
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgPruneExpiredConsensusStates_ValidateBasic() {
+	var msg *types.MsgPruneExpiredConsensusStates
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with maximum limit",
+			func() {
+				msg.Limit = types.MaxPruneLimit
+			},
+			true,
+		},
+		{
+			"invalid client-id",
+			func() {
+				msg.ClientId = ""
+			},
+			false,
+		},
+		{
+			"zero limit",
+			func() {
+				msg.Limit = 0
+			},
+			false,
+		},
+		{
+			"limit exceeds maximum",
+			func() {
+				msg.Limit = types.MaxPruneLimit + 1
+			},
+			false,
+		},
+		{
+			"invalid signer",
+			func() {
+				msg.Signer = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg = types.NewMsgPruneExpiredConsensusStates(ibctesting.FirstClientID, 10, suite.chainA.SenderAccount.GetAddress().String())
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -13,8 +13,17 @@ var (
 	// DefaultAllowedClients are "06-solomachine" and "07-tendermint"
 	DefaultAllowedClients = []string{exported.Solomachine, exported.Tendermint}
 
+	// DefaultConsensusStatePruneLimit is the default maximum number of consensus states pruned on client updates
+	DefaultConsensusStatePruneLimit uint64 = 1
+	// DefaultMaxConsensusStates is the default maximum number of consensus states kept by a client, zero meaning unbounded
+	DefaultMaxConsensusStates uint64 = 0
+
 	// KeyAllowedClients is store's key for AllowedClients Params
 	KeyAllowedClients = []byte("AllowedClients")
+	// KeyConsensusStatePruneLimit is store's key for ConsensusStatePruneLimit Params
+	KeyConsensusStatePruneLimit = []byte("ConsensusStatePruneLimit")
+	// KeyMaxConsensusStates is store's key for MaxConsensusStates Params
+	KeyMaxConsensusStates = []byte("MaxConsensusStates")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -22,10 +31,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-// NewParams creates a new parameter configuration for the ibc client module
+// NewParams creates a new parameter configuration for the ibc client module. The consensus state
+// pruning parameters are set to their default values.
 func NewParams(allowedClients ...string) Params {
 	return Params{
-		AllowedClients: allowedClients,
+		AllowedClients:           allowedClients,
+		ConsensusStatePruneLimit: DefaultConsensusStatePruneLimit,
+		MaxConsensusStates:       DefaultMaxConsensusStates,
 	}
 }
 
@@ -36,13 +48,23 @@ func DefaultParams() Params {
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
-	return validateClients(p.AllowedClients)
+	if err := validateClients(p.AllowedClients); err != nil {
+		return err
+	}
+
+	if err := validatePruneLimit(p.ConsensusStatePruneLimit); err != nil {
+		return err
+	}
+
+	return validateMaxConsensusStates(p.MaxConsensusStates)
 }
 
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedClients, p.AllowedClients, validateClients),
+		paramtypes.NewParamSetPair(KeyConsensusStatePruneLimit, p.ConsensusStatePruneLimit, validatePruneLimit),
+		paramtypes.NewParamSetPair(KeyMaxConsensusStates, p.MaxConsensusStates, validateMaxConsensusStates),
 	}
 }
 
@@ -70,3 +92,24 @@ func validateClients(i interface{}) error {
 
 	return nil
 }
+
+func validatePruneLimit(i interface{}) error {
+	limit, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if limit > MaxPruneLimit {
+		return fmt.Errorf("consensus state prune limit cannot exceed %d, got %d", MaxPruneLimit, limit)
+	}
+
+	return nil
+}
+
+func validateMaxConsensusStates(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
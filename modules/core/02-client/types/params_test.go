@@ -17,6 +17,9 @@ func TestValidateParams(t *testing.T) {
 		{"default params", DefaultParams(), true},
 		{"custom params", NewParams(exported.Tendermint), true},
 		{"blank client", NewParams(" "), false},
+		{"pruning on updates disabled", Params{AllowedClients: DefaultAllowedClients, ConsensusStatePruneLimit: 0}, true},
+		{"max consensus states", Params{AllowedClients: DefaultAllowedClients, ConsensusStatePruneLimit: 1, MaxConsensusStates: 100}, true},
+		{"prune limit exceeds maximum", Params{AllowedClients: DefaultAllowedClients, ConsensusStatePruneLimit: MaxPruneLimit + 1}, false},
 	}
 
 	for _, tc := range testCases {
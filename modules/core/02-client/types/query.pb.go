@@ -593,6 +593,110 @@ func (m *QueryClientStatusResponse) GetStatus() string {
 	return ""
 }
 
+// QueryPrunableConsensusStatesRequest is the request type for the
+// Query/PrunableConsensusStates RPC method
+type QueryPrunableConsensusStatesRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// maximum number of heights returned, all heights are returned if zero
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryPrunableConsensusStatesRequest) Reset()         { *m = QueryPrunableConsensusStatesRequest{} }
+func (m *QueryPrunableConsensusStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrunableConsensusStatesRequest) ProtoMessage()    {}
+func (*QueryPrunableConsensusStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{10}
+}
+func (m *QueryPrunableConsensusStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrunableConsensusStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrunableConsensusStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrunableConsensusStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrunableConsensusStatesRequest.Merge(m, src)
+}
+func (m *QueryPrunableConsensusStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrunableConsensusStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrunableConsensusStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrunableConsensusStatesRequest proto.InternalMessageInfo
+
+func (m *QueryPrunableConsensusStatesRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryPrunableConsensusStatesRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryPrunableConsensusStatesResponse is the response type for the
+// Query/PrunableConsensusStates RPC method. The heights are returned in
+// ascending order.
+type QueryPrunableConsensusStatesResponse struct {
+	// heights of the consensus states which can be pruned
+	ConsensusHeights []Height `protobuf:"bytes,1,rep,name=consensus_heights,json=consensusHeights,proto3" json:"consensus_heights"`
+}
+
+func (m *QueryPrunableConsensusStatesResponse) Reset()         { *m = QueryPrunableConsensusStatesResponse{} }
+func (m *QueryPrunableConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrunableConsensusStatesResponse) ProtoMessage()    {}
+func (*QueryPrunableConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{11}
+}
+func (m *QueryPrunableConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrunableConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrunableConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrunableConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrunableConsensusStatesResponse.Merge(m, src)
+}
+func (m *QueryPrunableConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrunableConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrunableConsensusStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrunableConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *QueryPrunableConsensusStatesResponse) GetConsensusHeights() []Height {
+	if m != nil {
+		return m.ConsensusHeights
+	}
+	return nil
+}
+
 // QueryClientParamsRequest is the request type for the Query/ClientParams RPC
 // method.
 type QueryClientParamsRequest struct {
@@ -602,7 +706,7 @@ func (m *QueryClientParamsRequest) Reset()         { *m = QueryClientParamsReque
 func (m *QueryClientParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClientParamsRequest) ProtoMessage()    {}
 func (*QueryClientParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{10}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{12}
 }
 func (m *QueryClientParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +746,7 @@ func (m *QueryClientParamsResponse) Reset()         { *m = QueryClientParamsResp
 func (m *QueryClientParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClientParamsResponse) ProtoMessage()    {}
 func (*QueryClientParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{11}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{13}
 }
 func (m *QueryClientParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +791,7 @@ func (m *QueryUpgradedClientStateRequest) Reset()         { *m = QueryUpgradedCl
 func (m *QueryUpgradedClientStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedClientStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedClientStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{12}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{14}
 }
 func (m *QueryUpgradedClientStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,7 +831,7 @@ func (m *QueryUpgradedClientStateResponse) Reset()         { *m = QueryUpgradedC
 func (m *QueryUpgradedClientStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedClientStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedClientStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{13}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{15}
 }
 func (m *QueryUpgradedClientStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +876,7 @@ func (m *QueryUpgradedConsensusStateRequest) Reset()         { *m = QueryUpgrade
 func (m *QueryUpgradedConsensusStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{14}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{16}
 }
 func (m *QueryUpgradedConsensusStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +916,7 @@ func (m *QueryUpgradedConsensusStateResponse) Reset()         { *m = QueryUpgrad
 func (m *QueryUpgradedConsensusStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{15}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{17}
 }
 func (m *QueryUpgradedConsensusStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,6 +963,8 @@ func init() {
 	proto.RegisterType((*QueryConsensusStatesResponse)(nil), "ibc.core.client.v1.QueryConsensusStatesResponse")
 	proto.RegisterType((*QueryClientStatusRequest)(nil), "ibc.core.client.v1.QueryClientStatusRequest")
 	proto.RegisterType((*QueryClientStatusResponse)(nil), "ibc.core.client.v1.QueryClientStatusResponse")
+	proto.RegisterType((*QueryPrunableConsensusStatesRequest)(nil), "ibc.core.client.v1.QueryPrunableConsensusStatesRequest")
+	proto.RegisterType((*QueryPrunableConsensusStatesResponse)(nil), "ibc.core.client.v1.QueryPrunableConsensusStatesResponse")
 	proto.RegisterType((*QueryClientParamsRequest)(nil), "ibc.core.client.v1.QueryClientParamsRequest")
 	proto.RegisterType((*QueryClientParamsResponse)(nil), "ibc.core.client.v1.QueryClientParamsResponse")
 	proto.RegisterType((*QueryUpgradedClientStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedClientStateRequest")
@@ -870,69 +976,74 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/query.proto", fileDescriptor_dc42cdfd1d52d76e) }
 
 var fileDescriptor_dc42cdfd1d52d76e = []byte{
-	// 983 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xa4, 0x69, 0xd4, 0x3e, 0xbb, 0x09, 0x9a, 0xa6, 0xa9, 0xbb, 0x2d, 0x8e, 0xbb, 0x41,
-	0x34, 0x2d, 0xc9, 0x4e, 0xe2, 0x40, 0xd3, 0x0b, 0x07, 0x52, 0xa9, 0xb4, 0x97, 0x52, 0x16, 0x21,
-	0x24, 0x24, 0x14, 0xed, 0xae, 0x27, 0x9b, 0x95, 0xec, 0x1d, 0xd7, 0xb3, 0x6b, 0x29, 0xaa, 0x72,
-	0xa0, 0x47, 0x4e, 0x48, 0x48, 0x5c, 0x91, 0x38, 0x72, 0xa8, 0x38, 0x20, 0x71, 0xe5, 0x84, 0x7a,
-	0xac, 0x04, 0x07, 0x4e, 0x04, 0x25, 0xfc, 0x21, 0x68, 0x67, 0x66, 0xe3, 0x1d, 0x7b, 0x8c, 0xd7,
-	0x88, 0xde, 0x76, 0xde, 0xe7, 0xef, 0x7d, 0xcc, 0x6f, 0xb4, 0x50, 0x8f, 0xfc, 0x80, 0x04, 0xac,
-	0x47, 0x49, 0xd0, 0x8e, 0x68, 0x9c, 0x90, 0xfe, 0x16, 0x79, 0x9a, 0xd2, 0xde, 0xa1, 0xd3, 0xed,
-	0xb1, 0x84, 0x61, 0x1c, 0xf9, 0x81, 0x93, 0xe9, 0x1d, 0xa9, 0x77, 0xfa, 0x5b, 0xd6, 0x9d, 0x80,
-	0xf1, 0x0e, 0xe3, 0xc4, 0xf7, 0x38, 0x95, 0xc6, 0xa4, 0xbf, 0xe5, 0xd3, 0xc4, 0xdb, 0x22, 0x5d,
-	0x2f, 0x8c, 0x62, 0x2f, 0x89, 0x58, 0x2c, 0xfd, 0xad, 0x15, 0x43, 0x7c, 0x15, 0x49, 0x1a, 0x5c,
-	0x0b, 0x19, 0x0b, 0xdb, 0x94, 0x88, 0x93, 0x9f, 0xee, 0x13, 0x2f, 0x56, 0xb9, 0xad, 0x1b, 0x4a,
-	0xe5, 0x75, 0x23, 0xe2, 0xc5, 0x31, 0x4b, 0x44, 0x60, 0xae, 0xb4, 0x4b, 0x21, 0x0b, 0x99, 0xf8,
-	0x24, 0xd9, 0x97, 0x94, 0xda, 0x77, 0xe1, 0xea, 0xc7, 0x19, 0xa2, 0xfb, 0x22, 0xc7, 0x27, 0x89,
-	0x97, 0x50, 0x97, 0x3e, 0x4d, 0x29, 0x4f, 0xf0, 0x75, 0xb8, 0x28, 0x33, 0xef, 0x45, 0xad, 0x1a,
-	0x6a, 0xa0, 0xb5, 0x8b, 0xee, 0x05, 0x29, 0x78, 0xd4, 0xb2, 0x5f, 0x20, 0xa8, 0x8d, 0x3a, 0xf2,
-	0x2e, 0x8b, 0x39, 0xc5, 0x3b, 0x50, 0x55, 0x9e, 0x3c, 0x93, 0x0b, 0xe7, 0x4a, 0x73, 0xc9, 0x91,
-	0xf8, 0x9c, 0x1c, 0xba, 0xf3, 0x41, 0x7c, 0xe8, 0x56, 0x82, 0x41, 0x00, 0xbc, 0x04, 0xe7, 0xbb,
-	0x3d, 0xc6, 0xf6, 0x6b, 0xb3, 0x0d, 0xb4, 0x56, 0x75, 0xe5, 0x01, 0xdf, 0x87, 0xaa, 0xf8, 0xd8,
-	0x3b, 0xa0, 0x51, 0x78, 0x90, 0xd4, 0xce, 0x89, 0x70, 0x96, 0x33, 0xda, 0x6a, 0xe7, 0xa1, 0xb0,
-	0xd8, 0x9d, 0x7b, 0xf9, 0xe7, 0xca, 0x8c, 0x5b, 0x11, 0x5e, 0x52, 0x64, 0xfb, 0xa3, 0x78, 0x79,
-	0x5e, 0xe9, 0x03, 0x80, 0xc1, 0x20, 0x14, 0xda, 0xb7, 0x1d, 0x39, 0x35, 0x27, 0x9b, 0x9a, 0x23,
-	0x47, 0xac, 0xa6, 0xe6, 0x3c, 0xf1, 0xc2, 0xbc, 0x4b, 0x6e, 0xc1, 0xd3, 0xfe, 0x1d, 0xc1, 0x35,
-	0x43, 0x12, 0xd5, 0x95, 0x18, 0x2e, 0x15, 0xbb, 0xc2, 0x6b, 0xa8, 0x71, 0x6e, 0xad, 0xd2, 0xbc,
-	0x6d, 0xaa, 0xe3, 0x51, 0x8b, 0xc6, 0x49, 0xb4, 0x1f, 0xd1, 0x56, 0x21, 0xd4, 0x6e, 0x3d, 0x2b,
-	0xeb, 0x87, 0xe3, 0x95, 0x65, 0xa3, 0x9a, 0xbb, 0xd5, 0x42, 0x2f, 0x39, 0xfe, 0x50, 0xab, 0x6a,
-	0x56, 0x54, 0x75, 0x6b, 0x62, 0x55, 0x12, 0xac, 0x56, 0xd6, 0x8f, 0x08, 0x2c, 0x59, 0x56, 0xa6,
-	0x8a, 0x79, 0xca, 0x4b, 0xef, 0x09, 0xbe, 0x05, 0x8b, 0x3d, 0xda, 0x8f, 0x78, 0xc4, 0xe2, 0xbd,
-	0x38, 0xed, 0xf8, 0xb4, 0x27, 0x90, 0xcc, 0xb9, 0x0b, 0xb9, 0xf8, 0xb1, 0x90, 0x6a, 0x86, 0x85,
-	0x39, 0x17, 0x0c, 0xe5, 0x20, 0xf1, 0x2a, 0x5c, 0x6a, 0x67, 0xf5, 0x25, 0xb9, 0xd9, 0x5c, 0x03,
-	0xad, 0x5d, 0x70, 0xab, 0x52, 0xa8, 0xa6, 0xfd, 0x33, 0x82, 0xeb, 0x46, 0xc8, 0x6a, 0x16, 0xef,
-	0xc3, 0x62, 0x90, 0x6b, 0x4a, 0x2c, 0xe9, 0x42, 0xa0, 0x85, 0x79, 0x9d, 0x7b, 0xfa, 0xdc, 0x8c,
-	0x9c, 0x97, 0xea, 0xf6, 0x03, 0xc3, 0xc8, 0xff, 0xcb, 0x22, 0xff, 0x8a, 0xe0, 0x86, 0x19, 0x84,
-	0xea, 0xdf, 0x17, 0xf0, 0xc6, 0x50, 0xff, 0xf2, 0x75, 0x5e, 0x37, 0x95, 0xab, 0x87, 0xf9, 0x2c,
-	0x4a, 0x0e, 0xb4, 0x06, 0x2c, 0xea, 0xed, 0xfd, 0x1f, 0x57, 0x77, 0x67, 0xe4, 0xd6, 0xa7, 0xa5,
-	0x3a, 0x69, 0x6f, 0x8f, 0xdc, 0xe4, 0x74, 0x50, 0xfd, 0x32, 0xcc, 0x73, 0x21, 0x51, 0x6e, 0xea,
-	0x64, 0x5b, 0x5a, 0xb6, 0x27, 0x5e, 0xcf, 0xeb, 0xe4, 0xd9, 0xec, 0x8f, 0xb4, 0x80, 0xb9, 0x4e,
-	0x05, 0x6c, 0xc2, 0x7c, 0x57, 0x48, 0xd4, 0x16, 0x1a, 0x77, 0x46, 0xf9, 0x28, 0x4b, 0xfb, 0x26,
-	0xac, 0x88, 0x80, 0x9f, 0x76, 0xc3, 0x9e, 0xd7, 0xd2, 0x98, 0x20, 0xcf, 0xd9, 0x86, 0xc6, 0x78,
-	0x13, 0x95, 0xfa, 0x21, 0x5c, 0x49, 0x95, 0x7a, 0xaf, 0x34, 0x69, 0x5f, 0x4e, 0x47, 0x23, 0xda,
-	0x6f, 0x81, 0xad, 0x67, 0x33, 0xb1, 0x85, 0x9d, 0xc2, 0xea, 0xbf, 0x5a, 0x29, 0x58, 0x8f, 0xa1,
-	0x36, 0x80, 0x35, 0xc5, 0x4d, 0x5d, 0x4e, 0x8d, 0x71, 0x9b, 0x5f, 0x56, 0xe0, 0xbc, 0xc8, 0x8b,
-	0xbf, 0x43, 0x50, 0x29, 0xc0, 0xc6, 0xef, 0x98, 0x7a, 0x3d, 0xe6, 0x4d, 0xb4, 0xd6, 0xcb, 0x19,
-	0xcb, 0x22, 0xec, 0xf7, 0x9e, 0xff, 0xf6, 0xf7, 0x37, 0xb3, 0x04, 0x6f, 0x90, 0xb1, 0xaf, 0xba,
-	0xba, 0x3c, 0xe4, 0xd9, 0xd9, 0x2a, 0x1e, 0xe1, 0x6f, 0x11, 0x54, 0x8b, 0xbc, 0x8e, 0x4b, 0x65,
-	0xcd, 0x37, 0xcd, 0xda, 0x28, 0x69, 0xad, 0x40, 0xde, 0x16, 0x20, 0x57, 0xf1, 0xcd, 0x89, 0x20,
-	0xf1, 0x31, 0x82, 0x05, 0xbd, 0xaf, 0xd8, 0x19, 0x9f, 0xcc, 0x34, 0x7e, 0x8b, 0x94, 0xb6, 0x57,
-	0xf0, 0xda, 0x02, 0xde, 0x3e, 0x6e, 0x19, 0xe1, 0x0d, 0x71, 0x50, 0xb1, 0x8d, 0x24, 0x7f, 0x37,
-	0xc8, 0xb3, 0xa1, 0x17, 0xe8, 0x88, 0x48, 0x82, 0x2e, 0x28, 0xa4, 0xe0, 0x08, 0xbf, 0x40, 0xb0,
-	0x38, 0xc4, 0x79, 0xb8, 0x2c, 0xe4, 0xb3, 0x01, 0x6c, 0x96, 0x77, 0x50, 0x45, 0xde, 0x13, 0x45,
-	0x36, 0xf1, 0xe6, 0xb4, 0x45, 0xe2, 0xef, 0xb5, 0x5d, 0x49, 0xcb, 0xed, 0x4a, 0x3a, 0xd5, 0xae,
-	0x0c, 0x88, 0xaf, 0xf4, 0x42, 0xa7, 0x3a, 0xc8, 0xaf, 0xce, 0x40, 0x4a, 0x0e, 0x9b, 0x08, 0x52,
-	0xa3, 0xce, 0x89, 0x20, 0x75, 0x32, 0xb5, 0xdf, 0x14, 0x20, 0xaf, 0xe2, 0x2b, 0x12, 0xe4, 0x19,
-	0x3e, 0xc9, 0x9b, 0xf8, 0x27, 0x04, 0x97, 0x0d, 0x84, 0x88, 0xb7, 0xc7, 0x66, 0x19, 0xcf, 0xb0,
-	0xd6, 0xbb, 0xd3, 0x39, 0x29, 0x84, 0x4d, 0x81, 0x70, 0x1d, 0xdf, 0x31, 0xb5, 0xd1, 0xc8, 0xc6,
-	0x1c, 0xff, 0x82, 0x60, 0xd9, 0xcc, 0x99, 0xf8, 0xee, 0x64, 0x10, 0xc6, 0xbb, 0xb8, 0x33, 0xb5,
-	0x5f, 0x99, 0x35, 0x18, 0x47, 0xdb, 0x7c, 0xd7, 0x7d, 0x79, 0x52, 0x47, 0xaf, 0x4e, 0xea, 0xe8,
-	0xaf, 0x93, 0x3a, 0xfa, 0xfa, 0xb4, 0x3e, 0xf3, 0xea, 0xb4, 0x3e, 0xf3, 0xc7, 0x69, 0x7d, 0xe6,
-	0xf3, 0x7b, 0x61, 0x94, 0x1c, 0xa4, 0xbe, 0x13, 0xb0, 0x0e, 0x51, 0x3f, 0x4b, 0x91, 0x1f, 0x6c,
-	0x84, 0x8c, 0xf4, 0xb7, 0x49, 0x87, 0xb5, 0xd2, 0x36, 0xe5, 0x32, 0xcf, 0x66, 0x73, 0x43, 0xa5,
-	0x4a, 0x0e, 0xbb, 0x94, 0xfb, 0xf3, 0x82, 0xfd, 0xb7, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xb0,
-	0x84, 0x64, 0x2c, 0x98, 0x0d, 0x00, 0x00,
+	// 1064 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xa4, 0x49, 0xd4, 0x3e, 0xbb, 0xc9, 0xf7, 0x3b, 0x4d, 0x13, 0x67, 0x5b, 0x1c, 0x77,
+	0x53, 0xd1, 0xb4, 0xc4, 0x3b, 0x89, 0x03, 0x4d, 0x10, 0xe2, 0xd0, 0x44, 0x2a, 0xed, 0x81, 0x12,
+	0x16, 0x21, 0x10, 0x12, 0xb2, 0x76, 0xd7, 0x9b, 0xcd, 0x4a, 0xf6, 0x8e, 0xeb, 0xd9, 0xb1, 0x14,
+	0x55, 0xb9, 0xf4, 0xc8, 0x09, 0x09, 0x89, 0x2b, 0x12, 0x47, 0x0e, 0x15, 0x07, 0x24, 0xae, 0x9c,
+	0x50, 0x6f, 0x54, 0x2a, 0x07, 0x4e, 0x14, 0x25, 0xfc, 0x21, 0xc8, 0x33, 0xb3, 0xf1, 0x6e, 0x3c,
+	0x8e, 0xd7, 0x15, 0xdc, 0x76, 0xdf, 0xcf, 0xcf, 0xfb, 0xbc, 0xb7, 0xef, 0xd9, 0x50, 0x0e, 0x5d,
+	0x8f, 0x78, 0xb4, 0xe3, 0x13, 0xaf, 0x19, 0xfa, 0x51, 0x4c, 0xba, 0x1b, 0xe4, 0x31, 0xf7, 0x3b,
+	0x87, 0x56, 0xbb, 0x43, 0x63, 0x8a, 0x71, 0xe8, 0x7a, 0x56, 0x4f, 0x6f, 0x49, 0xbd, 0xd5, 0xdd,
+	0x30, 0xee, 0x78, 0x94, 0xb5, 0x28, 0x23, 0xae, 0xc3, 0x7c, 0x69, 0x4c, 0xba, 0x1b, 0xae, 0x1f,
+	0x3b, 0x1b, 0xa4, 0xed, 0x04, 0x61, 0xe4, 0xc4, 0x21, 0x8d, 0xa4, 0xbf, 0xb1, 0xac, 0x89, 0xaf,
+	0x22, 0x49, 0x83, 0xa5, 0x80, 0xd2, 0xa0, 0xe9, 0x13, 0xf1, 0xe6, 0xf2, 0x7d, 0xe2, 0x44, 0x2a,
+	0xb7, 0x71, 0x5d, 0xa9, 0x9c, 0x76, 0x48, 0x9c, 0x28, 0xa2, 0xb1, 0x08, 0xcc, 0x94, 0x76, 0x3e,
+	0xa0, 0x01, 0x15, 0x8f, 0xa4, 0xf7, 0x24, 0xa5, 0xe6, 0x5d, 0x58, 0xfc, 0xb8, 0x87, 0x68, 0x57,
+	0xe4, 0xf8, 0x24, 0x76, 0x62, 0xdf, 0xf6, 0x1f, 0x73, 0x9f, 0xc5, 0xf8, 0x1a, 0x5c, 0x92, 0x99,
+	0xeb, 0x61, 0xa3, 0x84, 0x2a, 0x68, 0xf5, 0x92, 0x7d, 0x51, 0x0a, 0x1e, 0x36, 0xcc, 0x67, 0x08,
+	0x4a, 0x83, 0x8e, 0xac, 0x4d, 0x23, 0xe6, 0xe3, 0x2d, 0x28, 0x2a, 0x4f, 0xd6, 0x93, 0x0b, 0xe7,
+	0x42, 0x6d, 0xde, 0x92, 0xf8, 0xac, 0x04, 0xba, 0x75, 0x2f, 0x3a, 0xb4, 0x0b, 0x5e, 0x3f, 0x00,
+	0x9e, 0x87, 0xe9, 0x76, 0x87, 0xd2, 0xfd, 0xd2, 0x64, 0x05, 0xad, 0x16, 0x6d, 0xf9, 0x82, 0x77,
+	0xa1, 0x28, 0x1e, 0xea, 0x07, 0x7e, 0x18, 0x1c, 0xc4, 0xa5, 0x0b, 0x22, 0x9c, 0x61, 0x0d, 0x52,
+	0x6d, 0x3d, 0x10, 0x16, 0x3b, 0x53, 0xcf, 0xff, 0x5c, 0x9e, 0xb0, 0x0b, 0xc2, 0x4b, 0x8a, 0x4c,
+	0x77, 0x10, 0x2f, 0x4b, 0x2a, 0xbd, 0x0f, 0xd0, 0x6f, 0x84, 0x42, 0xfb, 0xa6, 0x25, 0xbb, 0x66,
+	0xf5, 0xba, 0x66, 0xc9, 0x16, 0xab, 0xae, 0x59, 0x7b, 0x4e, 0x90, 0xb0, 0x64, 0xa7, 0x3c, 0xcd,
+	0xdf, 0x11, 0x2c, 0x69, 0x92, 0x28, 0x56, 0x22, 0xb8, 0x9c, 0x66, 0x85, 0x95, 0x50, 0xe5, 0xc2,
+	0x6a, 0xa1, 0x76, 0x5b, 0x57, 0xc7, 0xc3, 0x86, 0x1f, 0xc5, 0xe1, 0x7e, 0xe8, 0x37, 0x52, 0xa1,
+	0x76, 0xca, 0xbd, 0xb2, 0x7e, 0x78, 0xb5, 0xbc, 0xa0, 0x55, 0x33, 0xbb, 0x98, 0xe2, 0x92, 0xe1,
+	0x0f, 0x32, 0x55, 0x4d, 0x8a, 0xaa, 0x6e, 0x8d, 0xac, 0x4a, 0x82, 0xcd, 0x94, 0xf5, 0x23, 0x02,
+	0x43, 0x96, 0xd5, 0x53, 0x45, 0x8c, 0xb3, 0xdc, 0x73, 0x82, 0x6f, 0xc1, 0x5c, 0xc7, 0xef, 0x86,
+	0x2c, 0xa4, 0x51, 0x3d, 0xe2, 0x2d, 0xd7, 0xef, 0x08, 0x24, 0x53, 0xf6, 0x6c, 0x22, 0x7e, 0x24,
+	0xa4, 0x19, 0xc3, 0x54, 0x9f, 0x53, 0x86, 0xb2, 0x91, 0x78, 0x05, 0x2e, 0x37, 0x7b, 0xf5, 0xc5,
+	0x89, 0xd9, 0x54, 0x05, 0xad, 0x5e, 0xb4, 0x8b, 0x52, 0xa8, 0xba, 0xfd, 0x33, 0x82, 0x6b, 0x5a,
+	0xc8, 0xaa, 0x17, 0xef, 0xc3, 0x9c, 0x97, 0x68, 0x72, 0x0c, 0xe9, 0xac, 0x97, 0x09, 0xf3, 0x5f,
+	0xce, 0xe9, 0x53, 0x3d, 0x72, 0x96, 0x8b, 0xed, 0xfb, 0x9a, 0x96, 0xbf, 0xce, 0x20, 0xff, 0x8a,
+	0xe0, 0xba, 0x1e, 0x84, 0xe2, 0xef, 0x4b, 0xf8, 0xdf, 0x19, 0xfe, 0x92, 0x71, 0x5e, 0xd3, 0x95,
+	0x9b, 0x0d, 0xf3, 0x59, 0x18, 0x1f, 0x64, 0x08, 0x98, 0xcb, 0xd2, 0xfb, 0x2f, 0x8e, 0xee, 0xd6,
+	0xc0, 0x57, 0xcf, 0x73, 0x31, 0x69, 0x6e, 0xc2, 0x92, 0xc6, 0x51, 0x55, 0xbf, 0x00, 0x33, 0x4c,
+	0x48, 0x94, 0x9b, 0x7a, 0x33, 0x3f, 0x87, 0x15, 0xe1, 0xb4, 0xd7, 0xe1, 0x91, 0xe3, 0x36, 0xfd,
+	0xd7, 0x69, 0xe1, 0x3c, 0x4c, 0x37, 0xc3, 0x56, 0x18, 0xab, 0xcf, 0x44, 0xbe, 0x98, 0x1c, 0x6e,
+	0x9e, 0x1f, 0x59, 0x21, 0xfb, 0x10, 0xfe, 0xdf, 0xef, 0x8b, 0x1c, 0xc3, 0xa4, 0x31, 0xa3, 0xe7,
+	0xb0, 0xdf, 0x52, 0x29, 0x66, 0xa6, 0x91, 0xa1, 0x6f, 0xcf, 0xe9, 0x38, 0xad, 0xa4, 0x0a, 0xf3,
+	0x23, 0x58, 0xd2, 0xe8, 0x14, 0x8e, 0x1a, 0xcc, 0xb4, 0x85, 0x44, 0x7d, 0x56, 0xda, 0xe4, 0xca,
+	0x47, 0x59, 0x9a, 0x37, 0x60, 0x59, 0x04, 0xfc, 0xb4, 0x1d, 0x74, 0x9c, 0x46, 0x66, 0xb5, 0x25,
+	0x39, 0x9b, 0x50, 0x19, 0x6e, 0xa2, 0x52, 0x3f, 0x80, 0xab, 0x5c, 0xa9, 0xeb, 0xb9, 0xaf, 0xd0,
+	0x15, 0x3e, 0x18, 0xd1, 0xbc, 0x09, 0x66, 0x36, 0x9b, 0x6e, 0xfd, 0x99, 0x1c, 0x56, 0xce, 0xb5,
+	0x52, 0xb0, 0x1e, 0x41, 0xa9, 0x0f, 0x6b, 0x8c, 0xd5, 0xb3, 0xc0, 0xb5, 0x71, 0x6b, 0x2f, 0x8b,
+	0x30, 0x2d, 0xf2, 0xe2, 0xef, 0x10, 0x14, 0x52, 0xb0, 0xf1, 0x5b, 0x3a, 0xae, 0x87, 0x1c, 0x79,
+	0x63, 0x2d, 0x9f, 0xb1, 0x2c, 0xc2, 0x7c, 0xe7, 0xe9, 0xcb, 0xbf, 0xbf, 0x99, 0x24, 0xb8, 0x4a,
+	0x86, 0xfe, 0x4c, 0x51, 0xdb, 0x80, 0x3c, 0x39, 0x1d, 0xf1, 0x23, 0xfc, 0x2d, 0x82, 0xe2, 0x6e,
+	0xfa, 0x34, 0xe5, 0xca, 0x9a, 0x4c, 0x9a, 0x51, 0xcd, 0x69, 0xad, 0x40, 0xde, 0x16, 0x20, 0x57,
+	0xf0, 0x8d, 0x91, 0x20, 0xf1, 0x2b, 0x04, 0xb3, 0x59, 0x5e, 0xb1, 0x35, 0x3c, 0x99, 0xae, 0xfd,
+	0x06, 0xc9, 0x6d, 0xaf, 0xe0, 0x35, 0x05, 0xbc, 0x7d, 0xdc, 0xd0, 0xc2, 0x3b, 0xb3, 0x54, 0xd3,
+	0x34, 0x92, 0xe4, 0x10, 0x92, 0x27, 0x67, 0x4e, 0xea, 0x11, 0x91, 0x9f, 0x7a, 0x4a, 0x21, 0x05,
+	0x47, 0xf8, 0x19, 0x82, 0xb9, 0x33, 0xcb, 0x02, 0xe7, 0x85, 0x7c, 0xda, 0x80, 0xf5, 0xfc, 0x0e,
+	0xaa, 0xc8, 0x6d, 0x51, 0x64, 0x0d, 0xaf, 0x8f, 0x5b, 0x24, 0xfe, 0x3e, 0x33, 0x2b, 0x3c, 0xdf,
+	0xac, 0xf0, 0xb1, 0x66, 0x85, 0xb3, 0xb1, 0x07, 0x9a, 0x67, 0x41, 0xfe, 0x86, 0x60, 0x71, 0xc8,
+	0x2a, 0xc6, 0x5b, 0x43, 0x11, 0x9c, 0x7f, 0x16, 0x8c, 0xed, 0xf1, 0x1d, 0x55, 0x15, 0xf7, 0x44,
+	0x15, 0xef, 0xe1, 0x77, 0x75, 0x55, 0xb4, 0x95, 0x73, 0xfd, 0x5c, 0xda, 0xbf, 0x3a, 0xa5, 0x5d,
+	0x6e, 0xe5, 0x91, 0xb4, 0x67, 0x8e, 0x81, 0x51, 0xcd, 0x69, 0xad, 0x00, 0xbf, 0x21, 0x00, 0x2f,
+	0xe2, 0xab, 0x12, 0x70, 0x1f, 0xab, 0xcc, 0xfd, 0x13, 0x82, 0x2b, 0x9a, 0x15, 0x8f, 0x37, 0x87,
+	0x66, 0x19, 0x7e, 0x33, 0x8c, 0xb7, 0xc7, 0x73, 0x52, 0x08, 0x6b, 0x02, 0xe1, 0x1a, 0xbe, 0xa3,
+	0xa3, 0x54, 0x7b, 0x5f, 0x18, 0xfe, 0x05, 0xc1, 0x82, 0xfe, 0x0a, 0xe0, 0xbb, 0xa3, 0x41, 0x68,
+	0xb7, 0xcb, 0xd6, 0xd8, 0x7e, 0x79, 0x06, 0x7b, 0xd8, 0x21, 0x62, 0x3b, 0xf6, 0xf3, 0xe3, 0x32,
+	0x7a, 0x71, 0x5c, 0x46, 0x7f, 0x1d, 0x97, 0xd1, 0xd7, 0x27, 0xe5, 0x89, 0x17, 0x27, 0xe5, 0x89,
+	0x3f, 0x4e, 0xca, 0x13, 0x5f, 0x6c, 0x07, 0x61, 0x7c, 0xc0, 0x5d, 0xcb, 0xa3, 0x2d, 0xa2, 0xfe,
+	0xcf, 0x86, 0xae, 0x57, 0x0d, 0x28, 0xe9, 0x6e, 0x92, 0x16, 0x6d, 0xf0, 0xa6, 0xcf, 0x64, 0x9e,
+	0xf5, 0x5a, 0x55, 0xa5, 0x8a, 0x0f, 0xdb, 0x3e, 0x73, 0x67, 0xc4, 0x3d, 0xdb, 0xfc, 0x67, 0x00,
+	0x2f, 0x8b, 0x9f, 0xe3, 0x3b, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -959,6 +1070,9 @@ type QueryClient interface {
 	ConsensusStates(ctx context.Context, in *QueryConsensusStatesRequest, opts ...grpc.CallOption) (*QueryConsensusStatesResponse, error)
 	// Status queries the status of an IBC client.
 	ClientStatus(ctx context.Context, in *QueryClientStatusRequest, opts ...grpc.CallOption) (*QueryClientStatusResponse, error)
+	// PrunableConsensusStates queries the heights of the consensus states of an
+	// IBC client which can be pruned.
+	PrunableConsensusStates(ctx context.Context, in *QueryPrunableConsensusStatesRequest, opts ...grpc.CallOption) (*QueryPrunableConsensusStatesResponse, error)
 	// ClientParams queries all parameters of the ibc client.
 	ClientParams(ctx context.Context, in *QueryClientParamsRequest, opts ...grpc.CallOption) (*QueryClientParamsResponse, error)
 	// UpgradedClientState queries an Upgraded IBC light client.
@@ -1020,6 +1134,15 @@ func (c *queryClient) ClientStatus(ctx context.Context, in *QueryClientStatusReq
 	return out, nil
 }
 
+func (c *queryClient) PrunableConsensusStates(ctx context.Context, in *QueryPrunableConsensusStatesRequest, opts ...grpc.CallOption) (*QueryPrunableConsensusStatesResponse, error) {
+	out := new(QueryPrunableConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/PrunableConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ClientParams(ctx context.Context, in *QueryClientParamsRequest, opts ...grpc.CallOption) (*QueryClientParamsResponse, error) {
 	out := new(QueryClientParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/ClientParams", in, out, opts...)
@@ -1061,6 +1184,9 @@ type QueryServer interface {
 	ConsensusStates(context.Context, *QueryConsensusStatesRequest) (*QueryConsensusStatesResponse, error)
 	// Status queries the status of an IBC client.
 	ClientStatus(context.Context, *QueryClientStatusRequest) (*QueryClientStatusResponse, error)
+	// PrunableConsensusStates queries the heights of the consensus states of an
+	// IBC client which can be pruned.
+	PrunableConsensusStates(context.Context, *QueryPrunableConsensusStatesRequest) (*QueryPrunableConsensusStatesResponse, error)
 	// ClientParams queries all parameters of the ibc client.
 	ClientParams(context.Context, *QueryClientParamsRequest) (*QueryClientParamsResponse, error)
 	// UpgradedClientState queries an Upgraded IBC light client.
@@ -1088,6 +1214,9 @@ func (*UnimplementedQueryServer) ConsensusStates(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) ClientStatus(ctx context.Context, req *QueryClientStatusRequest) (*QueryClientStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClientStatus not implemented")
 }
+func (*UnimplementedQueryServer) PrunableConsensusStates(ctx context.Context, req *QueryPrunableConsensusStatesRequest) (*QueryPrunableConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrunableConsensusStates not implemented")
+}
 func (*UnimplementedQueryServer) ClientParams(ctx context.Context, req *QueryClientParamsRequest) (*QueryClientParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClientParams not implemented")
 }
@@ -1192,6 +1321,24 @@ func _Query_ClientStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PrunableConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrunableConsensusStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrunableConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/PrunableConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrunableConsensusStates(ctx, req.(*QueryPrunableConsensusStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ClientParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryClientParamsRequest)
 	if err := dec(in); err != nil {
@@ -1270,6 +1417,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClientStatus",
 			Handler:    _Query_ClientStatus_Handler,
 		},
+		{
+			MethodName: "PrunableConsensusStates",
+			Handler:    _Query_PrunableConsensusStates_Handler,
+		},
 		{
 			MethodName: "ClientParams",
 			Handler:    _Query_ClientParams_Handler,
@@ -1706,6 +1857,78 @@ func (m *QueryClientStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPrunableConsensusStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrunableConsensusStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrunableConsensusStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrunableConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrunableConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrunableConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusHeights) > 0 {
+		for iNdEx := len(m.ConsensusHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryClientParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2058,6 +2281,37 @@ func (m *QueryClientStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPrunableConsensusStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryPrunableConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsensusHeights) > 0 {
+		for _, e := range m.ConsensusHeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryClientParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3266,6 +3520,191 @@ func (m *QueryClientStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPrunableConsensusStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrunableConsensusStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrunableConsensusStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrunableConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrunableConsensusStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrunableConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusHeights = append(m.ConsensusHeights, Height{})
+			if err := m.ConsensusHeights[len(m.ConsensusHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryClientParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
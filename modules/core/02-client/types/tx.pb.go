@@ -361,6 +361,101 @@ func (m *MsgSubmitMisbehaviourResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitMisbehaviourResponse proto.InternalMessageInfo
 
+// MsgPruneExpiredConsensusStates defines an sdk.Msg to prune the expired
+// consensus states of an IBC client, along with the oldest consensus states
+// exceeding the maximum number of consensus states kept by a client. At most
+// limit consensus states are pruned by a single message.
+type MsgPruneExpiredConsensusStates struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// maximum number of consensus states pruned
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPruneExpiredConsensusStates) Reset()         { *m = MsgPruneExpiredConsensusStates{} }
+func (m *MsgPruneExpiredConsensusStates) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneExpiredConsensusStates) ProtoMessage()    {}
+func (*MsgPruneExpiredConsensusStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{8}
+}
+func (m *MsgPruneExpiredConsensusStates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneExpiredConsensusStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneExpiredConsensusStates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneExpiredConsensusStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneExpiredConsensusStates.Merge(m, src)
+}
+func (m *MsgPruneExpiredConsensusStates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneExpiredConsensusStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneExpiredConsensusStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneExpiredConsensusStates proto.InternalMessageInfo
+
+// MsgPruneExpiredConsensusStatesResponse defines the
+// Msg/PruneExpiredConsensusStates response type.
+type MsgPruneExpiredConsensusStatesResponse struct {
+	// heights of the pruned consensus states
+	ConsensusHeights []Height `protobuf:"bytes,1,rep,name=consensus_heights,json=consensusHeights,proto3" json:"consensus_heights" yaml:"consensus_heights"`
+}
+
+func (m *MsgPruneExpiredConsensusStatesResponse) Reset() {
+	*m = MsgPruneExpiredConsensusStatesResponse{}
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneExpiredConsensusStatesResponse) ProtoMessage()    {}
+func (*MsgPruneExpiredConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{9}
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneExpiredConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneExpiredConsensusStatesResponse.Merge(m, src)
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneExpiredConsensusStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneExpiredConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *MsgPruneExpiredConsensusStatesResponse) GetConsensusHeights() []Height {
+	if m != nil {
+		return m.ConsensusHeights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateClient)(nil), "ibc.core.client.v1.MsgCreateClient")
 	proto.RegisterType((*MsgCreateClientResponse)(nil), "ibc.core.client.v1.MsgCreateClientResponse")
@@ -370,50 +465,59 @@ func init() {
 	proto.RegisterType((*MsgUpgradeClientResponse)(nil), "ibc.core.client.v1.MsgUpgradeClientResponse")
 	proto.RegisterType((*MsgSubmitMisbehaviour)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviour")
 	proto.RegisterType((*MsgSubmitMisbehaviourResponse)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviourResponse")
+	proto.RegisterType((*MsgPruneExpiredConsensusStates)(nil), "ibc.core.client.v1.MsgPruneExpiredConsensusStates")
+	proto.RegisterType((*MsgPruneExpiredConsensusStatesResponse)(nil), "ibc.core.client.v1.MsgPruneExpiredConsensusStatesResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/tx.proto", fileDescriptor_cb5dc4651eb49a04) }
 
 var fileDescriptor_cb5dc4651eb49a04 = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8e, 0x9b, 0xdf, 0x2f, 0x6a, 0xae, 0x81, 0x56, 0x26, 0xb4, 0xa9, 0xab, 0xda, 0x91, 0xe9,
-	0x10, 0x44, 0x7b, 0x47, 0xd2, 0xa5, 0xea, 0x46, 0x3a, 0x31, 0x44, 0x02, 0x57, 0x0c, 0xb0, 0x04,
-	0xff, 0xb9, 0x5e, 0x4e, 0xc4, 0xbe, 0xc8, 0x67, 0x47, 0xe4, 0x1b, 0x30, 0x32, 0xf0, 0x01, 0x2a,
-	0x06, 0x3e, 0x0b, 0x63, 0x07, 0x06, 0xa6, 0xa8, 0x4a, 0x16, 0xe6, 0x7c, 0x02, 0x14, 0x9f, 0x13,
-	0x62, 0x37, 0x8e, 0x22, 0xfe, 0x6c, 0x3e, 0xbf, 0xcf, 0x3d, 0xcf, 0xfb, 0xf8, 0x79, 0xcf, 0x07,
-	0x0e, 0xa8, 0x65, 0x23, 0x9b, 0xf9, 0x18, 0xd9, 0x5d, 0x8a, 0xbd, 0x00, 0xf5, 0xeb, 0x28, 0x78,
-	0x0f, 0x7b, 0x3e, 0x0b, 0x98, 0x2c, 0x53, 0xcb, 0x86, 0xd3, 0x22, 0x14, 0x45, 0xd8, 0xaf, 0x2b,
-	0x65, 0xc2, 0x08, 0x8b, 0xca, 0x68, 0xfa, 0x24, 0x90, 0xca, 0x3e, 0x61, 0x8c, 0x74, 0x31, 0x8a,
-	0x56, 0x56, 0x78, 0x85, 0x4c, 0x6f, 0x20, 0x4a, 0xfa, 0xad, 0x04, 0xb6, 0x5b, 0x9c, 0x5c, 0xf8,
-	0xd8, 0x0c, 0xf0, 0x45, 0xc4, 0x23, 0xbf, 0x00, 0x25, 0xc1, 0xd8, 0xe6, 0x81, 0x19, 0xe0, 0x8a,
-	0x54, 0x95, 0x6a, 0x5b, 0x8d, 0x32, 0x14, 0x2c, 0x70, 0xc6, 0x02, 0x9f, 0x79, 0x83, 0xe6, 0xde,
-	0x64, 0xa8, 0x3d, 0x18, 0x98, 0x6e, 0xf7, 0x5c, 0x5f, 0xdc, 0xa3, 0x1b, 0x5b, 0x62, 0x79, 0x39,
-	0x5d, 0xc9, 0xaf, 0xc1, 0xb6, 0xcd, 0x3c, 0x8e, 0x3d, 0x1e, 0xf2, 0x98, 0x74, 0x63, 0x05, 0xa9,
-	0x32, 0x19, 0x6a, 0xbb, 0x31, 0x69, 0x72, 0x9b, 0x6e, 0xdc, 0x9f, 0xbf, 0x11, 0xd4, 0xbb, 0xa0,
-	0xc0, 0x29, 0xf1, 0xb0, 0x5f, 0xc9, 0x57, 0xa5, 0x5a, 0xd1, 0x88, 0x57, 0xe7, 0x9b, 0x1f, 0xae,
-	0xb5, 0xdc, 0x8f, 0x6b, 0x2d, 0xa7, 0xef, 0x83, 0xbd, 0x94, 0x43, 0x03, 0xf3, 0xde, 0x94, 0x45,
-	0xff, 0x24, 0xdc, 0xbf, 0xea, 0x39, 0xbf, 0xdc, 0xd7, 0x41, 0x31, 0x76, 0x42, 0x9d, 0xc8, 0x7a,
-	0xb1, 0x59, 0x9e, 0x0c, 0xb5, 0x9d, 0x84, 0x49, 0xea, 0xe8, 0xc6, 0xa6, 0x78, 0x7e, 0xee, 0xc8,
-	0xc7, 0xa0, 0xd0, 0xc1, 0xa6, 0x83, 0xfd, 0x55, 0xae, 0x8c, 0x18, 0xb3, 0x76, 0xc7, 0x8b, 0x5d,
-	0xcd, 0x3b, 0xfe, 0x96, 0x07, 0x3b, 0x51, 0x8d, 0xf8, 0xa6, 0xf3, 0x07, 0x2d, 0xa7, 0x33, 0xde,
-	0xf8, 0x17, 0x19, 0xe7, 0xff, 0x52, 0xc6, 0x2f, 0x41, 0xb9, 0xe7, 0x33, 0x76, 0xd5, 0x0e, 0x85,
-	0xed, 0xb6, 0xd0, 0xad, 0xfc, 0x57, 0x95, 0x6a, 0xa5, 0xa6, 0x36, 0x19, 0x6a, 0x07, 0x82, 0x69,
-	0x19, 0x4a, 0x37, 0xe4, 0xe8, 0x75, 0xf2, 0x93, 0xbd, 0x03, 0x87, 0x29, 0x70, 0xaa, 0xf7, 0xff,
-	0x23, 0xee, 0xda, 0x64, 0xa8, 0x1d, 0x2d, 0xe5, 0x4e, 0xf7, 0xac, 0x24, 0x44, 0xb2, 0x66, 0xb4,
-	0x90, 0x91, 0xb8, 0x02, 0x2a, 0xe9, 0x54, 0xe7, 0x91, 0x7f, 0x91, 0xc0, 0xc3, 0x16, 0x27, 0x97,
-	0xa1, 0xe5, 0xd2, 0xa0, 0x45, 0xb9, 0x85, 0x3b, 0x66, 0x9f, 0xb2, 0xd0, 0xff, 0x9d, 0xdc, 0xcf,
-	0x40, 0xc9, 0x5d, 0xa0, 0x58, 0x39, 0xb0, 0x09, 0xe4, 0x1a, 0x63, 0xab, 0x81, 0xc3, 0xa5, 0x7d,
-	0xce, 0x9c, 0x34, 0x3e, 0xe7, 0x41, 0xbe, 0xc5, 0x89, 0xfc, 0x16, 0x94, 0x12, 0x3f, 0x9c, 0x47,
-	0xf0, 0xee, 0xaf, 0x0c, 0xa6, 0xce, 0xac, 0xf2, 0x64, 0x0d, 0xd0, 0x4c, 0x69, 0xaa, 0x90, 0x38,
-	0xd4, 0x59, 0x0a, 0x8b, 0xa0, 0x4c, 0x85, 0x65, 0x07, 0x51, 0xb6, 0xc1, 0xbd, 0xe4, 0x44, 0x1d,
-	0x65, 0xee, 0x5e, 0x40, 0x29, 0xc7, 0xeb, 0xa0, 0xe6, 0x22, 0x3e, 0x90, 0x97, 0xc4, 0xfe, 0x38,
-	0x83, 0xe3, 0x2e, 0x54, 0xa9, 0xaf, 0x0d, 0x9d, 0x69, 0x36, 0x8d, 0xaf, 0x23, 0x55, 0xba, 0x19,
-	0xa9, 0xd2, 0xed, 0x48, 0x95, 0x3e, 0x8e, 0xd5, 0xdc, 0xcd, 0x58, 0xcd, 0x7d, 0x1f, 0xab, 0xb9,
-	0x37, 0x67, 0x84, 0x06, 0x9d, 0xd0, 0x82, 0x36, 0x73, 0x91, 0xcd, 0xb8, 0xcb, 0x38, 0xa2, 0x96,
-	0x7d, 0x42, 0x18, 0xea, 0x9f, 0x22, 0x97, 0x39, 0x61, 0x17, 0x73, 0x71, 0x5b, 0x3d, 0x6d, 0x9c,
-	0xc4, 0x17, 0x56, 0x30, 0xe8, 0x61, 0x6e, 0x15, 0xa2, 0xb9, 0x3a, 0xfd, 0x19, 0x00, 0x00, 0xff,
-	0xff, 0x64, 0xe7, 0xce, 0xe6, 0xd0, 0x06, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x4e, 0xdb, 0x40,
+	0x18, 0x8f, 0x09, 0x44, 0x70, 0xa4, 0x85, 0xba, 0x29, 0x04, 0x23, 0xec, 0xc8, 0x45, 0x55, 0x2a,
+	0xc0, 0x6e, 0xc2, 0x82, 0xd8, 0x1a, 0x54, 0xa9, 0x1d, 0x22, 0x51, 0xa3, 0x0e, 0xed, 0x02, 0xfe,
+	0x73, 0x5c, 0x4e, 0x8d, 0x7d, 0x91, 0xcf, 0x8e, 0xc8, 0x1b, 0x54, 0xaa, 0x2a, 0x55, 0x6a, 0x1f,
+	0x80, 0xa9, 0x0f, 0xd0, 0xa7, 0x60, 0x64, 0xe8, 0xd0, 0xc9, 0x42, 0xb0, 0x74, 0xce, 0x13, 0x54,
+	0xf1, 0x19, 0x13, 0x9b, 0x24, 0x72, 0x69, 0xbb, 0xf9, 0xee, 0xfb, 0x7d, 0xbf, 0x3f, 0xfe, 0xce,
+	0x27, 0x83, 0x55, 0x6c, 0x98, 0xaa, 0x49, 0x5c, 0xa8, 0x9a, 0x6d, 0x0c, 0x1d, 0x4f, 0xed, 0xd6,
+	0x54, 0xef, 0x44, 0xe9, 0xb8, 0xc4, 0x23, 0x3c, 0x8f, 0x0d, 0x53, 0x19, 0x14, 0x15, 0x56, 0x54,
+	0xba, 0x35, 0xa1, 0x84, 0x08, 0x22, 0x61, 0x59, 0x1d, 0x3c, 0x31, 0xa4, 0xb0, 0x82, 0x08, 0x41,
+	0x6d, 0xa8, 0x86, 0x2b, 0xc3, 0x3f, 0x56, 0x75, 0xa7, 0x17, 0x95, 0xa4, 0x11, 0x0a, 0x11, 0x5d,
+	0x08, 0x90, 0x2f, 0x38, 0xb0, 0xd0, 0xa4, 0x68, 0xcf, 0x85, 0xba, 0x07, 0xf7, 0xc2, 0x0a, 0xbf,
+	0x0f, 0x8a, 0x0c, 0x73, 0x48, 0x3d, 0xdd, 0x83, 0x65, 0xae, 0xc2, 0x55, 0xe7, 0xeb, 0x25, 0x85,
+	0xc9, 0x28, 0xd7, 0x32, 0xca, 0x73, 0xa7, 0xd7, 0x58, 0xee, 0x07, 0xd2, 0xc3, 0x9e, 0x6e, 0xb7,
+	0x77, 0xe5, 0xe1, 0x1e, 0x59, 0x9b, 0x67, 0xcb, 0x83, 0xc1, 0x8a, 0x7f, 0x0b, 0x16, 0x4c, 0xe2,
+	0x50, 0xe8, 0x50, 0x9f, 0x46, 0xa4, 0x53, 0x13, 0x48, 0x85, 0x7e, 0x20, 0x2d, 0x45, 0xa4, 0xc9,
+	0x36, 0x59, 0xbb, 0x1f, 0xef, 0x30, 0xea, 0x25, 0x50, 0xa0, 0x18, 0x39, 0xd0, 0x2d, 0xe7, 0x2b,
+	0x5c, 0x75, 0x4e, 0x8b, 0x56, 0xbb, 0xb3, 0x1f, 0x4e, 0xa5, 0xdc, 0xaf, 0x53, 0x29, 0x27, 0xaf,
+	0x80, 0xe5, 0x54, 0x42, 0x0d, 0xd2, 0xce, 0x80, 0x45, 0xfe, 0xca, 0xd2, 0xbf, 0xe9, 0x58, 0x37,
+	0xe9, 0x6b, 0x60, 0x2e, 0x4a, 0x82, 0xad, 0x30, 0xfa, 0x5c, 0xa3, 0xd4, 0x0f, 0xa4, 0xc5, 0x44,
+	0x48, 0x6c, 0xc9, 0xda, 0x2c, 0x7b, 0x7e, 0x65, 0xf1, 0x9b, 0xa0, 0xd0, 0x82, 0xba, 0x05, 0xdd,
+	0x49, 0xa9, 0xb4, 0x08, 0x93, 0xd9, 0xf1, 0xb0, 0xab, 0xd8, 0xf1, 0x8f, 0x3c, 0x58, 0x0c, 0x6b,
+	0xc8, 0xd5, 0xad, 0xbf, 0xb0, 0x9c, 0x9e, 0xf1, 0xd4, 0xff, 0x98, 0x71, 0xfe, 0x1f, 0xcd, 0xf8,
+	0x35, 0x28, 0x75, 0x5c, 0x42, 0x8e, 0x0f, 0x7d, 0x16, 0xfb, 0x90, 0xe9, 0x96, 0xa7, 0x2b, 0x5c,
+	0xb5, 0xd8, 0x90, 0xfa, 0x81, 0xb4, 0xca, 0x98, 0x46, 0xa1, 0x64, 0x8d, 0x0f, 0xb7, 0x93, 0xaf,
+	0xec, 0x3d, 0x58, 0x4b, 0x81, 0x53, 0xde, 0x67, 0x42, 0xee, 0x6a, 0x3f, 0x90, 0xd6, 0x47, 0x72,
+	0xa7, 0x3d, 0x0b, 0x09, 0x91, 0x71, 0x67, 0xb4, 0x30, 0x66, 0xe2, 0x02, 0x28, 0xa7, 0xa7, 0x1a,
+	0x8f, 0xfc, 0x1b, 0x07, 0x1e, 0x35, 0x29, 0x3a, 0xf0, 0x0d, 0x1b, 0x7b, 0x4d, 0x4c, 0x0d, 0xd8,
+	0xd2, 0xbb, 0x98, 0xf8, 0xee, 0x5d, 0xe6, 0xbe, 0x03, 0x8a, 0xf6, 0x10, 0xc5, 0xc4, 0x03, 0x9b,
+	0x40, 0x66, 0x38, 0xb6, 0x12, 0x58, 0x1b, 0xe9, 0x33, 0x4e, 0xf2, 0x91, 0x03, 0x62, 0x93, 0xa2,
+	0x7d, 0xd7, 0x77, 0xe0, 0x8b, 0x93, 0x0e, 0x76, 0xa1, 0x95, 0x7c, 0x53, 0xf4, 0x2e, 0x91, 0x4a,
+	0x60, 0xa6, 0x8d, 0x6d, 0xec, 0x85, 0x59, 0xa6, 0x35, 0xb6, 0xc8, 0x60, 0xf7, 0x0b, 0x07, 0x9e,
+	0x4c, 0x76, 0x73, 0x6d, 0x9c, 0xc7, 0xe0, 0xc1, 0xcd, 0xc0, 0x5b, 0x10, 0xa3, 0x96, 0x47, 0xcb,
+	0x5c, 0x25, 0x5f, 0x9d, 0xaf, 0x0b, 0xca, 0xed, 0x7b, 0x5a, 0x79, 0x19, 0x42, 0x1a, 0x95, 0xb3,
+	0x40, 0xca, 0xf5, 0x03, 0xa9, 0x9c, 0x3e, 0xe7, 0x11, 0x85, 0xac, 0x2d, 0xc6, 0x7b, 0xac, 0x85,
+	0xd6, 0xbf, 0x4f, 0x83, 0x7c, 0x93, 0x22, 0xfe, 0x08, 0x14, 0x13, 0x97, 0xf2, 0xe3, 0x51, 0x3a,
+	0xa9, 0x7b, 0x4d, 0xd8, 0xc8, 0x00, 0x8a, 0x43, 0x1d, 0x81, 0x62, 0xe2, 0xe2, 0x1b, 0xa7, 0x30,
+	0x0c, 0x12, 0x36, 0x32, 0x80, 0x62, 0x05, 0x13, 0xdc, 0x4b, 0x7e, 0x75, 0xeb, 0x63, 0xbb, 0x87,
+	0x50, 0xc2, 0x66, 0x16, 0x54, 0x2c, 0xe2, 0x02, 0x7e, 0xc4, 0xa7, 0xf1, 0x74, 0x0c, 0xc7, 0x6d,
+	0xa8, 0x50, 0xcb, 0x0c, 0x8d, 0x35, 0x3f, 0x71, 0x60, 0x75, 0xd2, 0x29, 0xae, 0x8f, 0xa1, 0x9c,
+	0xd0, 0x23, 0xec, 0xfe, 0x79, 0xcf, 0xb5, 0x9f, 0x86, 0x76, 0x76, 0x29, 0x72, 0xe7, 0x97, 0x22,
+	0x77, 0x71, 0x29, 0x72, 0x9f, 0xaf, 0xc4, 0xdc, 0xf9, 0x95, 0x98, 0xfb, 0x79, 0x25, 0xe6, 0xde,
+	0xed, 0x20, 0xec, 0xb5, 0x7c, 0x43, 0x31, 0x89, 0xad, 0x9a, 0x84, 0xda, 0x84, 0xaa, 0xd8, 0x30,
+	0xb7, 0x10, 0x51, 0xbb, 0xdb, 0xaa, 0x4d, 0x2c, 0xbf, 0x0d, 0x29, 0xfb, 0x41, 0x78, 0x56, 0xdf,
+	0x8a, 0xfe, 0x11, 0xbc, 0x5e, 0x07, 0x52, 0xa3, 0x10, 0xde, 0x05, 0xdb, 0xbf, 0x07, 0x00, 0x38,
+	0x60, 0x99, 0xfd, 0xa5, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -436,6 +540,9 @@ type MsgClient interface {
 	UpgradeClient(ctx context.Context, in *MsgUpgradeClient, opts ...grpc.CallOption) (*MsgUpgradeClientResponse, error)
 	// SubmitMisbehaviour defines a rpc handler method for MsgSubmitMisbehaviour.
 	SubmitMisbehaviour(ctx context.Context, in *MsgSubmitMisbehaviour, opts ...grpc.CallOption) (*MsgSubmitMisbehaviourResponse, error)
+	// PruneExpiredConsensusStates defines a rpc handler method for
+	// MsgPruneExpiredConsensusStates.
+	PruneExpiredConsensusStates(ctx context.Context, in *MsgPruneExpiredConsensusStates, opts ...grpc.CallOption) (*MsgPruneExpiredConsensusStatesResponse, error)
 }
 
 type msgClient struct {
@@ -482,6 +589,15 @@ func (c *msgClient) SubmitMisbehaviour(ctx context.Context, in *MsgSubmitMisbeha
 	return out, nil
 }
 
+func (c *msgClient) PruneExpiredConsensusStates(ctx context.Context, in *MsgPruneExpiredConsensusStates, opts ...grpc.CallOption) (*MsgPruneExpiredConsensusStatesResponse, error) {
+	out := new(MsgPruneExpiredConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/PruneExpiredConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClient defines a rpc handler method for MsgCreateClient.
@@ -492,6 +608,9 @@ type MsgServer interface {
 	UpgradeClient(context.Context, *MsgUpgradeClient) (*MsgUpgradeClientResponse, error)
 	// SubmitMisbehaviour defines a rpc handler method for MsgSubmitMisbehaviour.
 	SubmitMisbehaviour(context.Context, *MsgSubmitMisbehaviour) (*MsgSubmitMisbehaviourResponse, error)
+	// PruneExpiredConsensusStates defines a rpc handler method for
+	// MsgPruneExpiredConsensusStates.
+	PruneExpiredConsensusStates(context.Context, *MsgPruneExpiredConsensusStates) (*MsgPruneExpiredConsensusStatesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -510,6 +629,9 @@ func (*UnimplementedMsgServer) UpgradeClient(ctx context.Context, req *MsgUpgrad
 func (*UnimplementedMsgServer) SubmitMisbehaviour(ctx context.Context, req *MsgSubmitMisbehaviour) (*MsgSubmitMisbehaviourResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitMisbehaviour not implemented")
 }
+func (*UnimplementedMsgServer) PruneExpiredConsensusStates(ctx context.Context, req *MsgPruneExpiredConsensusStates) (*MsgPruneExpiredConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredConsensusStates not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -587,6 +709,24 @@ func _Msg_SubmitMisbehaviour_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneExpiredConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneExpiredConsensusStates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneExpiredConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/PruneExpiredConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneExpiredConsensusStates(ctx, req.(*MsgPruneExpiredConsensusStates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -607,6 +747,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitMisbehaviour",
 			Handler:    _Msg_SubmitMisbehaviour_Handler,
 		},
+		{
+			MethodName: "PruneExpiredConsensusStates",
+			Handler:    _Msg_PruneExpiredConsensusStates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/client/v1/tx.proto",
@@ -931,6 +1075,85 @@ func (m *MsgSubmitMisbehaviourResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPruneExpiredConsensusStates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneExpiredConsensusStates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneExpiredConsensusStates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneExpiredConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneExpiredConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneExpiredConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusHeights) > 0 {
+		for iNdEx := len(m.ConsensusHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1074,6 +1297,41 @@ func (m *MsgSubmitMisbehaviourResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPruneExpiredConsensusStates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneExpiredConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsensusHeights) > 0 {
+		for _, e := range m.ConsensusHeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1988,6 +2246,223 @@ func (m *MsgSubmitMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPruneExpiredConsensusStates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneExpiredConsensusStates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneExpiredConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneExpiredConsensusStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneExpiredConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusHeights = append(m.ConsensusHeights, Height{})
+			if err := m.ConsensusHeights[len(m.ConsensusHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
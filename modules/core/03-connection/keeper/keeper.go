@@ -62,8 +62,13 @@ func (k Keeper) GenerateConnectionIdentifier(ctx sdk.Context) string {
 	return connectionID
 }
 
-// GetConnection returns a connection with a particular identifier
+// GetConnection returns a connection with a particular identifier. The sentinel localhost
+// connection is returned for the localhost connection identifier.
 func (k Keeper) GetConnection(ctx sdk.Context, connectionID string) (types.ConnectionEnd, bool) {
+	if connectionID == exported.LocalhostConnectionID {
+		return k.GetLocalhostConnection(ctx)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(host.ConnectionKey(connectionID))
 	if bz == nil {
@@ -84,8 +89,13 @@ func (k Keeper) SetConnection(ctx sdk.Context, connectionID string, connection t
 }
 
 // GetTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the
-// given height.
+// given height. The localhost client does not store consensus states, the current block
+// time is returned for connections using it.
 func (k Keeper) GetTimestampAtHeight(ctx sdk.Context, connection types.ConnectionEnd, height exported.Height) (uint64, error) {
+	if connection.GetClientID() == exported.Localhost {
+		return uint64(ctx.BlockTime().UnixNano()), nil
+	}
+
 	consensusState, found := k.clientKeeper.GetClientConsensusState(
 		ctx, connection.GetClientID(), height,
 	)
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// GetLocalhostConnection returns the sentinel localhost connection end. The sentinel connection
+// is not stored and is not created by a connection handshake. It is OPEN, uses the localhost
+// client, and is its own counterparty. It is only found if the localhost client exists and is
+// registered on the client allowlist, thus removing the localhost client from the allowlist
+// disables all channels built on top of it.
+func (k Keeper) GetLocalhostConnection(ctx sdk.Context) (types.ConnectionEnd, bool) {
+	if !k.clientKeeper.GetParams(ctx).IsAllowedClient(exported.Localhost) {
+		return types.ConnectionEnd{}, false
+	}
+
+	if _, found := k.clientKeeper.GetClientState(ctx, exported.Localhost); !found {
+		return types.ConnectionEnd{}, false
+	}
+
+	counterparty := types.NewCounterparty(
+		exported.Localhost, exported.LocalhostConnectionID,
+		commitmenttypes.NewMerklePrefix([]byte(k.storeKey.Name())),
+	)
+
+	return types.NewConnectionEnd(
+		types.OPEN, exported.Localhost, counterparty,
+		types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0,
+	), true
+}
+
+// clientStore returns the store provided to the connection's client for state verification.
+// The localhost client verifies state by reading the IBC store of the executing chain directly,
+// thus it is provided the IBC store rather than its client prefixed store.
+func (k Keeper) clientStore(ctx sdk.Context, clientID string) sdk.KVStore {
+	if clientID == exported.Localhost {
+		return ctx.KVStore(k.storeKey)
+	}
+
+	return k.clientKeeper.ClientStore(ctx, clientID)
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestGetLocalhostConnection() {
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"localhost client does not exist", func() {
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), exported.Localhost)
+			clientStore.Delete(host.ClientStateKey())
+		}, false},
+		{"localhost client is not registered on the allowlist", func() {
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.AllowedClients = []string{exported.Tendermint}
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewLocalhostPath(suite.chainA)
+			suite.coordinator.SetupConnections(path)
+
+			tc.malleate()
+
+			connection, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetConnection(suite.chainA.GetContext(), exported.LocalhostConnectionID)
+
+			if tc.expPass {
+				suite.Require().True(found)
+				suite.Require().Equal(types.OPEN, connection.State)
+				suite.Require().Equal(exported.Localhost, connection.ClientId)
+				suite.Require().Equal(exported.Localhost, connection.Counterparty.ClientId)
+				suite.Require().Equal(exported.LocalhostConnectionID, connection.Counterparty.ConnectionId)
+				suite.Require().Equal(suite.chainA.GetPrefix(), connection.Counterparty.Prefix)
+			} else {
+				suite.Require().False(found)
+			}
+		})
+	}
+}
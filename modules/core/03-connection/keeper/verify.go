@@ -20,7 +20,7 @@ func (k Keeper) VerifyClientState(
 	clientState exported.ClientState,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.clientStore(ctx, clientID)
 
 	targetClient, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -51,7 +51,7 @@ func (k Keeper) VerifyClientConsensusState(
 	consensusState exported.ConsensusState,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.clientStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -83,7 +83,7 @@ func (k Keeper) VerifyConnectionState(
 	connectionEnd exported.ConnectionI, // opposite connection
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.clientStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -116,7 +116,7 @@ func (k Keeper) VerifyChannelState(
 	channel exported.ChannelI,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.clientStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -138,6 +138,74 @@ func (k Keeper) VerifyChannelState(
 	return nil
 }
 
+// VerifyChannelUpgrade verifies a proof of the upgrade proposed by the specified
+// channel end, under the specified port, stored on the target machine.
+func (k Keeper) VerifyChannelUpgrade(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	upgrade exported.UpgradeI,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	if err := clientState.VerifyChannelUpgrade(
+		clientStore, k.cdc, height,
+		connection.GetCounterparty().GetPrefix(), proof,
+		portID, channelID, upgrade,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed channel upgrade verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyChannelUpgradeError verifies a proof of the upgrade error receipt of the
+// specified channel end, under the specified port, stored on the target machine.
+func (k Keeper) VerifyChannelUpgradeError(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	errorReceipt exported.ErrorReceiptI,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	if err := clientState.VerifyChannelUpgradeError(
+		clientStore, k.cdc, height,
+		connection.GetCounterparty().GetPrefix(), proof,
+		portID, channelID, errorReceipt,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed channel upgrade error receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
 // the specified port, specified channel, and specified sequence.
 func (k Keeper) VerifyPacketCommitment(
@@ -151,7 +219,7 @@ func (k Keeper) VerifyPacketCommitment(
 	commitmentBytes []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.clientStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -178,6 +246,45 @@ func (k Keeper) VerifyPacketCommitment(
 	return nil
 }
 
+// VerifyPacketCommitments verifies a single batch proof of multiple outgoing packet
+// commitments at the specified port, specified channel, and the sequences provided.
+func (k Keeper) VerifyPacketCommitments(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	commitments map[uint64][]byte,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	if err := clientState.VerifyPacketCommitments(
+		ctx, clientStore, k.cdc, height,
+		timeDelay, blockDelay,
+		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+		commitments,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed batch packet commitment verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyPacketAcknowledgement verifies a proof of an incoming packet
 // acknowledgement at the specified port, specified channel, and specified sequence.
 func (k Keeper) VerifyPacketAcknowledgement(
@@ -191,7 +298,7 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	acknowledgement []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.clientStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -218,6 +325,46 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	return nil
 }
 
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (k Keeper) VerifyPacketReceipt(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	if err := clientState.VerifyPacketReceipt(
+		ctx, clientStore, k.cdc, height,
+		timeDelay, blockDelay,
+		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+		sequence, receipt,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed packet receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
 // incoming packet receipt at the specified port, specified channel, and
 // specified sequence.
@@ -231,7 +378,7 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	sequence uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.clientStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -270,7 +417,7 @@ func (k Keeper) VerifyNextSequenceRecv(
 	nextSequenceRecv uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.clientStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -45,6 +45,9 @@ func (msg MsgConnectionOpenInit) ValidateBasic() error {
 	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
 		return sdkerrors.Wrap(err, "invalid client ID")
 	}
+	if msg.ClientId == exported.Localhost {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "localhost connection handshakes are disallowed")
+	}
 	if msg.Counterparty.ConnectionId != "" {
 		return sdkerrors.Wrap(ErrInvalidCounterparty, "counterparty connection identifier must be empty")
 	}
@@ -110,6 +113,9 @@ func (msg MsgConnectionOpenTry) ValidateBasic() error {
 	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
 		return sdkerrors.Wrap(err, "invalid client ID")
 	}
+	if msg.ClientId == exported.Localhost {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "localhost connection handshakes are disallowed")
+	}
 	// counterparty validate basic allows empty counterparty connection identifiers
 	if err := host.ConnectionIdentifierValidator(msg.Counterparty.ConnectionId); err != nil {
 		return sdkerrors.Wrap(err, "invalid counterparty connection ID")
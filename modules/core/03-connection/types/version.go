@@ -10,10 +10,10 @@ import (
 
 var (
 	// DefaultIBCVersion represents the latest supported version of IBC used
-	// in connection version negotiation. The current version supports only
-	// ORDERED and UNORDERED channels and requires at least one channel type
-	// to be agreed upon.
-	DefaultIBCVersion = NewVersion(DefaultIBCVersionIdentifier, []string{"ORDER_ORDERED", "ORDER_UNORDERED"})
+	// in connection version negotiation. The current version supports
+	// ORDERED, UNORDERED and ORDERED_ALLOW_TIMEOUT channels and requires at
+	// least one channel type to be agreed upon.
+	DefaultIBCVersion = NewVersion(DefaultIBCVersionIdentifier, []string{"ORDER_ORDERED", "ORDER_UNORDERED", "ORDER_ORDERED_ALLOW_TIMEOUT"})
 
 	// DefaultIBCVersionIdentifier is the IBC v1.0.0 protocol version identifier
 	DefaultIBCVersionIdentifier = "1"
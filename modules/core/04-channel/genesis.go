@@ -1,6 +1,8 @@
 package channel
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
@@ -12,6 +14,7 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, channel := range gs.Channels {
 		ch := types.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version)
+		ch.UpgradeSequence = channel.UpgradeSequence
 		k.SetChannel(ctx, channel.PortId, channel.ChannelId, ch)
 	}
 	for _, ack := range gs.Acknowledgements {
@@ -21,6 +24,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 		k.SetPacketCommitment(ctx, commitment.PortId, commitment.ChannelId, commitment.Sequence, commitment.Data)
 	}
 	for _, receipt := range gs.Receipts {
+		if bytes.Equal(receipt.Data, types.TimeoutReceipt) {
+			k.SetPacketTimeoutReceipt(ctx, receipt.PortId, receipt.ChannelId, receipt.Sequence)
+			continue
+		}
 		k.SetPacketReceipt(ctx, receipt.PortId, receipt.ChannelId, receipt.Sequence)
 	}
 	for _, ss := range gs.SendSequences {
@@ -177,6 +177,29 @@ func EmitRecvPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types
 	})
 }
 
+// EmitWriteTimeoutReceiptEvent emits an event that the relayer can query for when a packet
+// which timed out on an ORDERED_ALLOW_TIMEOUT channel is received
+func EmitWriteTimeoutReceiptEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWriteTimeoutReceipt,
+			sdk.NewAttribute(types.AttributeKeyTimeoutHeight, packet.GetTimeoutHeight().String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
+			sdk.NewAttribute(types.AttributeKeyConnection, channel.ConnectionHops[0]),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // EmitWriteAcknowledgementEvent emits an event that the relayer can query for
 func EmitWriteAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, acknowledgement []byte) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -250,3 +273,79 @@ func EmitTimeoutPacketEvent(ctx sdk.Context, packet exported.PacketI, channel ty
 		),
 	})
 }
+
+// EmitChannelUpgradeInitEvent emits a channel upgrade init event
+func EmitChannelUpgradeInitEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel, upgrade types.Upgrade) {
+	emitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeInit, portID, channelID, channel, upgrade)
+}
+
+// EmitChannelUpgradeTryEvent emits a channel upgrade try event
+func EmitChannelUpgradeTryEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel, upgrade types.Upgrade) {
+	emitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeTry, portID, channelID, channel, upgrade)
+}
+
+// EmitChannelUpgradeAckEvent emits a channel upgrade ack event. The channel provided
+// is the upgraded channel.
+func EmitChannelUpgradeAckEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+	emitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeAck, portID, channelID, channel,
+		types.Upgrade{Ordering: channel.Ordering, ConnectionHops: channel.ConnectionHops, Version: channel.Version},
+	)
+}
+
+// EmitChannelUpgradeConfirmEvent emits a channel upgrade confirm event. The channel
+// provided is the upgraded channel.
+func EmitChannelUpgradeConfirmEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+	emitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeConfirm, portID, channelID, channel,
+		types.Upgrade{Ordering: channel.Ordering, ConnectionHops: channel.ConnectionHops, Version: channel.Version},
+	)
+}
+
+// EmitChannelUpgradeTimeoutEvent emits a channel upgrade timeout event
+func EmitChannelUpgradeTimeoutEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel, upgrade types.Upgrade) {
+	emitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeTimeout, portID, channelID, channel, upgrade)
+}
+
+// EmitChannelUpgradeCancelEvent emits a channel upgrade cancel event
+func EmitChannelUpgradeCancelEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel, upgrade types.Upgrade) {
+	emitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeCancel, portID, channelID, channel, upgrade)
+}
+
+// EmitChannelUpgradeErrorEvent emits a channel upgrade error event when an error
+// receipt is written for an aborted upgrade
+func EmitChannelUpgradeErrorEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel, errorReceipt types.ErrorReceipt) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelUpgradeError,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", errorReceipt.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeError, errorReceipt.Message),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+func emitChannelUpgradeEvent(ctx sdk.Context, eventType string, portID string, channelID string, channel types.Channel, upgrade types.Upgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", channel.UpgradeSequence)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeOrdering, upgrade.Ordering.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeConnection, upgrade.ConnectionHops[0]),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, upgrade.Version),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -39,6 +39,15 @@ func (k Keeper) SendPacket(
 		)
 	}
 
+	// packets cannot be sent while the channel is upgrading as the channel parameters
+	// they would be sent with may change once the upgrade completes
+	if channel.IsUpgrading() {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel is upgrading (got %s)", channel.State.String(),
+		)
+	}
+
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())) {
 		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
 	}
@@ -83,14 +92,9 @@ func (k Keeper) SendPacket(
 		)
 	}
 
-	clientType, _, err := clienttypes.ParseClientIdentifier(connectionEnd.GetClientID())
-	if err != nil {
-		return err
-	}
-
 	// NOTE: this is a temporary fix. Solo machine does not support usage of 'GetTimestampAtHeight'
 	// A future change should move this function to be a ClientState callback.
-	if clientType != exported.Solomachine {
+	if clientType := clientState.ClientType(); clientType != exported.Solomachine && clientType != exported.SolomachineV3 {
 		latestTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
 		if err != nil {
 			return err
@@ -153,10 +157,11 @@ func (k Keeper) RecvPacket(
 		return sdkerrors.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
 
-	if channel.State != types.OPEN {
+	// packets sent before a channel upgrade can still be received while the channel is upgrading
+	if channel.State != types.OPEN && !channel.IsUpgrading() {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state is not OPEN, INITUPGRADE or TRYUPGRADE (got %s)", channel.State.String(),
 		)
 	}
 
@@ -169,36 +174,175 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
-	// packet must come from the channel's counterparty
-	if packet.GetSourcePort() != channel.Counterparty.PortId {
+	if err := validateRecvPacket(ctx, channel, packet); err != nil {
+		return err
+	}
+
+	if err := k.validateRecvPacketOnUpgradingChannel(ctx, channel, packet); err != nil {
+		return err
+	}
+
+	// Connection must be OPEN to receive a packet. It is possible for connection to not yet be open if packet was
+	// sent optimistically before connection and channel handshake completed. However, to receive a packet,
+	// connection and channel must both be open
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
 		return sdkerrors.Wrapf(
-			types.ErrInvalidPacket,
-			"packet source port doesn't match the counterparty's port (%s ≠ %s)", packet.GetSourcePort(), channel.Counterparty.PortId,
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
 		)
 	}
 
-	if packet.GetSourceChannel() != channel.Counterparty.ChannelId {
-		return sdkerrors.Wrapf(
-			types.ErrInvalidPacket,
-			"packet source channel doesn't match the counterparty's channel (%s ≠ %s)", packet.GetSourceChannel(), channel.Counterparty.ChannelId,
+	commitment := types.CommitPacket(k.cdc, packet)
+
+	// verify that the counterparty did commit to sending this packet
+	if err := k.connectionKeeper.VerifyPacketCommitment(
+		ctx, connectionEnd, proofHeight, proof,
+		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+		commitment,
+	); err != nil {
+		return sdkerrors.Wrap(err, "couldn't verify counterparty packet commitment")
+	}
+
+	return k.receivePacket(ctx, channel, packet)
+}
+
+// RecvPackets is called by a module in order to receive & process a batch of IBC packets
+// sent on the same channel by the corresponding module on the counterparty chain. The
+// packet commitments are verified using a single batch proof at the provided proof height.
+// The returned slice reports, in order, whether each packet was received. Packets which
+// have already been received are skipped, matching the no-op behaviour of RecvPacket.
+func (k Keeper) RecvPackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []exported.PacketI,
+	proof []byte,
+	proofHeight exported.Height,
+) ([]bool, error) {
+	if len(packets) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	portID, channelID := packets[0].GetDestPort(), packets[0].GetDestChannel()
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrChannelNotFound, channelID)
+	}
+
+	// packets sent before a channel upgrade can still be received while the channel is upgrading
+	if channel.State != types.OPEN && !channel.IsUpgrading() {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN, INITUPGRADE or TRYUPGRADE (got %s)", channel.State.String(),
 		)
 	}
 
+	// Authenticate capability to ensure caller has authority to receive packets on this channel
+	capName := host.ChannelCapabilityPath(portID, channelID)
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidChannelCapability,
+			"channel capability failed authentication for capability name %s", capName,
+		)
+	}
+
+	commitments := make(map[uint64][]byte, len(packets))
+	for _, packet := range packets {
+		if packet.GetDestPort() != portID || packet.GetDestChannel() != channelID {
+			return nil, sdkerrors.Wrapf(
+				types.ErrInvalidPacket,
+				"packet destination doesn't match the channel receiving the batch (%s/%s ≠ %s/%s)",
+				packet.GetDestPort(), packet.GetDestChannel(), portID, channelID,
+			)
+		}
+
+		if _, found := commitments[packet.GetSequence()]; found {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPacket, "duplicate packet sequence %d", packet.GetSequence())
+		}
+
+		if err := validateRecvPacket(ctx, channel, packet); err != nil {
+			return nil, sdkerrors.Wrapf(err, "packet sequence %d", packet.GetSequence())
+		}
+
+		if err := k.validateRecvPacketOnUpgradingChannel(ctx, channel, packet); err != nil {
+			return nil, sdkerrors.Wrapf(err, "packet sequence %d", packet.GetSequence())
+		}
+
+		commitments[packet.GetSequence()] = types.CommitPacket(k.cdc, packet)
+	}
+
 	// Connection must be OPEN to receive a packet. It is possible for connection to not yet be open if packet was
 	// sent optimistically before connection and channel handshake completed. However, to receive a packet,
 	// connection and channel must both be open
 	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
 	if !found {
-		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+		return nil, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
 
 	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
-		return sdkerrors.Wrapf(
+		return nil, sdkerrors.Wrapf(
 			connectiontypes.ErrInvalidConnectionState,
 			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
 		)
 	}
 
+	// verify that the counterparty did commit to sending all of the packets
+	if err := k.connectionKeeper.VerifyPacketCommitments(
+		ctx, connectionEnd, proofHeight, proof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		commitments,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "couldn't verify counterparty packet commitments")
+	}
+
+	received := make([]bool, len(packets))
+	for i, packet := range packets {
+		switch err := k.receivePacket(ctx, channel, packet); err {
+		case nil:
+			received[i] = true
+		case types.ErrNoOpMsg, types.ErrTimeoutReceiptWritten:
+			// packet has already been received or timed out, continue with the remaining packets
+		default:
+			return nil, sdkerrors.Wrapf(err, "packet sequence %d", packet.GetSequence())
+		}
+	}
+
+	return received, nil
+}
+
+// validateRecvPacket performs the stateless checks required to receive a packet on the given channel.
+// The packet must come from the channel's counterparty and must not have timed out, unless the channel
+// is ORDERED_ALLOW_TIMEOUT in which case a timeout receipt is written for the packet when it is received.
+func validateRecvPacket(ctx sdk.Context, channel types.Channel, packet exported.PacketI) error {
+	// packet must come from the channel's counterparty
+	if packet.GetSourcePort() != channel.Counterparty.PortId {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidPacket,
+			"packet source port doesn't match the counterparty's port (%s ≠ %s)", packet.GetSourcePort(), channel.Counterparty.PortId,
+		)
+	}
+
+	if packet.GetSourceChannel() != channel.Counterparty.ChannelId {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidPacket,
+			"packet source channel doesn't match the counterparty's channel (%s ≠ %s)", packet.GetSourceChannel(), channel.Counterparty.ChannelId,
+		)
+	}
+
+	if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+		return nil
+	}
+
+	return checkRecvPacketTimeout(ctx, packet)
+}
+
+// checkRecvPacketTimeout returns an error if the packet timed out on this chain.
+func checkRecvPacketTimeout(ctx sdk.Context, packet exported.PacketI) error {
 	// check if packet timeouted by comparing it with the latest height of the chain
 	selfHeight := clienttypes.GetSelfHeight(ctx)
 	timeoutHeight := packet.GetTimeoutHeight()
@@ -217,17 +361,15 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
-	commitment := types.CommitPacket(k.cdc, packet)
-
-	// verify that the counterparty did commit to sending this packet
-	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
-		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
-		commitment,
-	); err != nil {
-		return sdkerrors.Wrap(err, "couldn't verify counterparty packet commitment")
-	}
+	return nil
+}
 
+// receivePacket writes the packet receipt (unordered) or increments the next sequence receive (ordered)
+// for a packet whose commitment has already been verified. It returns ErrNoOpMsg if the packet has
+// already been received. A packet which timed out on an ORDERED_ALLOW_TIMEOUT channel increments the
+// next sequence receive and writes a timeout receipt instead, in which case ErrTimeoutReceiptWritten
+// is returned and the packet must not be executed.
+func (k Keeper) receivePacket(ctx sdk.Context, channel types.Channel, packet exported.PacketI) error {
 	switch channel.Ordering {
 	case types.UNORDERED:
 		// check if the packet receipt has been received already for unordered channels
@@ -246,7 +388,7 @@ func (k Keeper) RecvPacket(
 		// it's just a single store key set to an empty string to indicate that the packet has been received
 		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// check if the packet is being received in order
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
 		if !found {
@@ -279,6 +421,26 @@ func (k Keeper) RecvPacket(
 		// Since this is the receiving chain, our channelEnd is packet's destination port and channel
 		k.SetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv)
 
+		if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+			if err := checkRecvPacketTimeout(ctx, packet); err != nil {
+				// the timed out packet is skipped so that the following packets can be received, the
+				// timeout receipt allows the sending chain to time out the packet without closing the channel
+				k.SetPacketTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+				k.Logger(ctx).Info(
+					"timeout receipt written",
+					"sequence", packet.GetSequence(),
+					"src_port", packet.GetSourcePort(),
+					"src_channel", packet.GetSourceChannel(),
+					"dst_port", packet.GetDestPort(),
+					"dst_channel", packet.GetDestChannel(),
+				)
+
+				EmitWriteTimeoutReceiptEvent(ctx, packet, channel)
+
+				return types.ErrTimeoutReceiptWritten
+			}
+		}
 	}
 
 	// log that a packet has been received & executed
@@ -319,10 +481,11 @@ func (k Keeper) WriteAcknowledgement(
 		return sdkerrors.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
 
-	if channel.State != types.OPEN {
+	// acknowledgements can still be written for packets received while the channel is upgrading
+	if channel.State != types.OPEN && !channel.IsUpgrading() {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state is not OPEN, INITUPGRADE or TRYUPGRADE (got %s)", channel.State.String(),
 		)
 	}
 
@@ -394,10 +557,11 @@ func (k Keeper) AcknowledgePacket(
 		)
 	}
 
-	if channel.State != types.OPEN {
+	// packets sent before a channel upgrade can still be acknowledged while the channel is upgrading
+	if channel.State != types.OPEN && !channel.IsUpgrading() {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state is not OPEN, INITUPGRADE or TRYUPGRADE (got %s)", channel.State.String(),
 		)
 	}
 
@@ -463,7 +627,7 @@ func (k Keeper) AcknowledgePacket(
 	}
 
 	// assert packets acknowledged in order
-	if channel.Ordering == types.ORDERED {
+	if channel.Ordering.IsOrdered() {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if !found {
 			return sdkerrors.Wrapf(
@@ -479,7 +643,7 @@ func (k Keeper) AcknowledgePacket(
 			)
 		}
 
-		// All verification complete, in the case of ORDERED channels we must increment nextSequenceAck
+		// All verification complete, in the case of ORDERED and ORDERED_ALLOW_TIMEOUT channels we must increment nextSequenceAck
 		nextSequenceAck++
 
 		// incrementing NextSequenceAck and storing under this chain's channelEnd identifiers
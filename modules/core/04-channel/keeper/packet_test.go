@@ -490,6 +490,215 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 
 }
 
+func (suite *KeeperTestSuite) TestRecvPackets() {
+	var (
+		path        *ibctesting.Path
+		packets     []exported.PacketI
+		channelCap  *capabilitytypes.Capability
+		expReceived []bool
+		expError    *sdkerrors.Error
+	)
+
+	// sendPackets sends packets with sequences [1, n] from chainA to chainB
+	sendPackets := func(n uint64) {
+		packets = nil
+		for seq := uint64(1); seq <= n; seq++ {
+			packet := types.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			packets = append(packets, packet)
+		}
+	}
+
+	testCases := []testCase{
+		{"success: ORDERED channel", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			sendPackets(3)
+			expReceived = []bool{true, true, true}
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, true},
+		{"success: UNORDERED channel", func() {
+			// setup uses an UNORDERED channel
+			suite.coordinator.Setup(path)
+
+			sendPackets(3)
+			expReceived = []bool{true, true, true}
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, true},
+		{"success with out of order packets: UNORDERED channel", func() {
+			// setup uses an UNORDERED channel
+			suite.coordinator.Setup(path)
+
+			sendPackets(3)
+			packets = []exported.PacketI{packets[2], packets[0]}
+			expReceived = []bool{true, true}
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, true},
+		{"success with packet already relayed: ORDERED channel", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			sendPackets(3)
+			err := path.EndpointB.RecvPacket(packets[0].(types.Packet))
+			suite.Require().NoError(err)
+
+			expReceived = []bool{false, true, true}
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, true},
+		{"success with packet already relayed: UNORDERED channel", func() {
+			// setup uses an UNORDERED channel
+			suite.coordinator.Setup(path)
+
+			sendPackets(3)
+			err := path.EndpointB.RecvPacket(packets[1].(types.Packet))
+			suite.Require().NoError(err)
+
+			expReceived = []bool{true, false, true}
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, true},
+		{"out of order packets failure with ORDERED channel", func() {
+			expError = types.ErrPacketSequenceOutOfOrder
+
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			sendPackets(3)
+			// attempts to receive packets 2 and 3 without receiving packet 1
+			packets = packets[1:]
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+		{"empty packets", func() {
+			expError = types.ErrInvalidPacket
+			suite.coordinator.Setup(path)
+
+			packets = nil
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+		{"duplicate packet sequence", func() {
+			expError = types.ErrInvalidPacket
+			suite.coordinator.Setup(path)
+
+			sendPackets(2)
+			packets = append(packets, packets[0])
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+		{"packets destined to different channels", func() {
+			expError = types.ErrInvalidPacket
+			suite.coordinator.Setup(path)
+
+			sendPackets(2)
+			packets[1] = types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, ibctesting.InvalidID, timeoutHeight, disabledTimeoutTimestamp)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+		{"channel not found", func() {
+			expError = types.ErrChannelNotFound
+
+			// use wrong channel naming
+			suite.coordinator.Setup(path)
+			packets = []exported.PacketI{types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.InvalidID, ibctesting.InvalidID, timeoutHeight, disabledTimeoutTimestamp)}
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+		{"channel not open", func() {
+			expError = types.ErrInvalidChannelState
+			suite.coordinator.Setup(path)
+
+			sendPackets(2)
+			err := path.EndpointB.SetChannelClosed()
+			suite.Require().NoError(err)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+		{"capability cannot authenticate", func() {
+			expError = types.ErrInvalidChannelCapability
+			suite.coordinator.Setup(path)
+
+			sendPackets(2)
+			channelCap = capabilitytypes.NewCapability(3)
+		}, false},
+		{"packet source port ≠ channel counterparty port", func() {
+			expError = types.ErrInvalidPacket
+			suite.coordinator.Setup(path)
+
+			sendPackets(2)
+			packets[1] = types.NewPacket(ibctesting.MockPacketData, 2, ibctesting.InvalidID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+		{"timeout height passed for one packet", func() {
+			expError = types.ErrPacketTimeout
+			suite.coordinator.Setup(path)
+
+			sendPackets(1)
+			packets = append(packets, types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp))
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+		{"validation failed", func() {
+			// skip error code check, downstream error code is used from light-client implementations
+
+			// packet commitment for sequence 3 not set resulting in invalid proof
+			suite.coordinator.Setup(path)
+
+			sendPackets(2)
+			packets = append(packets, types.NewPacket(ibctesting.MockPacketData, 3, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp))
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+			expError = nil    // must explicitly set for failed cases
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			var (
+				proof       []byte
+				proofHeight clienttypes.Height
+			)
+			if len(packets) > 0 {
+				// get batch proof of packet commitments from chainA
+				var keys [][]byte
+				for _, packet := range packets {
+					keys = append(keys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+				}
+				proof, proofHeight = path.EndpointA.QueryBatchProof(keys...)
+			}
+
+			received, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPackets(suite.chainB.GetContext(), channelCap, packets, proof, proofHeight)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expReceived, received)
+
+				channelB, _ := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.Require().True(found)
+
+				if channelB.Ordering == types.ORDERED {
+					suite.Require().Equal(packets[len(packets)-1].GetSequence()+1, nextSeqRecv, "sequence not incremented in ordered channel")
+				} else {
+					suite.Require().Equal(uint64(1), nextSeqRecv, "sequence incremented for UNORDERED channel")
+				}
+
+				for _, packet := range packets {
+					_, receiptStored := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().Equal(channelB.Ordering == types.UNORDERED, receiptStored)
+				}
+			} else {
+				suite.Require().Error(err)
+
+				// only check if expError is set, since not all error codes can be known
+				if expError != nil {
+					suite.Require().True(errors.Is(err, expError))
+				}
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 	var (
 		path       *ibctesting.Path
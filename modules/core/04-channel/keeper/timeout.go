@@ -82,10 +82,11 @@ func (k Keeper) TimeoutPacket(
 		return types.ErrNoOpMsg
 	}
 
-	if channel.State != types.OPEN {
+	// packets sent before a channel upgrade can still be timed out while the channel is upgrading
+	if channel.State != types.OPEN && !channel.IsUpgrading() {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state is not OPEN, INITUPGRADE or TRYUPGRADE (got %s)", channel.State.String(),
 		)
 	}
 
@@ -111,6 +112,17 @@ func (k Keeper) TimeoutPacket(
 			ctx, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		if err := k.validateNextSequenceAck(ctx, packet); err != nil {
+			return err
+		}
+
+		// check that the counterparty skipped the packet and wrote a timeout receipt
+		err = k.connectionKeeper.VerifyPacketReceipt(
+			ctx, connectionEnd, proofHeight, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+			types.TimeoutReceipt,
+		)
 	case types.UNORDERED:
 		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
 			ctx, connectionEnd, proofHeight, proof,
@@ -130,6 +142,8 @@ func (k Keeper) TimeoutPacket(
 
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
 // If the timed-out packet came from an ORDERED channel then this channel will be closed.
+// If the timed-out packet came from an ORDERED_ALLOW_TIMEOUT channel then the next sequence
+// ack is incremented and the channel remains open.
 //
 // CONTRACT: this function must be called in the IBC handler
 func (k Keeper) TimeoutExecuted(
@@ -152,9 +166,13 @@ func (k Keeper) TimeoutExecuted(
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
-	if channel.Ordering == types.ORDERED {
+	switch channel.Ordering {
+	case types.ORDERED:
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		// timeouts are processed in order, the packet sequence is the next sequence ack
+		k.SetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1)
 	}
 
 	k.Logger(ctx).Info(
@@ -252,7 +270,13 @@ func (k Keeper) TimeoutOnClose(
 
 	var err error
 	switch channel.Ordering {
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
+		if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+			if err := k.validateNextSequenceAck(ctx, packet); err != nil {
+				return err
+			}
+		}
+
 		// check that packet has not been received
 		if nextSequenceRecv > packet.GetSequence() {
 			return sdkerrors.Wrapf(types.ErrInvalidPacket, "packet already received, next sequence receive > packet sequence (%d > %d", nextSequenceRecv, packet.GetSequence())
@@ -279,3 +303,24 @@ func (k Keeper) TimeoutOnClose(
 	// NOTE: the remaining code is located in the TimeoutExecuted function
 	return nil
 }
+
+// validateNextSequenceAck checks that the packet sequence is the next sequence ack of the sending
+// channel end. Packets sent on ORDERED_ALLOW_TIMEOUT channels are acknowledged and timed out in order.
+func (k Keeper) validateNextSequenceAck(ctx sdk.Context, packet exported.PacketI) error {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return sdkerrors.Wrapf(
+			types.ErrSequenceAckNotFound,
+			"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if packet.GetSequence() != nextSequenceAck {
+		return sdkerrors.Wrapf(
+			types.ErrPacketSequenceOutOfOrder,
+			"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+		)
+	}
+
+	return nil
+}
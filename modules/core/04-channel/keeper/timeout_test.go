@@ -47,6 +47,20 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 			// need to update chainA's client representing chainB to prove missing ack
 			path.EndpointA.UpdateClient()
 		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT", func() {
+			// the timeout receipt is proven using the packet receipt key
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+			// write the timeout receipt on chainB
+			err := path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+			// need to update chainA's client representing chainB to prove timeout receipt
+			path.EndpointA.UpdateClient()
+		}, true},
 		{"packet already timed out: ORDERED", func() {
 			expError = types.ErrNoOpMsg
 			ordered = true
@@ -142,6 +156,40 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
 			path.EndpointA.UpdateClient()
 		}, false},
+		{"packet sequence ≠ next ack sequence: ORDERED_ALLOW_TIMEOUT", func() {
+			expError = types.ErrPacketSequenceOutOfOrder
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+
+			suite.coordinator.Setup(path)
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			var packets []types.Packet
+			for seq := uint64(1); seq <= 2; seq++ {
+				packet = types.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				path.EndpointA.SendPacket(packet)
+				packets = append(packets, packet)
+			}
+			for _, p := range packets {
+				err := path.EndpointB.UpdateClient()
+				suite.Require().NoError(err)
+				err = path.EndpointB.RecvPacket(p)
+				suite.Require().NoError(err)
+			}
+			// attempt to time out packet 2 before packet 1
+			path.EndpointA.UpdateClient()
+		}, false},
+		{"timeout receipt verification failed: ORDERED_ALLOW_TIMEOUT", func() {
+			// skip error check, error occurs in light-clients
+
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+			// no timeout receipt is written on chainB
+			path.EndpointA.UpdateClient()
+		}, false},
 		{"next seq receive verification failed", func() {
 			// skip error check, error occurs in light-clients
 
@@ -432,3 +480,64 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 	}
 
 }
+
+// TestTimeoutPacketOrderedAllowTimeout tests that a timed out packet on an ORDERED_ALLOW_TIMEOUT
+// channel is skipped by the receiving chain and timed out on the sending chain without closing
+// the channel.
+func (suite *KeeperTestSuite) TestTimeoutPacketOrderedAllowTimeout() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	suite.coordinator.Setup(path)
+
+	// packet 1 times out, packet 2 does not
+	timedOutPacket := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+	err := path.EndpointA.SendPacket(timedOutPacket)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	err = path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	// packet 2 cannot be received before packet 1 is processed
+	err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacket(suite.chainB.GetContext(), suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID), packet, nil, clienttypes.ZeroHeight())
+	suite.Require().Error(err)
+
+	// receiving the timed out packet writes a timeout receipt and advances the next sequence recv
+	err = path.EndpointB.RecvPacket(timedOutPacket)
+	suite.Require().NoError(err)
+
+	receipt, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(string(types.TimeoutReceipt), receipt)
+
+	nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), nextSeqRecv)
+
+	_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+	suite.Require().False(found)
+
+	// time out packet 1 using the timeout receipt
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.TimeoutPacket(timedOutPacket)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+
+	commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+	suite.Require().Nil(commitment)
+
+	nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), nextSeqAck)
+
+	// packet 2 is received and acknowledged as usual
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	nextSeqAck, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(3), nextSeqAck)
+}
@@ -0,0 +1,859 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Upgrade Handshake
+//
+// This section defines the set of functions required to upgrade the ordering, connection
+// hops and version of an OPEN channel without closing it. During the handshake both channel
+// ends keep their current parameters, the proposed parameters are stored separately and
+// only applied once the upgrade completes on each end.
+//
+// Packets cannot be sent on a channel end which is upgrading, while packets which are
+// already in flight continue to be received, acknowledged and timed out using the
+// current channel parameters. A channel end can only complete the upgrade once all the
+// packets it has sent have been acknowledged or timed out.
+//
+// ChanUpgradeInit is called by a module to propose an upgrade of an OPEN channel to the
+// module on the counterparty chain.
+func (k Keeper) ChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	upgrade types.Upgrade,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if err := upgrade.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidUpgrade, err.Error())
+	}
+
+	if !upgrade.HasTimeout() {
+		return sdkerrors.Wrap(types.ErrInvalidUpgrade, "upgrade timeout height and upgrade timeout timestamp cannot both be 0")
+	}
+
+	if _, err := k.getUpgradeConnection(ctx, upgrade.ConnectionHops[0], upgrade.Ordering); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeInitChannel writes a channel which has successfully passed the UpgradeInit
+// handshake step. The channel upgrade sequence is incremented and the proposed upgrade is
+// stored along with the next send sequence of the channel. The new upgrade sequence is
+// returned. An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeInitChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	upgrade types.Upgrade,
+) uint64 {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeInit step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find next sequence send in successful ChanUpgradeInit step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade.NextSequenceSend = nextSequenceSend
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+
+	channel.State = types.INITUPGRADE
+	channel.UpgradeSequence++
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "OPEN", "new-state", "INITUPGRADE")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-init")
+	}()
+
+	EmitChannelUpgradeInitEvent(ctx, portID, channelID, channel, upgrade)
+
+	return channel.UpgradeSequence
+}
+
+// ChanUpgradeTry is called by a module to accept an upgrade proposed by the module on the
+// counterparty chain. The counterparty channel must be in INITUPGRADE with an upgrade
+// sequence greater than the upgrade sequence of the channel and the counterparty upgrade
+// must not have timed out.
+func (k Keeper) ChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	proposedConnectionHops []string,
+	counterpartyChannel types.Channel,
+	counterpartyUpgrade types.Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if counterpartyChannel.State != types.INITUPGRADE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"counterparty channel state is not INITUPGRADE (got %s)", counterpartyChannel.State.String(),
+		)
+	}
+
+	if err := validateCounterpartyChannel(portID, channelID, counterpartyChannel); err != nil {
+		return err
+	}
+
+	if counterpartyChannel.UpgradeSequence <= channel.UpgradeSequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgradeSequence,
+			"counterparty upgrade sequence must be greater than the current upgrade sequence (%d <= %d)", counterpartyChannel.UpgradeSequence, channel.UpgradeSequence,
+		)
+	}
+
+	if err := counterpartyUpgrade.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidUpgrade, err.Error())
+	}
+
+	// the upgrade timeout is expressed in terms of this chain, check that it has not passed
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	timeoutHeight := counterpartyUpgrade.TimeoutHeight
+	if !timeoutHeight.IsZero() && selfHeight.GTE(timeoutHeight) {
+		return sdkerrors.Wrapf(
+			types.ErrUpgradeTimeout,
+			"block height >= upgrade timeout height (%s >= %s)", selfHeight, timeoutHeight,
+		)
+	}
+
+	timeoutTimestamp := counterpartyUpgrade.TimeoutTimestamp
+	if timeoutTimestamp != 0 && uint64(ctx.BlockTime().UnixNano()) >= timeoutTimestamp {
+		return sdkerrors.Wrapf(
+			types.ErrUpgradeTimeout,
+			"block timestamp >= upgrade timeout timestamp (%s >= %s)", ctx.BlockTime(), time.Unix(0, int64(timeoutTimestamp)),
+		)
+	}
+
+	// connection hops only supports a single connection
+	if len(proposedConnectionHops) != 1 {
+		return sdkerrors.Wrapf(types.ErrTooManyConnectionHops, "expected 1, got %d", len(proposedConnectionHops))
+	}
+
+	proposedConnection, err := k.getUpgradeConnection(ctx, proposedConnectionHops[0], counterpartyUpgrade.Ordering)
+	if err != nil {
+		return err
+	}
+
+	if proposedConnection.GetCounterparty().GetConnectionID() != counterpartyUpgrade.ConnectionHops[0] {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnection,
+			"proposed connection counterparty does not match the counterparty upgrade connection (%s ≠ %s)",
+			proposedConnection.GetCounterparty().GetConnectionID(), counterpartyUpgrade.ConnectionHops[0],
+		)
+	}
+
+	if k.HasInflightPackets(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrPacketsInFlight, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return err
+	}
+
+	if err := k.connectionKeeper.VerifyChannelUpgrade(
+		ctx, connectionEnd, proofHeight, proofUpgrade,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyUpgrade,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeTryChannel writes a channel which has successfully passed the UpgradeTry
+// handshake step. The channel upgrade sequence is set to the counterparty upgrade sequence
+// and both the upgrade proposed by this channel end and the counterparty upgrade are stored.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeTryChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	upgradeSequence uint64,
+	upgrade types.Upgrade,
+	counterpartyUpgrade types.Upgrade,
+) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeTry step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find next sequence send in successful ChanUpgradeTry step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade.NextSequenceSend = nextSequenceSend
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+	k.SetCounterpartyUpgrade(ctx, portID, channelID, counterpartyUpgrade)
+
+	channel.State = types.TRYUPGRADE
+	channel.UpgradeSequence = upgradeSequence
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "OPEN", "new-state", "TRYUPGRADE")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-try")
+	}()
+
+	EmitChannelUpgradeTryEvent(ctx, portID, channelID, channel, upgrade)
+}
+
+// ChanUpgradeAck is called by the module which proposed the upgrade to acknowledge the
+// acceptance of the upgrade by the module on the counterparty chain. All the packets
+// sent on the channel must have been acknowledged or timed out.
+func (k Keeper) ChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterpartyChannel types.Channel,
+	counterpartyUpgrade types.Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.INITUPGRADE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not INITUPGRADE (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if counterpartyChannel.State != types.TRYUPGRADE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"counterparty channel state is not TRYUPGRADE (got %s)", counterpartyChannel.State.String(),
+		)
+	}
+
+	if err := validateCounterpartyChannel(portID, channelID, counterpartyChannel); err != nil {
+		return err
+	}
+
+	if counterpartyChannel.UpgradeSequence != channel.UpgradeSequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgradeSequence,
+			"counterparty upgrade sequence does not match the current upgrade sequence (%d ≠ %d)", counterpartyChannel.UpgradeSequence, channel.UpgradeSequence,
+		)
+	}
+
+	if err := k.validateCounterpartyUpgrade(ctx, upgrade, counterpartyUpgrade); err != nil {
+		return err
+	}
+
+	if k.HasInflightPackets(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrPacketsInFlight, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return err
+	}
+
+	if err := k.connectionKeeper.VerifyChannelUpgrade(
+		ctx, connectionEnd, proofHeight, proofUpgrade,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyUpgrade,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeAckChannel writes the upgraded channel for the successful UpgradeAck handshake
+// step. The proposed upgrade is applied using the version selected by the counterparty and
+// the channel is set to OPEN. An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeAckChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyUpgrade types.Upgrade,
+) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeAck step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing upgrade when updating channel state in successful ChanUpgradeAck step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade.Version = counterpartyUpgrade.Version
+	channel = k.openUpgradedChannel(ctx, portID, channelID, channel, upgrade, counterpartyUpgrade.NextSequenceSend)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "INITUPGRADE", "new-state", "OPEN")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-ack")
+	}()
+
+	EmitChannelUpgradeAckEvent(ctx, portID, channelID, channel)
+}
+
+// ChanUpgradeConfirm is called by the module which accepted the upgrade once the upgrade has
+// completed on the counterparty chain. The counterparty channel must be OPEN with the upgraded
+// parameters.
+func (k Keeper) ChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterpartyChannel types.Channel,
+	proofChannel []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.TRYUPGRADE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not TRYUPGRADE (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if counterpartyChannel.State != types.OPEN {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"counterparty channel state is not OPEN (got %s)", counterpartyChannel.State.String(),
+		)
+	}
+
+	if err := validateCounterpartyChannel(portID, channelID, counterpartyChannel); err != nil {
+		return err
+	}
+
+	if counterpartyChannel.UpgradeSequence != channel.UpgradeSequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgradeSequence,
+			"counterparty upgrade sequence does not match the current upgrade sequence (%d ≠ %d)", counterpartyChannel.UpgradeSequence, channel.UpgradeSequence,
+		)
+	}
+
+	// the counterparty channel must have been upgraded to the parameters agreed upon
+	expectedUpgrade := types.Upgrade{
+		Ordering:       counterpartyChannel.Ordering,
+		ConnectionHops: counterpartyChannel.ConnectionHops,
+		Version:        counterpartyChannel.Version,
+	}
+	if err := k.validateCounterpartyUpgrade(ctx, upgrade, expectedUpgrade); err != nil {
+		return err
+	}
+
+	if counterpartyChannel.Version != upgrade.Version {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelVersion,
+			"counterparty channel version does not match the upgrade version (%s ≠ %s)", counterpartyChannel.Version, upgrade.Version,
+		)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeConfirmChannel writes the upgraded channel for the successful UpgradeConfirm
+// handshake step. The proposed upgrade is applied and the channel is set to OPEN. An event
+// is emitted for the handshake step.
+func (k Keeper) WriteUpgradeConfirmChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeConfirm step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing upgrade when updating channel state in successful ChanUpgradeConfirm step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find counterparty upgrade when updating channel state in successful ChanUpgradeConfirm step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	channel = k.openUpgradedChannel(ctx, portID, channelID, channel, upgrade, counterpartyUpgrade.NextSequenceSend)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "TRYUPGRADE", "new-state", "OPEN")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-confirm")
+	}()
+
+	EmitChannelUpgradeConfirmEvent(ctx, portID, channelID, channel)
+}
+
+// ChanUpgradeTimeout is called by the module which proposed the upgrade to abort an upgrade
+// which has not been accepted by the counterparty before the upgrade timeout. The proof of
+// the counterparty channel must be provided at a height greater than or equal to the upgrade
+// timeout height or with a timestamp greater than or equal to the upgrade timeout timestamp.
+func (k Keeper) ChanUpgradeTimeout(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterpartyChannel types.Channel,
+	proofChannel []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.INITUPGRADE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not INITUPGRADE (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if err := validateCounterpartyChannel(portID, channelID, counterpartyChannel); err != nil {
+		return err
+	}
+
+	// the counterparty must not have accepted the upgrade
+	if counterpartyChannel.UpgradeSequence >= channel.UpgradeSequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgradeSequence,
+			"counterparty upgrade sequence must be less than the current upgrade sequence (%d >= %d)", counterpartyChannel.UpgradeSequence, channel.UpgradeSequence,
+		)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	timeoutHeight := upgrade.TimeoutHeight
+	heightTimeout := !timeoutHeight.IsZero() && proofHeight.GTE(timeoutHeight)
+	timestampTimeout := upgrade.TimeoutTimestamp != 0 && proofTimestamp >= upgrade.TimeoutTimestamp
+	if !heightTimeout && !timestampTimeout {
+		return sdkerrors.Wrapf(
+			types.ErrUpgradeTimeout,
+			"upgrade timeout not reached for height or timestamp, proof height: %s, proof timestamp: %d, timeout height: %s, timeout timestamp: %d",
+			proofHeight, proofTimestamp, timeoutHeight, upgrade.TimeoutTimestamp,
+		)
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeTimeoutChannel restores the channel for the successful UpgradeTimeout step and
+// writes an error receipt for the timed out upgrade. An event is emitted for the step.
+func (k Keeper) WriteUpgradeTimeoutChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeTimeout step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade, _ := k.GetUpgrade(ctx, portID, channelID)
+
+	k.AbortUpgrade(ctx, portID, channelID, channel.UpgradeSequence, types.ErrUpgradeTimeout)
+	channel, _ = k.GetChannel(ctx, portID, channelID)
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-timeout")
+	}()
+
+	EmitChannelUpgradeTimeoutEvent(ctx, portID, channelID, channel, upgrade)
+}
+
+// ChanUpgradeCancel is called by a module to abort an upgrade for which the counterparty
+// channel end has written an error receipt with the current upgrade sequence.
+func (k Keeper) ChanUpgradeCancel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	errorReceipt types.ErrorReceipt,
+	proofErrorReceipt []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !channel.IsUpgrading() {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not INITUPGRADE or TRYUPGRADE (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if errorReceipt.Sequence != channel.UpgradeSequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgradeSequence,
+			"error receipt sequence does not match the current upgrade sequence (%d ≠ %d)", errorReceipt.Sequence, channel.UpgradeSequence,
+		)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if err := k.connectionKeeper.VerifyChannelUpgradeError(
+		ctx, connectionEnd, proofHeight, proofErrorReceipt,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		errorReceipt,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeCancelChannel restores the channel for the successful UpgradeCancel step. An
+// event is emitted for the step.
+func (k Keeper) WriteUpgradeCancelChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeCancel step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade, _ := k.GetUpgrade(ctx, portID, channelID)
+
+	previousState := channel.State
+	channel = k.restoreChannel(ctx, portID, channelID, channel)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", "OPEN")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-cancel")
+	}()
+
+	EmitChannelUpgradeCancelEvent(ctx, portID, channelID, channel, upgrade)
+}
+
+// AbortUpgrade restores the channel to OPEN using its current parameters, removes any stored
+// upgrade and writes an error receipt for the provided upgrade sequence. It is used when an
+// application rejects an upgrade or when an upgrade times out, so that the counterparty
+// channel end can cancel the upgrade. Only the ABCI code of the error is written into state.
+func (k Keeper) AbortUpgrade(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	upgradeSequence uint64,
+	err error,
+) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when aborting channel upgrade, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	previousState := channel.State
+	channel.UpgradeSequence = upgradeSequence
+	channel = k.restoreChannel(ctx, portID, channelID, channel)
+
+	errorReceipt := types.NewErrorReceipt(upgradeSequence, err)
+	k.SetUpgradeErrorReceipt(ctx, portID, channelID, errorReceipt)
+
+	k.Logger(ctx).Info("channel upgrade aborted", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", "OPEN", "error", err.Error())
+
+	EmitChannelUpgradeErrorEvent(ctx, portID, channelID, channel, errorReceipt)
+}
+
+// validateRecvPacketOnUpgradingChannel ensures a channel end which has accepted an upgrade only
+// receives packets sent by the counterparty before the upgrade was proposed. Packets sent by
+// the counterparty once its channel end has been upgraded can only be received after the
+// upgrade is confirmed on this channel end.
+func (k Keeper) validateRecvPacketOnUpgradingChannel(ctx sdk.Context, channel types.Channel, packet exported.PacketI) error {
+	if channel.State != types.TRYUPGRADE {
+		return nil
+	}
+
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "counterparty upgrade not found for port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	if packet.GetSequence() >= counterpartyUpgrade.NextSequenceSend {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"packet sequence %d was sent on the upgraded counterparty channel, channel upgrade must be confirmed first", packet.GetSequence(),
+		)
+	}
+
+	return nil
+}
+
+// openUpgradedChannel applies the upgrade to the channel, sets it to OPEN and removes the stored
+// upgrades. If the upgraded channel is ORDERED, the next receive sequence is set to the next
+// send sequence of the counterparty when it entered the upgrade and the next acknowledgement
+// sequence to the next send sequence of the channel, as all packets sent before the upgrade
+// have been acknowledged or timed out.
+func (k Keeper) openUpgradedChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	channel types.Channel,
+	upgrade types.Upgrade,
+	counterpartyNextSequenceSend uint64,
+) types.Channel {
+	if upgrade.Ordering.IsOrdered() && !channel.Ordering.IsOrdered() {
+		k.SetNextSequenceRecv(ctx, portID, channelID, counterpartyNextSequenceSend)
+		k.SetNextSequenceAck(ctx, portID, channelID, upgrade.NextSequenceSend)
+	}
+
+	channel.State = types.OPEN
+	channel.Ordering = upgrade.Ordering
+	channel.ConnectionHops = upgrade.ConnectionHops
+	channel.Version = upgrade.Version
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	k.deleteUpgrade(ctx, portID, channelID)
+	k.deleteCounterpartyUpgrade(ctx, portID, channelID)
+
+	return channel
+}
+
+// restoreChannel sets the channel back to OPEN without modifying its parameters and removes
+// the stored upgrades.
+func (k Keeper) restoreChannel(ctx sdk.Context, portID, channelID string, channel types.Channel) types.Channel {
+	channel.State = types.OPEN
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	k.deleteUpgrade(ctx, portID, channelID)
+	k.deleteCounterpartyUpgrade(ctx, portID, channelID)
+
+	return channel
+}
+
+// validateCounterpartyUpgrade checks that the counterparty upgrade uses the same ordering as
+// the upgrade and that its connection is the counterparty of the upgrade connection.
+func (k Keeper) validateCounterpartyUpgrade(ctx sdk.Context, upgrade, counterpartyUpgrade types.Upgrade) error {
+	if counterpartyUpgrade.Ordering != upgrade.Ordering {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelOrdering,
+			"counterparty upgrade ordering does not match the upgrade ordering (%s ≠ %s)", counterpartyUpgrade.Ordering, upgrade.Ordering,
+		)
+	}
+
+	if len(counterpartyUpgrade.ConnectionHops) != 1 {
+		return sdkerrors.Wrapf(types.ErrTooManyConnectionHops, "expected 1, got %d", len(counterpartyUpgrade.ConnectionHops))
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, upgrade.ConnectionHops[0])
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, upgrade.ConnectionHops[0])
+	}
+
+	if connectionEnd.GetCounterparty().GetConnectionID() != counterpartyUpgrade.ConnectionHops[0] {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnection,
+			"upgrade connection counterparty does not match the counterparty upgrade connection (%s ≠ %s)",
+			connectionEnd.GetCounterparty().GetConnectionID(), counterpartyUpgrade.ConnectionHops[0],
+		)
+	}
+
+	return nil
+}
+
+// getUpgradeConnection returns the connection an upgraded channel would use. The connection
+// must be OPEN and support the proposed ordering.
+func (k Keeper) getUpgradeConnection(ctx sdk.Context, connectionID string, ordering types.Order) (connectiontypes.ConnectionEnd, error) {
+	connectionEnd, err := k.getOpenConnection(ctx, connectionID)
+	if err != nil {
+		return connectiontypes.ConnectionEnd{}, err
+	}
+
+	getVersions := connectionEnd.GetVersions()
+	if len(getVersions) != 1 {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"single version must be negotiated on connection before upgrading channel, got: %v",
+			getVersions,
+		)
+	}
+
+	if !connectiontypes.VerifySupportedFeature(getVersions[0], ordering.String()) {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"connection version %s does not support channel ordering: %s",
+			getVersions[0], ordering.String(),
+		)
+	}
+
+	return connectionEnd, nil
+}
+
+// getOpenConnection returns the connection with the given identifier if it is OPEN.
+func (k Keeper) getOpenConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error) {
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
+		)
+	}
+
+	return connectionEnd, nil
+}
+
+// validateCounterpartyChannel checks that the counterparty channel has the channel end
+// identified by the given port and channel identifiers as its counterparty.
+func validateCounterpartyChannel(portID, channelID string, counterpartyChannel types.Channel) error {
+	expectedCounterparty := types.NewCounterparty(portID, channelID)
+	if counterpartyChannel.Counterparty != expectedCounterparty {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidCounterparty,
+			"counterparty channel counterparty does not match the channel end (%s ≠ %s)", counterpartyChannel.Counterparty, expectedCounterparty,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,490 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+const upgradeVersion = "mock-version-v2"
+
+// newUpgrade returns an upgrade of the channel on endpoint to the ORDERED ordering and the upgrade
+// version which times out on the counterparty chain after 1000 blocks.
+func (suite *KeeperTestSuite) newUpgrade(endpoint *ibctesting.Endpoint) types.Upgrade {
+	timeoutHeight := clienttypes.GetSelfHeight(endpoint.Counterparty.Chain.GetContext()).Increment().(clienttypes.Height)
+	timeoutHeight.RevisionHeight += 1000
+
+	return types.NewUpgrade(types.ORDERED, []string{endpoint.ConnectionID}, upgradeVersion, timeoutHeight, 0, 0)
+}
+
+// TestChanUpgradeInit tests the UpgradeInit handshake call for channels. The channel is
+// upgraded on chainA.
+func (suite *KeeperTestSuite) TestChanUpgradeInit() {
+	var (
+		path    *ibctesting.Path
+		upgrade types.Upgrade
+		chanCap *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+		}, false},
+		{"channel state is not OPEN", func() {
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+		}, false},
+		{"capability is incorrect", func() {
+			chanCap = capabilitytypes.NewCapability(100)
+		}, false},
+		{"upgrade has no timeout", func() {
+			upgrade.TimeoutHeight = clienttypes.ZeroHeight()
+		}, false},
+		{"upgrade connection not found", func() {
+			upgrade.ConnectionHops = []string{"connection-100"}
+		}, false},
+		{"upgrade connection does not support ORDERED channels", func() {
+			conn := path.EndpointA.GetConnection()
+			conn.Versions = []*connectiontypes.Version{connectiontypes.NewVersion("1", []string{"ORDER_UNORDERED"})}
+			path.EndpointA.SetConnection(conn)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			upgrade = suite.newUpgrade(path.EndpointA)
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeInit(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				chanCap, upgrade,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeTry tests the UpgradeTry handshake call for channels. It uses message passing
+// to enter into the appropriate state and then calls ChanUpgradeTry directly. The upgrade is
+// proposed on chainA and accepted on chainB.
+func (suite *KeeperTestSuite) TestChanUpgradeTry() {
+	var (
+		path                   *ibctesting.Path
+		upgrade                types.Upgrade
+		proposedConnectionHops []string
+		chanCap                *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel state is not OPEN", func() {
+			suite.Require().NoError(path.EndpointB.SetChannelClosed())
+		}, false},
+		{"capability is incorrect", func() {
+			chanCap = capabilitytypes.NewCapability(100)
+		}, false},
+		{"counterparty channel state is not INITUPGRADE", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.State = types.OPEN
+			path.EndpointA.SetChannel(channel)
+		}, false},
+		{"counterparty upgrade sequence is not greater than the upgrade sequence", func() {
+			channel := path.EndpointB.GetChannel()
+			channel.UpgradeSequence = 1
+			path.EndpointB.SetChannel(channel)
+		}, false},
+		{"upgrade timed out", func() {
+			upgrade.TimeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgrade)
+		}, false},
+		{"proposed connection does not match the counterparty upgrade connection", func() {
+			proposedConnectionHops = []string{"connection-100"}
+		}, false},
+		{"packets in flight", func() {
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1, types.CommitPacket(suite.chainB.App.AppCodec(), packet))
+		}, false},
+		{"invalid upgrade proof", func() {
+			upgrade.Version = "invalid-version"
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			upgrade = suite.newUpgrade(path.EndpointA)
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit(upgrade))
+
+			upgrade, _ = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			proposedConnectionHops = []string{path.EndpointB.ConnectionID}
+			chanCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			tc.malleate()
+
+			suite.coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			counterpartyChannel := path.EndpointA.GetChannel()
+			channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			proofChannel, proofHeight := suite.chainA.QueryProof(channelKey)
+			upgradeKey := host.ChannelUpgradeKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			proofUpgrade, _ := suite.chainA.QueryProof(upgradeKey)
+
+			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeTry(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+				chanCap, proposedConnectionHops, counterpartyChannel, upgrade,
+				proofChannel, proofUpgrade, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeAck tests the UpgradeAck handshake call for channels. It uses message passing
+// to enter into the appropriate state and then calls ChanUpgradeAck directly. The upgrade is
+// proposed on chainA and accepted on chainB.
+func (suite *KeeperTestSuite) TestChanUpgradeAck() {
+	var (
+		path    *ibctesting.Path
+		chanCap *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel state is not INITUPGRADE", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.State = types.OPEN
+			path.EndpointA.SetChannel(channel)
+		}, false},
+		{"capability is incorrect", func() {
+			chanCap = capabilitytypes.NewCapability(100)
+		}, false},
+		{"upgrade sequence mismatch", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.UpgradeSequence++
+			path.EndpointA.SetChannel(channel)
+		}, false},
+		{"packets in flight", func() {
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, types.CommitPacket(suite.chainA.App.AppCodec(), packet))
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit(suite.newUpgrade(path.EndpointA)))
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+			suite.Require().Equal(types.TRYUPGRADE, path.EndpointB.GetChannel().State)
+
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			counterpartyChannel, counterpartyUpgrade, proofChannel, proofUpgrade, proofHeight := path.EndpointA.QueryChannelUpgradeProof()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeAck(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				chanCap, counterpartyChannel, counterpartyUpgrade,
+				proofChannel, proofUpgrade, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeConfirm tests the UpgradeConfirm handshake call for channels. It uses message
+// passing to enter into the appropriate state and then calls ChanUpgradeConfirm directly.
+func (suite *KeeperTestSuite) TestChanUpgradeConfirm() {
+	var (
+		path    *ibctesting.Path
+		chanCap *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel state is not TRYUPGRADE", func() {
+			channel := path.EndpointB.GetChannel()
+			channel.State = types.OPEN
+			path.EndpointB.SetChannel(channel)
+		}, false},
+		{"capability is incorrect", func() {
+			chanCap = capabilitytypes.NewCapability(100)
+		}, false},
+		{"upgrade version does not match the counterparty channel version", func() {
+			upgrade, _ := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			upgrade.Version = ibctesting.DefaultChannelVersion
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, upgrade)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit(suite.newUpgrade(path.EndpointA)))
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+			suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+
+			chanCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			proof, proofHeight := suite.chainA.QueryProof(channelKey)
+
+			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeConfirm(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+				chanCap, path.EndpointA.GetChannel(), proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeTimeout tests the UpgradeTimeout call for channels. The upgrade is proposed
+// on chainA and is never accepted on chainB.
+func (suite *KeeperTestSuite) TestChanUpgradeTimeout() {
+	var path *ibctesting.Path
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"upgrade timeout not reached", func() {
+			upgrade, _ := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			upgrade.TimeoutHeight.RevisionHeight += 1000
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgrade)
+		}, false},
+		{"counterparty accepted the upgrade", func() {
+			channel := path.EndpointB.GetChannel()
+			channel.UpgradeSequence = path.EndpointA.GetChannel().UpgradeSequence
+			path.EndpointB.SetChannel(channel)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			upgrade := suite.newUpgrade(path.EndpointA)
+			upgrade.TimeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit(upgrade))
+
+			tc.malleate()
+
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			proof, proofHeight := suite.chainB.QueryProof(channelKey)
+
+			chanCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeTimeout(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				chanCap, path.EndpointB.GetChannel(), proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeCancel tests the UpgradeCancel call for channels. The upgrade is accepted on
+// chainB and aborted on chainA which writes an error receipt.
+func (suite *KeeperTestSuite) TestChanUpgradeCancel() {
+	var (
+		path         *ibctesting.Path
+		errorReceipt types.ErrorReceipt
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel is not upgrading", func() {
+			channel := path.EndpointB.GetChannel()
+			channel.State = types.OPEN
+			path.EndpointB.SetChannel(channel)
+		}, false},
+		{"error receipt sequence does not match the upgrade sequence", func() {
+			errorReceipt.Sequence++
+		}, false},
+		{"invalid error receipt proof", func() {
+			errorReceipt.Message = "invalid message"
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit(suite.newUpgrade(path.EndpointA)))
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+
+			channel := path.EndpointA.GetChannel()
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.AbortUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel.UpgradeSequence, types.ErrInvalidUpgrade)
+			suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+
+			errorReceipt, _ = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			suite.coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			errorReceiptKey := host.ChannelUpgradeErrorKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			proof, proofHeight := suite.chainA.QueryProof(errorReceiptKey)
+
+			chanCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeCancel(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+				chanCap, errorReceipt, proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChannelUpgradeHandshake performs a full upgrade handshake using message passing while a
+// packet sent before the upgrade is in flight.
+func (suite *KeeperTestSuite) TestChannelUpgradeHandshake() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// send a packet from chainB before the upgrade is proposed
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	suite.Require().NoError(path.EndpointB.SendPacket(packet))
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit(suite.newUpgrade(path.EndpointA)))
+	suite.Require().Equal(types.INITUPGRADE, path.EndpointA.GetChannel().State)
+
+	// packets cannot be sent while upgrading
+	sendPacket := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	suite.Require().Error(path.EndpointA.SendPacket(sendPacket))
+
+	// in-flight packets are received and acknowledged on the upgrading channel
+	suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+	suite.Require().NoError(path.EndpointB.AcknowledgePacket(packet, ibctesting.MockAcknowledgement))
+
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().Equal(types.TRYUPGRADE, path.EndpointB.GetChannel().State)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	channelA := path.EndpointA.GetChannel()
+	suite.Require().Equal(types.OPEN, channelA.State)
+	suite.Require().Equal(types.ORDERED, channelA.Ordering)
+	suite.Require().Equal(upgradeVersion, channelA.Version)
+
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	channelB := path.EndpointB.GetChannel()
+	suite.Require().Equal(types.OPEN, channelB.State)
+	suite.Require().Equal(types.ORDERED, channelB.Ordering)
+	suite.Require().Equal(upgradeVersion, channelB.Version)
+	suite.Require().Equal(channelA.UpgradeSequence, channelB.UpgradeSequence)
+
+	_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().False(found)
+	_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().False(found)
+
+	// the ORDERED channel continues from the sequences used before the upgrade
+	sendPacket = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	suite.Require().NoError(path.EndpointA.SendPacket(sendPacket))
+	suite.Require().NoError(path.EndpointB.RecvPacket(sendPacket))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(sendPacket, ibctesting.MockAcknowledgement))
+
+	packet = types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	suite.Require().NoError(path.EndpointB.SendPacket(packet))
+	suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+}
+
+// TestChannelUpgradeTimeoutAndRetry times out an upgrade and checks that a new upgrade can be
+// proposed with the next upgrade sequence.
+func (suite *KeeperTestSuite) TestChannelUpgradeTimeoutAndRetry() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	upgrade := suite.newUpgrade(path.EndpointA)
+	upgrade.TimeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit(upgrade))
+
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeTimeout())
+	channel := path.EndpointA.GetChannel()
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(types.UNORDERED, channel.Ordering)
+	suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+
+	errorReceipt, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), errorReceipt.Sequence)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit(suite.newUpgrade(path.EndpointA)))
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().Equal(uint64(2), path.EndpointB.GetChannel().UpgradeSequence)
+}
@@ -51,12 +51,17 @@ func (ch Channel) GetVersion() string {
 	return ch.Version
 }
 
+// IsUpgrading returns true if the channel end is taking part in an upgrade handshake.
+func (ch Channel) IsUpgrading() bool {
+	return ch.State == INITUPGRADE || ch.State == TRYUPGRADE
+}
+
 // ValidateBasic performs a basic validation of the channel fields
 func (ch Channel) ValidateBasic() error {
 	if ch.State == UNINITIALIZED {
 		return ErrInvalidChannelState
 	}
-	if !(ch.Ordering == ORDERED || ch.Ordering == UNORDERED) {
+	if !ch.Ordering.IsValid() {
 		return sdkerrors.Wrap(ErrInvalidChannelOrdering, ch.Ordering.String())
 	}
 	if len(ch.ConnectionHops) != 1 {
@@ -105,13 +110,14 @@ func (c Counterparty) ValidateBasic() error {
 // NewIdentifiedChannel creates a new IdentifiedChannel instance
 func NewIdentifiedChannel(portID, channelID string, ch Channel) IdentifiedChannel {
 	return IdentifiedChannel{
-		State:          ch.State,
-		Ordering:       ch.Ordering,
-		Counterparty:   ch.Counterparty,
-		ConnectionHops: ch.ConnectionHops,
-		Version:        ch.Version,
-		PortId:         portID,
-		ChannelId:      channelID,
+		State:           ch.State,
+		Ordering:        ch.Ordering,
+		Counterparty:    ch.Counterparty,
+		ConnectionHops:  ch.ConnectionHops,
+		Version:         ch.Version,
+		PortId:          portID,
+		ChannelId:       channelID,
+		UpgradeSequence: ch.UpgradeSequence,
 	}
 }
 
@@ -126,3 +132,19 @@ func (ic IdentifiedChannel) ValidateBasic() error {
 	channel := NewChannel(ic.State, ic.Ordering, ic.Counterparty, ic.ConnectionHops, ic.Version)
 	return channel.ValidateBasic()
 }
+
+// IsValid returns true if the channel ordering is UNORDERED, ORDERED or ORDERED_ALLOW_TIMEOUT.
+func (o Order) IsValid() bool {
+	switch o {
+	case UNORDERED, ORDERED, ORDERED_ALLOW_TIMEOUT:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsOrdered returns true if packets on channels with the ordering must be received in the
+// order in which they were sent, that is for ORDERED and ORDERED_ALLOW_TIMEOUT channels.
+func (o Order) IsOrdered() bool {
+	return o == ORDERED || o == ORDERED_ALLOW_TIMEOUT
+}
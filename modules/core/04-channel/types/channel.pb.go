@@ -25,7 +25,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // State defines if a channel is in one of the following states:
-// CLOSED, INIT, TRYOPEN, OPEN or UNINITIALIZED.
+// CLOSED, INIT, TRYOPEN, OPEN, INITUPGRADE, TRYUPGRADE or UNINITIALIZED.
 type State int32
 
 const (
@@ -41,6 +41,12 @@ const (
 	// A channel has been closed and can no longer be used to send or receive
 	// packets.
 	CLOSED State = 4
+	// An open channel has started an upgrade handshake. The channel keeps its
+	// current parameters until the upgrade completes.
+	INITUPGRADE State = 5
+	// An open channel has accepted an upgrade handshake initiated on the
+	// counterparty chain.
+	TRYUPGRADE State = 6
 )
 
 var State_name = map[int32]string{
@@ -49,6 +55,8 @@ var State_name = map[int32]string{
 	2: "STATE_TRYOPEN",
 	3: "STATE_OPEN",
 	4: "STATE_CLOSED",
+	5: "STATE_INITUPGRADE",
+	6: "STATE_TRYUPGRADE",
 }
 
 var State_value = map[string]int32{
@@ -57,6 +65,8 @@ var State_value = map[string]int32{
 	"STATE_TRYOPEN":                   2,
 	"STATE_OPEN":                      3,
 	"STATE_CLOSED":                    4,
+	"STATE_INITUPGRADE":               5,
+	"STATE_TRYUPGRADE":                6,
 }
 
 func (x State) String() string {
@@ -78,18 +88,23 @@ const (
 	UNORDERED Order = 1
 	// packets are delivered exactly in the order which they were sent
 	ORDERED Order = 2
+	// packets are received exactly in the order which they were sent, a packet
+	// which timed out is skipped by the receiver without closing the channel
+	ORDERED_ALLOW_TIMEOUT Order = 3
 )
 
 var Order_name = map[int32]string{
 	0: "ORDER_NONE_UNSPECIFIED",
 	1: "ORDER_UNORDERED",
 	2: "ORDER_ORDERED",
+	3: "ORDER_ORDERED_ALLOW_TIMEOUT",
 }
 
 var Order_value = map[string]int32{
-	"ORDER_NONE_UNSPECIFIED": 0,
-	"ORDER_UNORDERED":        1,
-	"ORDER_ORDERED":          2,
+	"ORDER_NONE_UNSPECIFIED":      0,
+	"ORDER_UNORDERED":             1,
+	"ORDER_ORDERED":               2,
+	"ORDER_ORDERED_ALLOW_TIMEOUT": 3,
 }
 
 func (x Order) String() string {
@@ -115,6 +130,9 @@ type Channel struct {
 	ConnectionHops []string `protobuf:"bytes,4,rep,name=connection_hops,json=connectionHops,proto3" json:"connection_hops,omitempty" yaml:"connection_hops"`
 	// opaque channel version, which is agreed upon during the handshake
 	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	// sequence of the latest upgrade attempted on this channel end, incremented
+	// every time a new upgrade handshake is started
+	UpgradeSequence uint64 `protobuf:"varint,6,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty" yaml:"upgrade_sequence"`
 }
 
 func (m *Channel) Reset()         { *m = Channel{} }
@@ -168,6 +186,8 @@ type IdentifiedChannel struct {
 	PortId string `protobuf:"bytes,6,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	// channel identifier
 	ChannelId string `protobuf:"bytes,7,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence of the latest upgrade attempted on this channel end
+	UpgradeSequence uint64 `protobuf:"varint,8,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty" yaml:"upgrade_sequence"`
 }
 
 func (m *IdentifiedChannel) Reset()         { *m = IdentifiedChannel{} }
@@ -299,6 +319,102 @@ func (m *Packet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Packet proto.InternalMessageInfo
 
+// Upgrade defines the parameters a channel end proposes to switch to during a
+// channel upgrade handshake along with the upgrade timeout and the next send
+// sequence of the channel end at the time the upgrade was proposed.
+type Upgrade struct {
+	// proposed channel ordering
+	Ordering Order `protobuf:"varint,1,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+	// proposed list of connection identifiers, in order, along which packets
+	// sent on the upgraded channel will travel
+	ConnectionHops []string `protobuf:"bytes,2,rep,name=connection_hops,json=connectionHops,proto3" json:"connection_hops,omitempty" yaml:"connection_hops"`
+	// proposed opaque channel version
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// block height on the counterparty chain after which the upgrade times out
+	TimeoutHeight types.Height `protobuf:"bytes,4,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// block timestamp (in nanoseconds) on the counterparty chain after which
+	// the upgrade times out
+	TimeoutTimestamp uint64 `protobuf:"varint,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+	// next sequence send of the channel end when the upgrade was proposed
+	NextSequenceSend uint64 `protobuf:"varint,6,opt,name=next_sequence_send,json=nextSequenceSend,proto3" json:"next_sequence_send,omitempty" yaml:"next_sequence_send"`
+}
+
+func (m *Upgrade) Reset()         { *m = Upgrade{} }
+func (m *Upgrade) String() string { return proto.CompactTextString(m) }
+func (*Upgrade) ProtoMessage()    {}
+func (*Upgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{4}
+}
+func (m *Upgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Upgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Upgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Upgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Upgrade.Merge(m, src)
+}
+func (m *Upgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Upgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Upgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Upgrade proto.InternalMessageInfo
+
+// ErrorReceipt defines a receipt written when a channel upgrade is aborted. It
+// allows the counterparty channel end to cancel the upgrade with the same
+// upgrade sequence.
+type ErrorReceipt struct {
+	// upgrade sequence of the aborted upgrade
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// deterministic error message of the aborted upgrade
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *ErrorReceipt) Reset()         { *m = ErrorReceipt{} }
+func (m *ErrorReceipt) String() string { return proto.CompactTextString(m) }
+func (*ErrorReceipt) ProtoMessage()    {}
+func (*ErrorReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{5}
+}
+func (m *ErrorReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorReceipt.Merge(m, src)
+}
+func (m *ErrorReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorReceipt proto.InternalMessageInfo
+
 // PacketState defines the generic type necessary to retrieve and store
 // packet commitments, acknowledgements, and receipts.
 // Caller is responsible for knowing the context necessary to interpret this
@@ -318,7 +434,7 @@ func (m *PacketState) Reset()         { *m = PacketState{} }
 func (m *PacketState) String() string { return proto.CompactTextString(m) }
 func (*PacketState) ProtoMessage()    {}
 func (*PacketState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{4}
+	return fileDescriptor_c3a07336710636a0, []int{6}
 }
 func (m *PacketState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,6 +463,51 @@ func (m *PacketState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketState proto.InternalMessageInfo
 
+// PacketId is an identifer for a unique Packet
+// Source chains refer to packets by source port/channel
+// Destination chains refer to packets by destination port/channel
+type PacketId struct {
+	// channel port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *PacketId) Reset()         { *m = PacketId{} }
+func (m *PacketId) String() string { return proto.CompactTextString(m) }
+func (*PacketId) ProtoMessage()    {}
+func (*PacketId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{7}
+}
+func (m *PacketId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketId.Merge(m, src)
+}
+func (m *PacketId) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketId) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketId proto.InternalMessageInfo
+
 // Acknowledgement is the recommended acknowledgement format to be used by
 // app-specific protocols.
 // NOTE: The field numbers 21 and 22 were explicitly chosen to avoid accidental
@@ -367,7 +528,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{5}
+	return fileDescriptor_c3a07336710636a0, []int{8}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,71 +609,87 @@ func init() {
 	proto.RegisterType((*IdentifiedChannel)(nil), "ibc.core.channel.v1.IdentifiedChannel")
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
+	proto.RegisterType((*Upgrade)(nil), "ibc.core.channel.v1.Upgrade")
+	proto.RegisterType((*ErrorReceipt)(nil), "ibc.core.channel.v1.ErrorReceipt")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
+	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x8e, 0xda, 0x56,
-	0x14, 0xc6, 0x83, 0xf9, 0x3b, 0x0c, 0x0c, 0x73, 0xd3, 0x21, 0xae, 0x9b, 0x60, 0x62, 0x75, 0x31,
-	0x4a, 0x15, 0xc8, 0x24, 0x51, 0xab, 0x66, 0xd5, 0xe1, 0x27, 0x1a, 0xab, 0x11, 0x8c, 0x0c, 0xb3,
-	0x68, 0x36, 0x14, 0xec, 0x5b, 0xb0, 0x02, 0xbe, 0xd4, 0xbe, 0x30, 0x9a, 0x37, 0x88, 0x58, 0xf5,
-	0x05, 0x90, 0x2a, 0x55, 0xed, 0x2b, 0xf4, 0x15, 0xb2, 0xcc, 0xb2, 0x2b, 0xab, 0x9a, 0x59, 0x74,
-	0xcf, 0x0b, 0xb4, 0xf2, 0xbd, 0xd7, 0xfc, 0x4c, 0xa2, 0x2c, 0xbb, 0xca, 0x8a, 0x7b, 0xbe, 0xef,
-	0x3b, 0x3f, 0x3e, 0xe7, 0x70, 0x2f, 0x3c, 0x70, 0x06, 0x56, 0xd5, 0x22, 0x1e, 0xae, 0x5a, 0xa3,
-	0xbe, 0xeb, 0xe2, 0x71, 0x75, 0x7e, 0x12, 0x1d, 0x2b, 0x53, 0x8f, 0x50, 0x82, 0xee, 0x38, 0x03,
-	0xab, 0x12, 0x4a, 0x2a, 0x11, 0x3e, 0x3f, 0x51, 0x3f, 0x1b, 0x92, 0x21, 0x61, 0x7c, 0x35, 0x3c,
-	0x71, 0xa9, 0xaa, 0x6d, 0xa2, 0x8d, 0x1d, 0xec, 0x52, 0x16, 0x8c, 0x9d, 0xb8, 0x40, 0xff, 0x7d,
-	0x0f, 0x52, 0x75, 0x1e, 0x05, 0x3d, 0x86, 0x84, 0x4f, 0xfb, 0x14, 0x2b, 0x52, 0x59, 0x3a, 0xce,
-	0x3f, 0x51, 0x2b, 0x1f, 0xc8, 0x53, 0xe9, 0x84, 0x0a, 0x93, 0x0b, 0xd1, 0xd7, 0x90, 0x26, 0x9e,
-	0x8d, 0x3d, 0xc7, 0x1d, 0x2a, 0x7b, 0x1f, 0x71, 0x6a, 0x87, 0x22, 0x73, 0xad, 0x45, 0xdf, 0xc3,
-	0xbe, 0x45, 0x66, 0x2e, 0xc5, 0xde, 0xb4, 0xef, 0xd1, 0x2b, 0x25, 0x5e, 0x96, 0x8e, 0xb3, 0x4f,
-	0x1e, 0x7c, 0xd0, 0xb7, 0xbe, 0x25, 0xac, 0xc9, 0x6f, 0x03, 0x2d, 0x66, 0xee, 0x38, 0xa3, 0x3a,
-	0x1c, 0x58, 0xc4, 0x75, 0xb1, 0x45, 0x1d, 0xe2, 0xf6, 0x46, 0x64, 0xea, 0x2b, 0x72, 0x39, 0x7e,
-	0x9c, 0xa9, 0xa9, 0xab, 0x40, 0x2b, 0x5e, 0xf5, 0x27, 0xe3, 0xe7, 0xfa, 0x2d, 0x81, 0x6e, 0xe6,
-	0x37, 0xc8, 0x19, 0x99, 0xfa, 0x48, 0x81, 0xd4, 0x1c, 0x7b, 0xbe, 0x43, 0x5c, 0x25, 0x51, 0x96,
-	0x8e, 0x33, 0x66, 0x64, 0x3e, 0x97, 0xdf, 0xfc, 0xaa, 0xc5, 0xf4, 0x7f, 0xf6, 0xe0, 0xd0, 0xb0,
-	0xb1, 0x4b, 0x9d, 0x9f, 0x1c, 0x6c, 0x7f, 0xea, 0xd8, 0x47, 0x3a, 0x86, 0xee, 0x42, 0x6a, 0x4a,
-	0x3c, 0xda, 0x73, 0x6c, 0x25, 0xc9, 0x98, 0x64, 0x68, 0x1a, 0x36, 0xba, 0x0f, 0x20, 0xca, 0x0c,
-	0xb9, 0x14, 0xe3, 0x32, 0x02, 0x31, 0x6c, 0xd1, 0xe9, 0x4b, 0xd8, 0xdf, 0xfe, 0x00, 0xf4, 0xd5,
-	0x26, 0x5a, 0xd8, 0xe5, 0x4c, 0x0d, 0xad, 0x02, 0x2d, 0xcf, 0x8b, 0x14, 0x84, 0xbe, 0xce, 0xf0,
-	0x6c, 0x27, 0xc3, 0x1e, 0xd3, 0x1f, 0xad, 0x02, 0xed, 0x50, 0x7c, 0xd4, 0x9a, 0xd3, 0xdf, 0x4f,
-	0xfc, 0x6f, 0x1c, 0x92, 0xe7, 0x7d, 0xeb, 0x35, 0xa6, 0x48, 0x85, 0xb4, 0x8f, 0x7f, 0x9e, 0x61,
-	0xd7, 0xe2, 0xa3, 0x95, 0xcd, 0xb5, 0x8d, 0xbe, 0x81, 0xac, 0x4f, 0x66, 0x9e, 0x85, 0x7b, 0x61,
-	0x4e, 0x91, 0xa3, 0xb8, 0x0a, 0x34, 0xc4, 0x73, 0x6c, 0x91, 0xba, 0x09, 0xdc, 0x3a, 0x27, 0x1e,
-	0x45, 0xdf, 0x41, 0x5e, 0x70, 0x22, 0x33, 0x1b, 0x62, 0xa6, 0xf6, 0xf9, 0x2a, 0xd0, 0x8e, 0x76,
-	0x7c, 0x05, 0xaf, 0x9b, 0x39, 0x0e, 0x44, 0xeb, 0xf6, 0x02, 0x0a, 0x36, 0xf6, 0xa9, 0xe3, 0xf6,
-	0xd9, 0x5c, 0x58, 0x7e, 0x99, 0xc5, 0xf8, 0x62, 0x15, 0x68, 0x77, 0x79, 0x8c, 0xdb, 0x0a, 0xdd,
-	0x3c, 0xd8, 0x82, 0x58, 0x25, 0x6d, 0xb8, 0xb3, 0xad, 0x8a, 0xca, 0x61, 0x63, 0xac, 0x95, 0x56,
-	0x81, 0xa6, 0xbe, 0x1f, 0x6a, 0x5d, 0x13, 0xda, 0x42, 0xa3, 0xc2, 0x10, 0xc8, 0x76, 0x9f, 0xf6,
-	0xd9, 0xb8, 0xf7, 0x4d, 0x76, 0x46, 0x3f, 0x42, 0x9e, 0x3a, 0x13, 0x4c, 0x66, 0xb4, 0x37, 0xc2,
-	0xce, 0x70, 0x44, 0xd9, 0xc0, 0xb3, 0x3b, 0xfb, 0xce, 0x6f, 0xa2, 0xf9, 0x49, 0xe5, 0x8c, 0x29,
-	0x6a, 0xf7, 0xc3, 0x65, 0xdd, 0xb4, 0x63, 0xd7, 0x5f, 0x37, 0x73, 0x02, 0xe0, 0x6a, 0x64, 0xc0,
-	0x61, 0xa4, 0x08, 0x7f, 0x7d, 0xda, 0x9f, 0x4c, 0x95, 0x74, 0x38, 0xae, 0xda, 0xbd, 0x55, 0xa0,
-	0x29, 0xbb, 0x41, 0xd6, 0x12, 0xdd, 0x2c, 0x08, 0xac, 0x1b, 0x41, 0x62, 0x03, 0xfe, 0x90, 0x20,
-	0xcb, 0x37, 0x80, 0xfd, 0x67, 0xff, 0x87, 0xd5, 0xdb, 0xd9, 0xb4, 0xf8, 0xad, 0x4d, 0x8b, 0xba,
-	0x2a, 0x6f, 0xba, 0x2a, 0x0a, 0x6d, 0xc3, 0xc1, 0xa9, 0xf5, 0xda, 0x25, 0x97, 0x63, 0x6c, 0x0f,
-	0xf1, 0x04, 0xbb, 0x14, 0x29, 0x90, 0xf4, 0xb0, 0x3f, 0x1b, 0x53, 0xe5, 0x28, 0x94, 0x9f, 0xc5,
-	0x4c, 0x61, 0xa3, 0x22, 0x24, 0xb0, 0xe7, 0x11, 0x4f, 0x29, 0x86, 0x35, 0x9d, 0xc5, 0x4c, 0x6e,
-	0xd6, 0x00, 0xd2, 0x1e, 0xf6, 0xa7, 0xc4, 0xf5, 0xf1, 0xc3, 0x3f, 0x25, 0x48, 0x74, 0xc4, 0x05,
-	0xa5, 0x75, 0xba, 0xa7, 0xdd, 0x66, 0xef, 0xa2, 0x65, 0xb4, 0x8c, 0xae, 0x71, 0xfa, 0xd2, 0x78,
-	0xd5, 0x6c, 0xf4, 0x2e, 0x5a, 0x9d, 0xf3, 0x66, 0xdd, 0x78, 0x61, 0x34, 0x1b, 0x85, 0x98, 0x7a,
-	0xb8, 0x58, 0x96, 0x73, 0x3b, 0x02, 0xa4, 0x00, 0x70, 0xbf, 0x10, 0x2c, 0x48, 0x6a, 0x7a, 0xb1,
-	0x2c, 0xcb, 0xe1, 0x19, 0x95, 0x20, 0xc7, 0x99, 0xae, 0xf9, 0x43, 0xfb, 0xbc, 0xd9, 0x2a, 0xec,
-	0xa9, 0xd9, 0xc5, 0xb2, 0x9c, 0x12, 0xe6, 0xc6, 0x93, 0x91, 0x71, 0xee, 0xc9, 0x98, 0x7b, 0xb0,
-	0xcf, 0x99, 0xfa, 0xcb, 0x76, 0xa7, 0xd9, 0x28, 0xc8, 0x2a, 0x2c, 0x96, 0xe5, 0x24, 0xb7, 0x54,
-	0xf9, 0xcd, 0x6f, 0xa5, 0xd8, 0xc3, 0x4b, 0x48, 0xb0, 0xbb, 0x12, 0x7d, 0x09, 0xc5, 0xb6, 0xd9,
-	0x68, 0x9a, 0xbd, 0x56, 0xbb, 0xd5, 0xbc, 0x55, 0x2f, 0x0b, 0x19, 0xe2, 0x48, 0x87, 0x03, 0xae,
-	0xba, 0x68, 0xb1, 0xdf, 0x66, 0xa3, 0x20, 0xa9, 0xb9, 0xc5, 0xb2, 0x9c, 0x59, 0x03, 0x61, 0xc1,
-	0x5c, 0x13, 0x29, 0x44, 0xc1, 0xc2, 0xe4, 0x89, 0x6b, 0x9d, 0xb7, 0xd7, 0x25, 0xe9, 0xdd, 0x75,
-	0x49, 0xfa, 0xfb, 0xba, 0x24, 0xfd, 0x72, 0x53, 0x8a, 0xbd, 0xbb, 0x29, 0xc5, 0xfe, 0xba, 0x29,
-	0xc5, 0x5e, 0x7d, 0x3b, 0x74, 0xe8, 0x68, 0x36, 0xa8, 0x58, 0x64, 0x52, 0xb5, 0x88, 0x3f, 0x21,
-	0x7e, 0xd5, 0x19, 0x58, 0x8f, 0x86, 0xa4, 0x3a, 0x7f, 0x5a, 0x9d, 0x10, 0x7b, 0x36, 0xc6, 0x3e,
-	0x7f, 0x94, 0x1f, 0x3f, 0x7b, 0x14, 0xbd, 0xf2, 0xf4, 0x6a, 0x8a, 0xfd, 0x41, 0x92, 0xbd, 0xca,
-	0x4f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x31, 0x31, 0xe5, 0x06, 0x08, 0x00, 0x00,
+	// 1120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x25, 0xea, 0x6f, 0x64, 0x4b, 0xf4, 0xa6, 0x76, 0x18, 0x26, 0x11, 0x19, 0x22, 0x28,
+	0x8c, 0x14, 0x91, 0xe2, 0x24, 0x68, 0x51, 0x9f, 0x6a, 0x59, 0x74, 0xcd, 0xc6, 0x95, 0x0c, 0x4a,
+	0x42, 0xd1, 0x5c, 0x54, 0x99, 0xdc, 0xca, 0x44, 0x24, 0xae, 0x4a, 0x52, 0x4e, 0xfd, 0x00, 0x05,
+	0x02, 0x5d, 0xda, 0x17, 0x10, 0x10, 0xa0, 0x40, 0xaf, 0x3d, 0xf4, 0x25, 0x72, 0xcc, 0xb1, 0x27,
+	0xa1, 0xb0, 0xcf, 0xbd, 0xe8, 0x05, 0x5a, 0x90, 0xbb, 0xd4, 0x8f, 0x6d, 0x18, 0x68, 0x0a, 0xa4,
+	0x97, 0x9e, 0xb4, 0xf3, 0xcd, 0xb7, 0x33, 0xdf, 0xce, 0xce, 0xac, 0x08, 0xf7, 0xec, 0x23, 0xb3,
+	0x6c, 0x12, 0x17, 0x97, 0xcd, 0xe3, 0x8e, 0xe3, 0xe0, 0x5e, 0xf9, 0x64, 0x2b, 0x5a, 0x96, 0x06,
+	0x2e, 0xf1, 0x09, 0xba, 0x61, 0x1f, 0x99, 0xa5, 0x80, 0x52, 0x8a, 0xf0, 0x93, 0x2d, 0xe9, 0x83,
+	0x2e, 0xe9, 0x92, 0xd0, 0x5f, 0x0e, 0x56, 0x94, 0x2a, 0xc9, 0xf3, 0x68, 0x3d, 0x1b, 0x3b, 0x7e,
+	0x18, 0x2c, 0x5c, 0x51, 0x82, 0xfa, 0x67, 0x1c, 0xd2, 0xbb, 0x34, 0x0a, 0x7a, 0x04, 0x49, 0xcf,
+	0xef, 0xf8, 0x58, 0xe4, 0x14, 0x6e, 0x33, 0xff, 0x58, 0x2a, 0x5d, 0x91, 0xa7, 0xd4, 0x08, 0x18,
+	0x06, 0x25, 0xa2, 0x8f, 0x21, 0x43, 0x5c, 0x0b, 0xbb, 0xb6, 0xd3, 0x15, 0xe3, 0xd7, 0x6c, 0xaa,
+	0x07, 0x24, 0x63, 0xc6, 0x45, 0xcf, 0x60, 0xc5, 0x24, 0x43, 0xc7, 0xc7, 0xee, 0xa0, 0xe3, 0xfa,
+	0xa7, 0x62, 0x42, 0xe1, 0x36, 0x73, 0x8f, 0xef, 0x5d, 0xb9, 0x77, 0x77, 0x81, 0x58, 0xe1, 0xdf,
+	0x4c, 0xe4, 0x98, 0xb1, 0xb4, 0x19, 0xed, 0x42, 0xc1, 0x24, 0x8e, 0x83, 0x4d, 0xdf, 0x26, 0x4e,
+	0xfb, 0x98, 0x0c, 0x3c, 0x91, 0x57, 0x12, 0x9b, 0xd9, 0x8a, 0x34, 0x9d, 0xc8, 0x1b, 0xa7, 0x9d,
+	0x7e, 0x6f, 0x5b, 0xbd, 0x40, 0x50, 0x8d, 0xfc, 0x1c, 0xd9, 0x27, 0x03, 0x0f, 0x89, 0x90, 0x3e,
+	0xc1, 0xae, 0x67, 0x13, 0x47, 0x4c, 0x2a, 0xdc, 0x66, 0xd6, 0x88, 0x4c, 0xb4, 0x07, 0xc2, 0x70,
+	0xd0, 0x75, 0x3b, 0x16, 0x6e, 0x7b, 0xf8, 0xbb, 0x21, 0x76, 0x4c, 0x2c, 0xa6, 0x14, 0x6e, 0x93,
+	0xaf, 0xdc, 0x9e, 0x4e, 0xe4, 0x9b, 0x34, 0xfe, 0x45, 0x86, 0x6a, 0x14, 0x18, 0xd4, 0x60, 0xc8,
+	0x36, 0xff, 0xea, 0xb5, 0x1c, 0x53, 0x7f, 0x4d, 0xc0, 0x9a, 0x6e, 0x61, 0xc7, 0xb7, 0xbf, 0xb5,
+	0xb1, 0xf5, 0x7f, 0xe5, 0xaf, 0xab, 0xfc, 0x4d, 0x48, 0x0f, 0x88, 0xeb, 0xb7, 0x6d, 0x2b, 0x2c,
+	0x78, 0xd6, 0x48, 0x05, 0xa6, 0x6e, 0xa1, 0xbb, 0x00, 0x4c, 0x66, 0xe0, 0x4b, 0x87, 0xbe, 0x2c,
+	0x43, 0x74, 0xeb, 0xca, 0x1b, 0xcb, 0xbc, 0xf3, 0x8d, 0xbd, 0x84, 0x95, 0xc5, 0x42, 0xa0, 0x8f,
+	0xe6, 0xaa, 0x82, 0xdb, 0xca, 0x56, 0xd0, 0x74, 0x22, 0xe7, 0x69, 0x50, 0xe6, 0x50, 0x67, 0x4a,
+	0x9f, 0x2e, 0x29, 0x8d, 0x87, 0xfc, 0xf5, 0xe9, 0x44, 0x5e, 0x63, 0xc5, 0x99, 0xf9, 0xd4, 0x85,
+	0x03, 0xb0, 0xc4, 0x7f, 0x25, 0x20, 0x75, 0xd8, 0x31, 0x5f, 0x60, 0x1f, 0x49, 0x90, 0x99, 0x9d,
+	0x24, 0x48, 0xca, 0x1b, 0x33, 0x1b, 0x7d, 0x02, 0x39, 0x8f, 0x0c, 0x5d, 0x13, 0xb7, 0x83, 0x9c,
+	0x2c, 0xc7, 0xc6, 0x74, 0x22, 0x23, 0x9a, 0x63, 0xc1, 0xa9, 0x1a, 0x40, 0xad, 0x43, 0xe2, 0xfa,
+	0xe8, 0x33, 0xc8, 0x33, 0x1f, 0xcb, 0x1c, 0x36, 0x43, 0xb6, 0x72, 0x6b, 0x3a, 0x91, 0xd7, 0x97,
+	0xf6, 0x32, 0xbf, 0x6a, 0xac, 0x52, 0x20, 0x6a, 0xdb, 0x3d, 0x10, 0x2c, 0xec, 0xf9, 0xb6, 0xd3,
+	0x09, 0xef, 0x37, 0xcc, 0xcf, 0x87, 0x31, 0x16, 0x0a, 0x7d, 0x91, 0xa1, 0x1a, 0x85, 0x05, 0x28,
+	0x54, 0x52, 0x87, 0x1b, 0x8b, 0xac, 0x48, 0x4e, 0xd8, 0x0e, 0x95, 0xe2, 0x74, 0x22, 0x4b, 0x97,
+	0x43, 0xcd, 0x34, 0xa1, 0x05, 0x34, 0x12, 0x86, 0x80, 0xb7, 0x3a, 0x7e, 0x27, 0x6c, 0x9b, 0x15,
+	0x23, 0x5c, 0xa3, 0x6f, 0x20, 0xef, 0xdb, 0x7d, 0x4c, 0x86, 0x7e, 0xfb, 0x18, 0xdb, 0xdd, 0x63,
+	0x3f, 0x6c, 0x9c, 0xdc, 0xd2, 0xdc, 0xd0, 0x97, 0xf1, 0x64, 0xab, 0xb4, 0x1f, 0x32, 0x2a, 0x77,
+	0x83, 0xa6, 0x9f, 0x97, 0x63, 0x79, 0xbf, 0x6a, 0xac, 0x32, 0x80, 0xb2, 0x91, 0x0e, 0x6b, 0x11,
+	0x23, 0xf8, 0xf5, 0xfc, 0x4e, 0x7f, 0xc0, 0x1a, 0xef, 0xce, 0x74, 0x22, 0x8b, 0xcb, 0x41, 0x66,
+	0x14, 0xd5, 0x10, 0x18, 0xd6, 0x8c, 0x20, 0xd6, 0x01, 0xaf, 0x13, 0x90, 0x6e, 0xd1, 0xa6, 0x5c,
+	0x1a, 0x78, 0xee, 0x1f, 0x0c, 0xfc, 0x15, 0x33, 0x1a, 0xff, 0x37, 0x33, 0x9a, 0x58, 0x9e, 0xd1,
+	0xcb, 0x55, 0xe5, 0xdf, 0x47, 0x55, 0x93, 0xef, 0x52, 0x55, 0xf4, 0x0c, 0x90, 0x83, 0xbf, 0xf7,
+	0x67, 0x33, 0xdf, 0xf6, 0xb0, 0x63, 0xb1, 0xc7, 0xfc, 0xee, 0x74, 0x22, 0xdf, 0xa2, 0xb1, 0x2e,
+	0x73, 0x54, 0x43, 0x08, 0xc0, 0xe8, 0x65, 0x68, 0x60, 0x27, 0x1a, 0xd2, 0x2f, 0x60, 0x45, 0x73,
+	0x5d, 0xe2, 0x1a, 0xd8, 0xc4, 0xf6, 0xe0, 0xfa, 0x49, 0x15, 0x21, 0xdd, 0xc7, 0x9e, 0xd7, 0xe9,
+	0x62, 0x3a, 0xa5, 0x46, 0x64, 0xb2, 0x58, 0xbf, 0x70, 0x90, 0xa3, 0x03, 0x1f, 0x3e, 0xf5, 0xef,
+	0xe1, 0xa5, 0x59, 0x92, 0x9b, 0xb8, 0x20, 0x37, 0x1a, 0x22, 0x7e, 0x3e, 0x44, 0x4c, 0xe8, 0x8f,
+	0x1c, 0x64, 0xa8, 0x50, 0xdd, 0xfa, 0x8f, 0x55, 0x32, 0x45, 0x75, 0x28, 0xec, 0x98, 0x2f, 0x1c,
+	0xf2, 0xb2, 0x87, 0xad, 0x2e, 0xee, 0x63, 0xc7, 0x47, 0x22, 0xa4, 0x5c, 0xec, 0x0d, 0x7b, 0xbe,
+	0xb8, 0x1e, 0x1c, 0x60, 0x3f, 0x66, 0x30, 0x1b, 0x6d, 0x40, 0x12, 0x07, 0x77, 0x26, 0x6e, 0x04,
+	0xf9, 0xf7, 0x63, 0x06, 0x35, 0x2b, 0x00, 0x19, 0x17, 0x7b, 0x03, 0xe2, 0x78, 0xf8, 0xc1, 0x0f,
+	0x71, 0x48, 0x36, 0xd8, 0x3f, 0xad, 0xdc, 0x68, 0xee, 0x34, 0xb5, 0x76, 0xab, 0xa6, 0xd7, 0xf4,
+	0xa6, 0xbe, 0x73, 0xa0, 0x3f, 0xd7, 0xaa, 0xed, 0x56, 0xad, 0x71, 0xa8, 0xed, 0xea, 0x7b, 0xba,
+	0x56, 0x15, 0x62, 0xd2, 0xda, 0x68, 0xac, 0xac, 0x2e, 0x11, 0x90, 0x08, 0x40, 0xf7, 0x05, 0xa0,
+	0xc0, 0x49, 0x99, 0xd1, 0x58, 0xe1, 0x83, 0x35, 0x2a, 0xc2, 0x2a, 0xf5, 0x34, 0x8d, 0xaf, 0xeb,
+	0x87, 0x5a, 0x4d, 0x88, 0x4b, 0xb9, 0xd1, 0x58, 0x49, 0x33, 0x73, 0xbe, 0x33, 0x74, 0x26, 0xe8,
+	0xce, 0xd0, 0x73, 0x07, 0x56, 0xa8, 0x67, 0xf7, 0xa0, 0xde, 0xd0, 0xaa, 0x02, 0x2f, 0xc1, 0x68,
+	0xac, 0xa4, 0xa8, 0x85, 0x3e, 0x84, 0xb5, 0x79, 0xc6, 0xd6, 0xe1, 0xe7, 0xc6, 0x4e, 0x55, 0x13,
+	0x92, 0x52, 0x61, 0x34, 0x56, 0x72, 0x0b, 0x10, 0xba, 0x0f, 0xc2, 0x2c, 0x7f, 0x44, 0x4b, 0x49,
+	0xf9, 0xd1, 0x58, 0x81, 0x39, 0x22, 0xf1, 0xaf, 0x7e, 0x2e, 0xc6, 0x1e, 0xfc, 0xc6, 0x41, 0x32,
+	0x7c, 0x52, 0xd0, 0x7d, 0xd8, 0xa8, 0x1b, 0x55, 0xcd, 0x68, 0xd7, 0xea, 0x35, 0xed, 0xc2, 0xf1,
+	0x43, 0x85, 0x01, 0x8e, 0x54, 0x28, 0x50, 0x56, 0xab, 0x16, 0xfe, 0x6a, 0x55, 0x81, 0x93, 0x56,
+	0x47, 0x63, 0x25, 0x3b, 0x03, 0x82, 0xf3, 0x53, 0x4e, 0xc4, 0x60, 0xe7, 0x8f, 0xfc, 0xdb, 0x70,
+	0x7b, 0xc9, 0xdf, 0xde, 0x39, 0x38, 0xa8, 0x7f, 0xd5, 0x6e, 0xea, 0x5f, 0x6a, 0xf5, 0x56, 0x53,
+	0x48, 0x48, 0xb7, 0x46, 0x63, 0x65, 0xfd, 0x4a, 0x27, 0x55, 0x5d, 0x69, 0xbc, 0x39, 0x2b, 0x72,
+	0x6f, 0xcf, 0x8a, 0xdc, 0x1f, 0x67, 0x45, 0xee, 0xa7, 0xf3, 0x62, 0xec, 0xed, 0x79, 0x31, 0xf6,
+	0xfb, 0x79, 0x31, 0xf6, 0xfc, 0xd3, 0xae, 0xed, 0x1f, 0x0f, 0x8f, 0x4a, 0x26, 0xe9, 0x97, 0x4d,
+	0xe2, 0xf5, 0x89, 0x57, 0xb6, 0x8f, 0xcc, 0x87, 0x5d, 0x52, 0x3e, 0x79, 0x52, 0xee, 0x13, 0x6b,
+	0xd8, 0xc3, 0x1e, 0xfd, 0x60, 0x7e, 0xf4, 0xf4, 0x61, 0xf4, 0x05, 0xee, 0x9f, 0x0e, 0xb0, 0x77,
+	0x94, 0x0a, 0xbf, 0x98, 0x9f, 0xfc, 0x3d, 0x00, 0x30, 0xc2, 0xc2, 0x23, 0xa2, 0x0b, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -535,6 +712,11 @@ func (m *Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -594,6 +776,11 @@ func (m *IdentifiedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
 		copy(dAtA[i:], m.ChannelId)
@@ -762,6 +949,105 @@ func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Upgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Upgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextSequenceSend != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.NextSequenceSend))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionHops) > 0 {
+		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConnectionHops[iNdEx])
+			copy(dAtA[i:], m.ConnectionHops[iNdEx])
+			i = encodeVarintChannel(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Ordering != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PacketState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -811,6 +1097,48 @@ func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Acknowledgement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -912,6 +1240,9 @@ func (m *Channel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovChannel(uint64(l))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovChannel(uint64(m.UpgradeSequence))
+	}
 	return n
 }
 
@@ -947,6 +1278,9 @@ func (m *IdentifiedChannel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovChannel(uint64(l))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovChannel(uint64(m.UpgradeSequence))
+	}
 	return n
 }
 
@@ -1004,6 +1338,52 @@ func (m *Packet) Size() (n int) {
 	return n
 }
 
+func (m *Upgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ordering != 0 {
+		n += 1 + sovChannel(uint64(m.Ordering))
+	}
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovChannel(uint64(m.TimeoutTimestamp))
+	}
+	if m.NextSequenceSend != 0 {
+		n += 1 + sovChannel(uint64(m.NextSequenceSend))
+	}
+	return n
+}
+
+func (m *ErrorReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovChannel(uint64(m.Sequence))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
 func (m *PacketState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1028,26 +1408,46 @@ func (m *PacketState) Size() (n int) {
 	return n
 }
 
-func (m *Acknowledgement) Size() (n int) {
+func (m *PacketId) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Response != nil {
-		n += m.Response.Size()
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovChannel(uint64(m.Sequence))
 	}
 	return n
 }
 
-func (m *Acknowledgement_Result) Size() (n int) {
+func (m *Acknowledgement) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result != nil {
-		l = len(m.Result)
+	if m.Response != nil {
+		n += m.Response.Size()
+	}
+	return n
+}
+
+func (m *Acknowledgement_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = len(m.Result)
 		n += 2 + l + sovChannel(uint64(l))
 	}
 	return n
@@ -1233,6 +1633,25 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -1482,6 +1901,25 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -1900,6 +2338,311 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Upgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
+			}
+			m.NextSequenceSend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceSend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PacketState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2067,6 +2810,139 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PacketId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
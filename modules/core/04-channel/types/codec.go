@@ -38,9 +38,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelCloseInit{},
 		&MsgChannelCloseConfirm{},
 		&MsgRecvPacket{},
+		&MsgRecvPackets{},
 		&MsgAcknowledgement{},
 		&MsgTimeout{},
 		&MsgTimeoutOnClose{},
+		&MsgChannelUpgradeInit{},
+		&MsgChannelUpgradeTry{},
+		&MsgChannelUpgradeAck{},
+		&MsgChannelUpgradeConfirm{},
+		&MsgChannelUpgradeTimeout{},
+		&MsgChannelUpgradeCancel{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -38,4 +38,15 @@ var (
 	ErrNoOpMsg = sdkerrors.Register(SubModuleName, 23, "message is redundant, no-op will be performed")
 
 	ErrInvalidChannelVersion = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
+
+	// Channel upgrade errors
+	ErrInvalidUpgrade         = sdkerrors.Register(SubModuleName, 25, "invalid channel upgrade")
+	ErrUpgradeNotFound        = sdkerrors.Register(SubModuleName, 26, "channel upgrade not found")
+	ErrInvalidUpgradeSequence = sdkerrors.Register(SubModuleName, 27, "invalid channel upgrade sequence")
+	ErrUpgradeTimeout         = sdkerrors.Register(SubModuleName, 28, "channel upgrade timeout")
+	ErrUpgradeAborted         = sdkerrors.Register(SubModuleName, 29, "channel upgrade aborted")
+	ErrPacketsInFlight        = sdkerrors.Register(SubModuleName, 30, "channel has packets in flight")
+
+	// Perform a timeout receipt write on the current Msg instead of executing the packet
+	ErrTimeoutReceiptWritten = sdkerrors.Register(SubModuleName, 31, "packet timed out, timeout receipt written")
 )
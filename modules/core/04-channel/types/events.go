@@ -13,6 +13,11 @@ const (
 	AttributeKeyChannelID          = "channel_id"
 	AttributeCounterpartyPortID    = "counterparty_port_id"
 	AttributeCounterpartyChannelID = "counterparty_channel_id"
+	AttributeKeyUpgradeSequence    = "upgrade_sequence"
+	AttributeKeyUpgradeOrdering    = "upgrade_ordering"
+	AttributeKeyUpgradeConnection  = "upgrade_connection_id"
+	AttributeKeyUpgradeVersion     = "upgrade_version"
+	AttributeKeyUpgradeError       = "upgrade_error"
 
 	EventTypeSendPacket           = "send_packet"
 	EventTypeRecvPacket           = "recv_packet"
@@ -20,6 +25,7 @@ const (
 	EventTypeAcknowledgePacket    = "acknowledge_packet"
 	EventTypeTimeoutPacket        = "timeout_packet"
 	EventTypeTimeoutPacketOnClose = "timeout_on_close_packet"
+	EventTypeWriteTimeoutReceipt  = "write_timeout_receipt"
 
 	// NOTE: DEPRECATED in favor of AttributeKeyDataHex
 	AttributeKeyData = "packet_data"
@@ -48,5 +54,13 @@ var (
 	EventTypeChannelCloseInit    = "channel_close_init"
 	EventTypeChannelCloseConfirm = "channel_close_confirm"
 
+	EventTypeChannelUpgradeInit    = "channel_upgrade_init"
+	EventTypeChannelUpgradeTry     = "channel_upgrade_try"
+	EventTypeChannelUpgradeAck     = "channel_upgrade_ack"
+	EventTypeChannelUpgradeConfirm = "channel_upgrade_confirm"
+	EventTypeChannelUpgradeTimeout = "channel_upgrade_timeout"
+	EventTypeChannelUpgradeCancel  = "channel_upgrade_cancel"
+	EventTypeChannelUpgradeError   = "channel_upgrade_error"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
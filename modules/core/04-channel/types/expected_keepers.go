@@ -32,6 +32,24 @@ type ConnectionKeeper interface {
 		channelID string,
 		channel exported.ChannelI,
 	) error
+	VerifyChannelUpgrade(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		upgrade exported.UpgradeI,
+	) error
+	VerifyChannelUpgradeError(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		errorReceipt exported.ErrorReceiptI,
+	) error
 	VerifyPacketCommitment(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -42,6 +60,15 @@ type ConnectionKeeper interface {
 		sequence uint64,
 		commitmentBytes []byte,
 	) error
+	VerifyPacketCommitments(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		commitments map[uint64][]byte,
+	) error
 	VerifyPacketAcknowledgement(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -52,6 +79,16 @@ type ConnectionKeeper interface {
 		sequence uint64,
 		acknowledgement []byte,
 	) error
+	VerifyPacketReceipt(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		receipt []byte,
+	) error
 	VerifyPacketReceiptAbsence(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -357,6 +357,69 @@ func (msg MsgRecvPacket) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+var _ sdk.Msg = &MsgRecvPackets{}
+
+// NewMsgRecvPackets constructs new MsgRecvPackets
+// nolint:interfacer
+func NewMsgRecvPackets(
+	packets []Packet, proofCommitments []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:          packets,
+		ProofCommitments: proofCommitments,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg. All packets must be sent on the same channel
+// to the same destination channel and must have unique sequences.
+func (msg MsgRecvPackets) ValidateBasic() error {
+	if len(msg.Packets) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "packets cannot be empty")
+	}
+	if len(msg.ProofCommitments) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	first := msg.Packets[0]
+	sequences := make(map[uint64]bool, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid packet at index %d", i)
+		}
+
+		if packet.SourcePort != first.SourcePort || packet.SourceChannel != first.SourceChannel ||
+			packet.DestinationPort != first.DestinationPort || packet.DestinationChannel != first.DestinationChannel {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "packet at index %d is not sent on the same channel as the packet at index 0", i)
+		}
+
+		if sequences[packet.Sequence] {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "duplicate packet sequence %d", packet.Sequence)
+		}
+		sequences[packet.Sequence] = true
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRecvPackets) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
 var _ sdk.Msg = &MsgTimeout{}
 
 // NewMsgTimeout constructs new MsgTimeout
@@ -493,3 +556,338 @@ func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+var _ sdk.Msg = &MsgChannelUpgradeInit{}
+
+// NewMsgChannelUpgradeInit creates a new MsgChannelUpgradeInit instance
+// nolint:interfacer
+func NewMsgChannelUpgradeInit(
+	portID, channelID string, upgrade Upgrade, signer string,
+) *MsgChannelUpgradeInit {
+	return &MsgChannelUpgradeInit{
+		PortId:    portID,
+		ChannelId: channelID,
+		Upgrade:   upgrade,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeInit) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if err := msg.Upgrade.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid upgrade")
+	}
+	if !msg.Upgrade.HasTimeout() {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "upgrade timeout height and upgrade timeout timestamp cannot both be 0")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeInit) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeTry{}
+
+// NewMsgChannelUpgradeTry creates a new MsgChannelUpgradeTry instance
+// nolint:interfacer
+func NewMsgChannelUpgradeTry(
+	portID, channelID string, proposedConnectionHops []string,
+	counterpartyChannel Channel, counterpartyUpgrade Upgrade,
+	proofChannel, proofUpgrade []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeTry {
+	return &MsgChannelUpgradeTry{
+		PortId:                 portID,
+		ChannelId:              channelID,
+		ProposedConnectionHops: proposedConnectionHops,
+		CounterpartyChannel:    counterpartyChannel,
+		CounterpartyUpgrade:    counterpartyUpgrade,
+		ProofChannel:           proofChannel,
+		ProofUpgrade:           proofUpgrade,
+		ProofHeight:            proofHeight,
+		Signer:                 signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeTry) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProposedConnectionHops) != 1 {
+		return sdkerrors.Wrap(ErrTooManyConnectionHops, "current IBC version only supports one connection hop")
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ProposedConnectionHops[0]); err != nil {
+		return sdkerrors.Wrap(err, "invalid proposed connection hop ID")
+	}
+	if msg.CounterpartyChannel.State != INITUPGRADE {
+		return sdkerrors.Wrapf(ErrInvalidChannelState, "counterparty channel state must be INITUPGRADE, got %s", msg.CounterpartyChannel.State)
+	}
+	if err := msg.CounterpartyChannel.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty channel")
+	}
+	if err := msg.CounterpartyUpgrade.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty upgrade")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof channel")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof upgrade")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeTry) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeAck{}
+
+// NewMsgChannelUpgradeAck creates a new MsgChannelUpgradeAck instance
+// nolint:interfacer
+func NewMsgChannelUpgradeAck(
+	portID, channelID string, counterpartyChannel Channel, counterpartyUpgrade Upgrade,
+	proofChannel, proofUpgrade []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeAck {
+	return &MsgChannelUpgradeAck{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyChannel: counterpartyChannel,
+		CounterpartyUpgrade: counterpartyUpgrade,
+		ProofChannel:        proofChannel,
+		ProofUpgrade:        proofUpgrade,
+		ProofHeight:         proofHeight,
+		Signer:              signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeAck) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if msg.CounterpartyChannel.State != TRYUPGRADE {
+		return sdkerrors.Wrapf(ErrInvalidChannelState, "counterparty channel state must be TRYUPGRADE, got %s", msg.CounterpartyChannel.State)
+	}
+	if err := msg.CounterpartyChannel.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty channel")
+	}
+	if err := msg.CounterpartyUpgrade.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty upgrade")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof channel")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof upgrade")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeAck) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeConfirm{}
+
+// NewMsgChannelUpgradeConfirm creates a new MsgChannelUpgradeConfirm instance
+// nolint:interfacer
+func NewMsgChannelUpgradeConfirm(
+	portID, channelID string, counterpartyChannel Channel,
+	proofChannel []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeConfirm {
+	return &MsgChannelUpgradeConfirm{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyChannel: counterpartyChannel,
+		ProofChannel:        proofChannel,
+		ProofHeight:         proofHeight,
+		Signer:              signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if msg.CounterpartyChannel.State != OPEN {
+		return sdkerrors.Wrapf(ErrInvalidChannelState, "counterparty channel state must be OPEN, got %s", msg.CounterpartyChannel.State)
+	}
+	if err := msg.CounterpartyChannel.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty channel")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof channel")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeTimeout{}
+
+// NewMsgChannelUpgradeTimeout creates a new MsgChannelUpgradeTimeout instance
+// nolint:interfacer
+func NewMsgChannelUpgradeTimeout(
+	portID, channelID string, counterpartyChannel Channel,
+	proofChannel []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeTimeout {
+	return &MsgChannelUpgradeTimeout{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyChannel: counterpartyChannel,
+		ProofChannel:        proofChannel,
+		ProofHeight:         proofHeight,
+		Signer:              signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if err := msg.CounterpartyChannel.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty channel")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof channel")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeCancel{}
+
+// NewMsgChannelUpgradeCancel creates a new MsgChannelUpgradeCancel instance
+// nolint:interfacer
+func NewMsgChannelUpgradeCancel(
+	portID, channelID string, errorReceipt ErrorReceipt,
+	proofErrorReceipt []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeCancel {
+	return &MsgChannelUpgradeCancel{
+		PortId:            portID,
+		ChannelId:         channelID,
+		ErrorReceipt:      errorReceipt,
+		ProofErrorReceipt: proofErrorReceipt,
+		ProofHeight:       proofHeight,
+		Signer:            signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProofErrorReceipt) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof error receipt")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
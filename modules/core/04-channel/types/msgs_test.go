@@ -118,7 +118,7 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		{"too short port id", types.NewMsgChannelOpenInit(invalidShortPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"too long port id", types.NewMsgChannelOpenInit(invalidLongPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"port id contains non-alpha", types.NewMsgChannelOpenInit(invalidPort, version, types.ORDERED, connHops, cpportid, addr), false},
-		{"invalid channel order", types.NewMsgChannelOpenInit(portid, version, types.Order(3), connHops, cpportid, addr), false},
+		{"invalid channel order", types.NewMsgChannelOpenInit(portid, version, types.Order(4), connHops, cpportid, addr), false},
 		{"connection hops more than 1 ", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, invalidConnHops, cpportid, addr), false},
 		{"too short connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidShortConnHops, cpportid, addr), false},
 		{"too long connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidLongConnHops, cpportid, addr), false},
@@ -351,6 +351,49 @@ func (suite *TypesTestSuite) TestMsgRecvPacketGetSigners() {
 	suite.Equal(expected, fmt.Sprintf("%v", res))
 }
 
+func (suite *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	packet2 := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	otherChannelPacket := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, "otherchannel", timeoutHeight, timeoutTimestamp)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRecvPackets
+		expPass bool
+	}{
+		{"success", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, suite.proof, height, addr), true},
+		{"success: single packet", types.NewMsgRecvPackets([]types.Packet{packet}, suite.proof, height, addr), true},
+		{"empty packets", types.NewMsgRecvPackets(nil, suite.proof, height, addr), false},
+		{"proof height is zero", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"proof contain empty proof", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, emptyProof, height, addr), false},
+		{"missing signer address", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, suite.proof, height, emptyAddr), false},
+		{"invalid packet", types.NewMsgRecvPackets([]types.Packet{packet, invalidPacket}, suite.proof, height, addr), false},
+		{"packets sent on different channels", types.NewMsgRecvPackets([]types.Packet{packet, otherChannelPacket}, suite.proof, height, addr), false},
+		{"duplicate packet sequence", types.NewMsgRecvPackets([]types.Packet{packet, packet}, suite.proof, height, addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgRecvPacketsGetSigners() {
+	msg := types.NewMsgRecvPackets([]types.Packet{packet}, suite.proof, height, addr)
+	res := msg.GetSigners()
+
+	expected := "[7465737461646472313131313131313131313131]"
+	suite.Equal(expected, fmt.Sprintf("%v", res))
+}
+
 func (suite *TypesTestSuite) TestMsgTimeoutValidateBasic() {
 	testCases := []struct {
 		name    string
@@ -438,3 +481,105 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeInitValidateBasic() {
+	upgrade := types.NewUpgrade(types.ORDERED, connHops, version, timeoutHeight, 0, 0)
+	noTimeoutUpgrade := types.NewUpgrade(types.ORDERED, connHops, version, disabledTimeout, 0, 0)
+	invalidUpgrade := types.NewUpgrade(types.ORDERED, invalidConnHops, version, timeoutHeight, 0, 0)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeInit
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeInit(portid, chanid, upgrade, addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeInit(invalidShortPort, chanid, upgrade, addr), false},
+		{"too short channel id", types.NewMsgChannelUpgradeInit(portid, invalidShortChannel, upgrade, addr), false},
+		{"invalid connection hops", types.NewMsgChannelUpgradeInit(portid, chanid, invalidUpgrade, addr), false},
+		{"upgrade timeout disabled", types.NewMsgChannelUpgradeInit(portid, chanid, noTimeoutUpgrade, addr), false},
+		{"empty signer", types.NewMsgChannelUpgradeInit(portid, chanid, upgrade, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeTryValidateBasic() {
+	counterparty := types.NewCounterparty(portid, chanid)
+	counterpartyChannel := types.NewChannel(types.INITUPGRADE, types.UNORDERED, counterparty, connHops, version)
+	openChannel := types.NewChannel(types.OPEN, types.UNORDERED, counterparty, connHops, version)
+	upgrade := types.NewUpgrade(types.ORDERED, connHops, version, timeoutHeight, 0, 1)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeTry
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, counterpartyChannel, upgrade, suite.proof, suite.proof, height, addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeTry(invalidShortPort, chanid, connHops, counterpartyChannel, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"too short channel id", types.NewMsgChannelUpgradeTry(portid, invalidShortChannel, connHops, counterpartyChannel, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"too many connection hops", types.NewMsgChannelUpgradeTry(portid, chanid, invalidConnHops, counterpartyChannel, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"invalid connection hop", types.NewMsgChannelUpgradeTry(portid, chanid, invalidShortConnHops, counterpartyChannel, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"counterparty channel is not INITUPGRADE", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, openChannel, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"empty channel proof", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, counterpartyChannel, upgrade, emptyProof, suite.proof, height, addr), false},
+		{"empty upgrade proof", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, counterpartyChannel, upgrade, suite.proof, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, counterpartyChannel, upgrade, suite.proof, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"empty signer", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, counterpartyChannel, upgrade, suite.proof, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeCancelValidateBasic() {
+	errorReceipt := types.NewErrorReceipt(1, types.ErrInvalidUpgrade)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeCancel
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, suite.proof, height, addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeCancel(invalidShortPort, chanid, errorReceipt, suite.proof, height, addr), false},
+		{"too short channel id", types.NewMsgChannelUpgradeCancel(portid, invalidShortChannel, errorReceipt, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"empty signer", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
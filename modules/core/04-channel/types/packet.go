@@ -12,6 +12,11 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
+// TimeoutReceipt is the packet receipt written by the receiving end of an ORDERED_ALLOW_TIMEOUT
+// channel for a packet which timed out. The sending end verifies it in order to time out the
+// packet without closing the channel.
+var TimeoutReceipt = []byte{byte(2)}
+
 // CommitPacket returns the packet commitment bytes. The commitment consists of:
 // sha256_hash(timeout_timestamp + timeout_height.RevisionNumber + timeout_height.RevisionHeight + sha256_hash(data))
 // from a given packet. This results in a fixed length preimage.
@@ -111,3 +116,22 @@ func (p Packet) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewPacketId returns a new instance of PacketId
+func NewPacketId(portId, channelId string, seq uint64) PacketId {
+	return PacketId{PortId: portId, ChannelId: channelId, Sequence: seq}
+}
+
+// Validate performs basic validation of fields returning an error upon any failure.
+func (p PacketId) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+	return nil
+}
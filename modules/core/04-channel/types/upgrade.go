@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var (
+	_ exported.UpgradeI      = (*Upgrade)(nil)
+	_ exported.ErrorReceiptI = (*ErrorReceipt)(nil)
+)
+
+// NewUpgrade creates a new Upgrade instance
+func NewUpgrade(
+	ordering Order, hops []string, version string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp, nextSequenceSend uint64,
+) Upgrade {
+	return Upgrade{
+		Ordering:         ordering,
+		ConnectionHops:   hops,
+		Version:          version,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		NextSequenceSend: nextSequenceSend,
+	}
+}
+
+// GetOrdering implements Upgrade interface.
+func (u Upgrade) GetOrdering() int32 {
+	return int32(u.Ordering)
+}
+
+// GetConnectionHops implements Upgrade interface.
+func (u Upgrade) GetConnectionHops() []string {
+	return u.ConnectionHops
+}
+
+// GetVersion implements Upgrade interface.
+func (u Upgrade) GetVersion() string {
+	return u.Version
+}
+
+// GetTimeoutHeight implements Upgrade interface.
+func (u Upgrade) GetTimeoutHeight() exported.Height {
+	return u.TimeoutHeight
+}
+
+// GetTimeoutTimestamp implements Upgrade interface.
+func (u Upgrade) GetTimeoutTimestamp() uint64 {
+	return u.TimeoutTimestamp
+}
+
+// GetNextSequenceSend implements Upgrade interface.
+func (u Upgrade) GetNextSequenceSend() uint64 {
+	return u.NextSequenceSend
+}
+
+// ValidateBasic performs a basic validation of the upgrade fields. The upgrade
+// timeout is not validated as only the upgrade proposed by the initiating
+// channel end is required to have one.
+func (u Upgrade) ValidateBasic() error {
+	if !u.Ordering.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidChannelOrdering, u.Ordering.String())
+	}
+	if len(u.ConnectionHops) != 1 {
+		return sdkerrors.Wrap(
+			ErrTooManyConnectionHops,
+			"current IBC version only supports one connection hop",
+		)
+	}
+	if err := host.ConnectionIdentifierValidator(u.ConnectionHops[0]); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection hop ID")
+	}
+	return nil
+}
+
+// HasTimeout returns true if either the upgrade timeout height or the upgrade
+// timeout timestamp is set.
+func (u Upgrade) HasTimeout() bool {
+	return !u.TimeoutHeight.IsZero() || u.TimeoutTimestamp != 0
+}
+
+// NewErrorReceipt creates a new ErrorReceipt for the given upgrade sequence. Only
+// the ABCI code of the error is included in the message as error receipts are
+// written into state and error strings may change between patch versions.
+func NewErrorReceipt(sequence uint64, err error) ErrorReceipt {
+	_, code, _ := sdkerrors.ABCIInfo(err, false)
+	return ErrorReceipt{
+		Sequence: sequence,
+		Message:  fmt.Sprintf("ABCI code: %d: %s", code, ErrUpgradeAborted.Error()),
+	}
+}
+
+// GetSequence implements ErrorReceipt interface.
+func (er ErrorReceipt) GetSequence() uint64 {
+	return er.Sequence
+}
+
+// GetMessage implements ErrorReceipt interface.
+func (er ErrorReceipt) GetMessage() string {
+	return er.Message
+}
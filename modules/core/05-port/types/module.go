@@ -73,6 +73,63 @@ type IBCModule interface {
 		channelID string,
 	) error
 
+	// OnChanUpgradeInit will verify that the proposed upgrade parameters are valid and
+	// perform any custom upgrade INIT logic. It may return an error if the proposed
+	// parameters are invalid in which case the upgrade handshake is aborted. The channel
+	// keeps its current parameters until the upgrade completes.
+	OnChanUpgradeInit(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	) error
+
+	// OnChanUpgradeTry will verify the upgrade parameters proposed by the counterparty
+	// and perform custom upgrade TRY logic. If the counterparty-chosen version is not
+	// compatible with this module's supported versions, the callback must return an
+	// error in which case an error receipt is written and the counterparty cancels the
+	// upgrade. Otherwise the callback must select the upgraded version string and return
+	// it to core IBC.
+	OnChanUpgradeTry(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		counterpartyVersion string,
+	) (version string, err error)
+
+	// OnChanUpgradeAck will error if the counterparty selected version string is
+	// invalid, in which case an error receipt is written and the upgrade is aborted.
+	// Otherwise the upgrade is applied to the channel once the callback returns and
+	// the module should perform any logic required to use the upgraded channel.
+	OnChanUpgradeAck(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		counterpartyVersion string,
+	) error
+
+	// OnChanUpgradeConfirm will perform custom upgrade CONFIRM logic. The upgrade is
+	// applied to the channel once the callback returns. It may error to abort the
+	// transaction, in which case the upgrade can be confirmed again later.
+	OnChanUpgradeConfirm(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	) error
+
+	// OnChanUpgradeRestore is called when an upgrade is cancelled or times out and the
+	// channel is restored to its current parameters. Modules should revert any state
+	// changes made in anticipation of the upgrade.
+	OnChanUpgradeRestore(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	)
+
 	// OnRecvPacket must return an acknowledgement that implements the Acknowledgement interface.
 	// In the case of an asynchronous acknowledgement, nil should be returned.
 	// If the acknowledgement returned is successful, the state changes on callback are written,
@@ -112,6 +169,12 @@ type ICS4Wrapper interface {
 		packet exported.PacketI,
 		ack exported.Acknowledgement,
 	) error
+
+	GetAppVersion(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	) (string, bool)
 }
 
 // Middleware must implement IBCModule to wrap communication from core IBC to underlying application
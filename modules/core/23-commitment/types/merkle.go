@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/url"
+	"sort"
 
 	ics23 "github.com/confio/ics23/go"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -203,16 +204,117 @@ func (proof MerkleProof) VerifyNonMembership(specs []*ics23.ProofSpec, root expo
 	return nil
 }
 
-// BatchVerifyMembership verifies a group of key value pairs against the given root
-// NOTE: Currently left unimplemented as it is unused
+// BatchVerifyMembership verifies a group of key value pairs against the given root.
+// The path is the prefix path shared by all the keys, i.e. it does not contain the keys being proven.
+// The keys of the items map are the raw (unescaped) keys in the lowest subtree. The proof at index 0
+// must be a batch (or compressed batch) existence proof for every item, and each subsequent subroot
+// is then verified to be committed to by the next proof in the chain up to the final root.
 func (proof MerkleProof) BatchVerifyMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items map[string][]byte) error {
-	return sdkerrors.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
+	if err := proof.validateVerificationArgs(specs, root); err != nil {
+		return err
+	}
+
+	// BatchVerifyMembership specific argument validation
+	mpath, err := validateBatchVerificationPath(specs, path)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProof, "no items provided in batch membership proof")
+	}
+
+	// check the keys in sorted order so that any returned error is deterministic
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if len(items[key]) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidProof, "empty value for key %s in batch membership proof", key)
+		}
+	}
+
+	batchProof := ics23.Decompress(proof.Proofs[0])
+	switch batchProof.Proof.(type) {
+	case *ics23.CommitmentProof_Batch, *ics23.CommitmentProof_Exist:
+		subroot, err := batchProof.Calculate()
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProof, "could not calculate root for batch proof at index 0, merkle tree may be empty. %v", err)
+		}
+		if ok := ics23.BatchVerifyMembership(specs[0], subroot, batchProof, items); !ok {
+			return sdkerrors.Wrapf(ErrInvalidProof, "could not verify membership of %d items in subroot %X. Please ensure the keys and values are correct.", len(items), subroot)
+		}
+
+		// Verify chained membership proof starting from index 1 with value = subroot
+		if err := verifyChainedMembershipProof(root.GetHash(), specs, proof.Proofs, mpath, subroot, 1); err != nil {
+			return err
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidProof,
+			"expected proof type: %T, got: %T", &ics23.CommitmentProof_Batch{}, batchProof.Proof)
+	}
+	return nil
 }
 
-// BatchVerifyNonMembership verifies absence of a group of keys against the given root
-// NOTE: Currently left unimplemented as it is unused
+// BatchVerifyNonMembership verifies absence of a group of keys against the given root.
+// The path is the prefix path shared by all the keys, i.e. it does not contain the keys being proven.
+// The keys are the raw (unescaped) keys in the lowest subtree. The proof at index 0 must be a
+// batch (or compressed batch) non-existence proof for every key, and each subsequent subroot is
+// then verified to be committed to by the next proof in the chain up to the final root.
 func (proof MerkleProof) BatchVerifyNonMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items [][]byte) error {
-	return sdkerrors.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
+	if err := proof.validateVerificationArgs(specs, root); err != nil {
+		return err
+	}
+
+	// BatchVerifyNonMembership specific argument validation
+	mpath, err := validateBatchVerificationPath(specs, path)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProof, "no keys provided in batch non-membership proof")
+	}
+
+	batchProof := ics23.Decompress(proof.Proofs[0])
+	switch batchProof.Proof.(type) {
+	case *ics23.CommitmentProof_Batch, *ics23.CommitmentProof_Nonexist:
+		subroot, err := batchProof.Calculate()
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProof, "could not calculate root for batch proof at index 0, merkle tree is likely empty. %v", err)
+		}
+		if ok := ics23.BatchVerifyNonMembership(specs[0], subroot, batchProof, items); !ok {
+			return sdkerrors.Wrapf(ErrInvalidProof, "could not verify absence of %d keys in subroot %X. Please ensure that the keys are correct.", len(items), subroot)
+		}
+
+		// Verify chained membership proof starting from index 1 with value = subroot
+		if err := verifyChainedMembershipProof(root.GetHash(), specs, proof.Proofs, mpath, subroot, 1); err != nil {
+			return err
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidProof,
+			"expected proof type: %T, got: %T", &ics23.CommitmentProof_Batch{}, batchProof.Proof)
+	}
+	return nil
+}
+
+// validateBatchVerificationPath checks that the provided path is a MerklePath containing a key for every
+// proof in the chain except the lowest one. A placeholder key is appended to the returned path so that the
+// key indices line up with the proof indices when the chained membership proof is verified from index 1.
+func validateBatchVerificationPath(specs []*ics23.ProofSpec, path exported.Path) (MerklePath, error) {
+	mpath, ok := path.(MerklePath)
+	if !ok {
+		return MerklePath{}, sdkerrors.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+	}
+	if len(mpath.KeyPath) != len(specs)-1 {
+		return MerklePath{}, sdkerrors.Wrapf(ErrInvalidProof, "prefix path length %d not one less than proof length %d",
+			len(mpath.KeyPath), len(specs))
+	}
+
+	keyPath := make([]string, len(mpath.KeyPath), len(mpath.KeyPath)+1)
+	copy(keyPath, mpath.KeyPath)
+	return NewMerklePath(append(keyPath, "")...), nil
 }
 
 // verifyChainedMembershipProof takes a list of proofs and specs and verifies each proof sequentially ensuring that the value is committed to
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	ics23 "github.com/confio/ics23/go"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -139,6 +140,196 @@ func (suite *MerkleTestSuite) TestVerifyNonMembership() {
 
 }
 
+func (suite *MerkleTestSuite) TestBatchVerifyMembership() {
+	keys := []string{"MYKEY1", "MYKEY2", "MYKEY3"}
+	items := map[string][]byte{
+		"MYKEY1": []byte("MYVALUE1"),
+		"MYKEY2": []byte("MYVALUE2"),
+		"MYKEY3": []byte("MYVALUE3"),
+	}
+	for key, value := range items {
+		suite.iavlStore.Set([]byte(key), value)
+	}
+	cid := suite.store.Commit()
+
+	var proofs []types.MerkleProof
+	for _, key := range keys {
+		res := suite.store.Query(abci.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+			Data:  []byte(key),
+			Prove: true,
+		})
+		require.NotNil(suite.T(), res.ProofOps)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		require.NoError(suite.T(), err)
+
+		proofs = append(proofs, proof)
+	}
+
+	var (
+		proof     types.MerkleProof
+		testItems map[string][]byte
+	)
+
+	cases := []struct {
+		name       string
+		root       []byte
+		pathArr    []string
+		malleate   func()
+		shouldPass bool
+	}{
+		{"valid proof", cid.Hash, []string{suite.storeKey.Name()}, func() {}, true},
+		{"valid proof for subset of items", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			delete(testItems, "MYKEY1")
+		}, true},
+		{"valid proof for single item", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			proof = proofs[0]
+			testItems = map[string][]byte{keys[0]: items[keys[0]]}
+		}, true},
+		{"wrong value", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			testItems["MYKEY1"] = []byte("WRONGVALUE")
+		}, false},
+		{"nil value", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			testItems["MYKEY1"] = nil
+		}, false},
+		{"key not in proof", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			testItems["NOTMYKEY"] = []byte("MYVALUE1")
+		}, false},
+		{"no items", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			testItems = map[string][]byte{}
+		}, false},
+		{"wrong path 1", cid.Hash, []string{suite.storeKey.Name(), "MYKEY1"}, func() {}, false},
+		{"wrong path 2", cid.Hash, []string{}, func() {}, false},
+		{"wrong storekey", cid.Hash, []string{"otherStoreKey"}, func() {}, false},
+		{"wrong root", []byte("WRONGROOT"), []string{suite.storeKey.Name()}, func() {}, false},
+		{"nil root", []byte(nil), []string{suite.storeKey.Name()}, func() {}, false},
+		{"proof is wrong length", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			proof = types.MerkleProof{
+				Proofs: proof.Proofs[1:],
+			}
+		}, false},
+		{"proof is not a batch existence proof", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			proof = types.MerkleProof{
+				Proofs: append([]*ics23.CommitmentProof{proof.Proofs[1]}, proof.Proofs[1:]...),
+			}
+		}, false},
+	}
+
+	for i, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			var err error
+			proof, err = types.CombineMerkleProofs(proofs)
+			suite.Require().NoError(err)
+
+			testItems = make(map[string][]byte)
+			for key, value := range items {
+				testItems[key] = value
+			}
+
+			tc.malleate()
+
+			root := types.NewMerkleRoot(tc.root)
+			path := types.NewMerklePath(tc.pathArr...)
+
+			err = proof.BatchVerifyMembership(types.GetSDKSpecs(), &root, path, testItems)
+
+			if tc.shouldPass {
+				// nolint: scopelint
+				suite.Require().NoError(err, "test case %d should have passed", i)
+			} else {
+				// nolint: scopelint
+				suite.Require().Error(err, "test case %d should have failed", i)
+			}
+		})
+	}
+}
+
+func (suite *MerkleTestSuite) TestBatchVerifyNonMembership() {
+	suite.iavlStore.Set([]byte("MYKEY1"), []byte("MYVALUE1"))
+	suite.iavlStore.Set([]byte("MYKEY3"), []byte("MYVALUE3"))
+	cid := suite.store.Commit()
+
+	absentKeys := [][]byte{[]byte("MYABSENTKEY"), []byte("MYKEY2"), []byte("MYKEY4")}
+
+	var proofs []types.MerkleProof
+	for _, key := range absentKeys {
+		res := suite.store.Query(abci.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+			Data:  key,
+			Prove: true,
+		})
+		require.NotNil(suite.T(), res.ProofOps)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		require.NoError(suite.T(), err)
+
+		proofs = append(proofs, proof)
+	}
+
+	var (
+		proof    types.MerkleProof
+		testKeys [][]byte
+	)
+
+	cases := []struct {
+		name       string
+		root       []byte
+		pathArr    []string
+		malleate   func()
+		shouldPass bool
+	}{
+		{"valid proof", cid.Hash, []string{suite.storeKey.Name()}, func() {}, true},
+		{"valid proof for subset of keys", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			testKeys = testKeys[1:]
+		}, true},
+		{"existent key", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			testKeys = append(testKeys, []byte("MYKEY1"))
+		}, false},
+		{"no keys", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			testKeys = nil
+		}, false},
+		{"wrong path 1", cid.Hash, []string{suite.storeKey.Name(), "MYABSENTKEY"}, func() {}, false},
+		{"wrong path 2", cid.Hash, []string{}, func() {}, false},
+		{"wrong storeKey", cid.Hash, []string{"otherStoreKey"}, func() {}, false},
+		{"wrong root", []byte("WRONGROOT"), []string{suite.storeKey.Name()}, func() {}, false},
+		{"nil root", []byte(nil), []string{suite.storeKey.Name()}, func() {}, false},
+		{"proof is wrong length", cid.Hash, []string{suite.storeKey.Name()}, func() {
+			proof = types.MerkleProof{
+				Proofs: proof.Proofs[1:],
+			}
+		}, false},
+	}
+
+	for i, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			var err error
+			proof, err = types.CombineMerkleProofs(proofs)
+			suite.Require().NoError(err)
+
+			testKeys = append([][]byte{}, absentKeys...)
+
+			tc.malleate()
+
+			root := types.NewMerkleRoot(tc.root)
+			path := types.NewMerklePath(tc.pathArr...)
+
+			err = proof.BatchVerifyNonMembership(types.GetSDKSpecs(), &root, path, testKeys)
+
+			if tc.shouldPass {
+				// nolint: scopelint
+				suite.Require().NoError(err, "test case %d should have passed", i)
+			} else {
+				// nolint: scopelint
+				suite.Require().Error(err, "test case %d should have failed", i)
+			}
+		})
+	}
+}
+
 func TestApplyPrefix(t *testing.T) {
 	prefix := types.NewMerklePrefix([]byte("storePrefixKey"))
 
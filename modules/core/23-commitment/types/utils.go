@@ -3,6 +3,7 @@ package types
 import (
 	ics23 "github.com/confio/ics23/go"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 )
 
@@ -25,3 +26,41 @@ func ConvertProofs(tmProof *crypto.ProofOps) (MerkleProof, error) {
 		Proofs: proofs,
 	}, nil
 }
+
+// CombineMerkleProofs combines a list of MerkleProofs, proving keys within the same lowest subtree,
+// into a single MerkleProof. The proofs at index 0 are combined into a compressed ics23 batch proof.
+// All remaining proofs in the chain must be identical since they prove the same subroot up to the final root.
+func CombineMerkleProofs(proofs []MerkleProof) (MerkleProof, error) {
+	if len(proofs) == 0 {
+		return MerkleProof{}, sdkerrors.Wrap(ErrInvalidMerkleProof, "no proofs provided to combine")
+	}
+
+	chainLength := len(proofs[0].Proofs)
+	if chainLength == 0 {
+		return MerkleProof{}, sdkerrors.Wrap(ErrInvalidMerkleProof, "proof at index 0 cannot be empty")
+	}
+
+	lowestProofs := make([]*ics23.CommitmentProof, len(proofs))
+	for i, proof := range proofs {
+		if len(proof.Proofs) != chainLength {
+			return MerkleProof{}, sdkerrors.Wrapf(ErrInvalidMerkleProof, "proof at index %d has length %d, expected %d", i, len(proof.Proofs), chainLength)
+		}
+
+		for j := 1; j < chainLength; j++ {
+			if !proto.Equal(proof.Proofs[j], proofs[0].Proofs[j]) {
+				return MerkleProof{}, sdkerrors.Wrapf(ErrInvalidMerkleProof, "proof at index %d does not share the same subtree proof at index %d", i, j)
+			}
+		}
+
+		lowestProofs[i] = proof.Proofs[0]
+	}
+
+	batchProof, err := ics23.CombineProofs(lowestProofs)
+	if err != nil {
+		return MerkleProof{}, sdkerrors.Wrapf(ErrInvalidMerkleProof, "could not combine proofs: %v", err)
+	}
+
+	return MerkleProof{
+		Proofs: append([]*ics23.CommitmentProof{batchProof}, proofs[0].Proofs[1:]...),
+	}, nil
+}
@@ -96,3 +96,81 @@ func (suite *MerkleTestSuite) TestConvertProofs() {
 		}
 	}
 }
+
+func (suite *MerkleTestSuite) TestCombineMerkleProofs() {
+	suite.iavlStore.Set([]byte("MYKEY1"), []byte("MYVALUE1"))
+	suite.iavlStore.Set([]byte("MYKEY2"), []byte("MYVALUE2"))
+	cid := suite.store.Commit()
+
+	root := types.NewMerkleRoot(cid.Hash)
+	prefixPath := types.NewMerklePath(suite.storeKey.Name())
+	items := map[string][]byte{
+		"MYKEY1": []byte("MYVALUE1"),
+		"MYKEY2": []byte("MYVALUE2"),
+	}
+
+	queryProof := func(key string) types.MerkleProof {
+		res := suite.store.Query(abci.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+			Data:  []byte(key),
+			Prove: true,
+		})
+		require.NotNil(suite.T(), res.ProofOps)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		require.NoError(suite.T(), err)
+
+		return proof
+	}
+
+	var proofs []types.MerkleProof
+	testcases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"no proofs",
+			func() {
+				proofs = nil
+			},
+			false,
+		},
+		{
+			"proofs have different lengths",
+			func() {
+				proofs[1].Proofs = proofs[1].Proofs[1:]
+			},
+			false,
+		},
+		{
+			"proofs do not share the same subtree proof",
+			func() {
+				proofs[1].Proofs[1] = proofs[1].Proofs[0]
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testcases {
+		proofs = []types.MerkleProof{queryProof("MYKEY1"), queryProof("MYKEY2")}
+
+		tc.malleate()
+
+		proof, err := types.CombineMerkleProofs(proofs)
+		if tc.expPass {
+			suite.Require().NoError(err, "CombineMerkleProofs unexpectedly returned error for case: %s", tc.name)
+			suite.Require().NotNil(proof.Proofs[0].GetCompressed(), "combined proof is not compressed for case: %s", tc.name)
+
+			err := proof.BatchVerifyMembership(types.GetSDKSpecs(), &root, prefixPath, items)
+			suite.Require().NoError(err, "combined proof failed to verify batch membership for case: %s", tc.name)
+		} else {
+			suite.Require().Error(err, "CombineMerkleProofs passed on invalid case for case: %s", tc.name)
+		}
+	}
+}
@@ -34,6 +34,14 @@ func (ad AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				}
 				packetMsgs += 1
 
+			case *channeltypes.MsgRecvPackets:
+				for _, packet := range msg.Packets {
+					if _, found := ad.k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()); found {
+						redundancies += 1
+					}
+					packetMsgs += 1
+				}
+
 			case *channeltypes.MsgAcknowledgement:
 				if commitment := ad.k.GetPacketCommitment(ctx, msg.Packet.GetSourcePort(), msg.Packet.GetSourceChannel(), msg.Packet.GetSequence()); len(commitment) == 0 {
 					redundancies += 1
@@ -9,6 +9,7 @@ import (
 	connection "github.com/cosmos/ibc-go/v3/modules/core/03-connection"
 	channel "github.com/cosmos/ibc-go/v3/modules/core/04-channel"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	solomachine "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -24,6 +25,7 @@ func GetTxCmd() *cobra.Command {
 	ibcTxCmd.AddCommand(
 		ibcclient.GetTxCmd(),
 		channel.GetTxCmd(),
+		solomachine.GetTxCmd(),
 	)
 
 	return ibcTxCmd
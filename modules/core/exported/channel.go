@@ -18,6 +18,24 @@ type CounterpartyChannelI interface {
 	ValidateBasic() error
 }
 
+// UpgradeI defines the standard interface for a proposed channel upgrade.
+type UpgradeI interface {
+	GetOrdering() int32
+	GetConnectionHops() []string
+	GetVersion() string
+	GetTimeoutHeight() Height
+	GetTimeoutTimestamp() uint64
+	GetNextSequenceSend() uint64
+	ValidateBasic() error
+}
+
+// ErrorReceiptI defines the standard interface for the error receipt written
+// when a channel upgrade is aborted.
+type ErrorReceiptI interface {
+	GetSequence() uint64
+	GetMessage() string
+}
+
 // PacketI defines the standard interface for IBC packets
 type PacketI interface {
 	GetSequence() uint64
@@ -16,6 +16,10 @@ const (
 	// Solomachine is used to indicate that the light client is a solo machine.
 	Solomachine string = "06-solomachine"
 
+	// SolomachineV3 is used to indicate that the light client is a solo machine whose consensus state is a
+	// weighted set of signers. It is distinct from Solomachine so that it can be allowed separately.
+	SolomachineV3 string = "06-solomachine-v3"
+
 	// Tendermint is used to indicate that the client uses the Tendermint Consensus Algorithm.
 	Tendermint string = "07-tendermint"
 
@@ -23,6 +27,13 @@ const (
 	// for the localhost client.
 	Localhost string = "09-localhost"
 
+	// Committee is used to indicate that the client verifies state roots signed by a weighted committee.
+	Committee string = "10-committee"
+
+	// LocalhostConnectionID is the identifier of the sentinel connection end of the localhost client.
+	// The sentinel connection is always OPEN and exists without a connection handshake.
+	LocalhostConnectionID string = "connection-localhost"
+
 	// Active is a status type of a client. An active client is allowed to be used.
 	Active Status = "Active"
 
@@ -122,6 +133,26 @@ type ClientState interface {
 		channelID string,
 		channel ChannelI,
 	) error
+	VerifyChannelUpgrade(
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		upgrade UpgradeI,
+	) error
+	VerifyChannelUpgradeError(
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		errorReceipt ErrorReceiptI,
+	) error
 	VerifyPacketCommitment(
 		ctx sdk.Context,
 		store sdk.KVStore,
@@ -136,6 +167,19 @@ type ClientState interface {
 		sequence uint64,
 		commitmentBytes []byte,
 	) error
+	VerifyPacketCommitments(
+		ctx sdk.Context,
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		commitments map[uint64][]byte,
+	) error
 	VerifyPacketAcknowledgement(
 		ctx sdk.Context,
 		store sdk.KVStore,
@@ -150,6 +194,20 @@ type ClientState interface {
 		sequence uint64,
 		acknowledgement []byte,
 	) error
+	VerifyPacketReceipt(
+		ctx sdk.Context,
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		receipt []byte,
+	) error
 	VerifyPacketReceiptAbsence(
 		ctx sdk.Context,
 		store sdk.KVStore,
@@ -178,6 +236,21 @@ type ClientState interface {
 	) error
 }
 
+// ConsensusStatePruner defines the functions light clients implement to allow their consensus
+// states to be pruned by the client keeper. It is optional for light clients to implement.
+type ConsensusStatePruner interface {
+	ClientState
+
+	// GetPrunableConsensusStateHeights returns, in ascending order, the heights of at most limit consensus
+	// states which can be pruned. These are the expired consensus states as well as the oldest consensus
+	// states exceeding maxConsensusStates. The consensus state at the latest height is never prunable.
+	// A zero limit or maxConsensusStates is not applied.
+	GetPrunableConsensusStateHeights(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, maxConsensusStates, limit uint64) ([]Height, error)
+
+	// PruneConsensusState deletes the consensus state at the provided height along with its metadata.
+	PruneConsensusState(clientStore sdk.KVStore, height Height)
+}
+
 // ConsensusState is the state of the consensus process
 type ConsensusState interface {
 	proto.Message
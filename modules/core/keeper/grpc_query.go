@@ -33,6 +33,11 @@ func (q Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientStat
 	return q.ClientKeeper.ClientStatus(c, req)
 }
 
+// PrunableConsensusStates implements the IBC QueryServer interface
+func (q Keeper) PrunableConsensusStates(c context.Context, req *clienttypes.QueryPrunableConsensusStatesRequest) (*clienttypes.QueryPrunableConsensusStatesResponse, error) {
+	return q.ClientKeeper.PrunableConsensusStates(c, req)
+}
+
 // ClientParams implements the IBC QueryServer interface
 func (q Keeper) ClientParams(c context.Context, req *clienttypes.QueryClientParamsRequest) (*clienttypes.QueryClientParamsResponse, error) {
 	return q.ClientKeeper.ClientParams(c, req)
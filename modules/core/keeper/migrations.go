@@ -30,3 +30,10 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration sets the client consensus state pruning parameters to their default values.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	clientMigrator := clientkeeper.NewMigrator(m.keeper.ClientKeeper)
+	return clientMigrator.Migrate2to3(ctx)
+}
@@ -13,6 +13,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
 )
 
@@ -94,6 +95,28 @@ func (k Keeper) SubmitMisbehaviour(goCtx context.Context, msg *clienttypes.MsgSu
 	return &clienttypes.MsgSubmitMisbehaviourResponse{}, nil
 }
 
+// PruneExpiredConsensusStates defines a governance rpc handler method for MsgPruneExpiredConsensusStates.
+// The signer must be the authority of the IBC keeper.
+func (k Keeper) PruneExpiredConsensusStates(goCtx context.Context, msg *clienttypes.MsgPruneExpiredConsensusStates) (*clienttypes.MsgPruneExpiredConsensusStatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Signer {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	heights, err := k.ClientKeeper.PruneConsensusStates(ctx, msg.ClientId, msg.Limit)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to prune consensus states of IBC client")
+	}
+
+	consensusHeights := make([]clienttypes.Height, len(heights))
+	for i, height := range heights {
+		consensusHeights[i] = clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight())
+	}
+
+	return &clienttypes.MsgPruneExpiredConsensusStatesResponse{ConsensusHeights: consensusHeights}, nil
+}
+
 // ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
 func (k Keeper) ConnectionOpenInit(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenInit) (*connectiontypes.MsgConnectionOpenInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -360,6 +383,238 @@ func (k Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.Msg
 	return &channeltypes.MsgChannelCloseConfirmResponse{}, nil
 }
 
+// ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
+// ChannelUpgradeInit will perform 04-channel checks, route to the application
+// callback, and write an UpgradeInit channel into state upon successful execution.
+func (k Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeInit) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve application callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeInit(ctx, msg.PortId, msg.ChannelId, cap, msg.Upgrade); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel handshake upgrade init failed")
+	}
+
+	// Perform application logic callback
+	if err = cbs.OnChanUpgradeInit(ctx, msg.PortId, msg.ChannelId, msg.Upgrade.Ordering, msg.Upgrade.ConnectionHops, msg.Upgrade.Version); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade init callback failed")
+	}
+
+	// Write channel into state
+	upgradeSequence := k.ChannelKeeper.WriteUpgradeInitChannel(ctx, msg.PortId, msg.ChannelId, msg.Upgrade)
+
+	return &channeltypes.MsgChannelUpgradeInitResponse{
+		UpgradeSequence: upgradeSequence,
+	}, nil
+}
+
+// ChannelUpgradeTry defines a rpc handler method for MsgChannelUpgradeTry.
+// ChannelUpgradeTry will perform 04-channel checks, route to the application
+// callback, and write an UpgradeTry channel into state upon successful execution.
+// If the application callback returns an error, the upgrade is aborted and an
+// error receipt is written so that the counterparty may cancel the upgrade.
+func (k Keeper) ChannelUpgradeTry(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTry) (*channeltypes.MsgChannelUpgradeTryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve application callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeTry(
+		ctx, msg.PortId, msg.ChannelId, cap, msg.ProposedConnectionHops,
+		msg.CounterpartyChannel, msg.CounterpartyUpgrade,
+		msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel handshake upgrade try failed")
+	}
+
+	// Perform application logic callback
+	//
+	// Cache context so that we may discard state changes from callback if the upgrade is rejected.
+	cacheCtx, writeFn := ctx.CacheContext()
+	version, err := cbs.OnChanUpgradeTry(cacheCtx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade.Ordering, msg.ProposedConnectionHops, msg.CounterpartyUpgrade.Version)
+	if err != nil {
+		k.ChannelKeeper.AbortUpgrade(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannel.UpgradeSequence, err)
+		return &channeltypes.MsgChannelUpgradeTryResponse{Result: channeltypes.FAILURE}, nil
+	}
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeFn()
+
+	// Write channel into state
+	upgrade := channeltypes.NewUpgrade(msg.CounterpartyUpgrade.Ordering, msg.ProposedConnectionHops, version, clienttypes.ZeroHeight(), 0, 0)
+	k.ChannelKeeper.WriteUpgradeTryChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannel.UpgradeSequence, upgrade, msg.CounterpartyUpgrade)
+
+	return &channeltypes.MsgChannelUpgradeTryResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// ChannelUpgradeAck defines a rpc handler method for MsgChannelUpgradeAck.
+// ChannelUpgradeAck will perform 04-channel checks, route to the application
+// callback, and write the upgraded channel into state upon successful execution.
+// If the application callback returns an error, the upgrade is aborted and an
+// error receipt is written so that the counterparty may cancel the upgrade.
+func (k Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeAck) (*channeltypes.MsgChannelUpgradeAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve application callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeAck(
+		ctx, msg.PortId, msg.ChannelId, cap,
+		msg.CounterpartyChannel, msg.CounterpartyUpgrade,
+		msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel handshake upgrade ack failed")
+	}
+
+	// Perform application logic callback
+	//
+	// Cache context so that we may discard state changes from callback if the upgrade is rejected.
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err = cbs.OnChanUpgradeAck(cacheCtx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade.Version); err != nil {
+		channel, _ := k.ChannelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
+		k.ChannelKeeper.AbortUpgrade(ctx, msg.PortId, msg.ChannelId, channel.UpgradeSequence, err)
+		cbs.OnChanUpgradeRestore(ctx, msg.PortId, msg.ChannelId)
+		return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.FAILURE}, nil
+	}
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeFn()
+
+	// Write channel into state
+	k.ChannelKeeper.WriteUpgradeAckChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
+
+	return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// ChannelUpgradeConfirm defines a rpc handler method for MsgChannelUpgradeConfirm.
+// ChannelUpgradeConfirm will perform 04-channel checks, route to the application
+// callback, and write the upgraded channel into state upon successful execution.
+func (k Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeConfirm) (*channeltypes.MsgChannelUpgradeConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve application callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeConfirm(ctx, msg.PortId, msg.ChannelId, cap, msg.CounterpartyChannel, msg.ProofChannel, msg.ProofHeight); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel handshake upgrade confirm failed")
+	}
+
+	// Perform application logic callback
+	if err = cbs.OnChanUpgradeConfirm(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade confirm callback failed")
+	}
+
+	// Write channel into state
+	k.ChannelKeeper.WriteUpgradeConfirmChannel(ctx, msg.PortId, msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeConfirmResponse{}, nil
+}
+
+// ChannelUpgradeTimeout defines a rpc handler method for MsgChannelUpgradeTimeout.
+// ChannelUpgradeTimeout will perform 04-channel checks, restore the channel and
+// write an error receipt for the upgrade, then route to the application callback.
+func (k Keeper) ChannelUpgradeTimeout(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTimeout) (*channeltypes.MsgChannelUpgradeTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve application callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeTimeout(ctx, msg.PortId, msg.ChannelId, cap, msg.CounterpartyChannel, msg.ProofChannel, msg.ProofHeight); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel handshake upgrade timeout failed")
+	}
+
+	k.ChannelKeeper.WriteUpgradeTimeoutChannel(ctx, msg.PortId, msg.ChannelId)
+
+	// Perform application logic callback
+	cbs.OnChanUpgradeRestore(ctx, msg.PortId, msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeTimeoutResponse{}, nil
+}
+
+// ChannelUpgradeCancel defines a rpc handler method for MsgChannelUpgradeCancel.
+// ChannelUpgradeCancel will perform 04-channel checks, restore the channel and
+// route to the application callback.
+func (k Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeCancel) (*channeltypes.MsgChannelUpgradeCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve application callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId, cap, msg.ErrorReceipt, msg.ProofErrorReceipt, msg.ProofHeight); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel handshake upgrade cancel failed")
+	}
+
+	k.ChannelKeeper.WriteUpgradeCancelChannel(ctx, msg.PortId, msg.ChannelId)
+
+	// Perform application logic callback
+	cbs.OnChanUpgradeRestore(ctx, msg.PortId, msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeCancelResponse{}, nil
+}
+
 // RecvPacket defines a rpc handler method for MsgRecvPacket.
 func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacket) (*channeltypes.MsgRecvPacketResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -396,6 +651,11 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		return &channeltypes.MsgRecvPacketResponse{}, nil // no-op
+	case channeltypes.ErrTimeoutReceiptWritten:
+		// the packet timed out on an ORDERED_ALLOW_TIMEOUT channel, the timeout receipt is
+		// written without executing the packet so that it may be timed out on the sending chain
+		writeFn()
+		return &channeltypes.MsgRecvPacketResponse{}, nil
 	default:
 		return nil, sdkerrors.Wrap(err, "receive packet verification failed")
 	}
@@ -438,6 +698,106 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 	return &channeltypes.MsgRecvPacketResponse{}, nil
 }
 
+// RecvPackets defines a rpc handler method for MsgRecvPackets.
+func (k Keeper) RecvPackets(goCtx context.Context, msg *channeltypes.MsgRecvPackets) (*channeltypes.MsgRecvPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	// all packets are received on the same channel
+	destPort, destChannel := msg.Packets[0].DestinationPort, msg.Packets[0].DestinationChannel
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, destPort, destChannel)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	packets := make([]exported.PacketI, len(msg.Packets))
+	for i := range msg.Packets {
+		packets[i] = msg.Packets[i]
+	}
+
+	// Perform TAO verification
+	//
+	// Packets which were already received are treated as no-ops
+	// Use a cached context to prevent accidental state changes
+	cacheCtx, writeFn := ctx.CacheContext()
+	received, err := k.ChannelKeeper.RecvPackets(cacheCtx, cap, packets, msg.ProofCommitments, msg.ProofHeight)
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "receive packets verification failed")
+	}
+	writeFn()
+
+	results := make([]channeltypes.RecvPacketResult, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		results[i] = channeltypes.RecvPacketResult{
+			Sequence: packet.Sequence,
+			Result:   channeltypes.NOOP,
+		}
+
+		if !received[i] {
+			continue
+		}
+
+		// Perform application logic callback
+		//
+		// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
+		// Each packet uses its own cached context so that a failed callback does not revert the other packets.
+		cacheCtx, writeFn = ctx.CacheContext()
+		ack := cbs.OnRecvPacket(cacheCtx, packet, relayer)
+		// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+		// Events from callback are emitted regardless of acknowledgement success
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		if ack == nil || ack.Success() {
+			// write application state changes for asynchronous and successful acknowledgements
+			writeFn()
+			results[i].Result = channeltypes.SUCCESS
+		} else {
+			results[i].Result = channeltypes.FAILURE
+		}
+
+		// Set packet acknowledgement only if the acknowledgement is not nil.
+		// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
+		// acknowledgement is nil.
+		if ack != nil {
+			if err := k.ChannelKeeper.WriteAcknowledgement(ctx, cap, packet, ack); err != nil {
+				return nil, err
+			}
+		}
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"tx", "msg", "ibc", channeltypes.EventTypeRecvPacket},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
+			},
+		)
+	}
+
+	return &channeltypes.MsgRecvPacketsResponse{Results: results}, nil
+}
+
 // Timeout defines a rpc handler method for MsgTimeout.
 func (k Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*channeltypes.MsgTimeoutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
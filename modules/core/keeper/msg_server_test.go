@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -224,6 +226,144 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// tests the IBC handler receiving a batch of packets on ordered and unordered
+// channels. It verifies that each packet is routed to the application callback
+// and that a failed callback only discards the state changes of its own packet.
+func (suite *KeeperTestSuite) TestHandleRecvPackets() {
+	var (
+		packets    []channeltypes.Packet
+		path       *ibctesting.Path
+		expResults []channeltypes.ResponseResultType
+	)
+
+	// sendPackets sends a packet from chainA to chainB for each of the provided packet data
+	sendPackets := func(data ...[]byte) {
+		packets = nil
+		for i, bz := range data {
+			packet := channeltypes.NewPacket(bz, uint64(i+1), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			packets = append(packets, packet)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: ORDERED", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			sendPackets(ibctesting.MockPacketData, ibctesting.MockPacketData, ibctesting.MockPacketData)
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: UNORDERED", func() {
+			suite.coordinator.Setup(path)
+
+			sendPackets(ibctesting.MockPacketData, ibctesting.MockPacketData, ibctesting.MockPacketData)
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: one OnRecvPacket callback returns an error acknowledgement", func() {
+			suite.coordinator.Setup(path)
+
+			sendPackets(ibctesting.MockPacketData, ibctesting.MockFailPacketData, ibctesting.MockPacketData)
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.FAILURE, channeltypes.SUCCESS}
+		}, true},
+		{"success: ORDERED - async acknowledgement", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			sendPackets(ibctesting.MockPacketData, ibcmock.MockAsyncPacketData)
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"successful no-op: UNORDERED - packet already received (replay)", func() {
+			// mock will panic if application callback is called twice on the same packet
+			suite.coordinator.Setup(path)
+
+			sendPackets(ibctesting.MockPacketData, ibctesting.MockPacketData)
+			err := path.EndpointB.RecvPacket(packets[0])
+			suite.Require().NoError(err)
+
+			expResults = []channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.SUCCESS}
+		}, true},
+		{"failure: ORDERED out of order packets", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			sendPackets(ibctesting.MockPacketData, ibctesting.MockPacketData, ibctesting.MockPacketData)
+			packets = packets[1:]
+		}, false},
+		{"failure: packet not sent", func() {
+			suite.coordinator.Setup(path)
+
+			sendPackets(ibctesting.MockPacketData)
+			packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+		}, false},
+		{"failure: channel does not exist", func() {
+			suite.coordinator.SetupConnections(path)
+
+			packets = []channeltypes.Packet{channeltypes.NewPacket(ibctesting.MockPacketData, 1, ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.MockPort, ibctesting.FirstChannelID, timeoutHeight, 0)}
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			// get batch proof of packet commitments from chainA
+			var packetKeys [][]byte
+			for _, packet := range packets {
+				packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			}
+			proof, proofHeight := path.EndpointA.QueryBatchProof(packetKeys...)
+
+			msg := channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+			res, err := keeper.Keeper.RecvPackets(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.Results, len(packets))
+
+				for i, packet := range packets {
+					suite.Require().Equal(packet.GetSequence(), res.Results[i].Sequence)
+					suite.Require().Equal(expResults[i], res.Results[i].Result)
+
+					if expResults[i] == channeltypes.NOOP {
+						continue
+					}
+
+					// check that callback state was handled correctly
+					_, exists := suite.chainB.GetSimApp().ScopedIBCMockKeeper.GetCapability(suite.chainB.GetContext(), ibcmock.GetMockRecvCanaryCapabilityName(packet))
+					suite.Require().Equal(expResults[i] == channeltypes.SUCCESS, exists, "callback state not handled correctly for packet %d", packet.GetSequence())
+
+					// verify if ack was written
+					_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().Equal(!bytes.Equal(packet.GetData(), ibcmock.MockAsyncPacketData), found)
+				}
+
+				// replay should not fail since it will be treated as a no-op
+				res, err = keeper.Keeper.RecvPackets(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+				suite.Require().NoError(err)
+				for _, result := range res.Results {
+					suite.Require().Equal(channeltypes.NOOP, result.Result)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
@@ -771,3 +911,69 @@ func (suite *KeeperTestSuite) TestUpgradeClient() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestPruneExpiredConsensusStates() {
+	var (
+		path *ibctesting.Path
+		msg  *clienttypes.MsgPruneExpiredConsensusStates
+	)
+
+	cases := []struct {
+		name      string
+		malleate  func()
+		expPruned int
+		expPass   bool
+	}{
+		{"success", func() {}, 2, true},
+		{"limit applied", func() { msg.Limit = 1 }, 1, true},
+		{"unauthorized signer", func() { msg.Signer = suite.chainA.SenderAccount.GetAddress().String() }, 0, false},
+		{"client not found", func() { msg.ClientId = ibctesting.InvalidID }, 0, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			// disable pruning on updates and create consensus states every 10 days, the trusting period being 14 days
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.ConsensusStatePruneLimit = 0
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			heights := []exported.Height{path.EndpointA.GetClientState().GetLatestHeight()}
+			for i := 0; i < 3; i++ {
+				suite.coordinator.IncrementTimeBy(10 * 24 * time.Hour)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				heights = append(heights, path.EndpointA.GetClientState().GetLatestHeight())
+			}
+
+			msg = clienttypes.NewMsgPruneExpiredConsensusStates(path.EndpointA.ClientID, 10, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			res, err := keeper.Keeper.PruneExpiredConsensusStates(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.ConsensusHeights, tc.expPruned)
+
+				for i, height := range heights {
+					_, found := suite.chainA.GetConsensusState(path.EndpointA.ClientID, height)
+					suite.Require().Equal(i >= tc.expPruned, found)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+
+				// no consensus state is pruned
+				for _, height := range heights {
+					_, found := suite.chainA.GetConsensusState(path.EndpointA.ClientID, height)
+					suite.Require().True(found)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,915 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/core/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateClient          = "op_weight_msg_create_client"
+	OpWeightMsgUpdateClient          = "op_weight_msg_update_client"
+	OpWeightMsgConnectionOpenInit    = "op_weight_msg_connection_open_init"
+	OpWeightMsgConnectionOpenTry     = "op_weight_msg_connection_open_try"
+	OpWeightMsgConnectionOpenAck     = "op_weight_msg_connection_open_ack"
+	OpWeightMsgConnectionOpenConfirm = "op_weight_msg_connection_open_confirm"
+	OpWeightMsgChannelOpenTry        = "op_weight_msg_channel_open_try"
+	OpWeightMsgChannelOpenAck        = "op_weight_msg_channel_open_ack"
+	OpWeightMsgChannelOpenConfirm    = "op_weight_msg_channel_open_confirm"
+	OpWeightMsgChannelCloseConfirm   = "op_weight_msg_channel_close_confirm"
+)
+
+// Default simulation operation weights
+const (
+	DefaultWeightMsgCreateClient          = 10
+	DefaultWeightMsgUpdateClient          = 20
+	DefaultWeightMsgConnectionOpenInit    = 10
+	DefaultWeightMsgConnectionOpenTry     = 10
+	DefaultWeightMsgConnectionOpenAck     = 20
+	DefaultWeightMsgConnectionOpenConfirm = 20
+	DefaultWeightMsgChannelOpenTry        = 20
+	DefaultWeightMsgChannelOpenAck        = 20
+	DefaultWeightMsgChannelOpenConfirm    = 20
+	DefaultWeightMsgChannelCloseConfirm   = 5
+)
+
+// localhostProof is the proof provided to the localhost client, which verifies the state of the simulated chain
+// directly and ignores proofs
+var localhostProof = []byte("localhost")
+
+// WeightedOperations returns all the operations from the ibc module with their respective weights. The
+// operations use solo machines controlled by the simulation accounts as counterparties, channels are also
+// opened between the modules of the simulated chain over the localhost connection.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateClient          int
+		weightMsgUpdateClient          int
+		weightMsgConnectionOpenInit    int
+		weightMsgConnectionOpenTry     int
+		weightMsgConnectionOpenAck     int
+		weightMsgConnectionOpenConfirm int
+		weightMsgChannelOpenTry        int
+		weightMsgChannelOpenAck        int
+		weightMsgChannelOpenConfirm    int
+		weightMsgChannelCloseConfirm   int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClient, &weightMsgCreateClient, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClient = DefaultWeightMsgCreateClient
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateClient, &weightMsgUpdateClient, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateClient = DefaultWeightMsgUpdateClient
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgConnectionOpenInit, &weightMsgConnectionOpenInit, nil,
+		func(_ *rand.Rand) {
+			weightMsgConnectionOpenInit = DefaultWeightMsgConnectionOpenInit
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgConnectionOpenTry, &weightMsgConnectionOpenTry, nil,
+		func(_ *rand.Rand) {
+			weightMsgConnectionOpenTry = DefaultWeightMsgConnectionOpenTry
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgConnectionOpenAck, &weightMsgConnectionOpenAck, nil,
+		func(_ *rand.Rand) {
+			weightMsgConnectionOpenAck = DefaultWeightMsgConnectionOpenAck
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgConnectionOpenConfirm, &weightMsgConnectionOpenConfirm, nil,
+		func(_ *rand.Rand) {
+			weightMsgConnectionOpenConfirm = DefaultWeightMsgConnectionOpenConfirm
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenTry, &weightMsgChannelOpenTry, nil,
+		func(_ *rand.Rand) {
+			weightMsgChannelOpenTry = DefaultWeightMsgChannelOpenTry
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenAck, &weightMsgChannelOpenAck, nil,
+		func(_ *rand.Rand) {
+			weightMsgChannelOpenAck = DefaultWeightMsgChannelOpenAck
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenConfirm, &weightMsgChannelOpenConfirm, nil,
+		func(_ *rand.Rand) {
+			weightMsgChannelOpenConfirm = DefaultWeightMsgChannelOpenConfirm
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelCloseConfirm, &weightMsgChannelCloseConfirm, nil,
+		func(_ *rand.Rand) {
+			weightMsgChannelCloseConfirm = DefaultWeightMsgChannelCloseConfirm
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateClient,
+			SimulateMsgCreateClient(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateClient,
+			SimulateMsgUpdateClient(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgConnectionOpenInit,
+			SimulateMsgConnectionOpenInit(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgConnectionOpenTry,
+			SimulateMsgConnectionOpenTry(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgConnectionOpenAck,
+			SimulateMsgConnectionOpenAck(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgConnectionOpenConfirm,
+			SimulateMsgConnectionOpenConfirm(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChannelOpenTry,
+			SimulateMsgChannelOpenTry(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChannelOpenAck,
+			SimulateMsgChannelOpenAck(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChannelOpenConfirm,
+			SimulateMsgChannelOpenConfirm(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChannelCloseConfirm,
+			SimulateMsgChannelCloseConfirm(ak, k),
+		),
+	}
+}
+
+// SimulateMsgCreateClient generates a MsgCreateClient creating a solo machine client whose key is the key of
+// a random simulation account
+func SimulateMsgCreateClient(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&clienttypes.MsgCreateClient{})
+
+		if !k.ClientKeeper.GetParams(ctx).IsAllowedClient(exported.Solomachine) {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "solo machine clients are not allowed"), nil, nil
+		}
+
+		soloAccount, _ := simtypes.RandomAcc(r, accs)
+		signer, _ := simtypes.RandomAcc(r, accs)
+
+		solo := NewSolomachine(k.Codec(), "", soloAccount, 1, uint64(ctx.BlockTime().UnixNano()), simtypes.RandStringOfLength(r, 10))
+
+		msg, err := clienttypes.NewMsgCreateClient(solo.ClientState(), solo.ConsensusState(), signer.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to create msg"), nil, err
+		}
+
+		if _, err := DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgUpdateClient generates a MsgUpdateClient updating a random solo machine client to the key of a
+// random simulation account
+func SimulateMsgUpdateClient(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{})
+
+		solo, found := randomSolomachine(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no solo machine client found"), nil, nil
+		}
+
+		newKey, _ := simtypes.RandomAcc(r, accs)
+		header, err := solo.CreateHeader(newKey.PrivKey, uint64(ctx.BlockTime().UnixNano()))
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to create header"), nil, err
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg, err := clienttypes.NewMsgUpdateClient(solo.ClientID, header, signer.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to create msg"), nil, err
+		}
+
+		if _, err := DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgConnectionOpenInit generates a MsgConnectionOpenInit initializing a connection with a random solo
+// machine counterparty
+func SimulateMsgConnectionOpenInit(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenInit{})
+
+		solo, found := randomSolomachine(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no solo machine client found"), nil, nil
+		}
+
+		// the identifier of the client of the simulated chain stored by the solo machine
+		counterpartyClientID := clienttypes.FormatClientIdentifier(exported.Tendermint, uint64(r.Intn(100)))
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg := connectiontypes.NewMsgConnectionOpenInit(
+			solo.ClientID, counterpartyClientID, CounterpartyPrefix, nil, 0, signer.Address.String(),
+		)
+
+		if _, err := DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgConnectionOpenTry generates a MsgConnectionOpenTry opening a connection in TRYOPEN state with a
+// random solo machine counterparty. The solo machine proves it stores a connection in INIT state as well as a
+// tendermint client of the simulated chain at the previous block height.
+func SimulateMsgConnectionOpenTry(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenTry{})
+
+		solo, found := randomSolomachine(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no solo machine client found"), nil, nil
+		}
+
+		if ctx.BlockHeight() < 2 {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no previous block height"), nil, nil
+		}
+
+		clientState, err := newSelfClientState(app, ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to create self client state"), nil, err
+		}
+
+		// the historical info is not stored if the historical entries parameter of the staking module is zero
+		consensusHeight := clientState.LatestHeight
+		consensusState, err := k.ClientKeeper.GetSelfConsensusState(ctx, consensusHeight)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "self consensus state not found"), nil, nil
+		}
+
+		// the identifiers of the connection and of the client of the simulated chain stored by the solo machine
+		counterpartyClientID := clienttypes.FormatClientIdentifier(exported.Tendermint, uint64(r.Intn(100)))
+		counterpartyConnectionID := connectiontypes.FormatConnectionIdentifier(uint64(r.Intn(100)))
+
+		versions := connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions())
+		prefix := k.ConnectionKeeper.GetCommitmentPrefix()
+		counterpartyConnection := connectiontypes.NewConnectionEnd(
+			connectiontypes.INIT, counterpartyClientID,
+			connectiontypes.NewCounterparty(solo.ClientID, "", commitmenttypes.NewMerklePrefix(prefix.Bytes())),
+			versions, 0,
+		)
+
+		// the proofs are verified in order at consecutive sequences starting at the proof height
+		proofHeight := solo.GetHeight()
+		proofInit, err := solo.ConnectionStateProof(counterpartyConnectionID, counterpartyConnection)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		proofClient, err := solo.ClientStateProof(counterpartyClientID, clientState)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		proofConsensus, err := solo.ConsensusStateProof(counterpartyClientID, consensusHeight, consensusState)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg := connectiontypes.NewMsgConnectionOpenTry(
+			"", solo.ClientID, counterpartyConnectionID, counterpartyClientID, clientState, CounterpartyPrefix,
+			versions, 0, proofInit, proofClient, proofConsensus, proofHeight, consensusHeight, signer.Address.String(),
+		)
+
+		if _, err := DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgConnectionOpenAck generates a MsgConnectionOpenAck opening a random connection in INIT state
+// with a solo machine counterparty. The solo machine proves it stores a connection in TRYOPEN state as well as
+// a tendermint client of the simulated chain at the previous block height.
+func SimulateMsgConnectionOpenAck(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenAck{})
+
+		identified, solo, found := randomSolomachineConnection(r, ctx, k, accs, connectiontypes.INIT)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no connection in INIT state with a solo machine counterparty found"), nil, nil
+		}
+
+		if ctx.BlockHeight() < 2 {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no previous block height"), nil, nil
+		}
+
+		clientState, err := newSelfClientState(app, ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to create self client state"), nil, err
+		}
+
+		// the historical info is not stored if the historical entries parameter of the staking module is zero
+		consensusHeight := clientState.LatestHeight
+		consensusState, err := k.ClientKeeper.GetSelfConsensusState(ctx, consensusHeight)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "self consensus state not found"), nil, nil
+		}
+
+		connectionID := identified.Id
+		version := connectiontypes.DefaultIBCVersion
+		counterpartyConnectionID := connectiontypes.FormatConnectionIdentifier(uint64(r.Intn(100)))
+		prefix := k.ConnectionKeeper.GetCommitmentPrefix()
+		counterpartyConnection := connectiontypes.NewConnectionEnd(
+			connectiontypes.TRYOPEN, identified.Counterparty.ClientId,
+			connectiontypes.NewCounterparty(identified.ClientId, connectionID, commitmenttypes.NewMerklePrefix(prefix.Bytes())),
+			[]*connectiontypes.Version{version}, identified.DelayPeriod,
+		)
+
+		// the proofs are verified in order at consecutive sequences starting at the proof height
+		proofHeight := solo.GetHeight()
+		proofTry, err := solo.ConnectionStateProof(counterpartyConnectionID, counterpartyConnection)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		proofClient, err := solo.ClientStateProof(identified.Counterparty.ClientId, clientState)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		proofConsensus, err := solo.ConsensusStateProof(identified.Counterparty.ClientId, consensusHeight, consensusState)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg := connectiontypes.NewMsgConnectionOpenAck(
+			connectionID, counterpartyConnectionID, clientState, proofTry, proofClient, proofConsensus,
+			proofHeight, consensusHeight, version, signer.Address.String(),
+		)
+
+		if _, err := DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgConnectionOpenConfirm generates a MsgConnectionOpenConfirm opening a random connection in TRYOPEN
+// state with a solo machine counterparty. The solo machine proves it stores the connection in OPEN state.
+func SimulateMsgConnectionOpenConfirm(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenConfirm{})
+
+		identified, solo, found := randomSolomachineConnection(r, ctx, k, accs, connectiontypes.TRYOPEN)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no connection in TRYOPEN state with a solo machine counterparty found"), nil, nil
+		}
+
+		prefix := k.ConnectionKeeper.GetCommitmentPrefix()
+		counterpartyConnection := connectiontypes.NewConnectionEnd(
+			connectiontypes.OPEN, identified.Counterparty.ClientId,
+			connectiontypes.NewCounterparty(identified.ClientId, identified.Id, commitmenttypes.NewMerklePrefix(prefix.Bytes())),
+			identified.Versions, identified.DelayPeriod,
+		)
+
+		proofHeight := solo.GetHeight()
+		proofAck, err := solo.ConnectionStateProof(identified.Counterparty.ConnectionId, counterpartyConnection)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg := connectiontypes.NewMsgConnectionOpenConfirm(identified.Id, proofAck, proofHeight, signer.Address.String())
+
+		if _, err := DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgChannelOpenTry generates a MsgChannelOpenTry opening the counterparty of a random channel in INIT
+// state opened over the localhost connection. The channel is opened on the counterparty port of the channel in
+// INIT state with its version, which the application bound to the port may reject.
+func SimulateMsgChannelOpenTry(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelOpenTry{})
+
+		channel, found := randomLocalhostChannel(r, ctx, k, channeltypes.INIT)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no channel in INIT state over the localhost connection found"), nil, nil
+		}
+
+		if _, found := getLocalhostCounterpartyChannel(ctx, k, channel); found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "counterparty channel already exists"), nil, nil
+		}
+
+		proofHeight, found := getLocalhostProofHeight(ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "localhost client not found"), nil, nil
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgChannelOpenTry(
+			channel.Counterparty.PortId, "", channel.Version, channel.Ordering, []string{exported.LocalhostConnectionID},
+			channel.PortId, channel.ChannelId, channel.Version, localhostProof, proofHeight, signer.Address.String(),
+		)
+
+		cacheCtx, _ := ctx.CacheContext()
+		if _, err := k.ChannelOpenTry(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "channel open try rejected"), nil, nil
+		}
+
+		if _, err := DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgChannelOpenAck generates a MsgChannelOpenAck opening a random channel in INIT state opened over the
+// localhost connection whose counterparty channel is in TRYOPEN state. The application bound to the port of the
+// channel may reject the version of the counterparty channel.
+func SimulateMsgChannelOpenAck(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelOpenAck{})
+
+		channel, found := randomLocalhostChannel(r, ctx, k, channeltypes.INIT)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no channel in INIT state over the localhost connection found"), nil, nil
+		}
+
+		counterparty, found := getLocalhostCounterpartyChannel(ctx, k, channel)
+		if !found || counterparty.State != channeltypes.TRYOPEN {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no counterparty channel in TRYOPEN state found"), nil, nil
+		}
+
+		proofHeight, found := getLocalhostProofHeight(ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "localhost client not found"), nil, nil
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgChannelOpenAck(
+			channel.PortId, channel.ChannelId, counterparty.ChannelId, counterparty.Version, localhostProof, proofHeight, signer.Address.String(),
+		)
+
+		cacheCtx, _ := ctx.CacheContext()
+		if _, err := k.ChannelOpenAck(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "channel open ack rejected"), nil, nil
+		}
+
+		if _, err := DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgChannelOpenConfirm generates a MsgChannelOpenConfirm opening a random channel in TRYOPEN state whose
+// counterparty is either a solo machine or a channel in OPEN state over the localhost connection. The solo
+// machine proves it stores the counterparty channel in OPEN state.
+func SimulateMsgChannelOpenConfirm(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelOpenConfirm{})
+
+		channel, connection, solo, found := randomSolomachineChannel(r, ctx, k, accs, channeltypes.TRYOPEN)
+		if localhostChannel, ok := randomLocalhostChannel(r, ctx, k, channeltypes.TRYOPEN); ok && (!found || r.Intn(2) == 0) {
+			channel, solo, found = localhostChannel, nil, true
+		}
+
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no channel in TRYOPEN state with a solo machine or localhost counterparty found"), nil, nil
+		}
+
+		var (
+			proofAck    []byte
+			proofHeight clienttypes.Height
+			err         error
+		)
+
+		if solo == nil {
+			counterparty, found := k.ChannelKeeper.GetChannel(ctx, channel.Counterparty.PortId, channel.Counterparty.ChannelId)
+			if !found || counterparty.State != channeltypes.OPEN {
+				return simtypes.NoOpMsg(host.ModuleName, msgType, "counterparty channel is not open"), nil, nil
+			}
+
+			if proofHeight, found = getLocalhostProofHeight(ctx, k); !found {
+				return simtypes.NoOpMsg(host.ModuleName, msgType, "localhost client not found"), nil, nil
+			}
+
+			proofAck = localhostProof
+		} else {
+			proofHeight = solo.GetHeight()
+			proofAck, err = solo.ChannelStateProof(
+				channel.Counterparty.PortId, channel.Counterparty.ChannelId, counterpartyChannel(channeltypes.OPEN, connection, channel),
+			)
+			if err != nil {
+				return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to generate proof"), nil, err
+			}
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgChannelOpenConfirm(
+			channel.PortId, channel.ChannelId, proofAck, proofHeight, signer.Address.String(),
+		)
+
+		if _, err := DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgChannelCloseConfirm generates a MsgChannelCloseConfirm closing a random open channel with a solo
+// machine counterparty. The solo machine proves it closed the counterparty channel.
+func SimulateMsgChannelCloseConfirm(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelCloseConfirm{})
+
+		channel, connection, solo, found := randomSolomachineChannel(r, ctx, k, accs, channeltypes.OPEN)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "no open channel with a solo machine counterparty found"), nil, nil
+		}
+
+		proofHeight := solo.GetHeight()
+		proofInit, err := solo.ChannelStateProof(
+			channel.Counterparty.PortId, channel.Counterparty.ChannelId, counterpartyChannel(channeltypes.CLOSED, connection, channel),
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgChannelCloseConfirm(channel.PortId, channel.ChannelId, proofInit, proofHeight, signer.Address.String())
+
+		if _, err := DeliverTx(app, ctx, ak, signer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgAcknowledgement returns an operation acknowledging a packet sent to a solo machine counterparty
+// with the provided acknowledgement. It is intended to be scheduled as a future operation by the application
+// sending the packet.
+func SimulateMsgAcknowledgement(ak types.AccountKeeper, k *keeper.Keeper, packet channeltypes.Packet, acknowledgement []byte) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgAcknowledgement{})
+
+		solo, comment, ok := getPacketSolomachine(ctx, k, accs, packet)
+		if !ok {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, comment), nil, nil
+		}
+
+		proofHeight := solo.GetHeight()
+		proof, err := solo.PacketAcknowledgementProof(packet, acknowledgement)
+		if err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgAcknowledgement(packet, acknowledgement, proof, proofHeight, relayer.Address.String())
+
+		if _, err := DeliverTx(app, ctx, ak, relayer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// SimulateMsgTimeout returns an operation timing out a packet sent over the localhost connection. The localhost
+// client uses the block time of the simulated chain as the timestamp of the counterparty, thus the packet is
+// timed out once the block time passed its timeout timestamp. It is intended to be scheduled as a future
+// operation by the application sending the packet.
+//
+// NOTE: packets sent to solo machines are never timed out as solo machine clients only store consensus states
+// at heights lower than the sequence proofs are verified at, thus the timestamp at the proof height cannot be
+// retrieved by the timeout verification.
+func SimulateMsgTimeout(ak types.AccountKeeper, k *keeper.Keeper, packet channeltypes.Packet) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgTimeout{})
+
+		channel, found := k.ChannelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+		if !found || channel.State != channeltypes.OPEN {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "channel is not open"), nil, nil
+		}
+
+		if !IsLocalhostChannel(channel.ConnectionHops) {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "channel is not opened over the localhost connection"), nil, nil
+		}
+
+		if commitment := k.ChannelKeeper.GetPacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence); len(commitment) == 0 {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "packet already timed out"), nil, nil
+		}
+
+		if packet.TimeoutTimestamp == 0 || uint64(ctx.BlockTime().UnixNano()) < packet.TimeoutTimestamp {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "packet timeout timestamp not reached"), nil, nil
+		}
+
+		nextSequenceRecv := packet.Sequence
+		switch channel.Ordering {
+		case channeltypes.UNORDERED:
+		case channeltypes.ORDERED:
+			nextSequenceRecv, found = k.ChannelKeeper.GetNextSequenceRecv(ctx, packet.DestinationPort, packet.DestinationChannel)
+			if !found {
+				return simtypes.NoOpMsg(host.ModuleName, msgType, "counterparty next sequence receive not found"), nil, nil
+			}
+		default:
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unsupported channel ordering"), nil, nil
+		}
+
+		proofHeight, found := getLocalhostProofHeight(ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "localhost client not found"), nil, nil
+		}
+
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgTimeout(packet, nextSequenceRecv, localhostProof, proofHeight, relayer.Address.String())
+
+		if _, err := DeliverTx(app, ctx, ak, relayer, msg); err != nil {
+			return simtypes.NoOpMsg(host.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return NewOperationMsg(host.ModuleName, msg), nil, nil
+	}
+}
+
+// queryUnbondingTime queries the unbonding time of the staking module, which must be used by the tendermint
+// client of the simulated chain stored by the counterparty
+func queryUnbondingTime(app *baseapp.BaseApp, ctx sdk.Context) (time.Duration, error) {
+	handler := app.GRPCQueryRouter().Route("/cosmos.staking.v1beta1.Query/Params")
+	if handler == nil {
+		return 0, fmt.Errorf("staking params query route not found")
+	}
+
+	res, err := handler(ctx, abci.RequestQuery{})
+	if err != nil {
+		return 0, err
+	}
+
+	var paramsRes stakingtypes.QueryParamsResponse
+	if err := paramsRes.Unmarshal(res.Value); err != nil {
+		return 0, err
+	}
+
+	return paramsRes.Params.UnbondingTime, nil
+}
+
+// newSelfClientState returns a tendermint client state of the simulated chain at the previous block height, as
+// stored by a solo machine counterparty
+func newSelfClientState(app *baseapp.BaseApp, ctx sdk.Context, k *keeper.Keeper) (*ibctmtypes.ClientState, error) {
+	unbondingTime, err := queryUnbondingTime(app, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clientState := ibctmtypes.NewClientState(
+		ctx.ChainID(), ibctmtypes.DefaultTrustLevel, unbondingTime/2, unbondingTime, 10*time.Second,
+		clienttypes.NewHeight(clienttypes.ParseChainID(ctx.ChainID()), uint64(ctx.BlockHeight()-1)),
+		commitmenttypes.GetSDKSpecs(), []string{upgradetypes.StoreKey, upgradetypes.KeyUpgradedIBCState},
+		false, false,
+	)
+
+	if err := k.ClientKeeper.ValidateSelfClient(ctx, clientState); err != nil {
+		return nil, err
+	}
+
+	return clientState, nil
+}
+
+// randomSolomachine returns the solo machine counterparty of a random solo machine client controlled by one of
+// the simulation accounts
+func randomSolomachine(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account) (*Solomachine, bool) {
+	clients := k.ClientKeeper.GetAllGenesisClients(ctx)
+	r.Shuffle(len(clients), func(i, j int) {
+		clients[i], clients[j] = clients[j], clients[i]
+	})
+
+	for _, client := range clients {
+		if solo, found := GetSolomachine(ctx, k, accs, client.ClientId); found {
+			return solo, true
+		}
+	}
+
+	return nil, false
+}
+
+// randomSolomachineConnection returns a random connection in the provided state whose counterparty is a solo
+// machine controlled by one of the simulation accounts
+func randomSolomachineConnection(
+	r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account, state connectiontypes.State,
+) (connectiontypes.IdentifiedConnection, *Solomachine, bool) {
+	var connections []connectiontypes.IdentifiedConnection
+	for _, connection := range k.ConnectionKeeper.GetAllConnections(ctx) {
+		if connection.State == state {
+			connections = append(connections, connection)
+		}
+	}
+
+	r.Shuffle(len(connections), func(i, j int) {
+		connections[i], connections[j] = connections[j], connections[i]
+	})
+
+	for _, connection := range connections {
+		connectionEnd := connectiontypes.NewConnectionEnd(connection.State, connection.ClientId, connection.Counterparty, connection.Versions, connection.DelayPeriod)
+		if solo, found := GetConnectionSolomachine(ctx, k, accs, connectionEnd); found {
+			return connection, solo, true
+		}
+	}
+
+	return connectiontypes.IdentifiedConnection{}, nil, false
+}
+
+// randomSolomachineChannel returns a random channel in the provided state whose counterparty is a solo machine
+// controlled by one of the simulation accounts, together with the connection of the channel
+func randomSolomachineChannel(
+	r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account, state channeltypes.State,
+) (channeltypes.IdentifiedChannel, connectiontypes.ConnectionEnd, *Solomachine, bool) {
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range k.ChannelKeeper.GetAllChannels(ctx) {
+		if channel.State == state {
+			channels = append(channels, channel)
+		}
+	}
+
+	r.Shuffle(len(channels), func(i, j int) {
+		channels[i], channels[j] = channels[j], channels[i]
+	})
+
+	for _, channel := range channels {
+		if connection, solo, found := GetChannelSolomachine(ctx, k, accs, channel.PortId, channel.ChannelId); found {
+			return channel, connection, solo, true
+		}
+	}
+
+	return channeltypes.IdentifiedChannel{}, connectiontypes.ConnectionEnd{}, nil, false
+}
+
+// randomLocalhostChannel returns a random channel in the provided state opened over the localhost connection
+func randomLocalhostChannel(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, state channeltypes.State) (channeltypes.IdentifiedChannel, bool) {
+	if _, found := k.ConnectionKeeper.GetConnection(ctx, exported.LocalhostConnectionID); !found {
+		return channeltypes.IdentifiedChannel{}, false
+	}
+
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range k.ChannelKeeper.GetAllChannels(ctx) {
+		if channel.State == state && IsLocalhostChannel(channel.ConnectionHops) {
+			channels = append(channels, channel)
+		}
+	}
+
+	if len(channels) == 0 {
+		return channeltypes.IdentifiedChannel{}, false
+	}
+
+	return channels[r.Intn(len(channels))], true
+}
+
+// getLocalhostCounterpartyChannel returns the channel opened over the localhost connection whose counterparty
+// is the provided channel
+func getLocalhostCounterpartyChannel(ctx sdk.Context, k *keeper.Keeper, channel channeltypes.IdentifiedChannel) (channeltypes.IdentifiedChannel, bool) {
+	for _, counterparty := range k.ChannelKeeper.GetAllChannels(ctx) {
+		if IsLocalhostChannel(counterparty.ConnectionHops) &&
+			counterparty.Counterparty.PortId == channel.PortId && counterparty.Counterparty.ChannelId == channel.ChannelId {
+			return counterparty, true
+		}
+	}
+
+	return channeltypes.IdentifiedChannel{}, false
+}
+
+// getLocalhostProofHeight returns the latest height of the localhost client, at which the localhost client
+// verifies the state of the simulated chain
+func getLocalhostProofHeight(ctx sdk.Context, k *keeper.Keeper) (clienttypes.Height, bool) {
+	clientState, found := k.ClientKeeper.GetClientState(ctx, exported.Localhost)
+	if !found {
+		return clienttypes.Height{}, false
+	}
+
+	height, ok := clientState.GetLatestHeight().(clienttypes.Height)
+	return height, ok
+}
+
+// counterpartyChannel returns the channel end in the provided state expected to be stored by the solo machine
+// counterparty of a channel
+func counterpartyChannel(state channeltypes.State, connection connectiontypes.ConnectionEnd, channel channeltypes.IdentifiedChannel) channeltypes.Channel {
+	return channeltypes.NewChannel(
+		state, channel.Ordering, channeltypes.NewCounterparty(channel.PortId, channel.ChannelId),
+		[]string{connection.Counterparty.ConnectionId}, channel.Version,
+	)
+}
+
+// getPacketSolomachine returns the solo machine counterparty of the channel a packet was sent on if the packet
+// can be acknowledged or timed out, otherwise a comment explaining why is returned. The packets of ordered
+// channels must be acknowledged or timed out in order.
+func getPacketSolomachine(ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account, packet channeltypes.Packet) (*Solomachine, string, bool) {
+	channel, found := k.ChannelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if !found || channel.State != channeltypes.OPEN {
+		return nil, "channel is not open", false
+	}
+
+	if commitment := k.ChannelKeeper.GetPacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence); len(commitment) == 0 {
+		return nil, "packet already acknowledged or timed out", false
+	}
+
+	switch channel.Ordering {
+	case channeltypes.UNORDERED:
+	case channeltypes.ORDERED:
+		nextSequenceAck, found := k.ChannelKeeper.GetNextSequenceAck(ctx, packet.SourcePort, packet.SourceChannel)
+		if !found || nextSequenceAck != packet.Sequence {
+			return nil, "packet is not the next packet to be acknowledged on the ordered channel", false
+		}
+	default:
+		return nil, "unsupported channel ordering", false
+	}
+
+	_, solo, found := GetChannelSolomachine(ctx, k, accs, packet.SourcePort, packet.SourceChannel)
+	if !found {
+		return nil, "channel counterparty is not a solo machine", false
+	}
+
+	return solo, "", true
+}
@@ -0,0 +1,162 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/simulation"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+
+	header := tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+}
+
+// TestWeightedOperations tests the weights, routes and msg types of the operations
+func (suite *SimTestSuite) TestWeightedOperations() {
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, suite.app.AppCodec(), suite.app.AccountKeeper, suite.app.IBCKeeper)
+
+	r := rand.New(rand.NewSource(1))
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight  int
+		msgType string
+	}{
+		{simulation.DefaultWeightMsgCreateClient, sdk.MsgTypeURL(&clienttypes.MsgCreateClient{})},
+		{simulation.DefaultWeightMsgUpdateClient, sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{})},
+		{simulation.DefaultWeightMsgConnectionOpenInit, sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenInit{})},
+		{simulation.DefaultWeightMsgConnectionOpenTry, sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenTry{})},
+		{simulation.DefaultWeightMsgConnectionOpenAck, sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenAck{})},
+		{simulation.DefaultWeightMsgConnectionOpenConfirm, sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenConfirm{})},
+		{simulation.DefaultWeightMsgChannelOpenTry, sdk.MsgTypeURL(&channeltypes.MsgChannelOpenTry{})},
+		{simulation.DefaultWeightMsgChannelOpenAck, sdk.MsgTypeURL(&channeltypes.MsgChannelOpenAck{})},
+		{simulation.DefaultWeightMsgChannelOpenConfirm, sdk.MsgTypeURL(&channeltypes.MsgChannelOpenConfirm{})},
+		{simulation.DefaultWeightMsgChannelCloseConfirm, sdk.MsgTypeURL(&channeltypes.MsgChannelCloseConfirm{})},
+	}
+
+	suite.Require().Len(weightedOps, len(expected))
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+
+		// the expected operations are dependent on the ordering of the output given by WeightedOperations
+		suite.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		suite.Require().Equal(host.ModuleName, operationMsg.Route, "route should be the same")
+		suite.Require().Equal(expected[i].msgType, operationMsg.Name, "operation msg name should be the same")
+	}
+}
+
+// TestSimulateMsgCreateClient tests the creation of a solo machine client controlled by a simulation account
+func (suite *SimTestSuite) TestSimulateMsgCreateClient() {
+	r := rand.New(rand.NewSource(1))
+	accs := suite.getTestingAccounts(r, 3)
+
+	op := simulation.SimulateMsgCreateClient(suite.app.AccountKeeper, suite.app.IBCKeeper)
+	operationMsg, futureOps, err := op(r, suite.app.BaseApp, suite.ctx, accs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Empty(futureOps)
+
+	clients := suite.app.IBCKeeper.ClientKeeper.GetAllGenesisClients(suite.ctx)
+	suite.Require().NotEmpty(clients)
+
+	var found bool
+	for _, clientState := range clients {
+		if _, ok := simulation.GetSolomachine(suite.ctx, suite.app.IBCKeeper, accs, clientState.ClientId); ok {
+			found = true
+		}
+	}
+	suite.Require().True(found, "solo machine client should be controlled by a simulation account")
+}
+
+// TestSimulateMsgTimeout tests timing out a transfer sent between two channels opened over the localhost
+// connection once the block time passed the timeout timestamp of the packet
+func TestSimulateMsgTimeout(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	app := chain.GetSimApp()
+
+	path := ibctesting.NewLocalhostPath(chain)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	coordinator.Setup(path)
+
+	sender := chain.SenderAccount.GetAddress().String()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timeoutTimestamp := uint64(chain.GetContext().BlockTime().UnixNano()) + 1
+
+	msg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender, sender, clienttypes.ZeroHeight(), timeoutTimestamp, "")
+	res, err := chain.SendMsgs(msg)
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+
+	// the block time of the next block is past the timeout timestamp of the packet
+	coordinator.CommitBlock(chain)
+
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 1)
+	app.AccountKeeper.SetAccount(chain.GetContext(), app.AccountKeeper.NewAccountWithAddress(chain.GetContext(), accs[0].Address))
+
+	op := simulation.SimulateMsgTimeout(app.AccountKeeper, app.IBCKeeper, packet)
+
+	operationMsg, futureOps, err := op(r, app.BaseApp, chain.GetContext(), accs, chain.ChainID)
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+	require.Equal(t, sdk.MsgTypeURL(&channeltypes.MsgTimeout{}), operationMsg.Name)
+	require.Empty(t, futureOps)
+
+	commitment := app.IBCKeeper.ChannelKeeper.GetPacketCommitment(chain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	require.Empty(t, commitment)
+
+	// the packet commitment is deleted, thus the packet is not timed out again
+	operationMsg, _, err = op(r, app.BaseApp, chain.GetContext(), accs, chain.ChainID)
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	return accounts
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -0,0 +1,281 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+)
+
+// CounterpartyPrefix is the commitment prefix used by the simulated solo machine counterparties
+var CounterpartyPrefix = commitmenttypes.NewMerklePrefix([]byte("ibc"))
+
+// Solomachine is a solo machine counterparty simulated in-process. It signs with the private key of a
+// simulation account and tracks the sequence, timestamp and diversifier of the solo machine client stored
+// on the simulated chain. Each proof or header generated assumes the message using it is delivered
+// successfully and advances the solo machine accordingly.
+type Solomachine struct {
+	cdc         codec.BinaryCodec
+	ClientID    string
+	PrivateKey  cryptotypes.PrivKey
+	Sequence    uint64
+	Time        uint64
+	Diversifier string
+	Prefix      commitmenttypes.MerklePrefix
+}
+
+// NewSolomachine returns a new solo machine counterparty signing with the key of the provided simulation
+// account
+func NewSolomachine(cdc codec.BinaryCodec, clientID string, account simtypes.Account, sequence, timestamp uint64, diversifier string) *Solomachine {
+	return &Solomachine{
+		cdc:         cdc,
+		ClientID:    clientID,
+		PrivateKey:  account.PrivKey,
+		Sequence:    sequence,
+		Time:        timestamp,
+		Diversifier: diversifier,
+		Prefix:      CounterpartyPrefix,
+	}
+}
+
+// GetSolomachine returns the solo machine counterparty of the provided client. False is returned if the client
+// is not an active solo machine client or if its public key does not belong to any of the simulation accounts.
+func GetSolomachine(ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account, clientID string) (*Solomachine, bool) {
+	clientState, found := k.ClientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, false
+	}
+
+	soloClient, ok := clientState.(*solomachinetypes.ClientState)
+	if !ok || soloClient.IsFrozen || soloClient.ConsensusState == nil {
+		return nil, false
+	}
+
+	publicKey, err := soloClient.ConsensusState.GetPubKey()
+	if err != nil {
+		return nil, false
+	}
+
+	for _, acc := range accs {
+		if acc.PubKey.Equals(publicKey) {
+			return NewSolomachine(k.Codec(), clientID, acc, soloClient.Sequence, soloClient.ConsensusState.Timestamp, soloClient.ConsensusState.Diversifier), true
+		}
+	}
+
+	return nil, false
+}
+
+// GetConnectionSolomachine returns the solo machine counterparty of the provided connection, using the
+// commitment prefix of the connection counterparty
+func GetConnectionSolomachine(ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account, connection connectiontypes.ConnectionEnd) (*Solomachine, bool) {
+	solo, found := GetSolomachine(ctx, k, accs, connection.ClientId)
+	if !found {
+		return nil, false
+	}
+
+	solo.Prefix = connection.Counterparty.Prefix
+
+	return solo, true
+}
+
+// GetHeight returns the height of the solo machine, its sequence being the revision height
+func (solo *Solomachine) GetHeight() clienttypes.Height {
+	return clienttypes.NewHeight(0, solo.Sequence)
+}
+
+// ClientState returns a solo machine ClientState instance at the current sequence
+func (solo *Solomachine) ClientState() *solomachinetypes.ClientState {
+	return solomachinetypes.NewClientState(solo.Sequence, solo.ConsensusState(), false)
+}
+
+// ConsensusState returns a solo machine ConsensusState instance for the current key
+func (solo *Solomachine) ConsensusState() *solomachinetypes.ConsensusState {
+	publicKey, err := codectypes.NewAnyWithValue(solo.PrivateKey.PubKey())
+	if err != nil {
+		panic(err)
+	}
+
+	return &solomachinetypes.ConsensusState{
+		PublicKey:   publicKey,
+		Diversifier: solo.Diversifier,
+		Timestamp:   solo.Time,
+	}
+}
+
+// CreateHeader returns a header updating the solo machine client to the provided key at the provided
+// timestamp. The timestamp of the solo machine is never decreased.
+func (solo *Solomachine) CreateHeader(newPrivKey cryptotypes.PrivKey, timestamp uint64) (*solomachinetypes.Header, error) {
+	if timestamp > solo.Time {
+		solo.Time = timestamp
+	}
+
+	publicKey, err := codectypes.NewAnyWithValue(newPrivKey.PubKey())
+	if err != nil {
+		return nil, err
+	}
+
+	header := &solomachinetypes.Header{
+		Sequence:       solo.Sequence,
+		Timestamp:      solo.Time,
+		NewPublicKey:   publicKey,
+		NewDiversifier: solo.Diversifier,
+	}
+
+	signBytes, err := solomachinetypes.HeaderSignBytes(solo.cdc, header)
+	if err != nil {
+		return nil, err
+	}
+
+	header.Signature, err = solo.generateSignature(signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	solo.Sequence++
+	solo.PrivateKey = newPrivKey
+
+	return header, nil
+}
+
+// ConnectionStateProof returns a proof of the connection state stored by the solo machine
+func (solo *Solomachine) ConnectionStateProof(connectionID string, connection connectiontypes.ConnectionEnd) ([]byte, error) {
+	path, err := solo.applyPrefix(host.ConnectionPath(connectionID))
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := solomachinetypes.ConnectionStateSignBytes(solo.cdc, solo.Sequence, solo.Time, solo.Diversifier, path, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return solo.generateProof(signBytes)
+}
+
+// ClientStateProof returns a proof of the state of the client of the simulated chain stored by the solo machine
+func (solo *Solomachine) ClientStateProof(clientID string, clientState exported.ClientState) ([]byte, error) {
+	path, err := solo.applyPrefix(host.FullClientStatePath(clientID))
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := solomachinetypes.ClientStateSignBytes(solo.cdc, solo.Sequence, solo.Time, solo.Diversifier, path, clientState)
+	if err != nil {
+		return nil, err
+	}
+
+	return solo.generateProof(signBytes)
+}
+
+// ConsensusStateProof returns a proof of a consensus state of the simulated chain stored by the solo machine
+func (solo *Solomachine) ConsensusStateProof(clientID string, consensusHeight exported.Height, consensusState exported.ConsensusState) ([]byte, error) {
+	path, err := solo.applyPrefix(host.FullConsensusStatePath(clientID, consensusHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := solomachinetypes.ConsensusStateSignBytes(solo.cdc, solo.Sequence, solo.Time, solo.Diversifier, path, consensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	return solo.generateProof(signBytes)
+}
+
+// ChannelStateProof returns a proof of the channel state stored by the solo machine
+func (solo *Solomachine) ChannelStateProof(portID, channelID string, channel channeltypes.Channel) ([]byte, error) {
+	path, err := solo.applyPrefix(host.ChannelPath(portID, channelID))
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := solomachinetypes.ChannelStateSignBytes(solo.cdc, solo.Sequence, solo.Time, solo.Diversifier, path, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	return solo.generateProof(signBytes)
+}
+
+// PacketCommitmentProof returns a proof of the commitment of a packet sent by the solo machine
+func (solo *Solomachine) PacketCommitmentProof(packet channeltypes.Packet) ([]byte, error) {
+	path, err := solo.applyPrefix(host.PacketCommitmentPath(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	if err != nil {
+		return nil, err
+	}
+
+	commitment := channeltypes.CommitPacket(solo.cdc, packet)
+	signBytes, err := solomachinetypes.PacketCommitmentSignBytes(solo.cdc, solo.Sequence, solo.Time, solo.Diversifier, path, commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	return solo.generateProof(signBytes)
+}
+
+// PacketAcknowledgementProof returns a proof of the acknowledgement written by the solo machine for a packet
+// sent by the simulated chain
+func (solo *Solomachine) PacketAcknowledgementProof(packet channeltypes.Packet, acknowledgement []byte) ([]byte, error) {
+	path, err := solo.applyPrefix(host.PacketAcknowledgementPath(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := solomachinetypes.PacketAcknowledgementSignBytes(solo.cdc, solo.Sequence, solo.Time, solo.Diversifier, path, acknowledgement)
+	if err != nil {
+		return nil, err
+	}
+
+	return solo.generateProof(signBytes)
+}
+
+// applyPrefix applies the commitment prefix of the solo machine to the provided path
+func (solo *Solomachine) applyPrefix(path string) (commitmenttypes.MerklePath, error) {
+	return commitmenttypes.ApplyPrefix(solo.Prefix, commitmenttypes.NewMerklePath(path))
+}
+
+// generateProof signs over the sign bytes and increments the sequence of the solo machine, as done by the
+// solo machine client upon successful verification
+func (solo *Solomachine) generateProof(signBytes []byte) ([]byte, error) {
+	sig, err := solo.generateSignature(signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := solo.cdc.Marshal(&solomachinetypes.TimestampedSignatureData{
+		SignatureData: sig,
+		Timestamp:     solo.Time,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	solo.Sequence++
+
+	return proof, nil
+}
+
+// generateSignature signs over the sign bytes with the private key of the solo machine
+func (solo *Solomachine) generateSignature(signBytes []byte) ([]byte, error) {
+	sig, err := solo.PrivateKey.Sign(signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	sigData := signing.SignatureDataToProto(&signing.SingleSignatureData{
+		Signature: sig,
+	})
+
+	return solo.cdc.Marshal(sigData)
+}
@@ -0,0 +1,206 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+// DeliverTx generates a transaction containing the provided msgs signed by the provided simulation account
+// and delivers it without fees. The result of the transaction is returned.
+func DeliverTx(app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, simAccount simtypes.Account, msgs ...sdk.Msg) (*sdk.Result, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	if account == nil {
+		return nil, fmt.Errorf("account %s not found", simAccount.Address)
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		msgs,
+		sdk.Coins{},
+		helpers.DefaultGenTxGas,
+		ctx.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, res, err := app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// NewOperationMsg returns a successful operation message for the provided msg. The msg itself is not
+// included as IBC msgs do not implement the legacy amino msg interface.
+func NewOperationMsg(route string, msg sdk.Msg) simtypes.OperationMsg {
+	return simtypes.NewOperationMsgBasic(route, sdk.MsgTypeURL(msg), "", true, nil)
+}
+
+// RandomSolomachineConnection returns a random open connection of the simulated chain whose counterparty is
+// a solo machine simulated by one of the simulation accounts. False is returned if no such connection exists.
+func RandomSolomachineConnection(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account) (connectiontypes.IdentifiedConnection, *Solomachine, bool) {
+	return randomSolomachineConnection(r, ctx, k, accs, connectiontypes.OPEN)
+}
+
+// RandomCounterpartyConnection returns either the localhost connection, if the localhost client is allowed, or a
+// random open connection whose counterparty is a solo machine simulated by one of the simulation accounts
+func RandomCounterpartyConnection(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account) (connectiontypes.IdentifiedConnection, bool) {
+	if r.Intn(2) == 0 {
+		if connection, found := k.ConnectionKeeper.GetConnection(ctx, exported.LocalhostConnectionID); found {
+			return connectiontypes.NewIdentifiedConnection(exported.LocalhostConnectionID, connection), true
+		}
+	}
+
+	connection, _, found := RandomSolomachineConnection(r, ctx, k, accs)
+	return connection, found
+}
+
+// IsLocalhostChannel returns true if the provided connection hops of a channel are the localhost connection
+func IsLocalhostChannel(connectionHops []string) bool {
+	return len(connectionHops) == 1 && connectionHops[0] == exported.LocalhostConnectionID
+}
+
+// GetChannelSolomachine returns the connection and solo machine counterparty of the provided channel. False is
+// returned if the channel connection is not open or its counterparty is not a solo machine simulated by one of
+// the simulation accounts.
+func GetChannelSolomachine(ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account, portID, channelID string) (connectiontypes.ConnectionEnd, *Solomachine, bool) {
+	channel, found := k.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found || len(channel.ConnectionHops) != 1 {
+		return connectiontypes.ConnectionEnd{}, nil, false
+	}
+
+	connection, found := k.ConnectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found || connection.State != connectiontypes.OPEN {
+		return connectiontypes.ConnectionEnd{}, nil, false
+	}
+
+	solo, found := GetConnectionSolomachine(ctx, k, accs, connection)
+	if !found {
+		return connectiontypes.ConnectionEnd{}, nil, false
+	}
+
+	return connection, solo, true
+}
+
+// GenMsgChannelOpenTry returns a MsgChannelOpenTry opening a channel in TRYOPEN state on the provided port and
+// connection, proving the solo machine counterparty initialized a channel with the given version
+func GenMsgChannelOpenTry(
+	solo *Solomachine, connection connectiontypes.IdentifiedConnection, portID string, ordering channeltypes.Order,
+	counterpartyPortID, counterpartyChannelID, counterpartyVersion, signer string,
+) (*channeltypes.MsgChannelOpenTry, error) {
+	counterpartyChannel := channeltypes.NewChannel(
+		channeltypes.INIT, ordering, channeltypes.NewCounterparty(portID, ""),
+		[]string{connection.Counterparty.ConnectionId}, counterpartyVersion,
+	)
+
+	proofHeight := solo.GetHeight()
+	proofInit, err := solo.ChannelStateProof(counterpartyPortID, counterpartyChannelID, counterpartyChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	return channeltypes.NewMsgChannelOpenTry(
+		portID, "", counterpartyVersion, ordering, []string{connection.Id},
+		counterpartyPortID, counterpartyChannelID, counterpartyVersion, proofInit, proofHeight, signer,
+	), nil
+}
+
+// GenMsgChannelOpenAck returns a MsgChannelOpenAck for a channel in INIT state whose counterparty is the
+// provided solo machine, proving the counterparty channel was opened in TRYOPEN with the given version
+func GenMsgChannelOpenAck(
+	solo *Solomachine, connection connectiontypes.ConnectionEnd, channel channeltypes.IdentifiedChannel,
+	counterpartyChannelID, counterpartyVersion, signer string,
+) (*channeltypes.MsgChannelOpenAck, error) {
+	counterpartyChannel := channeltypes.NewChannel(
+		channeltypes.TRYOPEN, channel.Ordering, channeltypes.NewCounterparty(channel.PortId, channel.ChannelId),
+		[]string{connection.Counterparty.ConnectionId}, counterpartyVersion,
+	)
+
+	proofHeight := solo.GetHeight()
+	proofTry, err := solo.ChannelStateProof(channel.Counterparty.PortId, counterpartyChannelID, counterpartyChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	return channeltypes.NewMsgChannelOpenAck(
+		channel.PortId, channel.ChannelId, counterpartyChannelID, counterpartyVersion, proofTry, proofHeight, signer,
+	), nil
+}
+
+// ParsePacketFromEvents parses the events of a transaction result and returns the first packet found
+func ParsePacketFromEvents(events []abci.Event) (channeltypes.Packet, error) {
+	for _, ev := range events {
+		if ev.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+
+		packet := channeltypes.Packet{}
+		for _, attr := range ev.Attributes {
+			switch string(attr.Key) {
+			case channeltypes.AttributeKeyData:
+				packet.Data = attr.Value
+
+			case channeltypes.AttributeKeySequence:
+				seq, err := strconv.ParseUint(string(attr.Value), 10, 64)
+				if err != nil {
+					return channeltypes.Packet{}, err
+				}
+
+				packet.Sequence = seq
+
+			case channeltypes.AttributeKeySrcPort:
+				packet.SourcePort = string(attr.Value)
+
+			case channeltypes.AttributeKeySrcChannel:
+				packet.SourceChannel = string(attr.Value)
+
+			case channeltypes.AttributeKeyDstPort:
+				packet.DestinationPort = string(attr.Value)
+
+			case channeltypes.AttributeKeyDstChannel:
+				packet.DestinationChannel = string(attr.Value)
+
+			case channeltypes.AttributeKeyTimeoutHeight:
+				height, err := clienttypes.ParseHeight(string(attr.Value))
+				if err != nil {
+					return channeltypes.Packet{}, err
+				}
+
+				packet.TimeoutHeight = height
+
+			case channeltypes.AttributeKeyTimeoutTimestamp:
+				timestamp, err := strconv.ParseUint(string(attr.Value), 10, 64)
+				if err != nil {
+					return channeltypes.Packet{}, err
+				}
+
+				packet.TimeoutTimestamp = timestamp
+			}
+		}
+
+		return packet, nil
+	}
+
+	return channeltypes.Packet{}, fmt.Errorf("%s event not found", channeltypes.EventTypeSendPacket)
+}
@@ -8,8 +8,10 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	solomachinev3types "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/v3/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	localhosttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost/types"
+	committeetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
 )
 
 // RegisterInterfaces registers x/ibc interfaces into protobuf Any.
@@ -18,7 +20,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	connectiontypes.RegisterInterfaces(registry)
 	channeltypes.RegisterInterfaces(registry)
 	solomachinetypes.RegisterInterfaces(registry)
+	solomachinev3types.RegisterInterfaces(registry)
 	ibctmtypes.RegisterInterfaces(registry)
 	localhosttypes.RegisterInterfaces(registry)
+	committeetypes.RegisterInterfaces(registry)
 	commitmenttypes.RegisterInterfaces(registry)
 }
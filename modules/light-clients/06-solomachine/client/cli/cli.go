@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// NewTxCmd returns the command used by a solo machine to sign headers and proofs, and to
+// construct misbehaviour, offline with a key of the keyring.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "solomachine",
+		Short:                      "Solo machine offline signing subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSignHeaderCmd(),
+		NewMisbehaviourCmd(),
+		NewProofCmd(),
+	)
+
+	return txCmd
+}
+
+// NewProofCmd returns the command to sign membership and non-membership proofs of
+// the solo machine state.
+func NewProofCmd() *cobra.Command {
+	proofCmd := &cobra.Command{
+		Use:                        "proof",
+		Short:                      "Sign solo machine state proofs",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	proofCmd.AddCommand(
+		NewSignClientStateCmd(),
+		NewSignConsensusStateCmd(),
+		NewSignConnectionStateCmd(),
+		NewSignChannelStateCmd(),
+		NewSignChannelUpgradeCmd(),
+		NewSignChannelUpgradeErrorCmd(),
+		NewSignPacketCommitmentCmd(),
+		NewSignPacketAcknowledgementCmd(),
+		NewSignPacketReceiptCmd(),
+		NewSignPacketReceiptAbsenceCmd(),
+		NewSignNextSequenceRecvCmd(),
+	)
+
+	return proofCmd
+}
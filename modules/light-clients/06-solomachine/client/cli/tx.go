@@ -0,0 +1,786 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+)
+
+const (
+	flagTimestamp        = "timestamp"
+	flagDiversifier      = "diversifier"
+	flagPrefix           = "prefix"
+	flagSignatureAndData = "signature-and-data"
+)
+
+// NewSignHeaderCmd defines the command to sign a header rotating the public key of a solo machine.
+func NewSignHeaderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "header [sequence] [new-public-key]",
+		Short: "sign a solo machine header",
+		Long: `sign a header updating the solo machine to the new public key and diversifier with the key provided by --from.
+The header is printed as JSON and may be submitted with the client update command.
+	- new public key JSON example: {"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtK50+5pJOoaa04qqAqrnyAqsYrwrR/INnA6UPIaYZlp"}`,
+		Example: fmt.Sprintf("%s tx ibc solomachine header 1 [new-public-key] --diversifier testing --from solo --keyring-backend file", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			sequence, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var newPublicKey cryptotypes.PubKey
+			if err := cdc.UnmarshalInterfaceJSON([]byte(args[1]), &newPublicKey); err != nil {
+				return fmt.Errorf("error unmarshalling new public key: %w", err)
+			}
+
+			anyPublicKey, err := codectypes.NewAnyWithValue(newPublicKey)
+			if err != nil {
+				return err
+			}
+
+			timestamp, diversifier, err := getTimestampAndDiversifier(cmd)
+			if err != nil {
+				return err
+			}
+
+			header := &types.Header{
+				Sequence:       sequence,
+				Timestamp:      timestamp,
+				NewPublicKey:   anyPublicKey,
+				NewDiversifier: diversifier,
+			}
+
+			signBytes, err := types.HeaderSignBytes(cdc, header)
+			if err != nil {
+				return err
+			}
+
+			header.Signature, err = generateSignature(clientCtx, cdc, signBytes)
+			if err != nil {
+				return err
+			}
+
+			if err := header.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return printInterfaceJSON(clientCtx, cdc, header)
+		},
+	}
+
+	addSigningFlags(cmd)
+	cmd.Flags().String(flagDiversifier, "", "The diversifier of the solo machine after the update")
+
+	return cmd
+}
+
+// NewMisbehaviourCmd defines the command to construct solo machine misbehaviour from two
+// signatures over different data at the same sequence.
+func NewMisbehaviourCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "misbehaviour [client-id] [sequence] [path/to/signature_and_data_one.json] [path/to/signature_and_data_two.json]",
+		Short: "construct solo machine misbehaviour",
+		Long: `construct misbehaviour of the solo machine tracked by the client from two signatures over different data at the same sequence.
+The signatures and data are created by the proof subcommands with the --signature-and-data flag. The misbehaviour is printed as
+JSON and may be submitted with the client misbehaviour command.`,
+		Example: fmt.Sprintf("%s tx ibc solomachine misbehaviour 06-solomachine-0 1 [path/to/signature_and_data_one.json] [path/to/signature_and_data_two.json]", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signatureOne := &types.SignatureAndData{}
+			if err := unmarshalJSON(cdc, args[2], signatureOne, "signature and data one"); err != nil {
+				return err
+			}
+
+			signatureTwo := &types.SignatureAndData{}
+			if err := unmarshalJSON(cdc, args[3], signatureTwo, "signature and data two"); err != nil {
+				return err
+			}
+
+			misbehaviour := &types.Misbehaviour{
+				ClientId:     args[0],
+				Sequence:     sequence,
+				SignatureOne: signatureOne,
+				SignatureTwo: signatureTwo,
+			}
+
+			if err := misbehaviour.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return printInterfaceJSON(clientCtx, cdc, misbehaviour)
+		},
+	}
+}
+
+// NewSignClientStateCmd defines the command to sign a proof of the client state of a
+// counterparty stored on the solo machine.
+func NewSignClientStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-state [sequence] [counterparty-client-id] [path/to/client_state.json]",
+		Short:   "sign a proof of a client state stored on the solo machine",
+		Long:    "sign a proof of the client state, tracking the counterparty, stored on the solo machine under the counterparty client identifier",
+		Example: fmt.Sprintf("%s tx ibc solomachine proof client-state 1 07-tendermint-0 [path/to/client_state.json] --from solo", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			var clientState exported.ClientState
+			if err := unmarshalInterfaceJSON(cdc, args[2], &clientState, "client state"); err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.FullClientStatePath(args[1]))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.ClientStateDataBytes(cdc, path, clientState)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.CLIENT, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// NewSignConsensusStateCmd defines the command to sign a proof of a consensus state of a
+// counterparty stored on the solo machine.
+func NewSignConsensusStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-state [sequence] [counterparty-client-id] [consensus-height] [path/to/consensus_state.json]",
+		Short: "sign a proof of a consensus state stored on the solo machine",
+		Long: `sign a proof of the consensus state, tracking the counterparty, stored on the solo machine under the counterparty client identifier
+and consensus height. NOTE: the proof is verified at the proof height sequence incremented by two, thus the sequence signed at must be
+the proof height sequence submitted to the counterparty incremented by two.`,
+		Example: fmt.Sprintf("%s tx ibc solomachine proof consensus-state 3 07-tendermint-0 1-100 [path/to/consensus_state.json] --from solo", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			consensusHeight, err := clienttypes.ParseHeight(args[2])
+			if err != nil {
+				return err
+			}
+
+			var consensusState exported.ConsensusState
+			if err := unmarshalInterfaceJSON(cdc, args[3], &consensusState, "consensus state"); err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.FullConsensusStatePath(args[1], consensusHeight))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.ConsensusStateDataBytes(cdc, path, consensusState)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.CONSENSUS, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// NewSignConnectionStateCmd defines the command to sign a proof of a connection end
+// stored on the solo machine.
+func NewSignConnectionStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "connection [sequence] [connection-id] [path/to/connection_end.json]",
+		Short:   "sign a proof of a connection end stored on the solo machine",
+		Long:    "sign a proof of the connection end stored on the solo machine under the connection identifier",
+		Example: fmt.Sprintf("%s tx ibc solomachine proof connection 1 connection-0 [path/to/connection_end.json] --from solo", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			var connection connectiontypes.ConnectionEnd
+			if err := unmarshalJSON(cdc, args[2], &connection, "connection end"); err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.ConnectionPath(args[1]))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.ConnectionStateDataBytes(cdc, path, connection)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.CONNECTION, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// NewSignChannelStateCmd defines the command to sign a proof of a channel end stored on
+// the solo machine.
+func NewSignChannelStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel [sequence] [port-id] [channel-id] [path/to/channel.json]",
+		Short:   "sign a proof of a channel end stored on the solo machine",
+		Long:    "sign a proof of the channel end stored on the solo machine under the port and channel identifiers",
+		Example: fmt.Sprintf("%s tx ibc solomachine proof channel 1 transfer channel-0 [path/to/channel.json] --from solo", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			var channel channeltypes.Channel
+			if err := unmarshalJSON(cdc, args[3], &channel, "channel"); err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.ChannelPath(args[1], args[2]))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.ChannelStateDataBytes(cdc, path, channel)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.CHANNEL, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// NewSignChannelUpgradeCmd defines the command to sign a proof of a channel upgrade
+// stored on the solo machine.
+func NewSignChannelUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-upgrade [sequence] [port-id] [channel-id] [path/to/upgrade.json]",
+		Short:   "sign a proof of a channel upgrade stored on the solo machine",
+		Long:    "sign a proof of the upgrade proposed by the channel end stored on the solo machine under the port and channel identifiers",
+		Example: fmt.Sprintf("%s tx ibc solomachine proof channel-upgrade 1 transfer channel-0 [path/to/upgrade.json] --from solo", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			var upgrade channeltypes.Upgrade
+			if err := unmarshalJSON(cdc, args[3], &upgrade, "upgrade"); err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.ChannelUpgradePath(args[1], args[2]))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.ChannelUpgradeDataBytes(cdc, path, upgrade)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.CHANNELUPGRADE, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// NewSignChannelUpgradeErrorCmd defines the command to sign a proof of a channel upgrade
+// error receipt stored on the solo machine.
+func NewSignChannelUpgradeErrorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-upgrade-error [sequence] [port-id] [channel-id] [path/to/error_receipt.json]",
+		Short:   "sign a proof of a channel upgrade error receipt stored on the solo machine",
+		Long:    "sign a proof of the upgrade error receipt of the channel end stored on the solo machine under the port and channel identifiers",
+		Example: fmt.Sprintf("%s tx ibc solomachine proof channel-upgrade-error 1 transfer channel-0 [path/to/error_receipt.json] --from solo", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			var errorReceipt channeltypes.ErrorReceipt
+			if err := unmarshalJSON(cdc, args[3], &errorReceipt, "error receipt"); err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.ChannelUpgradeErrorPath(args[1], args[2]))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.ChannelUpgradeErrorDataBytes(cdc, path, errorReceipt)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.CHANNELUPGRADEERROR, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// NewSignPacketCommitmentCmd defines the command to sign a proof of a packet commitment
+// stored on the solo machine.
+func NewSignPacketCommitmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-commitment [sequence] [port-id] [channel-id] [packet-sequence] [commitment-hex]",
+		Short:   "sign a proof of a packet commitment stored on the solo machine",
+		Long:    "sign a proof of the hex encoded commitment of an outgoing packet stored on the solo machine under the port and channel identifiers and packet sequence",
+		Example: fmt.Sprintf("%s tx ibc solomachine proof packet-commitment 1 transfer channel-0 1 [commitment-hex] --from solo", version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			packetSequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			commitment, err := hex.DecodeString(args[4])
+			if err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.PacketCommitmentPath(args[1], args[2], packetSequence))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.PacketCommitmentDataBytes(cdc, path, commitment)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.PACKETCOMMITMENT, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// NewSignPacketAcknowledgementCmd defines the command to sign a proof of a packet
+// acknowledgement stored on the solo machine.
+func NewSignPacketAcknowledgementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-acknowledgement [sequence] [port-id] [channel-id] [packet-sequence] [acknowledgement-commitment-hex]",
+		Short:   "sign a proof of a packet acknowledgement stored on the solo machine",
+		Long:    "sign a proof of the hex encoded acknowledgement commitment of an incoming packet stored on the solo machine under the port and channel identifiers and packet sequence",
+		Example: fmt.Sprintf("%s tx ibc solomachine proof packet-acknowledgement 1 transfer channel-0 1 [acknowledgement-commitment-hex] --from solo", version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			packetSequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			acknowledgement, err := hex.DecodeString(args[4])
+			if err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.PacketAcknowledgementPath(args[1], args[2], packetSequence))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.PacketAcknowledgementDataBytes(cdc, path, acknowledgement)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.PACKETACKNOWLEDGEMENT, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// NewSignPacketReceiptCmd defines the command to sign a proof of a packet receipt
+// stored on the solo machine.
+func NewSignPacketReceiptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-receipt [sequence] [port-id] [channel-id] [packet-sequence] [receipt-hex]",
+		Short:   "sign a proof of a packet receipt stored on the solo machine",
+		Long:    "sign a proof of the hex encoded receipt of an incoming packet stored on the solo machine under the port and channel identifiers and packet sequence",
+		Example: fmt.Sprintf("%s tx ibc solomachine proof packet-receipt 1 transfer channel-0 1 01 --from solo", version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			packetSequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			receipt, err := hex.DecodeString(args[4])
+			if err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.PacketReceiptPath(args[1], args[2], packetSequence))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.PacketReceiptDataBytes(cdc, path, receipt)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.PACKETRECEIPT, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// NewSignPacketReceiptAbsenceCmd defines the command to sign a proof of the absence of a
+// packet receipt on the solo machine.
+func NewSignPacketReceiptAbsenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-receipt-absence [sequence] [port-id] [channel-id] [packet-sequence]",
+		Short:   "sign a proof of the absence of a packet receipt on the solo machine",
+		Long:    "sign a proof of the absence of the receipt of an incoming packet on the solo machine under the port and channel identifiers and packet sequence",
+		Example: fmt.Sprintf("%s tx ibc solomachine proof packet-receipt-absence 1 transfer channel-0 1 --from solo", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			packetSequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.PacketReceiptPath(args[1], args[2], packetSequence))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.PacketReceiptAbsenceDataBytes(cdc, path)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.PACKETRECEIPTABSENCE, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// NewSignNextSequenceRecvCmd defines the command to sign a proof of the next sequence
+// receive counter stored on the solo machine.
+func NewSignNextSequenceRecvCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "next-sequence-recv [sequence] [port-id] [channel-id] [next-sequence-recv]",
+		Short:   "sign a proof of a next sequence receive stored on the solo machine",
+		Long:    "sign a proof of the next sequence receive counter stored on the solo machine under the port and channel identifiers",
+		Example: fmt.Sprintf("%s tx ibc solomachine proof next-sequence-recv 1 transfer channel-0 2 --from solo", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			nextSequenceRecv, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			path, err := applyPrefix(cmd, host.NextSequenceRecvPath(args[1], args[2]))
+			if err != nil {
+				return err
+			}
+
+			dataBz, err := types.NextSequenceRecvDataBytes(cdc, path, nextSequenceRecv)
+			if err != nil {
+				return err
+			}
+
+			return signData(cmd, clientCtx, cdc, args[0], types.NEXTSEQUENCERECV, dataBz)
+		},
+	}
+
+	addProofFlags(cmd)
+
+	return cmd
+}
+
+// signData signs over the data bytes of the provided data type at the sequence, timestamp
+// and diversifier of the solo machine with the key provided by --from. The base64 encoded
+// proof is printed or, if --signature-and-data is set, the signature and data used to
+// construct misbehaviour.
+func signData(cmd *cobra.Command, clientCtx client.Context, cdc codec.Codec, sequenceStr string, dataType types.DataType, dataBz []byte) error {
+	sequence, err := strconv.ParseUint(sequenceStr, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	timestamp, diversifier, err := getTimestampAndDiversifier(cmd)
+	if err != nil {
+		return err
+	}
+
+	signBytes, err := cdc.Marshal(&types.SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		DataType:    dataType,
+		Data:        dataBz,
+	})
+	if err != nil {
+		return err
+	}
+
+	signature, err := generateSignature(clientCtx, cdc, signBytes)
+	if err != nil {
+		return err
+	}
+
+	signatureAndData, err := cmd.Flags().GetBool(flagSignatureAndData)
+	if err != nil {
+		return err
+	}
+
+	if signatureAndData {
+		bz, err := cdc.MarshalJSON(&types.SignatureAndData{
+			Signature: signature,
+			DataType:  dataType,
+			Data:      dataBz,
+			Timestamp: timestamp,
+		})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(string(bz) + "\n")
+	}
+
+	proof, err := cdc.Marshal(&types.TimestampedSignatureData{
+		SignatureData: signature,
+		Timestamp:     timestamp,
+	})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintString(base64.StdEncoding.EncodeToString(proof) + "\n")
+}
+
+// generateSignature signs over the sign bytes with the key provided by --from and returns
+// the encoded signature data expected by the solo machine client.
+func generateSignature(clientCtx client.Context, cdc codec.Codec, signBytes []byte) ([]byte, error) {
+	if clientCtx.Keyring == nil {
+		return nil, fmt.Errorf("keyring must be provided to sign")
+	}
+
+	signature, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	sigData := signing.SignatureDataToProto(&signing.SingleSignatureData{
+		Signature: signature,
+	})
+
+	return cdc.Marshal(sigData)
+}
+
+// getTimestampAndDiversifier returns the timestamp and diversifier to sign with. The
+// timestamp defaults to the current time in nanoseconds.
+func getTimestampAndDiversifier(cmd *cobra.Command) (uint64, string, error) {
+	timestamp, err := cmd.Flags().GetUint64(flagTimestamp)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if timestamp == 0 {
+		timestamp = uint64(time.Now().UnixNano())
+	}
+
+	diversifier, err := cmd.Flags().GetString(flagDiversifier)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return timestamp, diversifier, nil
+}
+
+// applyPrefix applies the commitment prefix provided by --prefix to the path.
+func applyPrefix(cmd *cobra.Command, path string) (commitmenttypes.MerklePath, error) {
+	prefix, err := cmd.Flags().GetString(flagPrefix)
+	if err != nil {
+		return commitmenttypes.MerklePath{}, err
+	}
+
+	return commitmenttypes.ApplyPrefix(commitmenttypes.NewMerklePrefix([]byte(prefix)), commitmenttypes.NewMerklePath(path))
+}
+
+// unmarshalInterfaceJSON unmarshals the JSON input, or the contents of the .json file if a
+// path to a file is provided, into the interface.
+func unmarshalInterfaceJSON(cdc codec.Codec, contentOrFileName string, ptr interface{}, name string) error {
+	if err := cdc.UnmarshalInterfaceJSON([]byte(contentOrFileName), ptr); err != nil {
+
+		// check for file path if JSON input is not provided
+		contents, err := ioutil.ReadFile(contentOrFileName)
+		if err != nil {
+			return fmt.Errorf("neither JSON input nor path to .json file for %s were provided: %w", name, err)
+		}
+
+		if err := cdc.UnmarshalInterfaceJSON(contents, ptr); err != nil {
+			return fmt.Errorf("error unmarshalling %s file: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// unmarshalJSON unmarshals the JSON input, or the contents of the .json file if a path to
+// a file is provided, into the message.
+func unmarshalJSON(cdc codec.Codec, contentOrFileName string, msg proto.Message, name string) error {
+	if err := cdc.UnmarshalJSON([]byte(contentOrFileName), msg); err != nil {
+
+		// check for file path if JSON input is not provided
+		contents, err := ioutil.ReadFile(contentOrFileName)
+		if err != nil {
+			return fmt.Errorf("neither JSON input nor path to .json file for %s were provided: %w", name, err)
+		}
+
+		if err := cdc.UnmarshalJSON(contents, msg); err != nil {
+			return fmt.Errorf("error unmarshalling %s file: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// printInterfaceJSON prints the JSON encoding of the message including its type URL, such
+// that it may be provided to the client commands.
+func printInterfaceJSON(clientCtx client.Context, cdc codec.Codec, msg proto.Message) error {
+	bz, err := cdc.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintString(string(bz) + "\n")
+}
+
+// addSigningFlags adds the flags used to sign with a key of the keyring.
+func addSigningFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of private key with which to sign")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+	cmd.Flags().Uint64(flagTimestamp, 0, "The timestamp of the signature in nanoseconds; if omitted, the current time will be used")
+}
+
+// addProofFlags adds the flags used to sign proofs of the solo machine state.
+func addProofFlags(cmd *cobra.Command) {
+	addSigningFlags(cmd)
+	cmd.Flags().String(flagDiversifier, "", "The diversifier of the solo machine")
+	cmd.Flags().String(flagPrefix, "ibc", "The commitment prefix of the state stored on the solo machine")
+	cmd.Flags().Bool(flagSignatureAndData, false, "Print the signature and data, used to construct misbehaviour, instead of the proof")
+}
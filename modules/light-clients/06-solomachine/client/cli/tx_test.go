@@ -0,0 +1,336 @@
+package cli_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/client/cli"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+const (
+	signerName  = "solo"
+	diversifier = "testing"
+	// sequence of the solo machine client the proofs are signed at
+	sequence  = uint64(3)
+	timestamp = uint64(10)
+
+	counterpartyClientID = "07-tendermint-0"
+	connectionID         = "connection-0"
+	portID               = "transfer"
+	channelID            = "channel-0"
+	packetSequence       = uint64(1)
+)
+
+var prefix = commitmenttypes.NewMerklePrefix([]byte("ibc"))
+
+type TxTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chain used for convenience and readability
+	chainA *ibctesting.TestChain
+
+	clientCtx client.Context
+	cdc       codec.Codec
+	publicKey cryptotypes.PubKey
+	store     sdk.KVStore
+}
+
+func (suite *TxTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	suite.cdc = encodingConfig.Marshaler
+
+	// the commands sign with a key of an in-memory keyring
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic(signerName, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	suite.Require().NoError(err)
+	suite.publicKey = info.GetPubKey()
+
+	suite.clientCtx = client.Context{}.
+		WithKeyring(kr).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithCodec(encodingConfig.Marshaler)
+
+	suite.store = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), exported.Solomachine)
+}
+
+func TestTxTestSuite(t *testing.T) {
+	suite.Run(t, new(TxTestSuite))
+}
+
+// clientState returns a solo machine client state at the provided sequence tracking the
+// public key of the keyring.
+func (suite *TxTestSuite) clientState(sequence uint64) *types.ClientState {
+	publicKey, err := codectypes.NewAnyWithValue(suite.publicKey)
+	suite.Require().NoError(err)
+
+	return types.NewClientState(sequence, &types.ConsensusState{
+		PublicKey:   publicKey,
+		Diversifier: diversifier,
+		Timestamp:   timestamp,
+	}, false)
+}
+
+// execCmd executes the command with the provided arguments and returns its output.
+func (suite *TxTestSuite) execCmd(cmd *cobra.Command, args ...string) (string, error) {
+	out, err := clitestutil.ExecTestCLICmd(suite.clientCtx, cmd, args)
+	return strings.TrimSpace(out.String()), err
+}
+
+// signCmd executes the command with the provided arguments, signing with the key of the
+// keyring at the solo machine timestamp, and returns its output.
+func (suite *TxTestSuite) signCmd(cmd *cobra.Command, args ...string) string {
+	args = append(args,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, signerName),
+		fmt.Sprintf("--timestamp=%d", timestamp),
+	)
+
+	out, err := suite.execCmd(cmd, args...)
+	suite.Require().NoError(err)
+
+	return out
+}
+
+// marshalInterfaceJSON returns the JSON encoding, including its type URL, of the message.
+func (suite *TxTestSuite) marshalInterfaceJSON(msg codec.ProtoMarshaler) string {
+	bz, err := suite.cdc.MarshalInterfaceJSON(msg)
+	suite.Require().NoError(err)
+
+	return string(bz)
+}
+
+// marshalJSON returns the JSON encoding of the message.
+func (suite *TxTestSuite) marshalJSON(msg codec.ProtoMarshaler) string {
+	bz, err := suite.cdc.MarshalJSON(msg)
+	suite.Require().NoError(err)
+
+	return string(bz)
+}
+
+func (suite *TxTestSuite) TestSignHeader() {
+	newInfo, _, err := suite.clientCtx.Keyring.NewMnemonic("new", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	suite.Require().NoError(err)
+
+	newPublicKey, err := suite.cdc.MarshalInterfaceJSON(newInfo.GetPubKey())
+	suite.Require().NoError(err)
+
+	out := suite.signCmd(cli.NewSignHeaderCmd(), fmt.Sprint(sequence), string(newPublicKey), "--diversifier=new")
+
+	var header exported.Header
+	err = suite.cdc.UnmarshalInterfaceJSON([]byte(out), &header)
+	suite.Require().NoError(err)
+
+	clientState := suite.clientState(sequence)
+	newClientState, consensusState, err := clientState.CheckHeaderAndUpdateState(suite.chainA.GetContext(), suite.cdc, suite.store, header)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sequence+1, newClientState.GetLatestHeight().GetRevisionHeight())
+
+	smConsensusState := consensusState.(*types.ConsensusState)
+	publicKey, err := smConsensusState.GetPubKey()
+	suite.Require().NoError(err)
+	suite.Require().Equal(newInfo.GetPubKey(), publicKey)
+	suite.Require().Equal("new", smConsensusState.Diversifier)
+}
+
+func (suite *TxTestSuite) TestSignProofs() {
+	counterpartyClientState := suite.clientState(1)
+	counterpartyConsensusState := counterpartyClientState.ConsensusState
+	consensusHeight := clienttypes.NewHeight(1, 100)
+
+	counterparty := connectiontypes.NewCounterparty("07-tendermint-1", "connection-1", prefix)
+	connection := connectiontypes.NewConnectionEnd(connectiontypes.OPEN, counterpartyClientID, counterparty, connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0)
+	channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(portID, "channel-1"), []string{connectionID}, "ics20-1")
+	upgrade := channeltypes.NewUpgrade(channeltypes.ORDERED, []string{connectionID}, "ics20-2", clienttypes.NewHeight(1, 1000), 0, 2)
+	errorReceipt := channeltypes.NewErrorReceipt(1, channeltypes.ErrUpgradeAborted)
+
+	commitment := []byte("commitment")
+	acknowledgement := []byte("acknowledgement")
+	receipt := []byte{1}
+
+	// the sequence of the solo machine is encoded in the revision height of the proof height
+	height := clienttypes.NewHeight(0, sequence)
+
+	testCases := []struct {
+		name   string
+		cmd    *cobra.Command
+		args   []string
+		verify func(clientState *types.ClientState, proof []byte) error
+	}{
+		{
+			"client state", cli.NewSignClientStateCmd(),
+			[]string{counterpartyClientID, suite.marshalInterfaceJSON(counterpartyClientState)},
+			func(clientState *types.ClientState, proof []byte) error {
+				// the client state is verified at the proof height sequence incremented by one
+				return clientState.VerifyClientState(suite.store, suite.cdc, clienttypes.NewHeight(0, sequence-1), &prefix, counterpartyClientID, proof, counterpartyClientState)
+			},
+		},
+		{
+			"consensus state", cli.NewSignConsensusStateCmd(),
+			[]string{counterpartyClientID, consensusHeight.String(), suite.marshalInterfaceJSON(counterpartyConsensusState)},
+			func(clientState *types.ClientState, proof []byte) error {
+				// the consensus state is verified at the proof height sequence incremented by two
+				return clientState.VerifyClientConsensusState(suite.store, suite.cdc, clienttypes.NewHeight(0, sequence-2), counterpartyClientID, consensusHeight, &prefix, proof, counterpartyConsensusState)
+			},
+		},
+		{
+			"connection", cli.NewSignConnectionStateCmd(),
+			[]string{connectionID, suite.marshalJSON(&connection)},
+			func(clientState *types.ClientState, proof []byte) error {
+				return clientState.VerifyConnectionState(suite.store, suite.cdc, height, &prefix, proof, connectionID, connection)
+			},
+		},
+		{
+			"channel", cli.NewSignChannelStateCmd(),
+			[]string{portID, channelID, suite.marshalJSON(&channel)},
+			func(clientState *types.ClientState, proof []byte) error {
+				return clientState.VerifyChannelState(suite.store, suite.cdc, height, &prefix, proof, portID, channelID, channel)
+			},
+		},
+		{
+			"channel upgrade", cli.NewSignChannelUpgradeCmd(),
+			[]string{portID, channelID, suite.marshalJSON(&upgrade)},
+			func(clientState *types.ClientState, proof []byte) error {
+				return clientState.VerifyChannelUpgrade(suite.store, suite.cdc, height, &prefix, proof, portID, channelID, upgrade)
+			},
+		},
+		{
+			"channel upgrade error", cli.NewSignChannelUpgradeErrorCmd(),
+			[]string{portID, channelID, suite.marshalJSON(&errorReceipt)},
+			func(clientState *types.ClientState, proof []byte) error {
+				return clientState.VerifyChannelUpgradeError(suite.store, suite.cdc, height, &prefix, proof, portID, channelID, errorReceipt)
+			},
+		},
+		{
+			"packet commitment", cli.NewSignPacketCommitmentCmd(),
+			[]string{portID, channelID, fmt.Sprint(packetSequence), hex.EncodeToString(commitment)},
+			func(clientState *types.ClientState, proof []byte) error {
+				return clientState.VerifyPacketCommitment(suite.chainA.GetContext(), suite.store, suite.cdc, height, 0, 0, &prefix, proof, portID, channelID, packetSequence, commitment)
+			},
+		},
+		{
+			"packet acknowledgement", cli.NewSignPacketAcknowledgementCmd(),
+			[]string{portID, channelID, fmt.Sprint(packetSequence), hex.EncodeToString(acknowledgement)},
+			func(clientState *types.ClientState, proof []byte) error {
+				return clientState.VerifyPacketAcknowledgement(suite.chainA.GetContext(), suite.store, suite.cdc, height, 0, 0, &prefix, proof, portID, channelID, packetSequence, acknowledgement)
+			},
+		},
+		{
+			"packet receipt", cli.NewSignPacketReceiptCmd(),
+			[]string{portID, channelID, fmt.Sprint(packetSequence), hex.EncodeToString(receipt)},
+			func(clientState *types.ClientState, proof []byte) error {
+				return clientState.VerifyPacketReceipt(suite.chainA.GetContext(), suite.store, suite.cdc, height, 0, 0, &prefix, proof, portID, channelID, packetSequence, receipt)
+			},
+		},
+		{
+			"packet receipt absence", cli.NewSignPacketReceiptAbsenceCmd(),
+			[]string{portID, channelID, fmt.Sprint(packetSequence)},
+			func(clientState *types.ClientState, proof []byte) error {
+				return clientState.VerifyPacketReceiptAbsence(suite.chainA.GetContext(), suite.store, suite.cdc, height, 0, 0, &prefix, proof, portID, channelID, packetSequence)
+			},
+		},
+		{
+			"next sequence recv", cli.NewSignNextSequenceRecvCmd(),
+			[]string{portID, channelID, "2"},
+			func(clientState *types.ClientState, proof []byte) error {
+				return clientState.VerifyNextSequenceRecv(suite.chainA.GetContext(), suite.store, suite.cdc, height, 0, 0, &prefix, proof, portID, channelID, 2)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			args := append([]string{fmt.Sprint(sequence)}, tc.args...)
+			args = append(args, fmt.Sprintf("--diversifier=%s", diversifier))
+
+			out := suite.signCmd(tc.cmd, args...)
+
+			proof, err := base64.StdEncoding.DecodeString(out)
+			suite.Require().NoError(err)
+
+			clientState := suite.clientState(sequence)
+			err = tc.verify(clientState, proof)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sequence+1, clientState.Sequence)
+
+			// the proof is bound to the diversifier of the solo machine
+			args[len(args)-1] = "--diversifier=other"
+			out = suite.signCmd(tc.cmd, args...)
+
+			proof, err = base64.StdEncoding.DecodeString(out)
+			suite.Require().NoError(err)
+
+			err = tc.verify(suite.clientState(sequence), proof)
+			suite.Require().Error(err)
+		})
+	}
+}
+
+func (suite *TxTestSuite) TestMisbehaviour() {
+	dir := suite.T().TempDir()
+
+	// signatureAndDataFile signs a packet commitment with the --signature-and-data flag and
+	// writes the output to a file
+	signatureAndDataFile := func(name string, commitment []byte) string {
+		out := suite.signCmd(
+			cli.NewSignPacketCommitmentCmd(), fmt.Sprint(sequence), portID, channelID, fmt.Sprint(packetSequence),
+			hex.EncodeToString(commitment), fmt.Sprintf("--diversifier=%s", diversifier), "--signature-and-data",
+		)
+
+		fileName := filepath.Join(dir, name)
+		err := os.WriteFile(fileName, []byte(out), 0o600)
+		suite.Require().NoError(err)
+
+		return fileName
+	}
+
+	fileOne := signatureAndDataFile("signature_one.json", []byte("commitment one"))
+	fileTwo := signatureAndDataFile("signature_two.json", []byte("commitment two"))
+
+	out, err := suite.execCmd(cli.NewMisbehaviourCmd(), exported.Solomachine, fmt.Sprint(sequence), fileOne, fileTwo)
+	suite.Require().NoError(err)
+
+	var misbehaviour exported.Misbehaviour
+	err = suite.cdc.UnmarshalInterfaceJSON([]byte(out), &misbehaviour)
+	suite.Require().NoError(err)
+
+	clientState := suite.clientState(sequence)
+	newClientState, err := clientState.CheckMisbehaviourAndUpdateState(suite.chainA.GetContext(), suite.cdc, suite.store, misbehaviour)
+	suite.Require().NoError(err)
+	suite.Require().True(newClientState.(*types.ClientState).IsFrozen)
+
+	// signatures over the same data are not misbehaviour
+	_, err = suite.execCmd(cli.NewMisbehaviourCmd(), exported.Solomachine, fmt.Sprint(sequence), fileOne, fileOne)
+	suite.Require().Error(err)
+}
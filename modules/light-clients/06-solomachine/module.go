@@ -1,6 +1,9 @@
 package solomachine
 
 import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/client/cli"
 	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
 )
 
@@ -8,3 +11,8 @@ import (
 func Name() string {
 	return types.SubModuleName
 }
+
+// GetTxCmd returns the root tx command for the solo machine offline signing subcommands.
+func GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
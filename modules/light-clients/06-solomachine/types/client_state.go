@@ -248,6 +248,82 @@ func (cs *ClientState) VerifyChannelState(
 	return nil
 }
 
+// VerifyChannelUpgrade verifies a proof of the upgrade proposed by the specified
+// channel end, under the specified port, stored on the target machine.
+func (cs *ClientState) VerifyChannelUpgrade(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	upgrade exported.UpgradeI,
+) error {
+	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	upgradePath := commitmenttypes.NewMerklePath(host.ChannelUpgradePath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, upgradePath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := ChannelUpgradeSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, upgrade)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyChannelUpgradeError verifies a proof of the upgrade error receipt of the
+// specified channel end, under the specified port, stored on the target machine.
+func (cs *ClientState) VerifyChannelUpgradeError(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	errorReceipt exported.ErrorReceiptI,
+) error {
+	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	errorPath := commitmenttypes.NewMerklePath(host.ChannelUpgradeErrorPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, errorPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := ChannelUpgradeErrorSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, errorReceipt)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
 // the specified port, specified channel, and specified sequence.
 func (cs *ClientState) VerifyPacketCommitment(
@@ -290,6 +366,24 @@ func (cs *ClientState) VerifyPacketCommitment(
 	return nil
 }
 
+// VerifyPacketCommitments returns an error. A solo machine signature commits to a
+// single path and value, thus batch proofs of packet commitments are not supported.
+func (cs *ClientState) VerifyPacketCommitments(
+	_ sdk.Context,
+	_ sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	_,
+	_ string,
+	_ map[uint64][]byte,
+) error {
+	return sdkerrors.Wrap(ErrInvalidProof, "batch proofs of packet commitments are not supported by solo machine clients")
+}
+
 // VerifyPacketAcknowledgement verifies a proof of an incoming packet
 // acknowledgement at the specified port, specified channel, and specified sequence.
 func (cs *ClientState) VerifyPacketAcknowledgement(
@@ -332,6 +426,48 @@ func (cs *ClientState) VerifyPacketAcknowledgement(
 	return nil
 }
 
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (cs *ClientState) VerifyPacketReceipt(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	packetSequence uint64,
+	receipt []byte,
+) error {
+	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	receiptPath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, packetSequence))
+	path, err := commitmenttypes.ApplyPrefix(prefix, receiptPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := PacketReceiptSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, receipt)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
 // incoming packet receipt at the specified port, specified channel, and
 // specified sequence.
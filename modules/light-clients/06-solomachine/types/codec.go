@@ -109,6 +109,14 @@ func UnmarshalDataByType(cdc codec.BinaryCodec, dataType DataType, data []byte)
 
 		return ackData, nil
 
+	case PACKETRECEIPT:
+		receiptData := &PacketReceiptData{}
+		if err := cdc.Unmarshal(data, receiptData); err != nil {
+			return nil, err
+		}
+
+		return receiptData, nil
+
 	case PACKETRECEIPTABSENCE:
 		receiptAbsenceData := &PacketReceiptAbsenceData{}
 		if err := cdc.Unmarshal(data, receiptAbsenceData); err != nil {
@@ -125,6 +133,22 @@ func UnmarshalDataByType(cdc codec.BinaryCodec, dataType DataType, data []byte)
 
 		return nextSeqRecvData, nil
 
+	case CHANNELUPGRADE:
+		upgradeData := &ChannelUpgradeData{}
+		if err := cdc.Unmarshal(data, upgradeData); err != nil {
+			return nil, err
+		}
+
+		return upgradeData, nil
+
+	case CHANNELUPGRADEERROR:
+		upgradeErrorData := &ChannelUpgradeErrorData{}
+		if err := cdc.Unmarshal(data, upgradeErrorData); err != nil {
+			return nil, err
+		}
+
+		return upgradeErrorData, nil
+
 	default:
 		return nil, sdkerrors.Wrapf(ErrInvalidDataType, "unsupported data type %T", dataType)
 	}
@@ -51,19 +51,28 @@ const (
 	NEXTSEQUENCERECV DataType = 8
 	// Data type for header verification
 	HEADER DataType = 9
+	// Data type for channel upgrade verification
+	CHANNELUPGRADE DataType = 10
+	// Data type for channel upgrade error receipt verification
+	CHANNELUPGRADEERROR DataType = 11
+	// Data type for packet receipt verification
+	PACKETRECEIPT DataType = 12
 )
 
 var DataType_name = map[int32]string{
-	0: "DATA_TYPE_UNINITIALIZED_UNSPECIFIED",
-	1: "DATA_TYPE_CLIENT_STATE",
-	2: "DATA_TYPE_CONSENSUS_STATE",
-	3: "DATA_TYPE_CONNECTION_STATE",
-	4: "DATA_TYPE_CHANNEL_STATE",
-	5: "DATA_TYPE_PACKET_COMMITMENT",
-	6: "DATA_TYPE_PACKET_ACKNOWLEDGEMENT",
-	7: "DATA_TYPE_PACKET_RECEIPT_ABSENCE",
-	8: "DATA_TYPE_NEXT_SEQUENCE_RECV",
-	9: "DATA_TYPE_HEADER",
+	0:  "DATA_TYPE_UNINITIALIZED_UNSPECIFIED",
+	1:  "DATA_TYPE_CLIENT_STATE",
+	2:  "DATA_TYPE_CONSENSUS_STATE",
+	3:  "DATA_TYPE_CONNECTION_STATE",
+	4:  "DATA_TYPE_CHANNEL_STATE",
+	5:  "DATA_TYPE_PACKET_COMMITMENT",
+	6:  "DATA_TYPE_PACKET_ACKNOWLEDGEMENT",
+	7:  "DATA_TYPE_PACKET_RECEIPT_ABSENCE",
+	8:  "DATA_TYPE_NEXT_SEQUENCE_RECV",
+	9:  "DATA_TYPE_HEADER",
+	10: "DATA_TYPE_CHANNEL_UPGRADE",
+	11: "DATA_TYPE_CHANNEL_UPGRADE_ERROR",
+	12: "DATA_TYPE_PACKET_RECEIPT",
 }
 
 var DataType_value = map[string]int32{
@@ -77,6 +86,9 @@ var DataType_value = map[string]int32{
 	"DATA_TYPE_PACKET_RECEIPT_ABSENCE":    7,
 	"DATA_TYPE_NEXT_SEQUENCE_RECV":        8,
 	"DATA_TYPE_HEADER":                    9,
+	"DATA_TYPE_CHANNEL_UPGRADE":           10,
+	"DATA_TYPE_CHANNEL_UPGRADE_ERROR":     11,
+	"DATA_TYPE_PACKET_RECEIPT":            12,
 }
 
 func (x DataType) String() string {
@@ -590,6 +602,86 @@ func (m *ChannelStateData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChannelStateData proto.InternalMessageInfo
 
+// ChannelUpgradeData returns the SignBytes data for channel upgrade
+// verification.
+type ChannelUpgradeData struct {
+	Path    []byte          `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Upgrade *types2.Upgrade `protobuf:"bytes,2,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+}
+
+func (m *ChannelUpgradeData) Reset()         { *m = ChannelUpgradeData{} }
+func (m *ChannelUpgradeData) String() string { return proto.CompactTextString(m) }
+func (*ChannelUpgradeData) ProtoMessage()    {}
+func (*ChannelUpgradeData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{12}
+}
+func (m *ChannelUpgradeData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelUpgradeData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelUpgradeData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelUpgradeData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelUpgradeData.Merge(m, src)
+}
+func (m *ChannelUpgradeData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelUpgradeData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelUpgradeData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelUpgradeData proto.InternalMessageInfo
+
+// ChannelUpgradeErrorData returns the SignBytes data for channel upgrade error
+// receipt verification.
+type ChannelUpgradeErrorData struct {
+	Path         []byte               `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	ErrorReceipt *types2.ErrorReceipt `protobuf:"bytes,2,opt,name=error_receipt,json=errorReceipt,proto3" json:"error_receipt,omitempty" yaml:"error_receipt"`
+}
+
+func (m *ChannelUpgradeErrorData) Reset()         { *m = ChannelUpgradeErrorData{} }
+func (m *ChannelUpgradeErrorData) String() string { return proto.CompactTextString(m) }
+func (*ChannelUpgradeErrorData) ProtoMessage()    {}
+func (*ChannelUpgradeErrorData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{13}
+}
+func (m *ChannelUpgradeErrorData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelUpgradeErrorData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelUpgradeErrorData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelUpgradeErrorData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelUpgradeErrorData.Merge(m, src)
+}
+func (m *ChannelUpgradeErrorData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelUpgradeErrorData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelUpgradeErrorData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelUpgradeErrorData proto.InternalMessageInfo
+
 // PacketCommitmentData returns the SignBytes data for packet commitment
 // verification.
 type PacketCommitmentData struct {
@@ -601,7 +693,7 @@ func (m *PacketCommitmentData) Reset()         { *m = PacketCommitmentData{} }
 func (m *PacketCommitmentData) String() string { return proto.CompactTextString(m) }
 func (*PacketCommitmentData) ProtoMessage()    {}
 func (*PacketCommitmentData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{12}
+	return fileDescriptor_141333b361aae010, []int{14}
 }
 func (m *PacketCommitmentData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +747,7 @@ func (m *PacketAcknowledgementData) Reset()         { *m = PacketAcknowledgement
 func (m *PacketAcknowledgementData) String() string { return proto.CompactTextString(m) }
 func (*PacketAcknowledgementData) ProtoMessage()    {}
 func (*PacketAcknowledgementData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{13}
+	return fileDescriptor_141333b361aae010, []int{15}
 }
 func (m *PacketAcknowledgementData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -698,6 +790,60 @@ func (m *PacketAcknowledgementData) GetAcknowledgement() []byte {
 	return nil
 }
 
+// PacketReceiptData returns the SignBytes data for packet receipt
+// verification.
+type PacketReceiptData struct {
+	Path    []byte `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (m *PacketReceiptData) Reset()         { *m = PacketReceiptData{} }
+func (m *PacketReceiptData) String() string { return proto.CompactTextString(m) }
+func (*PacketReceiptData) ProtoMessage()    {}
+func (*PacketReceiptData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{16}
+}
+func (m *PacketReceiptData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketReceiptData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketReceiptData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketReceiptData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketReceiptData.Merge(m, src)
+}
+func (m *PacketReceiptData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketReceiptData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketReceiptData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketReceiptData proto.InternalMessageInfo
+
+func (m *PacketReceiptData) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *PacketReceiptData) GetReceipt() []byte {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
 // PacketReceiptAbsenceData returns the SignBytes data for
 // packet receipt absence verification.
 type PacketReceiptAbsenceData struct {
@@ -708,7 +854,7 @@ func (m *PacketReceiptAbsenceData) Reset()         { *m = PacketReceiptAbsenceDa
 func (m *PacketReceiptAbsenceData) String() string { return proto.CompactTextString(m) }
 func (*PacketReceiptAbsenceData) ProtoMessage()    {}
 func (*PacketReceiptAbsenceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{14}
+	return fileDescriptor_141333b361aae010, []int{17}
 }
 func (m *PacketReceiptAbsenceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +901,7 @@ func (m *NextSequenceRecvData) Reset()         { *m = NextSequenceRecvData{} }
 func (m *NextSequenceRecvData) String() string { return proto.CompactTextString(m) }
 func (*NextSequenceRecvData) ProtoMessage()    {}
 func (*NextSequenceRecvData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{15}
+	return fileDescriptor_141333b361aae010, []int{18}
 }
 func (m *NextSequenceRecvData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,8 +958,11 @@ func init() {
 	proto.RegisterType((*ConsensusStateData)(nil), "ibc.lightclients.solomachine.v2.ConsensusStateData")
 	proto.RegisterType((*ConnectionStateData)(nil), "ibc.lightclients.solomachine.v2.ConnectionStateData")
 	proto.RegisterType((*ChannelStateData)(nil), "ibc.lightclients.solomachine.v2.ChannelStateData")
+	proto.RegisterType((*ChannelUpgradeData)(nil), "ibc.lightclients.solomachine.v2.ChannelUpgradeData")
+	proto.RegisterType((*ChannelUpgradeErrorData)(nil), "ibc.lightclients.solomachine.v2.ChannelUpgradeErrorData")
 	proto.RegisterType((*PacketCommitmentData)(nil), "ibc.lightclients.solomachine.v2.PacketCommitmentData")
 	proto.RegisterType((*PacketAcknowledgementData)(nil), "ibc.lightclients.solomachine.v2.PacketAcknowledgementData")
+	proto.RegisterType((*PacketReceiptData)(nil), "ibc.lightclients.solomachine.v2.PacketReceiptData")
 	proto.RegisterType((*PacketReceiptAbsenceData)(nil), "ibc.lightclients.solomachine.v2.PacketReceiptAbsenceData")
 	proto.RegisterType((*NextSequenceRecvData)(nil), "ibc.lightclients.solomachine.v2.NextSequenceRecvData")
 }
@@ -823,93 +972,102 @@ func init() {
 }
 
 var fileDescriptor_141333b361aae010 = []byte{
-	// 1370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x8f, 0xdb, 0x44,
-	0x10, 0x3f, 0xa7, 0xe9, 0xf5, 0x32, 0xb9, 0xde, 0x05, 0x37, 0x6d, 0x73, 0x6e, 0x95, 0x18, 0x23,
-	0xca, 0x81, 0x68, 0xc2, 0x5d, 0x45, 0x85, 0x2a, 0x04, 0x38, 0x8e, 0x4b, 0xd3, 0xde, 0xf9, 0x82,
-	0xe3, 0x03, 0x5a, 0x21, 0x19, 0xc7, 0xd9, 0x4b, 0xac, 0x26, 0xde, 0x34, 0x76, 0x92, 0x06, 0x09,
-	0x09, 0xf1, 0x54, 0x22, 0x1e, 0xf8, 0x02, 0x91, 0x10, 0x88, 0xcf, 0xc1, 0x1b, 0xf0, 0xd8, 0x47,
-	0x9e, 0x02, 0x6a, 0xbf, 0x41, 0x3e, 0x01, 0xb2, 0x77, 0x13, 0xdb, 0xb9, 0x5e, 0x4e, 0xfc, 0x7b,
-	0xdb, 0x9d, 0xdf, 0xcc, 0x6f, 0x66, 0x67, 0xc6, 0xb3, 0x6b, 0xd8, 0xb1, 0x6a, 0x66, 0xa1, 0x65,
-	0x35, 0x9a, 0xae, 0xd9, 0xb2, 0x90, 0xed, 0x3a, 0x05, 0x07, 0xb7, 0x70, 0xdb, 0x30, 0x9b, 0x96,
-	0x8d, 0x0a, 0xfd, 0xdd, 0xf0, 0x36, 0xdf, 0xe9, 0x62, 0x17, 0xb3, 0x39, 0xab, 0x66, 0xe6, 0xc3,
-	0x26, 0xf9, 0xb0, 0x4e, 0x7f, 0x97, 0x7b, 0xcd, 0xe3, 0x34, 0x71, 0x17, 0x15, 0x4c, 0x6c, 0xdb,
-	0xc8, 0x74, 0x2d, 0x6c, 0x17, 0xfa, 0x3b, 0xa1, 0x1d, 0x61, 0xe2, 0x5e, 0x0e, 0x14, 0x9b, 0x86,
-	0x6d, 0xa3, 0x96, 0xaf, 0x45, 0x96, 0x54, 0x25, 0xdd, 0xc0, 0x0d, 0xec, 0x2f, 0x0b, 0xde, 0x8a,
-	0x4a, 0xb7, 0x1a, 0x18, 0x37, 0x5a, 0xa8, 0xe0, 0xef, 0x6a, 0xbd, 0xa3, 0x82, 0x61, 0x0f, 0x09,
-	0x24, 0xfc, 0x1c, 0x83, 0xa4, 0xe4, 0xc7, 0x55, 0x75, 0x0d, 0x17, 0xb1, 0x1c, 0xac, 0x39, 0xe8,
-	0x51, 0x0f, 0xd9, 0x26, 0xca, 0x30, 0x3c, 0xb3, 0x1d, 0x57, 0xe7, 0x7b, 0x76, 0x07, 0x12, 0x96,
-	0xa3, 0x1f, 0x75, 0xf1, 0x17, 0xc8, 0xce, 0xc4, 0x78, 0x66, 0x7b, 0xad, 0x98, 0x9e, 0x4e, 0x72,
-	0xa9, 0xa1, 0xd1, 0x6e, 0xdd, 0x12, 0xe6, 0x90, 0xa0, 0xae, 0x59, 0xce, 0x6d, 0x7f, 0xc9, 0xba,
-	0xb0, 0x69, 0x62, 0xdb, 0x41, 0xb6, 0xd3, 0x73, 0x74, 0xc7, 0xf3, 0x90, 0x39, 0xc3, 0x33, 0xdb,
-	0xc9, 0xdd, 0x42, 0xfe, 0x94, 0xb4, 0xe4, 0xa5, 0x99, 0x9d, 0x1f, 0x58, 0x91, 0x9b, 0x4e, 0x72,
-	0x97, 0x88, 0xa7, 0x05, 0x46, 0x41, 0xdd, 0x30, 0x23, 0xba, 0x2c, 0x82, 0x2b, 0x46, 0xab, 0x85,
-	0x07, 0x7a, 0xaf, 0x53, 0x37, 0x5c, 0xa4, 0x1b, 0x47, 0x2e, 0xea, 0xea, 0x9d, 0x2e, 0xee, 0x60,
-	0xc7, 0x68, 0x65, 0xe2, 0x7e, 0xe8, 0xd7, 0xa6, 0x93, 0x9c, 0x40, 0x08, 0x97, 0x28, 0x0b, 0x6a,
-	0xc6, 0x47, 0x0f, 0x7d, 0x50, 0xf4, 0xb0, 0x0a, 0x85, 0x6e, 0xc5, 0x9f, 0x7c, 0x9f, 0x5b, 0x11,
-	0x7e, 0x60, 0x60, 0x23, 0x1a, 0x2b, 0x7b, 0x17, 0xa0, 0xd3, 0xab, 0xb5, 0x2c, 0x53, 0x7f, 0x88,
-	0x86, 0x7e, 0x1a, 0x93, 0xbb, 0xe9, 0x3c, 0x29, 0x42, 0x7e, 0x56, 0x84, 0xbc, 0x68, 0x0f, 0x8b,
-	0x17, 0xa7, 0x93, 0xdc, 0x4b, 0x24, 0x88, 0xc0, 0x42, 0x50, 0x13, 0x64, 0x73, 0x0f, 0x0d, 0x59,
-	0x1e, 0x92, 0x75, 0xab, 0x8f, 0xba, 0x8e, 0x75, 0x64, 0xa1, 0xae, 0x9f, 0xf6, 0x84, 0x1a, 0x16,
-	0xb1, 0x57, 0x21, 0xe1, 0x5a, 0x6d, 0xe4, 0xb8, 0x46, 0xbb, 0xe3, 0x67, 0x37, 0xae, 0x06, 0x02,
-	0x1a, 0xe4, 0xd7, 0x31, 0x58, 0xbd, 0x83, 0x8c, 0x3a, 0xea, 0x2e, 0xad, 0x70, 0x84, 0x2a, 0xb6,
-	0x40, 0xe5, 0xa1, 0x8e, 0xd5, 0xb0, 0x0d, 0xb7, 0xd7, 0x25, 0x65, 0x5c, 0x57, 0x03, 0x01, 0x7b,
-	0x08, 0x1b, 0x36, 0x1a, 0xe8, 0xa1, 0x83, 0xc7, 0x97, 0x1c, 0x7c, 0x6b, 0x3a, 0xc9, 0x5d, 0x24,
-	0x07, 0x8f, 0x5a, 0x09, 0xea, 0xba, 0x8d, 0x06, 0x95, 0xf9, 0xf9, 0x25, 0xd8, 0xf4, 0x14, 0xc2,
-	0x39, 0x38, 0xeb, 0xe5, 0x20, 0xdc, 0x10, 0x0b, 0x0a, 0x82, 0xea, 0x45, 0x52, 0x0a, 0x04, 0x34,
-	0x09, 0xbf, 0xc6, 0x60, 0x7d, 0xdf, 0x72, 0x6a, 0xa8, 0x69, 0xf4, 0x2d, 0xdc, 0xeb, 0x7a, 0x0d,
-	0x4d, 0x9a, 0x4f, 0xb7, 0xea, 0x7e, 0x2e, 0x12, 0xe1, 0x86, 0x9e, 0x43, 0x82, 0xba, 0x46, 0xd6,
-	0xe5, 0x7a, 0x24, 0x7b, 0xb1, 0x85, 0xec, 0x75, 0xe0, 0xfc, 0x3c, 0x1d, 0x3a, 0xb6, 0x67, 0xad,
-	0xbe, 0x73, 0x6a, 0xab, 0x57, 0x67, 0x56, 0xa2, 0x5d, 0x2f, 0x19, 0xae, 0x51, 0xcc, 0x4c, 0x27,
-	0xb9, 0x34, 0x89, 0x22, 0xc2, 0x28, 0xa8, 0xeb, 0xf3, 0xfd, 0x81, 0xbd, 0xe0, 0xd1, 0x1d, 0x60,
-	0x9a, 0xf2, 0xff, 0xca, 0xa3, 0x3b, 0xc0, 0x61, 0x8f, 0xda, 0x00, 0xd3, 0x4c, 0xfe, 0xc2, 0x40,
-	0x6a, 0x91, 0x22, 0xda, 0x1e, 0xcc, 0x62, 0x7b, 0x7c, 0x06, 0x89, 0xba, 0xe1, 0x1a, 0xba, 0x3b,
-	0xec, 0x90, 0xcc, 0x6d, 0xec, 0xbe, 0x7e, 0x6a, 0x98, 0x1e, 0xaf, 0x36, 0xec, 0xa0, 0x70, 0x59,
-	0xe6, 0x2c, 0x82, 0xba, 0x56, 0xa7, 0x38, 0xcb, 0x42, 0xdc, 0x5b, 0xd3, 0xae, 0xf4, 0xd7, 0xd1,
-	0x66, 0x8e, 0xbf, 0xf8, 0xbb, 0xf8, 0x8a, 0x81, 0x8c, 0x36, 0x93, 0xa1, 0xfa, 0xfc, 0x4c, 0xfe,
-	0x81, 0x3e, 0x80, 0x8d, 0x20, 0x17, 0x3e, 0xbd, 0x7f, 0xaa, 0x70, 0xef, 0x46, 0x71, 0x41, 0x0d,
-	0xca, 0x51, 0x3a, 0x16, 0x42, 0xec, 0xc5, 0x21, 0xfc, 0xc1, 0x40, 0xc2, 0xf3, 0x5b, 0x1c, 0xba,
-	0xc8, 0xf9, 0x17, 0x5f, 0xe7, 0xc2, 0xa0, 0x38, 0x73, 0x7c, 0x50, 0x44, 0x4a, 0x10, 0xff, 0xbf,
-	0x4a, 0x70, 0x36, 0x28, 0x01, 0x3d, 0xe1, 0x4f, 0x0c, 0x00, 0x19, 0x3e, 0x7e, 0x52, 0xf6, 0x20,
-	0x49, 0x3f, 0xf9, 0x53, 0xc7, 0xe3, 0xa5, 0xe9, 0x24, 0xc7, 0x46, 0xa6, 0x04, 0x9d, 0x8f, 0x64,
-	0x44, 0x9c, 0x30, 0x1f, 0x62, 0xff, 0x70, 0x3e, 0x7c, 0x09, 0x9b, 0xa1, 0xab, 0xd0, 0x8f, 0x95,
-	0x85, 0x78, 0xc7, 0x70, 0x9b, 0xb4, 0x9d, 0xfd, 0x35, 0x5b, 0x81, 0x75, 0x3a, 0x1a, 0xc8, 0x85,
-	0x16, 0x5b, 0x72, 0x80, 0xcb, 0xd3, 0x49, 0xee, 0x42, 0x64, 0x9c, 0xd0, 0x2b, 0x2b, 0x69, 0x06,
-	0x9e, 0xa8, 0xfb, 0x6f, 0x18, 0x60, 0xa3, 0x17, 0xc9, 0x89, 0x21, 0xdc, 0x3f, 0x7e, 0xad, 0x2e,
-	0x8b, 0xe2, 0x6f, 0xdc, 0x9d, 0x34, 0x96, 0x3e, 0x5c, 0x90, 0xe6, 0xcf, 0x8f, 0xe5, 0xb1, 0xc8,
-	0x00, 0xc1, 0x4b, 0x85, 0x86, 0xf1, 0xaa, 0xdf, 0x56, 0xde, 0x53, 0x25, 0x1f, 0x7a, 0xc5, 0xf4,
-	0x77, 0xf2, 0x01, 0xa9, 0x6c, 0xd7, 0xd5, 0x90, 0x21, 0xf5, 0x5b, 0x87, 0x94, 0x44, 0x1e, 0x34,
-	0xcb, 0x9d, 0xde, 0x84, 0x73, 0xf4, 0xe1, 0x43, 0x3d, 0x5e, 0x0d, 0x79, 0xa4, 0x2f, 0x22, 0xcf,
-	0x1d, 0x59, 0xaa, 0x33, 0x65, 0xea, 0xe5, 0x2e, 0xa4, 0x2b, 0x86, 0xf9, 0x10, 0xb9, 0x12, 0x6e,
-	0xb7, 0x2d, 0xb7, 0x8d, 0x6c, 0xf7, 0x44, 0x4f, 0x59, 0xef, 0x78, 0x33, 0x2d, 0xdf, 0xd9, 0xba,
-	0x1a, 0x92, 0x08, 0xf7, 0x61, 0x8b, 0x70, 0x89, 0xe6, 0x43, 0x1b, 0x0f, 0x5a, 0xa8, 0xde, 0x40,
-	0x4b, 0x09, 0xb7, 0x61, 0xd3, 0x88, 0xaa, 0x52, 0xd6, 0x45, 0xb1, 0x90, 0x87, 0x0c, 0xa1, 0x56,
-	0x91, 0x89, 0xac, 0x8e, 0x2b, 0xd6, 0x1c, 0x6f, 0x0e, 0x9c, 0xc4, 0x2c, 0x34, 0x21, 0xad, 0xa0,
-	0xc7, 0x6e, 0x95, 0xce, 0x0b, 0x15, 0x99, 0xfd, 0x13, 0xa3, 0x78, 0x17, 0xce, 0xdb, 0xe8, 0xb1,
-	0xab, 0x3b, 0xe8, 0x91, 0xde, 0x45, 0x66, 0x9f, 0xcc, 0x93, 0xf0, 0x35, 0x10, 0x81, 0x05, 0x35,
-	0x69, 0x13, 0x6a, 0x8f, 0xf5, 0x8d, 0x6f, 0xe3, 0xb0, 0x36, 0x1b, 0x0c, 0xec, 0x3b, 0xf0, 0x4a,
-	0x49, 0xd4, 0x44, 0x5d, 0xbb, 0x5f, 0x91, 0xf5, 0x43, 0xa5, 0xac, 0x94, 0xb5, 0xb2, 0xb8, 0x57,
-	0x7e, 0x20, 0x97, 0xf4, 0x43, 0xa5, 0x5a, 0x91, 0xa5, 0xf2, 0xed, 0xb2, 0x5c, 0x4a, 0xad, 0x70,
-	0x9b, 0xa3, 0x31, 0x9f, 0x0c, 0x89, 0xd8, 0x6b, 0x70, 0x29, 0xb0, 0x94, 0xf6, 0xca, 0xb2, 0xa2,
-	0xe9, 0x55, 0x4d, 0xd4, 0xe4, 0x14, 0xc3, 0xc1, 0x68, 0xcc, 0xaf, 0x12, 0x19, 0xfb, 0x26, 0x6c,
-	0x85, 0xf4, 0x0e, 0x94, 0xaa, 0xac, 0x54, 0x0f, 0xab, 0x54, 0x35, 0xc6, 0x9d, 0x1f, 0x8d, 0xf9,
-	0xc4, 0x5c, 0xcc, 0xe6, 0x81, 0x8b, 0x68, 0x2b, 0xb2, 0xa4, 0x95, 0x0f, 0x14, 0xaa, 0x7e, 0x86,
-	0xdb, 0x18, 0x8d, 0x79, 0x08, 0xe4, 0xec, 0x36, 0x5c, 0x0e, 0xe9, 0xdf, 0x11, 0x15, 0x45, 0xde,
-	0xa3, 0xca, 0x71, 0x2e, 0x39, 0x1a, 0xf3, 0xe7, 0xa8, 0x90, 0x7d, 0x1b, 0xae, 0x04, 0x9a, 0x15,
-	0x51, 0xba, 0x27, 0x6b, 0xba, 0x74, 0xb0, 0xbf, 0x5f, 0xd6, 0xf6, 0x65, 0x45, 0x4b, 0x9d, 0xe5,
-	0xd2, 0xa3, 0x31, 0x9f, 0x22, 0x40, 0x20, 0x67, 0xdf, 0x07, 0xfe, 0x98, 0x99, 0x28, 0xdd, 0x53,
-	0x0e, 0x3e, 0xd9, 0x93, 0x4b, 0x1f, 0xca, 0xbe, 0xed, 0x2a, 0xb7, 0x35, 0x1a, 0xf3, 0x17, 0x09,
-	0xba, 0x00, 0xb2, 0xef, 0xbd, 0x80, 0x40, 0x95, 0x25, 0xb9, 0x5c, 0xd1, 0x74, 0xb1, 0x58, 0x95,
-	0x15, 0x49, 0x4e, 0x9d, 0xe3, 0x32, 0xa3, 0x31, 0x9f, 0x26, 0x28, 0x05, 0x29, 0xc6, 0xde, 0x84,
-	0xab, 0x81, 0xbd, 0x22, 0x7f, 0xaa, 0xe9, 0x55, 0xf9, 0xa3, 0x43, 0x0f, 0xf2, 0x68, 0x3e, 0x4e,
-	0xad, 0x91, 0xc0, 0x3d, 0x64, 0x06, 0x78, 0x72, 0x96, 0x87, 0x54, 0x60, 0x77, 0x47, 0x16, 0x4b,
-	0xb2, 0x9a, 0x4a, 0x90, 0xca, 0x90, 0x1d, 0x17, 0x7f, 0xf2, 0x63, 0x76, 0xa5, 0xf8, 0xf9, 0x6f,
-	0xcf, 0xb2, 0xcc, 0xd3, 0x67, 0x59, 0xe6, 0xcf, 0x67, 0x59, 0xe6, 0xbb, 0xe7, 0xd9, 0x95, 0xa7,
-	0xcf, 0xb3, 0x2b, 0xbf, 0x3f, 0xcf, 0xae, 0x3c, 0xb8, 0xdd, 0xb0, 0xdc, 0x66, 0xaf, 0x96, 0x37,
-	0x71, 0xbb, 0x60, 0x62, 0xa7, 0x8d, 0x9d, 0x82, 0x55, 0x33, 0xaf, 0x37, 0x70, 0xa1, 0x7f, 0xa3,
-	0xd0, 0xc6, 0xf5, 0x5e, 0x0b, 0x39, 0xe4, 0x7f, 0xea, 0xfa, 0xec, 0x87, 0xea, 0xad, 0x9b, 0xd7,
-	0xc3, 0xff, 0x54, 0xde, 0x35, 0xe3, 0xd4, 0x56, 0xfd, 0x79, 0x76, 0xe3, 0xaf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x5d, 0xd4, 0x6c, 0xfb, 0x80, 0x0d, 0x00, 0x00,
+	// 1506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x8f, 0xda, 0xd6,
+	0x16, 0x1f, 0x13, 0xe6, 0x0f, 0x07, 0x66, 0x86, 0xdc, 0x90, 0x0c, 0xe3, 0x44, 0xe0, 0xf8, 0xe9,
+	0xe5, 0xcd, 0x7b, 0x7a, 0x81, 0x37, 0x13, 0xbd, 0xa8, 0x8a, 0xa2, 0xb6, 0x1e, 0x70, 0x12, 0x92,
+	0x19, 0x86, 0x1a, 0x68, 0x9b, 0xa8, 0x92, 0x63, 0xec, 0x3b, 0x8c, 0x15, 0xb0, 0x89, 0x6d, 0x98,
+	0x50, 0xa9, 0x52, 0xd5, 0x55, 0xca, 0xaa, 0x9b, 0x2e, 0x91, 0xaa, 0x56, 0xdd, 0xf6, 0x2b, 0x74,
+	0xd7, 0x76, 0x99, 0x65, 0x57, 0xb4, 0x4a, 0xbe, 0x01, 0x9f, 0xa0, 0xb2, 0xef, 0x05, 0xdb, 0x24,
+	0x30, 0xea, 0xbf, 0xdd, 0xbd, 0xe7, 0x77, 0xce, 0xef, 0x9c, 0x7b, 0xce, 0xf1, 0xb9, 0xd7, 0xb0,
+	0xab, 0x37, 0xd4, 0x7c, 0x4b, 0x6f, 0x9e, 0x38, 0x6a, 0x4b, 0xc7, 0x86, 0x63, 0xe7, 0x6d, 0xb3,
+	0x65, 0xb6, 0x15, 0xf5, 0x44, 0x37, 0x70, 0xbe, 0xb7, 0x17, 0xdc, 0xe6, 0x3a, 0x96, 0xe9, 0x98,
+	0x28, 0xab, 0x37, 0xd4, 0x5c, 0xd0, 0x24, 0x17, 0xd4, 0xe9, 0xed, 0xb1, 0xff, 0x72, 0x39, 0x55,
+	0xd3, 0xc2, 0x79, 0xd5, 0x34, 0x0c, 0xac, 0x3a, 0xba, 0x69, 0xe4, 0x7b, 0xbb, 0x81, 0x1d, 0x61,
+	0x62, 0xaf, 0xfa, 0x8a, 0x27, 0x8a, 0x61, 0xe0, 0x96, 0xa7, 0x45, 0x96, 0x54, 0x25, 0xd5, 0x34,
+	0x9b, 0xa6, 0xb7, 0xcc, 0xbb, 0x2b, 0x2a, 0xdd, 0x6e, 0x9a, 0x66, 0xb3, 0x85, 0xf3, 0xde, 0xae,
+	0xd1, 0x3d, 0xce, 0x2b, 0x46, 0x9f, 0x40, 0xfc, 0xf7, 0x11, 0x88, 0x17, 0xbc, 0xb8, 0xaa, 0x8e,
+	0xe2, 0x60, 0xc4, 0xc2, 0x9a, 0x8d, 0x9f, 0x76, 0xb1, 0xa1, 0xe2, 0x34, 0xc3, 0x31, 0x3b, 0x51,
+	0x69, 0xba, 0x47, 0xbb, 0x10, 0xd3, 0x6d, 0xf9, 0xd8, 0x32, 0x3f, 0xc6, 0x46, 0x3a, 0xc2, 0x31,
+	0x3b, 0x6b, 0xfb, 0xa9, 0xf1, 0x28, 0x9b, 0xec, 0x2b, 0xed, 0xd6, 0x2d, 0x7e, 0x0a, 0xf1, 0xd2,
+	0x9a, 0x6e, 0xdf, 0xf1, 0x96, 0xc8, 0x81, 0x4d, 0xd5, 0x34, 0x6c, 0x6c, 0xd8, 0x5d, 0x5b, 0xb6,
+	0x5d, 0x0f, 0xe9, 0x73, 0x1c, 0xb3, 0x13, 0xdf, 0xcb, 0xe7, 0xce, 0x48, 0x4b, 0xae, 0x30, 0xb1,
+	0xf3, 0x02, 0xdb, 0x67, 0xc7, 0xa3, 0xec, 0x25, 0xe2, 0x69, 0x86, 0x91, 0x97, 0x36, 0xd4, 0x90,
+	0x2e, 0xc2, 0x70, 0x59, 0x69, 0xb5, 0xcc, 0x53, 0xb9, 0xdb, 0xd1, 0x14, 0x07, 0xcb, 0xca, 0xb1,
+	0x83, 0x2d, 0xb9, 0x63, 0x99, 0x1d, 0xd3, 0x56, 0x5a, 0xe9, 0xa8, 0x17, 0xfa, 0xb5, 0xf1, 0x28,
+	0xcb, 0x13, 0xc2, 0x05, 0xca, 0xbc, 0x94, 0xf6, 0xd0, 0xba, 0x07, 0x0a, 0x2e, 0x56, 0xa1, 0xd0,
+	0xad, 0xe8, 0xf3, 0xaf, 0xb2, 0x4b, 0xfc, 0xd7, 0x0c, 0x6c, 0x84, 0x63, 0x45, 0xf7, 0x01, 0x3a,
+	0xdd, 0x46, 0x4b, 0x57, 0xe5, 0x27, 0xb8, 0xef, 0xa5, 0x31, 0xbe, 0x97, 0xca, 0x91, 0x22, 0xe4,
+	0x26, 0x45, 0xc8, 0x09, 0x46, 0x7f, 0xff, 0xe2, 0x78, 0x94, 0x3d, 0x4f, 0x82, 0xf0, 0x2d, 0x78,
+	0x29, 0x46, 0x36, 0x0f, 0x70, 0x1f, 0x71, 0x10, 0xd7, 0xf4, 0x1e, 0xb6, 0x6c, 0xfd, 0x58, 0xc7,
+	0x96, 0x97, 0xf6, 0x98, 0x14, 0x14, 0xa1, 0x2b, 0x10, 0x73, 0xf4, 0x36, 0xb6, 0x1d, 0xa5, 0xdd,
+	0xf1, 0xb2, 0x1b, 0x95, 0x7c, 0x01, 0x0d, 0xf2, 0xb3, 0x08, 0xac, 0xdc, 0xc3, 0x8a, 0x86, 0xad,
+	0x85, 0x15, 0x0e, 0x51, 0x45, 0x66, 0xa8, 0x5c, 0xd4, 0xd6, 0x9b, 0x86, 0xe2, 0x74, 0x2d, 0x52,
+	0xc6, 0x84, 0xe4, 0x0b, 0x50, 0x1d, 0x36, 0x0c, 0x7c, 0x2a, 0x07, 0x0e, 0x1e, 0x5d, 0x70, 0xf0,
+	0xed, 0xf1, 0x28, 0x7b, 0x91, 0x1c, 0x3c, 0x6c, 0xc5, 0x4b, 0x09, 0x03, 0x9f, 0x56, 0xa6, 0xe7,
+	0x2f, 0xc0, 0xa6, 0xab, 0x10, 0xcc, 0xc1, 0xb2, 0x9b, 0x83, 0x60, 0x43, 0xcc, 0x28, 0xf0, 0x92,
+	0x1b, 0x49, 0xd1, 0x17, 0xd0, 0x24, 0xfc, 0x18, 0x81, 0xc4, 0xa1, 0x6e, 0x37, 0xf0, 0x89, 0xd2,
+	0xd3, 0xcd, 0xae, 0xe5, 0x36, 0x34, 0x69, 0x3e, 0x59, 0xd7, 0xbc, 0x5c, 0xc4, 0x82, 0x0d, 0x3d,
+	0x85, 0x78, 0x69, 0x8d, 0xac, 0x4b, 0x5a, 0x28, 0x7b, 0x91, 0x99, 0xec, 0x75, 0x60, 0x7d, 0x9a,
+	0x0e, 0xd9, 0x34, 0x26, 0xad, 0xbe, 0x7b, 0x66, 0xab, 0x57, 0x27, 0x56, 0x82, 0xa1, 0x15, 0x15,
+	0x47, 0xd9, 0x4f, 0x8f, 0x47, 0xd9, 0x14, 0x89, 0x22, 0xc4, 0xc8, 0x4b, 0x89, 0xe9, 0xfe, 0xc8,
+	0x98, 0xf1, 0xe8, 0x9c, 0x9a, 0xe9, 0xe8, 0x5f, 0xea, 0xd1, 0x39, 0x35, 0x83, 0x1e, 0x6b, 0xa7,
+	0x26, 0xcd, 0xe4, 0x0f, 0x0c, 0x24, 0x67, 0x29, 0xc2, 0xed, 0xc1, 0xcc, 0xb6, 0xc7, 0x47, 0x10,
+	0xd3, 0x14, 0x47, 0x91, 0x9d, 0x7e, 0x87, 0x64, 0x6e, 0x63, 0xef, 0xdf, 0x67, 0x86, 0xe9, 0xf2,
+	0xd6, 0xfa, 0x1d, 0x1c, 0x2c, 0xcb, 0x94, 0x85, 0x97, 0xd6, 0x34, 0x8a, 0x23, 0x04, 0x51, 0x77,
+	0x4d, 0xbb, 0x32, 0xaa, 0xd1, 0x78, 0xfc, 0x66, 0x8e, 0xbe, 0xf9, 0xbb, 0xf8, 0x94, 0x81, 0x74,
+	0x6d, 0x22, 0xc3, 0xda, 0xf4, 0x4c, 0xde, 0x81, 0xde, 0x85, 0x0d, 0x3f, 0x17, 0x1e, 0xbd, 0x77,
+	0xaa, 0x60, 0xef, 0x86, 0x71, 0x5e, 0x5a, 0xb7, 0x43, 0x0c, 0x0b, 0xbf, 0x27, 0x1a, 0xc2, 0x2f,
+	0x0c, 0xc4, 0x5c, 0xbf, 0xfb, 0x7d, 0x07, 0xdb, 0x7f, 0xe2, 0xeb, 0x9c, 0x19, 0x14, 0xe7, 0x5e,
+	0x1f, 0x14, 0xa1, 0x12, 0x44, 0xff, 0xae, 0x12, 0x2c, 0xfb, 0x25, 0xa0, 0x27, 0xfc, 0x96, 0x01,
+	0x20, 0xc3, 0xc7, 0x4b, 0xca, 0x01, 0xc4, 0xe9, 0x27, 0x7f, 0xe6, 0x78, 0xbc, 0x34, 0x1e, 0x65,
+	0x51, 0x68, 0x4a, 0xd0, 0xf9, 0x48, 0x46, 0xc4, 0x9c, 0xf9, 0x10, 0xf9, 0x83, 0xf3, 0xe1, 0x13,
+	0xd8, 0x0c, 0x5c, 0x85, 0x5e, 0xac, 0x08, 0xa2, 0x1d, 0xc5, 0x39, 0xa1, 0xed, 0xec, 0xad, 0x51,
+	0x05, 0x12, 0x74, 0x34, 0x90, 0x0b, 0x2d, 0xb2, 0xe0, 0x00, 0x5b, 0xe3, 0x51, 0xf6, 0x42, 0x68,
+	0x9c, 0xd0, 0x2b, 0x2b, 0xae, 0xfa, 0x9e, 0xa8, 0xfb, 0xcf, 0x19, 0x40, 0xe1, 0x8b, 0x64, 0x6e,
+	0x08, 0x0f, 0x5f, 0xbf, 0x56, 0x17, 0x45, 0xf1, 0x3b, 0xee, 0x4e, 0x1a, 0x4b, 0x0f, 0x2e, 0x14,
+	0xa6, 0xcf, 0x8f, 0xc5, 0xb1, 0x88, 0x00, 0xfe, 0x4b, 0x85, 0x86, 0xf1, 0x4f, 0xaf, 0xad, 0xdc,
+	0xa7, 0x4a, 0xce, 0xc7, 0x72, 0xbd, 0xdd, 0x9c, 0x4f, 0x2a, 0x1a, 0x9a, 0x14, 0x30, 0xa4, 0x7e,
+	0x35, 0x48, 0x16, 0xc8, 0x83, 0x66, 0xb1, 0xd3, 0x9b, 0xb0, 0x4a, 0x1f, 0x3e, 0xd4, 0xe3, 0x95,
+	0x80, 0x47, 0x02, 0x78, 0xee, 0xc8, 0x52, 0x9a, 0x28, 0x53, 0x2f, 0xc7, 0x80, 0x28, 0x52, 0xef,
+	0x34, 0x2d, 0x45, 0x5b, 0xe8, 0xa7, 0x4b, 0x54, 0x16, 0xfa, 0xa1, 0x34, 0xd2, 0x44, 0x99, 0xfa,
+	0xf9, 0x92, 0x81, 0xad, 0xb0, 0x23, 0xd1, 0xb2, 0x4c, 0x6b, 0xae, 0xb7, 0xc7, 0xb0, 0x8e, 0x5d,
+	0x05, 0xd9, 0xc2, 0x2a, 0xd6, 0x3b, 0x0e, 0xf5, 0x79, 0xf5, 0x8d, 0x3e, 0x3d, 0x2a, 0x89, 0x28,
+	0x06, 0xc7, 0x77, 0x88, 0x81, 0x97, 0x12, 0x38, 0xa0, 0x47, 0xe3, 0xba, 0x0f, 0xa9, 0x8a, 0xa2,
+	0x3e, 0xc1, 0x4e, 0xc1, 0x6c, 0xb7, 0x75, 0xa7, 0x8d, 0x0d, 0x67, 0x6e, 0x4c, 0x19, 0xb7, 0xbc,
+	0x13, 0x2d, 0x2f, 0xa0, 0x84, 0x14, 0x90, 0xf0, 0x0f, 0x61, 0x9b, 0x70, 0x09, 0xea, 0x13, 0xc3,
+	0x3c, 0x6d, 0x61, 0xad, 0x89, 0x17, 0x12, 0xee, 0xc0, 0xa6, 0x12, 0x56, 0xa5, 0xac, 0xb3, 0x62,
+	0x5e, 0x80, 0xf3, 0x84, 0x9a, 0x46, 0x3f, 0x97, 0x32, 0x0d, 0xab, 0xc1, 0x8c, 0x25, 0xa4, 0xc9,
+	0x96, 0xcf, 0x41, 0x3a, 0x44, 0x21, 0x34, 0x6c, 0x77, 0x94, 0xce, 0x63, 0xe2, 0x4f, 0x20, 0x55,
+	0xc6, 0xcf, 0x9c, 0x2a, 0x1d, 0xb9, 0x12, 0x56, 0x7b, 0x73, 0xbd, 0xde, 0x86, 0x75, 0x03, 0x3f,
+	0x73, 0x64, 0x1b, 0x3f, 0x75, 0xd3, 0xdd, 0x23, 0x23, 0x39, 0x58, 0x8a, 0x10, 0xcc, 0x4b, 0x71,
+	0x83, 0x50, 0xbb, 0xac, 0xff, 0xf9, 0x6e, 0x19, 0xd6, 0x26, 0xb3, 0x15, 0xbd, 0x05, 0xff, 0x28,
+	0x0a, 0x35, 0x41, 0xae, 0x3d, 0xac, 0x88, 0x72, 0xbd, 0x5c, 0x2a, 0x97, 0x6a, 0x25, 0xe1, 0xa0,
+	0xf4, 0x48, 0x2c, 0xca, 0xf5, 0x72, 0xb5, 0x22, 0x16, 0x4a, 0x77, 0x4a, 0x62, 0x31, 0xb9, 0xc4,
+	0x6e, 0x0e, 0x86, 0x5c, 0x3c, 0x20, 0x42, 0xd7, 0xe0, 0x92, 0x6f, 0x59, 0x38, 0x28, 0x89, 0xe5,
+	0x9a, 0x5c, 0xad, 0x09, 0x35, 0x31, 0xc9, 0xb0, 0x30, 0x18, 0x72, 0x2b, 0x44, 0x86, 0xfe, 0x0b,
+	0xdb, 0x01, 0xbd, 0xa3, 0x72, 0x55, 0x2c, 0x57, 0xeb, 0x55, 0xaa, 0x1a, 0x61, 0xd7, 0x07, 0x43,
+	0x2e, 0x36, 0x15, 0xa3, 0x1c, 0xb0, 0x21, 0xed, 0xb2, 0x58, 0xa8, 0x95, 0x8e, 0xca, 0x54, 0xfd,
+	0x1c, 0xbb, 0x31, 0x18, 0x72, 0xe0, 0xcb, 0xd1, 0x0e, 0x6c, 0x05, 0xf4, 0xef, 0x09, 0xe5, 0xb2,
+	0x78, 0x40, 0x95, 0xa3, 0x6c, 0x7c, 0x30, 0xe4, 0x56, 0xa9, 0x10, 0xfd, 0x1f, 0x2e, 0xfb, 0x9a,
+	0x15, 0xa1, 0xf0, 0x40, 0xac, 0xc9, 0x85, 0xa3, 0xc3, 0xc3, 0x52, 0xed, 0x50, 0x2c, 0xd7, 0x92,
+	0xcb, 0x6c, 0x6a, 0x30, 0xe4, 0x92, 0x04, 0xf0, 0xe5, 0xe8, 0x1d, 0xe0, 0x5e, 0x33, 0x13, 0x0a,
+	0x0f, 0xca, 0x47, 0x1f, 0x1c, 0x88, 0xc5, 0xbb, 0xa2, 0x67, 0xbb, 0xc2, 0x6e, 0x0f, 0x86, 0xdc,
+	0x45, 0x82, 0xce, 0x80, 0xe8, 0xed, 0x37, 0x10, 0x48, 0x62, 0x41, 0x2c, 0x55, 0x6a, 0xb2, 0xb0,
+	0x5f, 0x15, 0xcb, 0x05, 0x31, 0xb9, 0xca, 0xa6, 0x07, 0x43, 0x2e, 0x45, 0x50, 0x0a, 0x52, 0x0c,
+	0xdd, 0x84, 0x2b, 0xbe, 0x7d, 0x59, 0xfc, 0xb0, 0x26, 0x57, 0xc5, 0xf7, 0xea, 0x2e, 0xe4, 0xd2,
+	0xbc, 0x9f, 0x5c, 0x23, 0x81, 0xbb, 0xc8, 0x04, 0x70, 0xe5, 0x88, 0x83, 0xa4, 0x6f, 0x77, 0x4f,
+	0x14, 0x8a, 0xa2, 0x94, 0x8c, 0x91, 0xca, 0x90, 0x1d, 0xda, 0x0d, 0x55, 0x86, 0xe6, 0xae, 0x5e,
+	0xb9, 0x2b, 0x09, 0x45, 0x31, 0x09, 0x2c, 0x1a, 0x0c, 0xb9, 0x0d, 0x2a, 0xa6, 0x52, 0x74, 0x1b,
+	0xb2, 0x73, 0x4d, 0x64, 0x51, 0x92, 0x8e, 0xa4, 0x64, 0x9c, 0xdd, 0x1a, 0x0c, 0xb9, 0x0b, 0x61,
+	0x43, 0x0f, 0x42, 0x79, 0x48, 0xcf, 0x4b, 0x45, 0x32, 0xc1, 0x9e, 0x1f, 0x0c, 0xb9, 0xf5, 0x50,
+	0x0a, 0xd8, 0xe8, 0xf3, 0x6f, 0x32, 0x4b, 0xfb, 0x8f, 0x7f, 0x7a, 0x99, 0x61, 0x5e, 0xbc, 0xcc,
+	0x30, 0xbf, 0xbe, 0xcc, 0x30, 0x5f, 0xbc, 0xca, 0x2c, 0xbd, 0x78, 0x95, 0x59, 0xfa, 0xf9, 0x55,
+	0x66, 0xe9, 0xd1, 0x9d, 0xa6, 0xee, 0x9c, 0x74, 0x1b, 0x39, 0xd5, 0x6c, 0xe7, 0x55, 0xd3, 0x6e,
+	0x9b, 0x76, 0x5e, 0x6f, 0xa8, 0xd7, 0x9b, 0x66, 0xbe, 0x77, 0x23, 0xdf, 0x36, 0xb5, 0x6e, 0x0b,
+	0xdb, 0xe4, 0xa7, 0xf9, 0xfa, 0xe4, 0xaf, 0xf9, 0x7f, 0x37, 0xaf, 0x07, 0x7f, 0x9c, 0xdd, 0xb7,
+	0x84, 0xdd, 0x58, 0xf1, 0x2e, 0xad, 0x1b, 0xbf, 0x0d, 0x00, 0x74, 0xca, 0x80, 0x97, 0x65, 0x0f,
+	0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -1481,6 +1639,90 @@ func (m *ChannelStateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChannelUpgradeData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelUpgradeData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelUpgradeData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelUpgradeErrorData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelUpgradeErrorData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelUpgradeErrorData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ErrorReceipt != nil {
+		{
+			size, err := m.ErrorReceipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PacketCommitmentData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1555,6 +1797,43 @@ func (m *PacketAcknowledgementData) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketReceiptData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketReceiptData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketReceiptData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receipt) > 0 {
+		i -= len(m.Receipt)
+		copy(dAtA[i:], m.Receipt)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Receipt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PacketReceiptAbsenceData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1874,6 +2153,40 @@ func (m *ChannelStateData) Size() (n int) {
 	return n
 }
 
+func (m *ChannelUpgradeData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelUpgradeErrorData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.ErrorReceipt != nil {
+		l = m.ErrorReceipt.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
+}
+
 func (m *PacketCommitmentData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1908,6 +2221,23 @@ func (m *PacketAcknowledgementData) Size() (n int) {
 	return n
 }
 
+func (m *PacketReceiptData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	l = len(m.Receipt)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
+}
+
 func (m *PacketReceiptAbsenceData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3618,7 +3948,7 @@ func (m *ChannelStateData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketCommitmentData) Unmarshal(dAtA []byte) error {
+func (m *ChannelUpgradeData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3641,10 +3971,10 @@ func (m *PacketCommitmentData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketCommitmentData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelUpgradeData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketCommitmentData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelUpgradeData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3683,9 +4013,9 @@ func (m *PacketCommitmentData) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSolomachine
@@ -3695,24 +4025,26 @@ func (m *PacketCommitmentData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSolomachine
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSolomachine
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			if m.Upgrade == nil {
+				m.Upgrade = &types2.Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3736,7 +4068,7 @@ func (m *PacketCommitmentData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketAcknowledgementData) Unmarshal(dAtA []byte) error {
+func (m *ChannelUpgradeErrorData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3759,10 +4091,10 @@ func (m *PacketAcknowledgementData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketAcknowledgementData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelUpgradeErrorData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketAcknowledgementData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelUpgradeErrorData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3801,9 +4133,9 @@ func (m *PacketAcknowledgementData) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSolomachine
@@ -3813,7 +4145,245 @@ func (m *PacketAcknowledgementData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ErrorReceipt == nil {
+				m.ErrorReceipt = &types2.ErrorReceipt{}
+			}
+			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketCommitmentData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketCommitmentData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketCommitmentData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path[:0], dAtA[iNdEx:postIndex]...)
+			if m.Path == nil {
+				m.Path = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketAcknowledgementData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketAcknowledgementData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketAcknowledgementData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path[:0], dAtA[iNdEx:postIndex]...)
+			if m.Path == nil {
+				m.Path = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3854,6 +4424,124 @@ func (m *PacketAcknowledgementData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PacketReceiptData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketReceiptData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketReceiptData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path[:0], dAtA[iNdEx:postIndex]...)
+			if m.Path == nil {
+				m.Path = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipt = append(m.Receipt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Receipt == nil {
+				m.Receipt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PacketReceiptAbsenceData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
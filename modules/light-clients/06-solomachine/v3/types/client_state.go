@@ -0,0 +1,565 @@
+package types
+
+import (
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// NewClientState creates a new ClientState instance.
+func NewClientState(latestSequence uint64, consensusState *ConsensusState, allowUpdateAfterProposal bool) *ClientState {
+	return &ClientState{
+		Sequence:                 latestSequence,
+		IsFrozen:                 false,
+		ConsensusState:           consensusState,
+		AllowUpdateAfterProposal: allowUpdateAfterProposal,
+	}
+}
+
+// ClientType is the v3 Solo Machine.
+func (cs ClientState) ClientType() string {
+	return exported.SolomachineV3
+}
+
+// GetLatestHeight returns the latest sequence number.
+// Return exported.Height to satisfy ClientState interface
+// Revision number is always 0 for a solo-machine.
+func (cs ClientState) GetLatestHeight() exported.Height {
+	return clienttypes.NewHeight(0, cs.Sequence)
+}
+
+// Status returns the status of the solo machine client.
+// The client may be:
+// - Active: if the client is not frozen
+// - Frozen: otherwise solo machine is frozen
+func (cs ClientState) Status(_ sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec) exported.Status {
+	if cs.IsFrozen {
+		return exported.Frozen
+	}
+
+	return exported.Active
+}
+
+// Validate performs basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if cs.Sequence == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "sequence cannot be 0")
+	}
+	if cs.ConsensusState == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "consensus state cannot be nil")
+	}
+	if !cs.IsFrozen && len(cs.MisbehavingSigners) != 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "misbehaving signers can only be set on a frozen client")
+	}
+	for _, index := range cs.MisbehavingSigners {
+		if uint64(index) >= uint64(len(cs.ConsensusState.Signers)) {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "misbehaving signer index %d out of range of signer set of size %d", index, len(cs.ConsensusState.Signers))
+		}
+	}
+	return cs.ConsensusState.ValidateBasic()
+}
+
+// ZeroCustomFields returns solomachine client state with client-specific fields
+// AllowUpdateAfterProposal and MisbehavingSigners zeroed out
+func (cs ClientState) ZeroCustomFields() exported.ClientState {
+	return NewClientState(
+		cs.Sequence, cs.ConsensusState, false,
+	)
+}
+
+// Initialize will check that initial consensus state is equal to the latest consensus state of the initial client.
+func (cs ClientState) Initialize(_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore, consState exported.ConsensusState) error {
+	if !reflect.DeepEqual(cs.ConsensusState, consState) {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "consensus state in initial client does not equal initial consensus state. expected: %s, got: %s",
+			cs.ConsensusState, consState)
+	}
+	return nil
+}
+
+// ExportMetadata is a no-op since solomachine does not store any metadata in client store
+func (cs ClientState) ExportMetadata(_ sdk.KVStore) []exported.GenesisMetadata {
+	return nil
+}
+
+// VerifyUpgradeAndUpdateState returns an error since solomachine client does not support upgrades
+func (cs ClientState) VerifyUpgradeAndUpdateState(
+	_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore,
+	_ exported.ClientState, _ exported.ConsensusState, _, _ []byte,
+) (exported.ClientState, exported.ConsensusState, error) {
+	return nil, nil, sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade solomachine client")
+}
+
+// VerifyClientState verifies a proof of the client state of the running chain
+// stored on the solo machine.
+func (cs *ClientState) VerifyClientState(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	counterpartyClientIdentifier string,
+	proof []byte,
+	clientState exported.ClientState,
+) error {
+	// NOTE: the proof height sequence is incremented by one due to the connection handshake verification ordering
+	height = clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+1)
+
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	clientPrefixedPath := commitmenttypes.NewMerklePath(host.FullClientStatePath(counterpartyClientIdentifier))
+	path, err := commitmenttypes.ApplyPrefix(prefix, clientPrefixedPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.ClientStateSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, clientState)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyClientConsensusState verifies a proof of the consensus state of the
+// running chain stored on the solo machine.
+func (cs *ClientState) VerifyClientConsensusState(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	counterpartyClientIdentifier string,
+	consensusHeight exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	consensusState exported.ConsensusState,
+) error {
+	// NOTE: the proof height sequence is incremented by two due to the connection handshake verification ordering
+	height = clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+2)
+
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	clientPrefixedPath := commitmenttypes.NewMerklePath(host.FullConsensusStatePath(counterpartyClientIdentifier, consensusHeight))
+	path, err := commitmenttypes.ApplyPrefix(prefix, clientPrefixedPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.ConsensusStateSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, consensusState)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyConnectionState verifies a proof of the connection state of the
+// specified connection end stored on the target machine.
+func (cs *ClientState) VerifyConnectionState(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	connectionID string,
+	connectionEnd exported.ConnectionI,
+) error {
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	connectionPath := commitmenttypes.NewMerklePath(host.ConnectionPath(connectionID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, connectionPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.ConnectionStateSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, connectionEnd)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyChannelState verifies a proof of the channel state of the specified
+// channel end, under the specified port, stored on the target machine.
+func (cs *ClientState) VerifyChannelState(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	channel exported.ChannelI,
+) error {
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	channelPath := commitmenttypes.NewMerklePath(host.ChannelPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, channelPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.ChannelStateSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, channel)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyChannelUpgrade verifies a proof of the upgrade proposed by the specified
+// channel end, under the specified port, stored on the target machine.
+func (cs *ClientState) VerifyChannelUpgrade(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	upgrade exported.UpgradeI,
+) error {
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	upgradePath := commitmenttypes.NewMerklePath(host.ChannelUpgradePath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, upgradePath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.ChannelUpgradeSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, upgrade)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyChannelUpgradeError verifies a proof of the upgrade error receipt of the
+// specified channel end, under the specified port, stored on the target machine.
+func (cs *ClientState) VerifyChannelUpgradeError(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	errorReceipt exported.ErrorReceiptI,
+) error {
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	errorPath := commitmenttypes.NewMerklePath(host.ChannelUpgradeErrorPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, errorPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.ChannelUpgradeErrorSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, errorReceipt)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
+// the specified port, specified channel, and specified sequence.
+func (cs *ClientState) VerifyPacketCommitment(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	packetSequence uint64,
+	commitmentBytes []byte,
+) error {
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	commitmentPath := commitmenttypes.NewMerklePath(host.PacketCommitmentPath(portID, channelID, packetSequence))
+	path, err := commitmenttypes.ApplyPrefix(prefix, commitmentPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.PacketCommitmentSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, commitmentBytes)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyPacketCommitments returns an error. A solo machine signature commits to a
+// single path and value, thus batch proofs of packet commitments are not supported.
+func (cs *ClientState) VerifyPacketCommitments(
+	_ sdk.Context,
+	_ sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	_,
+	_ string,
+	_ map[uint64][]byte,
+) error {
+	return sdkerrors.Wrap(solomachinetypes.ErrInvalidProof, "batch proofs of packet commitments are not supported by solo machine clients")
+}
+
+// VerifyPacketAcknowledgement verifies a proof of an incoming packet
+// acknowledgement at the specified port, specified channel, and specified sequence.
+func (cs *ClientState) VerifyPacketAcknowledgement(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	packetSequence uint64,
+	acknowledgement []byte,
+) error {
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	ackPath := commitmenttypes.NewMerklePath(host.PacketAcknowledgementPath(portID, channelID, packetSequence))
+	path, err := commitmenttypes.ApplyPrefix(prefix, ackPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.PacketAcknowledgementSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, acknowledgement)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (cs *ClientState) VerifyPacketReceipt(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	packetSequence uint64,
+	receipt []byte,
+) error {
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	receiptPath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, packetSequence))
+	path, err := commitmenttypes.ApplyPrefix(prefix, receiptPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.PacketReceiptSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, receipt)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyPacketReceiptAbsence verifies a proof of the absence of an
+// incoming packet receipt at the specified port, specified channel, and
+// specified sequence.
+func (cs *ClientState) VerifyPacketReceiptAbsence(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	packetSequence uint64,
+) error {
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	receiptPath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, packetSequence))
+	path, err := commitmenttypes.ApplyPrefix(prefix, receiptPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.PacketReceiptAbsenceSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyNextSequenceRecv verifies a proof of the next sequence number to be
+// received of the specified channel at the specified port.
+func (cs *ClientState) VerifyNextSequenceRecv(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+) error {
+	signature, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	nextSequenceRecvPath := commitmenttypes.NewMerklePath(host.NextSequenceRecvPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, nextSequenceRecvPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := solomachinetypes.NextSequenceRecvSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, nextSequenceRecv)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, cs.ConsensusState, signBz, signature); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// sets the client state to the store
+func setClientState(store sdk.KVStore, cdc codec.BinaryCodec, clientState exported.ClientState) {
+	bz := clienttypes.MustMarshalClientState(cdc, clientState)
+	store.Set([]byte(host.KeyClientState), bz)
+}
@@ -0,0 +1,302 @@
+package types_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/v3/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+const (
+	testConnectionID = "connectionid"
+	testChannelID    = "testchannelid"
+	testPortID       = "testportid"
+)
+
+var prefix = &commitmenttypes.MerklePrefix{
+	KeyPrefix: []byte("ibc"),
+}
+
+func (suite *SoloMachineTestSuite) TestStatus() {
+	clientState := suite.solomachine.ClientStateV3()
+	// solo machine discards arguements
+	status := clientState.Status(suite.chainA.GetContext(), nil, nil)
+	suite.Require().Equal(exported.Active, status)
+
+	// freeze solo machine
+	clientState.IsFrozen = true
+	status = clientState.Status(suite.chainA.GetContext(), nil, nil)
+	suite.Require().Equal(exported.Frozen, status)
+}
+
+func (suite *SoloMachineTestSuite) TestClientStateValidate() {
+	var clientState *types.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid client state",
+			func() {},
+			true,
+		},
+		{
+			"valid frozen client state with misbehaving signers",
+			func() {
+				clientState.IsFrozen = true
+				clientState.MisbehavingSigners = []uint32{0, 2}
+			},
+			true,
+		},
+		{
+			"sequence is zero",
+			func() {
+				clientState.Sequence = 0
+			},
+			false,
+		},
+		{
+			"consensus state is nil",
+			func() {
+				clientState.ConsensusState = nil
+			},
+			false,
+		},
+		{
+			"invalid consensus state",
+			func() {
+				clientState.ConsensusState.Threshold = 0
+			},
+			false,
+		},
+		{
+			"misbehaving signers set on an active client",
+			func() {
+				clientState.MisbehavingSigners = []uint32{0}
+			},
+			false,
+		},
+		{
+			"misbehaving signer index out of range",
+			func() {
+				clientState.IsFrozen = true
+				clientState.MisbehavingSigners = []uint32{3}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			clientState = suite.solomachine.ClientStateV3()
+
+			tc.malleate()
+
+			err := clientState.Validate()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestInitialize() {
+	malleatedConsensus := suite.solomachine.ConsensusStateV3()
+	malleatedConsensus.Threshold++
+
+	testCases := []struct {
+		name      string
+		consState exported.ConsensusState
+		expPass   bool
+	}{
+		{
+			"valid consensus state",
+			suite.solomachine.ConsensusStateV3(),
+			true,
+		},
+		{
+			"invalid consensus state: v2 solo machine consensus state",
+			suite.solomachine.ConsensusState(),
+			false,
+		},
+		{
+			"invalid consensus state: Tendermint consensus state",
+			&ibctmtypes.ConsensusState{},
+			false,
+		},
+		{
+			"invalid consensus state: consensus state does not match consensus state in client",
+			malleatedConsensus,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := suite.solomachine.ClientStateV3().Initialize(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, tc.consState)
+
+		if tc.expPass {
+			suite.Require().NoError(err, "valid testcase: %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid testcase: %s passed", tc.name)
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyConnectionState() {
+	counterparty := connectiontypes.NewCounterparty("clientB", testConnectionID, *prefix)
+	conn := connectiontypes.NewConnectionEnd(connectiontypes.OPEN, "clientA", counterparty, connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0)
+
+	path := suite.solomachine.GetConnectionStatePath(testConnectionID)
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineWeighted} {
+
+		value, err := solomachinetypes.ConnectionStateSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, conn)
+		suite.Require().NoError(err)
+
+		testCases := []struct {
+			name        string
+			clientState *types.ClientState
+			prefix      exported.Prefix
+			proof       []byte
+			expPass     bool
+		}{
+			{
+				"successful verification",
+				solomachine.ClientStateV3(),
+				prefix,
+				solomachine.GenerateMultiPartyProof(value),
+				true,
+			},
+			{
+				"successful verification by a quorum",
+				solomachine.ClientStateV3(),
+				prefix,
+				solomachine.GenerateMultiPartyProof(value, 0, 1),
+				true,
+			},
+			{
+				"signing weight is less than the threshold",
+				solomachine.ClientStateV3(),
+				prefix,
+				solomachine.GenerateMultiPartyProof(value, 1),
+				false,
+			},
+			{
+				"ApplyPrefix failed",
+				solomachine.ClientStateV3(),
+				commitmenttypes.NewMerklePrefix([]byte{}),
+				solomachine.GenerateMultiPartyProof(value),
+				false,
+			},
+			{
+				"proof is nil",
+				solomachine.ClientStateV3(),
+				prefix,
+				nil,
+				false,
+			},
+			{
+				"proof verification failed",
+				solomachine.ClientStateV3(),
+				prefix,
+				suite.GetInvalidProof(),
+				false,
+			},
+		}
+
+		for i, tc := range testCases {
+			tc := tc
+
+			expSeq := tc.clientState.Sequence + 1
+
+			err := tc.clientState.VerifyConnectionState(
+				suite.store, suite.chainA.Codec, solomachine.GetHeight(), tc.prefix, tc.proof, testConnectionID, conn,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(expSeq, tc.clientState.Sequence)
+				suite.Require().Equal(expSeq, suite.GetSequenceFromStore(), "sequence not updated in the store (%d) on valid test case %d: %s", suite.GetSequenceFromStore(), i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+			}
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyPacketCommitment() {
+	commitmentBytes := []byte("COMMITMENT BYTES")
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineWeighted} {
+
+		path := solomachine.GetPacketCommitmentPath(testPortID, testChannelID)
+
+		value, err := solomachinetypes.PacketCommitmentSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, commitmentBytes)
+		suite.Require().NoError(err)
+
+		testCases := []struct {
+			name        string
+			clientState *types.ClientState
+			height      exported.Height
+			proof       []byte
+			expPass     bool
+		}{
+			{
+				"successful verification",
+				solomachine.ClientStateV3(),
+				solomachine.GetHeight(),
+				solomachine.GenerateMultiPartyProof(value),
+				true,
+			},
+			{
+				"signing weight is less than the threshold",
+				solomachine.ClientStateV3(),
+				solomachine.GetHeight(),
+				solomachine.GenerateMultiPartyProof(value, 2),
+				false,
+			},
+			{
+				"proof height does not match the client state sequence",
+				solomachine.ClientStateV3(),
+				clienttypes.NewHeight(0, solomachine.Sequence+1),
+				solomachine.GenerateMultiPartyProof(value),
+				false,
+			},
+			{
+				"revision number is not zero",
+				solomachine.ClientStateV3(),
+				clienttypes.NewHeight(1, solomachine.Sequence),
+				solomachine.GenerateMultiPartyProof(value),
+				false,
+			},
+		}
+
+		for i, tc := range testCases {
+			tc := tc
+
+			expSeq := tc.clientState.Sequence + 1
+
+			err := tc.clientState.VerifyPacketCommitment(
+				suite.chainA.GetContext(), suite.store, suite.chainA.Codec, tc.height, 0, 0, prefix, tc.proof, testPortID, testChannelID, solomachine.Sequence, commitmentBytes,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(expSeq, suite.GetSequenceFromStore(), "sequence not updated in the store (%d) on valid test case %d: %s", suite.GetSequenceFromStore(), i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/internal/signerset"
+)
+
+var _ exported.ConsensusState = &ConsensusState{}
+
+// NewSigner creates a new Signer instance.
+func NewSigner(publicKey cryptotypes.PubKey, weight uint64) (Signer, error) {
+	anyPublicKey, err := codectypes.NewAnyWithValue(publicKey)
+	if err != nil {
+		return Signer{}, err
+	}
+
+	return Signer{
+		PublicKey: anyPublicKey,
+		Weight:    weight,
+	}, nil
+}
+
+// GetPubKey unmarshals the public key into a cryptotypes.PubKey type.
+// An error is returned if the public key is nil or the cached value
+// is not a PubKey.
+func (s Signer) GetPubKey() (cryptotypes.PubKey, error) {
+	if s.PublicKey == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidSignerSet, "signer PublicKey cannot be nil")
+	}
+
+	publicKey, ok := s.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidSignerSet, "signer PublicKey is not cryptotypes.PubKey")
+	}
+
+	return publicKey, nil
+}
+
+// GetWeight returns the weight of the signer.
+func (s Signer) GetWeight() uint64 {
+	return s.Weight
+}
+
+// ValidateSignerSet ensures that the signer set is non-empty, that every signer has a
+// non-zero weight and a unique, non-multisig public key, and that the threshold is
+// non-zero and reachable by the total weight of the signer set.
+func ValidateSignerSet(signers []Signer, threshold uint64) error {
+	return signerset.ValidateSignerSet(weightedSigners(signers), threshold)
+}
+
+// weightedSigners returns the provided signers as the signers of a weighted signer set.
+func weightedSigners(signers []Signer) []signerset.Signer {
+	weightedSigners := make([]signerset.Signer, len(signers))
+	for i, signer := range signers {
+		weightedSigners[i] = signer
+	}
+
+	return weightedSigners
+}
+
+// ClientType returns the v3 Solo Machine type.
+func (ConsensusState) ClientType() string {
+	return exported.SolomachineV3
+}
+
+// GetTimestamp returns the timestamp of the consensus state.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// GetRoot returns nil since solo machines do not have roots.
+func (cs ConsensusState) GetRoot() exported.Root {
+	return nil
+}
+
+// ValidateBasic defines basic validation for the solo machine consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.Timestamp == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be 0")
+	}
+	if cs.Diversifier != "" && strings.TrimSpace(cs.Diversifier) == "" {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "diversifier cannot contain only spaces")
+	}
+
+	if err := ValidateSignerSet(cs.Signers, cs.Threshold); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, err.Error())
+	}
+
+	return nil
+}
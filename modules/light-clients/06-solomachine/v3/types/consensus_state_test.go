@@ -0,0 +1,141 @@
+package types_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/v3/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestConsensusState() {
+	consensusState := suite.solomachine.ConsensusStateV3()
+
+	suite.Require().Equal(exported.SolomachineV3, consensusState.ClientType())
+	suite.Require().Equal(suite.solomachine.Time, consensusState.GetTimestamp())
+	suite.Require().Nil(consensusState.GetRoot())
+}
+
+func (suite *SoloMachineTestSuite) TestConsensusStateValidateBasic() {
+	var consensusState *types.ConsensusState
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineWeighted} {
+
+		testCases := []struct {
+			name     string
+			malleate func()
+			expPass  bool
+		}{
+			{
+				"valid consensus state",
+				func() {},
+				true,
+			},
+			{
+				"threshold equals total weight",
+				func() {
+					consensusState.Threshold = 0
+					for _, signer := range consensusState.Signers {
+						consensusState.Threshold += signer.Weight
+					}
+				},
+				true,
+			},
+			{
+				"timestamp is zero",
+				func() {
+					consensusState.Timestamp = 0
+				},
+				false,
+			},
+			{
+				"diversifier is blank",
+				func() {
+					consensusState.Diversifier = " "
+				},
+				false,
+			},
+			{
+				"signer set is empty",
+				func() {
+					consensusState.Signers = nil
+				},
+				false,
+			},
+			{
+				"signer public key is nil",
+				func() {
+					consensusState.Signers[0].PublicKey = nil
+				},
+				false,
+			},
+			{
+				"signer public key is a multisig public key",
+				func() {
+					multiSolomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachinemulti", "testing", 2)
+					signer, err := types.NewSigner(multiSolomachine.PublicKey, 1)
+					suite.Require().NoError(err)
+
+					consensusState.Signers[0] = signer
+				},
+				false,
+			},
+			{
+				"duplicate signer public key",
+				func() {
+					consensusState.Signers[1].PublicKey = consensusState.Signers[0].PublicKey
+				},
+				false,
+			},
+			{
+				"signer weight is zero",
+				func() {
+					consensusState.Signers[0].Weight = 0
+				},
+				false,
+			},
+			{
+				"total weight overflows",
+				func() {
+					signer, err := types.NewSigner(secp256k1.GenPrivKey().PubKey(), ^uint64(0))
+					suite.Require().NoError(err)
+
+					consensusState.Signers = append(consensusState.Signers, signer)
+				},
+				false,
+			},
+			{
+				"threshold is zero",
+				func() {
+					consensusState.Threshold = 0
+				},
+				false,
+			},
+			{
+				"threshold is greater than total weight",
+				func() {
+					consensusState.Threshold = 100
+				},
+				false,
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				consensusState = solomachine.ConsensusStateV3()
+
+				tc.malleate()
+
+				err := consensusState.ValidateBasic()
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+				} else {
+					suite.Require().Error(err)
+				}
+			})
+		}
+	}
+}
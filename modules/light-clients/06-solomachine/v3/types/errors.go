@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/internal/signerset"
+)
+
+// The v3 solo machine errors are the errors of the weighted signer set shared with the committee client.
+var (
+	ErrInvalidSignerSet          = signerset.ErrInvalidSignerSet
+	ErrInvalidMultiSignature     = signerset.ErrInvalidSignatures
+	ErrInsufficientSigningWeight = signerset.ErrInsufficientSigningWeight
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Header = &Header{}
+
+// ClientType defines that the Header is a v3 Solo Machine.
+func (Header) ClientType() string {
+	return exported.SolomachineV3
+}
+
+// GetHeight returns the current sequence number as the height.
+// Return clientexported.Height to satisfy interface
+// Revision number is always 0 for a solo-machine
+func (h Header) GetHeight() exported.Height {
+	return clienttypes.NewHeight(0, h.Sequence)
+}
+
+// ValidateBasic ensures that the sequence and signature have been initialized
+// and that the new signer set and threshold are valid.
+func (h Header) ValidateBasic() error {
+	if h.Sequence == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "sequence number cannot be zero")
+	}
+
+	if h.Timestamp == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "timestamp cannot be zero")
+	}
+
+	if h.NewDiversifier != "" && strings.TrimSpace(h.NewDiversifier) == "" {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "diversifier cannot contain only spaces")
+	}
+
+	if len(h.Signature) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "signature cannot be empty")
+	}
+
+	if err := ValidateSignerSet(h.NewSigners, h.NewThreshold); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/v3/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
+	var header *types.Header
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineWeighted} {
+
+		testCases := []struct {
+			name     string
+			malleate func()
+			expPass  bool
+		}{
+			{
+				"valid header",
+				func() {},
+				true,
+			},
+			{
+				"sequence is zero",
+				func() {
+					header.Sequence = 0
+				},
+				false,
+			},
+			{
+				"timestamp is zero",
+				func() {
+					header.Timestamp = 0
+				},
+				false,
+			},
+			{
+				"new diversifier is blank",
+				func() {
+					header.NewDiversifier = " "
+				},
+				false,
+			},
+			{
+				"signature is empty",
+				func() {
+					header.Signature = nil
+				},
+				false,
+			},
+			{
+				"new signer set is empty",
+				func() {
+					header.NewSigners = nil
+				},
+				false,
+			},
+			{
+				"new threshold is zero",
+				func() {
+					header.NewThreshold = 0
+				},
+				false,
+			},
+			{
+				"new threshold is greater than total weight",
+				func() {
+					header.NewThreshold = 100
+				},
+				false,
+			},
+		}
+
+		suite.Require().Equal(exported.SolomachineV3, solomachine.CreateHeaderV3().ClientType())
+
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				header = solomachine.CreateHeaderV3()
+
+				tc.malleate()
+
+				err := header.ValidateBasic()
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+				} else {
+					suite.Require().Error(err)
+				}
+			})
+		}
+	}
+}
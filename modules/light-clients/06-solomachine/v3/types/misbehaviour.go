@@ -0,0 +1,67 @@
+package types
+
+import (
+	"bytes"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Misbehaviour = &Misbehaviour{}
+
+// ClientType is a v3 Solo Machine light client.
+func (misbehaviour Misbehaviour) ClientType() string {
+	return exported.SolomachineV3
+}
+
+// GetClientID returns the ID of the client that committed a misbehaviour.
+func (misbehaviour Misbehaviour) GetClientID() string {
+	return misbehaviour.ClientId
+}
+
+// Type implements Evidence interface.
+func (misbehaviour Misbehaviour) Type() string {
+	return exported.TypeClientMisbehaviour
+}
+
+// ValidateBasic implements Evidence interface.
+func (misbehaviour Misbehaviour) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(misbehaviour.ClientId); err != nil {
+		return sdkerrors.Wrap(err, "invalid client identifier for solo machine")
+	}
+
+	if misbehaviour.Sequence == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "sequence cannot be 0")
+	}
+
+	if misbehaviour.SignatureOne == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "signature one cannot be nil")
+	}
+
+	if misbehaviour.SignatureTwo == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "signature two cannot be nil")
+	}
+
+	if err := misbehaviour.SignatureOne.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "signature one failed basic validation")
+	}
+
+	if err := misbehaviour.SignatureTwo.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "signature two failed basic validation")
+	}
+
+	// misbehaviour signatures cannot be identical
+	if bytes.Equal(misbehaviour.SignatureOne.Signature, misbehaviour.SignatureTwo.Signature) {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "misbehaviour signatures cannot be equal")
+	}
+
+	// message data signed cannot be identical
+	if bytes.Equal(misbehaviour.SignatureOne.Data, misbehaviour.SignatureTwo.Data) {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "misbehaviour signature data must be signed over different messages")
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+)
+
+// CheckMisbehaviourAndUpdateState determines whether or not a quorum of the currently
+// registered signers signed over two different messages with the same sequence. If this
+// is true the client state is updated to a frozen status and the signers who contributed
+// to both signatures are recorded as misbehaving signers.
+// NOTE: If the threshold is at most half of the total weight, two disjoint quorums may
+// sign over different messages. The client is frozen but no misbehaving signer is recorded.
+// NOTE: Misbehaviour is not tracked for previous signer sets, a solo machine may update to
+// a new signer set before the misbehaviour is processed. Therefore, misbehaviour is data
+// order processing dependent.
+func (cs ClientState) CheckMisbehaviourAndUpdateState(
+	ctx sdk.Context,
+	cdc codec.BinaryCodec,
+	clientStore sdk.KVStore,
+	misbehaviour exported.Misbehaviour,
+) (exported.ClientState, error) {
+
+	soloMisbehaviour, ok := misbehaviour.(*Misbehaviour)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClientType,
+			"misbehaviour type %T, expected %T", misbehaviour, &Misbehaviour{},
+		)
+	}
+
+	// NOTE: a check that the misbehaviour message data are not equal is done by
+	// misbehaviour.ValidateBasic which is called by the 02-client keeper.
+
+	// verify first signature
+	signersOne, err := verifySignatureAndData(cdc, cs, soloMisbehaviour, soloMisbehaviour.SignatureOne)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to verify signature one")
+	}
+
+	// verify second signature
+	signersTwo, err := verifySignatureAndData(cdc, cs, soloMisbehaviour, soloMisbehaviour.SignatureTwo)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to verify signature two")
+	}
+
+	cs.IsFrozen = true
+	cs.MisbehavingSigners = intersectSigners(signersOne, signersTwo)
+	return &cs, nil
+}
+
+// verifySignatureAndData verifies that a quorum of the currently registered signers has
+// signed over the provided data and that the data is valid. The data is valid if it can be
+// unmarshaled into the specified data type. The indices of the signers are returned.
+func verifySignatureAndData(cdc codec.BinaryCodec, clientState ClientState, misbehaviour *Misbehaviour, sigAndData *solomachinetypes.SignatureAndData) ([]uint32, error) {
+
+	// do not check misbehaviour timestamp since we want to allow processing of past misbehaviour
+
+	// ensure data can be unmarshaled to the specified data type
+	if _, err := solomachinetypes.UnmarshalDataByType(cdc, sigAndData.DataType, sigAndData.Data); err != nil {
+		return nil, err
+	}
+
+	data, err := solomachinetypes.MisbehaviourSignBytes(
+		cdc,
+		misbehaviour.Sequence, sigAndData.Timestamp,
+		clientState.ConsensusState.Diversifier,
+		sigAndData.DataType,
+		sigAndData.Data,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return VerifyMultiSignature(cdc, clientState.ConsensusState, data, sigAndData.Signature)
+}
+
+// intersectSigners returns the signer indices contained in both of the provided
+// strictly increasing lists of signer indices.
+func intersectSigners(signersOne, signersTwo []uint32) []uint32 {
+	var signers []uint32
+	for i, j := 0, 0; i < len(signersOne) && j < len(signersTwo); {
+		switch {
+		case signersOne[i] < signersTwo[j]:
+			i++
+		case signersOne[i] > signersTwo[j]:
+			j++
+		default:
+			signers = append(signers, signersOne[i])
+			i++
+			j++
+		}
+	}
+
+	return signers
+}
@@ -0,0 +1,194 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/v3/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestCheckMisbehaviourAndUpdateState() {
+	var (
+		clientState  exported.ClientState
+		misbehaviour exported.Misbehaviour
+	)
+
+	testCases := []struct {
+		name                  string
+		weighted              bool
+		setup                 func(solomachine *ibctesting.Solomachine)
+		expMisbehavingSigners []uint32
+		expPass               bool
+	}{
+		{
+			"valid misbehaviour signed twice by all signers",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				misbehaviour = solomachine.CreateMisbehaviourV3(nil, nil)
+			},
+			[]uint32{0, 1, 2},
+			true,
+		},
+		{
+			"valid misbehaviour identifies the signer contained in both quorums",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				misbehaviour = solomachine.CreateMisbehaviourV3([]uint32{0, 1}, []uint32{1, 2})
+			},
+			[]uint32{1},
+			true,
+		},
+		{
+			"valid misbehaviour identifies the weighted signers contained in both quorums",
+			true,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				misbehaviour = solomachine.CreateMisbehaviourV3([]uint32{0, 1, 2}, []uint32{0, 2, 3})
+			},
+			[]uint32{0, 2},
+			true,
+		},
+		{
+			"valid misbehaviour of disjoint quorums identifies no signer",
+			true,
+			func(solomachine *ibctesting.Solomachine) {
+				solomachine.Threshold = 3
+				clientState = solomachine.ClientStateV3()
+				misbehaviour = solomachine.CreateMisbehaviourV3([]uint32{0}, []uint32{1, 2, 3})
+			},
+			nil,
+			true,
+		},
+		{
+			"old misbehaviour is successful (timestamp is less than current consensus state)",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				solomachine.Time = solomachine.Time - 5
+				misbehaviour = solomachine.CreateMisbehaviourV3(nil, nil)
+			},
+			[]uint32{0, 1, 2},
+			true,
+		},
+		{
+			"signature one is not signed by a quorum",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				misbehaviour = solomachine.CreateMisbehaviourV3([]uint32{1}, nil)
+			},
+			nil,
+			false,
+		},
+		{
+			"signature two is not signed by a quorum",
+			true,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				misbehaviour = solomachine.CreateMisbehaviourV3(nil, []uint32{1, 2, 3})
+			},
+			nil,
+			false,
+		},
+		{
+			"wrong client state type",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = &ibctmtypes.ClientState{}
+				misbehaviour = solomachine.CreateMisbehaviourV3(nil, nil)
+			},
+			nil,
+			false,
+		},
+		{
+			"invalid misbehaviour type",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				misbehaviour = solomachine.CreateMisbehaviour()
+			},
+			nil,
+			false,
+		},
+		{
+			"invalid signature one",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				m := solomachine.CreateMisbehaviourV3(nil, nil)
+				m.SignatureOne.Signature = suite.GetInvalidProof()
+				misbehaviour = m
+			},
+			nil,
+			false,
+		},
+		{
+			"data signed is not of the specified data type",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				m := solomachine.CreateMisbehaviourV3(nil, nil)
+				m.SignatureTwo.Data = []byte("invalid data")
+				misbehaviour = m
+			},
+			nil,
+			false,
+		},
+		{
+			"signatures sign over different sequence",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				m := solomachine.CreateMisbehaviourV3(nil, nil)
+				m.Sequence++
+				misbehaviour = m
+			},
+			nil,
+			false,
+		},
+		{
+			"signatures by a previous signer set",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				m := solomachine.CreateMisbehaviourV3(nil, nil)
+				// rotate the signer set
+				solomachine.CreateHeaderV3()
+				clientState = solomachine.ClientStateV3()
+				m.Sequence = solomachine.Sequence
+				misbehaviour = m
+			},
+			nil,
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			solomachine := suite.solomachine
+			if tc.weighted {
+				solomachine = suite.solomachineWeighted
+			}
+
+			// setup test
+			tc.setup(solomachine)
+
+			clientState, err := clientState.CheckMisbehaviourAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, misbehaviour)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(exported.Frozen, clientState.Status(suite.chainA.GetContext(), suite.store, suite.chainA.Codec))
+				suite.Require().Equal(tc.expMisbehavingSigners, clientState.(*types.ClientState).MisbehavingSigners)
+				suite.Require().NoError(clientState.Validate())
+			} else {
+				suite.Require().Error(err, "test case %d: %s", i, tc.name)
+				suite.Require().Nil(clientState)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/v3/types"
+)
+
+func (suite *SoloMachineTestSuite) TestMisbehaviour() {
+	misbehaviour := suite.solomachine.CreateMisbehaviourV3(nil, nil)
+
+	suite.Require().Equal(exported.SolomachineV3, misbehaviour.ClientType())
+	suite.Require().Equal(suite.solomachine.ClientID, misbehaviour.GetClientID())
+	suite.Require().Equal(exported.TypeClientMisbehaviour, misbehaviour.Type())
+}
+
+func (suite *SoloMachineTestSuite) TestMisbehaviourValidateBasic() {
+	var misbehaviour *types.Misbehaviour
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid misbehaviour",
+			func() {},
+			true,
+		},
+		{
+			"invalid client ID",
+			func() {
+				misbehaviour.ClientId = "(badclientid)"
+			},
+			false,
+		},
+		{
+			"sequence is zero",
+			func() {
+				misbehaviour.Sequence = 0
+			},
+			false,
+		},
+		{
+			"signature one is nil",
+			func() {
+				misbehaviour.SignatureOne = nil
+			},
+			false,
+		},
+		{
+			"signature two data type is unspecified",
+			func() {
+				misbehaviour.SignatureTwo.DataType = solomachinetypes.UNSPECIFIED
+			},
+			false,
+		},
+		{
+			"signatures are identical",
+			func() {
+				misbehaviour.SignatureTwo.Signature = misbehaviour.SignatureOne.Signature
+			},
+			false,
+		},
+		{
+			"data signed is identical",
+			func() {
+				misbehaviour.SignatureTwo.Data = misbehaviour.SignatureOne.Data
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			misbehaviour = suite.solomachine.CreateMisbehaviourV3([]uint32{0, 1}, []uint32{1, 2})
+
+			tc.malleate()
+
+			err := misbehaviour.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/internal/signerset"
+)
+
+// VerifyMultiSignature verifies that the provided multi signature contains valid signatures
+// over the sign bytes by signers of the consensus state whose combined weight meets the
+// threshold of the consensus state. The signatures must be ordered by strictly increasing
+// signer index. The indices of the signers who provided the signatures are returned.
+func VerifyMultiSignature(cdc codec.BinaryCodec, consensusState *ConsensusState, signBytes, signature []byte) ([]uint32, error) {
+	multiSig := &MultiSignature{}
+	if err := cdc.Unmarshal(signature, multiSig); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to unmarshal signature into type %T", multiSig)
+	}
+
+	signatures := make([]signerset.IndexedSignature, len(multiSig.Signatures))
+	signerIndices := make([]uint32, len(multiSig.Signatures))
+	for i, sig := range multiSig.Signatures {
+		signatures[i] = signerset.IndexedSignature{SignerIndex: sig.SignerIndex, Signature: sig.Signature}
+		signerIndices[i] = sig.SignerIndex
+	}
+
+	if err := signerset.VerifySignatures(weightedSigners(consensusState.Signers), consensusState.Threshold, signBytes, signatures); err != nil {
+		return nil, err
+	}
+
+	return signerIndices, nil
+}
+
+// HeaderSignBytes returns the sign bytes for verification of the header.
+func HeaderSignBytes(
+	cdc codec.BinaryCodec,
+	header *Header,
+) ([]byte, error) {
+	data := &HeaderData{
+		NewSigners:     header.NewSigners,
+		NewThreshold:   header.NewThreshold,
+		NewDiversifier: header.NewDiversifier,
+	}
+
+	dataBz, err := cdc.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &solomachinetypes.SignBytes{
+		Sequence:    header.Sequence,
+		Timestamp:   header.Timestamp,
+		Diversifier: header.NewDiversifier,
+		DataType:    solomachinetypes.HEADER,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
+
+// produceVerificationArgs perfoms the basic checks on the arguments that are
+// shared between the verification functions and returns the multi signature
+// and timestamp of the proof along with the solo-machine sequence encoded in
+// the proofHeight.
+func produceVerificationArgs(
+	cdc codec.BinaryCodec,
+	cs *ClientState,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+) ([]byte, uint64, uint64, error) {
+	if revision := height.GetRevisionNumber(); revision != 0 {
+		return nil, 0, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "revision must be 0 for solomachine, got revision-number: %d", revision)
+	}
+	// sequence is encoded in the revision height of height struct
+	sequence := height.GetRevisionHeight()
+	if prefix == nil {
+		return nil, 0, 0, sdkerrors.Wrap(commitmenttypes.ErrInvalidPrefix, "prefix cannot be empty")
+	}
+
+	_, ok := prefix.(*commitmenttypes.MerklePrefix)
+	if !ok {
+		return nil, 0, 0, sdkerrors.Wrapf(commitmenttypes.ErrInvalidPrefix, "invalid prefix type %T, expected MerklePrefix", prefix)
+	}
+
+	if proof == nil {
+		return nil, 0, 0, sdkerrors.Wrap(solomachinetypes.ErrInvalidProof, "proof cannot be empty")
+	}
+
+	timestampedSigData := &solomachinetypes.TimestampedSignatureData{}
+	if err := cdc.Unmarshal(proof, timestampedSigData); err != nil {
+		return nil, 0, 0, sdkerrors.Wrapf(err, "failed to unmarshal proof into type %T", timestampedSigData)
+	}
+
+	timestamp := timestampedSigData.Timestamp
+
+	if len(timestampedSigData.SignatureData) == 0 {
+		return nil, 0, 0, sdkerrors.Wrap(solomachinetypes.ErrInvalidProof, "signature data cannot be empty")
+	}
+
+	if cs.ConsensusState == nil {
+		return nil, 0, 0, sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "consensus state cannot be empty")
+	}
+
+	latestSequence := cs.GetLatestHeight().GetRevisionHeight()
+	if latestSequence != sequence {
+		return nil, 0, 0, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state sequence != proof sequence (%d != %d)", latestSequence, sequence,
+		)
+	}
+
+	if cs.ConsensusState.GetTimestamp() > timestamp {
+		return nil, 0, 0, sdkerrors.Wrapf(solomachinetypes.ErrInvalidProof, "the consensus state timestamp is greater than the signature timestamp (%d >= %d)", cs.ConsensusState.GetTimestamp(), timestamp)
+	}
+
+	return timestampedSigData.SignatureData, timestamp, sequence, nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// CheckSubstituteAndUpdateState verifies that the subject is allowed to be updated by
+// a governance proposal and that the substitute client is a v3 solo machine.
+// It will update the consensus state to the substitute's consensus state and
+// the sequence to the substitute's current sequence. The misbehaving signers of
+// the subject are cleared. An error is returned if the client has been disallowed
+// to be updated by a governance proposal, the substitute is not a v3 solo machine,
+// or the current signer set and threshold equal the new signer set and threshold.
+func (cs ClientState) CheckSubstituteAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	_ sdk.KVStore, substituteClient exported.ClientState,
+) (exported.ClientState, error) {
+
+	if !cs.AllowUpdateAfterProposal {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrUpdateClientFailed,
+			"solo machine client is not allowed to updated with a proposal",
+		)
+	}
+
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClientType, "substitute client state type %T, expected  %T", substituteClient, &ClientState{},
+		)
+	}
+
+	if cs.ConsensusState.Threshold == substituteClientState.ConsensusState.Threshold &&
+		equalSigners(cs.ConsensusState.Signers, substituteClientState.ConsensusState.Signers) {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader, "subject and substitute have the same signer set and threshold",
+		)
+	}
+
+	clientState := &cs
+
+	// update to substitute parameters
+	clientState.Sequence = substituteClientState.Sequence
+	clientState.ConsensusState = substituteClientState.ConsensusState
+	clientState.IsFrozen = false
+	clientState.MisbehavingSigners = nil
+
+	return clientState, nil
+}
+
+// equalSigners returns true if both signer sets contain the same public keys with
+// the same weights in the same order.
+func equalSigners(signersOne, signersTwo []Signer) bool {
+	if len(signersOne) != len(signersTwo) {
+		return false
+	}
+
+	for i := range signersOne {
+		if signersOne[i].Weight != signersTwo[i].Weight || !signersOne[i].PublicKey.Equal(signersTwo[i].PublicKey) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/v3/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestCheckSubstituteAndUpdateState() {
+	var (
+		subjectClientState *types.ClientState
+		substituteClient   exported.ClientState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid substitute",
+			func() {
+				subjectClientState.AllowUpdateAfterProposal = true
+			},
+			true,
+		},
+		{
+			"valid substitute of a frozen client with misbehaving signers",
+			func() {
+				subjectClientState.AllowUpdateAfterProposal = true
+				subjectClientState.IsFrozen = true
+				subjectClientState.MisbehavingSigners = []uint32{1}
+			},
+			true,
+		},
+		{
+			"valid substitute with the same signer set and a different threshold",
+			func() {
+				subjectClientState.AllowUpdateAfterProposal = true
+				substitute := suite.solomachine.ClientStateV3()
+				substitute.ConsensusState.Threshold = 3
+				substituteClient = substitute
+			},
+			true,
+		},
+		{
+			"subject not allowed to be updated",
+			func() {
+				subjectClientState.AllowUpdateAfterProposal = false
+			},
+			false,
+		},
+		{
+			"substitute is not a v3 solo machine",
+			func() {
+				subjectClientState.AllowUpdateAfterProposal = true
+				substituteClient = &ibctmtypes.ClientState{}
+			},
+			false,
+		},
+		{
+			"substitute is a v2 solo machine",
+			func() {
+				subjectClientState.AllowUpdateAfterProposal = true
+				substituteClient = suite.solomachine.ClientState()
+			},
+			false,
+		},
+		{
+			"subject and substitute have the same signer set and threshold",
+			func() {
+				subjectClientState.AllowUpdateAfterProposal = true
+				substituteClient = suite.solomachine.ClientStateV3()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			subjectClientState = suite.solomachine.ClientStateV3()
+			substitute := ibctesting.NewMultiPartySolomachine(suite.T(), suite.chainA.Codec, "substitute", "testing", []uint64{1, 1, 1}, 2)
+			substituteClient = substitute.ClientStateV3()
+
+			tc.malleate()
+
+			clientState, err := subjectClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, nil, substituteClient)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				substituteClientState := substituteClient.(*types.ClientState)
+				updatedClientState := clientState.(*types.ClientState)
+				suite.Require().Equal(substituteClientState.ConsensusState, updatedClientState.ConsensusState)
+				suite.Require().Equal(substituteClientState.Sequence, updatedClientState.Sequence)
+				suite.Require().False(updatedClientState.IsFrozen)
+				suite.Require().Empty(updatedClientState.MisbehavingSigners)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(clientState)
+			}
+		})
+	}
+}
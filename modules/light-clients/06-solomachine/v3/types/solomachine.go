@@ -0,0 +1,45 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Interface implementation checks.
+var _, _, _, _, _ codectypes.UnpackInterfacesMessage = &ClientState{}, &ConsensusState{}, &Signer{}, &Header{}, &HeaderData{}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (cs ClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return cs.ConsensusState.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (cs ConsensusState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackSigners(unpacker, cs.Signers)
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (s Signer) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(s.PublicKey, new(cryptotypes.PubKey))
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (h Header) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackSigners(unpacker, h.NewSigners)
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (hd HeaderData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackSigners(unpacker, hd.NewSigners)
+}
+
+// unpackSigners unpacks the public keys of the provided signers.
+func unpackSigners(unpacker codectypes.AnyUnpacker, signers []Signer) error {
+	for _, signer := range signers {
+		if err := signer.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
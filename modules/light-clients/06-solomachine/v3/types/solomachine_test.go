@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/v3/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type SoloMachineTestSuite struct {
+	suite.Suite
+
+	solomachine         *ibctesting.Solomachine // equally weighted signers
+	solomachineWeighted *ibctesting.Solomachine // unequally weighted signers
+	coordinator         *ibctesting.Coordinator
+
+	// testing chain used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	store sdk.KVStore
+}
+
+func (suite *SoloMachineTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	// 2 of 3 signers are required to sign
+	suite.solomachine = ibctesting.NewMultiPartySolomachine(suite.T(), suite.chainA.Codec, "solomachineequal", "testing", []uint64{1, 1, 1}, 2)
+	// the first signer along with any other signer is required to sign
+	suite.solomachineWeighted = ibctesting.NewMultiPartySolomachine(suite.T(), suite.chainA.Codec, "solomachineweighted", "testing", []uint64{3, 1, 1, 1}, 4)
+
+	suite.store = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), exported.Solomachine)
+}
+
+func TestSoloMachineTestSuite(t *testing.T) {
+	suite.Run(t, new(SoloMachineTestSuite))
+}
+
+func (suite *SoloMachineTestSuite) GetSequenceFromStore() uint64 {
+	bz := suite.store.Get(host.ClientStateKey())
+	suite.Require().NotNil(bz)
+
+	var clientState exported.ClientState
+	err := suite.chainA.Codec.UnmarshalInterface(bz, &clientState)
+	suite.Require().NoError(err)
+	return clientState.GetLatestHeight().GetRevisionHeight()
+}
+
+func (suite *SoloMachineTestSuite) GetInvalidProof() []byte {
+	invalidProof, err := suite.chainA.Codec.Marshal(&solomachinetypes.TimestampedSignatureData{Timestamp: suite.solomachine.Time})
+	suite.Require().NoError(err)
+
+	return invalidProof
+}
+
+func TestUnpackInterfaces_Header(t *testing.T) {
+	registry := testdata.NewTestInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+
+	pk := secp256k1.GenPrivKey().PubKey()
+	signer, err := types.NewSigner(pk, 1)
+	require.NoError(t, err)
+
+	header := types.Header{
+		NewSigners: []types.Signer{signer},
+	}
+	bz, err := header.Marshal()
+	require.NoError(t, err)
+
+	var header2 types.Header
+	err = header2.Unmarshal(bz)
+	require.NoError(t, err)
+
+	err = codectypes.UnpackInterfaces(header2, registry)
+	require.NoError(t, err)
+
+	require.Equal(t, pk, header2.NewSigners[0].PublicKey.GetCachedValue())
+}
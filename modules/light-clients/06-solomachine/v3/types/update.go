@@ -0,0 +1,83 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+)
+
+// CheckHeaderAndUpdateState checks if the provided header is valid and updates
+// the consensus state if appropriate. It returns an error if:
+// - the header provided is not parseable to a solo machine header
+// - the header sequence does not match the current sequence
+// - the header timestamp is less than the consensus state timestamp
+// - the currently registered signers with a combined weight of at least the
+// threshold did not provide the update signature
+func (cs ClientState) CheckHeaderAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	header exported.Header,
+) (exported.ClientState, exported.ConsensusState, error) {
+	smHeader, ok := header.(*Header)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader, "header type %T, expected  %T", header, &Header{},
+		)
+	}
+
+	if err := checkHeader(cdc, &cs, smHeader); err != nil {
+		return nil, nil, err
+	}
+
+	clientState, consensusState := update(&cs, smHeader)
+	return clientState, consensusState, nil
+}
+
+// checkHeader checks if the Solo Machine update signature is valid.
+func checkHeader(cdc codec.BinaryCodec, clientState *ClientState, header *Header) error {
+	// assert update sequence is current sequence
+	if header.Sequence != clientState.Sequence {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader,
+			"header sequence does not match the client state sequence (%d != %d)", header.Sequence, clientState.Sequence,
+		)
+	}
+
+	// assert update timestamp is not less than current consensus state timestamp
+	if header.Timestamp < clientState.ConsensusState.Timestamp {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader,
+			"header timestamp is less than to the consensus state timestamp (%d < %d)", header.Timestamp, clientState.ConsensusState.Timestamp,
+		)
+	}
+
+	// assert a quorum of the currently registered signers signed over the new signer set with correct sequence
+	data, err := HeaderSignBytes(cdc, header)
+	if err != nil {
+		return err
+	}
+
+	if _, err := VerifyMultiSignature(cdc, clientState.ConsensusState, data, header.Signature); err != nil {
+		return sdkerrors.Wrap(solomachinetypes.ErrInvalidHeader, err.Error())
+	}
+
+	return nil
+}
+
+// update the consensus state to the new signer set and threshold and an incremented sequence
+func update(clientState *ClientState, header *Header) (*ClientState, *ConsensusState) {
+	consensusState := &ConsensusState{
+		Signers:     header.NewSigners,
+		Threshold:   header.NewThreshold,
+		Diversifier: header.NewDiversifier,
+		Timestamp:   header.Timestamp,
+	}
+
+	// increment sequence number
+	clientState.Sequence++
+	clientState.ConsensusState = consensusState
+	return clientState, consensusState
+}
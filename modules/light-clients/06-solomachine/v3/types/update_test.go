@@ -0,0 +1,239 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/v3/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateState() {
+	var (
+		clientState exported.ClientState
+		header      exported.Header
+	)
+
+	testCases := []struct {
+		name     string
+		weighted bool
+		setup    func(solomachine *ibctesting.Solomachine)
+		expPass  bool
+	}{
+		{
+			"successful update signed by all signers",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				header = solomachine.CreateHeaderV3()
+			},
+			true,
+		},
+		{
+			"successful update signed by a quorum of equally weighted signers",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				header = solomachine.CreateHeaderV3(0, 2)
+			},
+			true,
+		},
+		{
+			"successful update signed by a quorum of weighted signers",
+			true,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				header = solomachine.CreateHeaderV3(0, 3)
+			},
+			true,
+		},
+		{
+			"successful update with a new threshold",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				solomachine.Threshold = 3
+				header = solomachine.CreateHeaderV3(0, 1)
+			},
+			true,
+		},
+		{
+			"signing weight of equally weighted signers is less than the threshold",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				header = solomachine.CreateHeaderV3(1)
+			},
+			false,
+		},
+		{
+			"signing weight of weighted signers is less than the threshold",
+			true,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				header = solomachine.CreateHeaderV3(1, 2, 3)
+			},
+			false,
+		},
+		{
+			"duplicate signer",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				header = solomachine.CreateHeaderV3(1, 1)
+			},
+			false,
+		},
+		{
+			"signer index out of range",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				h := solomachine.CreateHeaderV3()
+
+				multiSig := &types.MultiSignature{}
+				suite.Require().NoError(suite.chainA.Codec.Unmarshal(h.Signature, multiSig))
+				multiSig.Signatures[2].SignerIndex = 3
+
+				bz, err := suite.chainA.Codec.Marshal(multiSig)
+				suite.Require().NoError(err)
+
+				h.Signature = bz
+				header = h
+			},
+			false,
+		},
+		{
+			"signature by a signer not in the signer set",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				// rotate the signer set without updating the client
+				solomachine.CreateHeaderV3()
+				solomachine.Sequence--
+				header = solomachine.CreateHeaderV3()
+			},
+			false,
+		},
+		{
+			"wrong client state type",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = &ibctmtypes.ClientState{}
+				header = solomachine.CreateHeaderV3()
+			},
+			false,
+		},
+		{
+			"invalid header type",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				header = solomachine.CreateHeader()
+			},
+			false,
+		},
+		{
+			"wrong sequence in header",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				h := solomachine.CreateHeaderV3()
+				h.Sequence++
+				header = h
+			},
+			false,
+		},
+		{
+			"invalid header signature",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				h := solomachine.CreateHeaderV3()
+				h.Signature = suite.GetInvalidProof()
+				header = h
+			},
+			false,
+		},
+		{
+			"invalid timestamp in header",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				h := solomachine.CreateHeaderV3()
+				h.Timestamp--
+				header = h
+			},
+			false,
+		},
+		{
+			"signature signs over old threshold",
+			false,
+			func(solomachine *ibctesting.Solomachine) {
+				clientState = solomachine.ClientStateV3()
+				h := solomachine.CreateHeaderV3()
+				h.NewThreshold = 1
+				header = h
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			solomachine := suite.solomachine
+			if tc.weighted {
+				solomachine = suite.solomachineWeighted
+			}
+
+			// setup test
+			tc.setup(solomachine)
+
+			clientState, consensusState, err := clientState.CheckHeaderAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, header)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				smHeader := header.(*types.Header)
+				smConsensusState := consensusState.(*types.ConsensusState)
+
+				suite.Require().Equal(smHeader.NewSigners, smConsensusState.Signers)
+				suite.Require().Equal(smHeader.NewThreshold, smConsensusState.Threshold)
+				suite.Require().Equal(smHeader.NewDiversifier, smConsensusState.Diversifier)
+				suite.Require().Equal(smHeader.Timestamp, smConsensusState.Timestamp)
+				suite.Require().Equal(smHeader.GetHeight().GetRevisionHeight()+1, clientState.GetLatestHeight().GetRevisionHeight())
+				suite.Require().Equal(solomachine.ClientStateV3(), clientState)
+			} else {
+				suite.Require().Error(err, "test case %d: %s", i, tc.name)
+				suite.Require().Nil(clientState)
+				suite.Require().Nil(consensusState)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestUpdateClient() {
+	// create a v3 solo machine client and rotate its signer set through the 02-client keeper
+	solomachine := suite.solomachineWeighted
+	ctx := suite.chainA.GetContext()
+
+	// the v3 solo machine must be allowed explicitly
+	params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(ctx)
+	params.AllowedClients = append(params.AllowedClients, exported.SolomachineV3)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(ctx, params)
+
+	clientID, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(ctx, solomachine.ClientStateV3(), solomachine.ConsensusStateV3())
+	suite.Require().NoError(err)
+
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, clientID, solomachine.CreateHeaderV3(0, 1))
+	suite.Require().NoError(err)
+
+	clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().Equal(solomachine.ClientStateV3(), clientState)
+
+	// signers without a quorum of the new signer set cannot update the client
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, clientID, solomachine.CreateHeaderV3(1, 2, 3))
+	suite.Require().Error(err)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"sort"
 	"strings"
 	"time"
 
@@ -350,6 +351,86 @@ func (cs ClientState) VerifyChannelState(
 	return nil
 }
 
+// VerifyChannelUpgrade verifies a proof of the upgrade proposed by the specified
+// channel end, under the specified port, stored on the target machine.
+func (cs ClientState) VerifyChannelUpgrade(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	upgrade exported.UpgradeI,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	upgradePath := commitmenttypes.NewMerklePath(host.ChannelUpgradePath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, upgradePath)
+	if err != nil {
+		return err
+	}
+
+	channelUpgrade, ok := upgrade.(channeltypes.Upgrade)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid upgrade type %T", upgrade)
+	}
+
+	bz, err := cdc.Marshal(&channelUpgrade)
+	if err != nil {
+		return err
+	}
+
+	if err := merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, bz); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VerifyChannelUpgradeError verifies a proof of the upgrade error receipt of the
+// specified channel end, under the specified port, stored on the target machine.
+func (cs ClientState) VerifyChannelUpgradeError(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	errorReceipt exported.ErrorReceiptI,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	errorPath := commitmenttypes.NewMerklePath(host.ChannelUpgradeErrorPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, errorPath)
+	if err != nil {
+		return err
+	}
+
+	receipt, ok := errorReceipt.(channeltypes.ErrorReceipt)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid error receipt type %T", errorReceipt)
+	}
+
+	bz, err := cdc.Marshal(&receipt)
+	if err != nil {
+		return err
+	}
+
+	if err := merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, bz); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
 // the specified port, specified channel, and specified sequence.
 func (cs ClientState) VerifyPacketCommitment(
@@ -389,6 +470,62 @@ func (cs ClientState) VerifyPacketCommitment(
 	return nil
 }
 
+// VerifyPacketCommitments verifies a single batch proof of multiple outgoing packet
+// commitments at the specified port, specified channel, and the sequences provided.
+func (cs ClientState) VerifyPacketCommitments(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	commitments map[uint64][]byte,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	// check delay period has passed
+	if err := verifyDelayPeriodPassed(ctx, store, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	// the batch proof is verified against the prefix path, the commitment paths are the keys
+	// proven within the lowest subtree
+	path, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath())
+	if err != nil {
+		return err
+	}
+
+	// iterate in ascending sequence order so that any returned error is deterministic
+	sequences := make([]uint64, 0, len(commitments))
+	for sequence := range commitments {
+		sequences = append(sequences, sequence)
+	}
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+
+	items := make(map[string][]byte, len(commitments))
+	for _, sequence := range sequences {
+		commitmentBytes := commitments[sequence]
+		if len(commitmentBytes) == 0 {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "packet commitment for sequence %d cannot be empty", sequence)
+		}
+
+		items[host.PacketCommitmentPath(portID, channelID, sequence)] = commitmentBytes
+	}
+
+	if err := merkleProof.BatchVerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, items); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // VerifyPacketAcknowledgement verifies a proof of an incoming packet
 // acknowledgement at the specified port, specified channel, and specified sequence.
 func (cs ClientState) VerifyPacketAcknowledgement(
@@ -428,6 +565,45 @@ func (cs ClientState) VerifyPacketAcknowledgement(
 	return nil
 }
 
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketReceipt(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	// check delay period has passed
+	if err := verifyDelayPeriodPassed(ctx, store, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	receiptPath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence))
+	path, err := commitmenttypes.ApplyPrefix(prefix, receiptPath)
+	if err != nil {
+		return err
+	}
+
+	if err := merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, receipt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
 // incoming packet receipt at the specified port, specified channel, and
 // specified sequence.
@@ -528,6 +528,132 @@ func (suite *TendermintTestSuite) TestVerifyPacketCommitment() {
 	}
 }
 
+// test verification of a batch of packet commitments on chainB being represented
+// in the light client on chainA. A send packet must be successfully executed on
+// chainB for each packet in the batch.
+func (suite *TendermintTestSuite) TestVerifyPacketCommitments() {
+	var (
+		clientState      *types.ClientState
+		proof            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		prefix           commitmenttypes.MerklePrefix
+		commitments      map[uint64][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"successful verification of subset of commitments", func() {
+				delete(commitments, 1)
+			}, true,
+		},
+		{
+			name: "delay time period has not passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			expPass: false,
+		},
+		{
+			name: "delay block period has not passed",
+			malleate: func() {
+				delayBlockPeriod = 1000
+			},
+			expPass: false,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+		{
+			"commitment not included in proof", func() {
+				commitments[4] = commitments[1]
+			}, false,
+		},
+		{
+			"invalid commitment", func() {
+				commitments[1] = []byte("invalid commitment")
+			}, false,
+		},
+		{
+			"empty commitment", func() {
+				commitments[2] = []byte{}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			commitments = make(map[uint64][]byte)
+			var packetKeys [][]byte
+			for seq := uint64(1); seq <= 3; seq++ {
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, seq, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+				err := path.EndpointB.SendPacket(packet)
+				suite.Require().NoError(err)
+
+				commitments[seq] = channeltypes.CommitPacket(suite.chainA.App.GetIBCKeeper().Codec(), packet)
+				packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			}
+
+			var ok bool
+			clientStateI := suite.chainA.GetClientState(path.EndpointA.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make batch packet commitment proof
+			proof, proofHeight = path.EndpointB.QueryBatchProof(packetKeys...)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			err := clientState.VerifyPacketCommitments(
+				ctx, store, suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod, &prefix, proof,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, commitments,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // test verification of the acknowledgement on chainB being represented
 // in the light client on chainA. A send and ack from chainA to chainB
 // is simulated.
@@ -650,6 +776,131 @@ func (suite *TendermintTestSuite) TestVerifyPacketAcknowledgement() {
 // test verification of the absent acknowledgement on chainB being represented
 // in the light client on chainA. A send from chainB to chainA is simulated, but
 // no receive.
+// test verification of a packet receipt on chainB being represented
+// in the light client on chainA. A timed out packet on an ORDERED_ALLOW_TIMEOUT
+// channel is used to write a timeout receipt.
+func (suite *TendermintTestSuite) TestVerifyPacketReceipt() {
+	var (
+		clientState      *types.ClientState
+		proof            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		prefix           commitmenttypes.MerklePrefix
+		receipt          []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			name: "delay time period has passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+			},
+			expPass: true,
+		},
+		{
+			name: "delay time period has not passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			expPass: false,
+		},
+		{
+			name: "delay block period has passed",
+			malleate: func() {
+				delayBlockPeriod = 1
+			},
+			expPass: true,
+		},
+		{
+			name: "delay block period has not passed",
+			malleate: func() {
+				delayBlockPeriod = 10
+			},
+			expPass: false,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"receipt does not match", func() {
+				receipt = []byte{byte(1)}
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0)
+
+			// send packet and recv after it has timed out
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			var ok bool
+			clientStateI := suite.chainA.GetClientState(path.EndpointA.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			prefix = suite.chainB.GetPrefix()
+			receipt = channeltypes.TimeoutReceipt
+
+			// make packet receipt proof
+			receiptKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			proof, proofHeight = path.EndpointB.QueryProof(receiptKey)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			err = clientState.VerifyPacketReceipt(
+				ctx, store, suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod, &prefix, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), receipt,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestVerifyPacketReceiptAbsence() {
 	var (
 		clientState      *types.ClientState
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.ConsensusStatePruner = (*ClientState)(nil)
+
+// GetPrunableConsensusStateHeights returns, in ascending order, the heights of at most limit consensus
+// states which can be pruned. A consensus state can be pruned if it is expired or if it is one of the
+// oldest consensus states exceeding maxConsensusStates. The consensus state at the latest height is
+// kept since it is used to determine the status of the client. A zero limit or maxConsensusStates is
+// not applied.
+func (cs ClientState) GetPrunableConsensusStateHeights(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	maxConsensusStates, limit uint64,
+) ([]exported.Height, error) {
+	var excess uint64
+	if maxConsensusStates != 0 {
+		// at most limit consensus states are pruned, thus the consensus states are only counted
+		// until the excess reaches the limit, bounding the iteration by limit + maxConsensusStates
+		var count uint64
+		IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+			count++
+			return limit != 0 && count > maxConsensusStates && count-maxConsensusStates >= limit
+		})
+
+		if count > maxConsensusStates {
+			excess = count - maxConsensusStates
+		}
+	}
+
+	var (
+		heights []exported.Height
+		err     error
+	)
+	pruneCb := func(height exported.Height) bool {
+		if limit != 0 && uint64(len(heights)) == limit {
+			return true
+		}
+
+		if height.EQ(cs.GetLatestHeight()) {
+			return true
+		}
+
+		if uint64(len(heights)) >= excess {
+			var consState *ConsensusState
+			consState, err = GetConsensusState(clientStore, cdc, height)
+			// this error should never occur
+			if err != nil {
+				return true
+			}
+
+			// consensus state timestamps increase with their heights, thus no later consensus state is expired
+			if !cs.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+				return true
+			}
+		}
+
+		heights = append(heights, height)
+		return false
+	}
+
+	IterateConsensusStateAscending(clientStore, pruneCb)
+	if err != nil {
+		return nil, err
+	}
+
+	return heights, nil
+}
+
+// PruneConsensusState deletes the consensus state at the given height along with its processed time,
+// processed height and iteration key metadata.
+func (cs ClientState) PruneConsensusState(clientStore sdk.KVStore, height exported.Height) {
+	deleteConsensusState(clientStore, height)
+	deleteConsensusMetadata(clientStore, height)
+}
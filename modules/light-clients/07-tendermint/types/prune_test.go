@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *TendermintTestSuite) TestGetPrunableConsensusStateHeights() {
+	var (
+		path               *ibctesting.Path
+		heights            []exported.Height
+		maxConsensusStates uint64
+		limit              uint64
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expHeights func() []exported.Height
+	}{
+		{
+			"expired consensus states", func() {}, func() []exported.Height { return heights[:2] },
+		},
+		{
+			"limit applied", func() {
+				limit = 1
+			}, func() []exported.Height { return heights[:1] },
+		},
+		{
+			"oldest consensus states exceeding max consensus states", func() {
+				maxConsensusStates = 1
+			}, func() []exported.Height { return heights[:3] },
+		},
+		{
+			"max consensus states not exceeded by unexpired consensus states", func() {
+				maxConsensusStates = 3
+			}, func() []exported.Height { return heights[:2] },
+		},
+		{
+			"unexpired consensus states exceeding max consensus states, limit applied", func() {
+				suite.coordinator.IncrementTimeBy(-30 * 24 * time.Hour)
+				maxConsensusStates = 1
+				limit = 2
+			}, func() []exported.Height { return heights[:2] },
+		},
+		{
+			"unexpired consensus states exceeding max consensus states by the limit", func() {
+				suite.coordinator.IncrementTimeBy(-30 * 24 * time.Hour)
+				maxConsensusStates = 3
+				limit = 1
+			}, func() []exported.Height { return heights[:1] },
+		},
+		{
+			"latest consensus state of expired client is kept", func() {
+				suite.coordinator.IncrementTimeBy(30 * 24 * time.Hour)
+			}, func() []exported.Height { return heights[:3] },
+		},
+		{
+			"no consensus state expired", func() {
+				suite.coordinator.IncrementTimeBy(-30 * 24 * time.Hour)
+			}, func() []exported.Height { return nil },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			// disable pruning on updates
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.ConsensusStatePruneLimit = 0
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			// consensus states are created every 10 days, the trusting period being 14 days
+			heights = []exported.Height{path.EndpointA.GetClientState().GetLatestHeight()}
+			for i := 0; i < 3; i++ {
+				suite.coordinator.IncrementTimeBy(10 * 24 * time.Hour)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				heights = append(heights, path.EndpointA.GetClientState().GetLatestHeight())
+			}
+
+			maxConsensusStates, limit = 0, 0
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			clientState := path.EndpointA.GetClientState().(*types.ClientState)
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			prunableHeights, err := clientState.GetPrunableConsensusStateHeights(ctx, suite.chainA.Codec, clientStore, maxConsensusStates, limit)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expHeights(), prunableHeights)
+
+			for _, height := range prunableHeights {
+				clientState.PruneConsensusState(clientStore, height)
+
+				_, ok := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, height)
+				suite.Require().False(ok)
+				_, ok = types.GetProcessedTime(clientStore, height)
+				suite.Require().False(ok)
+				_, ok = types.GetProcessedHeight(clientStore, height)
+				suite.Require().False(ok)
+				suite.Require().Nil(types.GetIterationKey(clientStore, height))
+			}
+		})
+	}
+}
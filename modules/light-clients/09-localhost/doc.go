@@ -1,5 +1,7 @@
 /*
-Package localhost implements a concrete `ConsensusState`, `Header`,
-`Misbehaviour` and `Equivocation` types for the loop-back client.
+Package localhost implements a concrete `ClientState` type for the loop-back client.
+The localhost client verifies the state of the executing chain by reading its IBC
+store directly at the current height. It is used along with the sentinel localhost
+connection to open channels between modules of the same chain.
 */
 package localhost
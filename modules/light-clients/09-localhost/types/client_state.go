@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -98,7 +99,7 @@ func (cs ClientState) CheckMisbehaviourAndUpdateState(
 }
 
 // CheckSubstituteAndUpdateState returns an error. The localhost cannot be modified by
-// proposals.
+// proposals. The localhost client is always Active and thus never needs to be recovered.
 func (cs ClientState) CheckSubstituteAndUpdateState(
 	ctx sdk.Context, _ codec.BinaryCodec, _, _ sdk.KVStore,
 	_ exported.ClientState,
@@ -106,7 +107,9 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 	return nil, sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "cannot update localhost client with a proposal")
 }
 
-// VerifyUpgradeAndUpdateState returns an error since localhost cannot be upgraded
+// VerifyUpgradeAndUpdateState returns an error since localhost cannot be upgraded. The localhost
+// client does not need to be upgraded since it tracks the chain ID, and thus the revision, of the
+// executing chain on every update.
 func (cs ClientState) VerifyUpgradeAndUpdateState(
 	_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore,
 	_ exported.ClientState, _ exported.ConsensusState, _, _ []byte,
@@ -114,13 +117,18 @@ func (cs ClientState) VerifyUpgradeAndUpdateState(
 	return nil, nil, sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade localhost client")
 }
 
-// VerifyClientState verifies that the localhost client state is stored locally
+// VerifyClientState verifies that the client state of the counterparty client identifier is
+// stored locally
 func (cs ClientState) VerifyClientState(
 	store sdk.KVStore, cdc codec.BinaryCodec,
-	_ exported.Height, _ exported.Prefix, _ string, _ []byte, clientState exported.ClientState,
+	height exported.Height, _ exported.Prefix, counterpartyClientIdentifier string, _ []byte, clientState exported.ClientState,
 ) error {
-	path := host.KeyClientState
-	bz := store.Get([]byte(path))
+	if err := cs.verifyHeight(height); err != nil {
+		return err
+	}
+
+	path := host.FullClientStateKey(counterpartyClientIdentifier)
+	bz := store.Get(path)
 	if bz == nil {
 		return sdkerrors.Wrapf(clienttypes.ErrFailedClientStateVerification,
 			"not found for path: %s", path)
@@ -152,28 +160,36 @@ func (cs ClientState) VerifyClientConsensusState(
 func (cs ClientState) VerifyConnectionState(
 	store sdk.KVStore,
 	cdc codec.BinaryCodec,
-	_ exported.Height,
+	height exported.Height,
 	_ exported.Prefix,
 	_ []byte,
 	connectionID string,
 	connectionEnd exported.ConnectionI,
 ) error {
+	if err := cs.verifyHeight(height); err != nil {
+		return err
+	}
+
 	path := host.ConnectionKey(connectionID)
-	bz := store.Get(path)
-	if bz == nil {
+	data := store.Get(path)
+	if len(data) == 0 {
 		return sdkerrors.Wrapf(clienttypes.ErrFailedConnectionStateVerification, "not found for path %s", path)
 	}
 
-	var prevConnection connectiontypes.ConnectionEnd
-	err := cdc.Unmarshal(bz, &prevConnection)
+	connection, ok := connectionEnd.(connectiontypes.ConnectionEnd)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid connection type %T", connectionEnd)
+	}
+
+	bz, err := cdc.Marshal(&connection)
 	if err != nil {
 		return err
 	}
 
-	if !reflect.DeepEqual(&prevConnection, connectionEnd) {
+	if !bytes.Equal(data, bz) {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrFailedConnectionStateVerification,
-			"connection end ≠ previous stored connection: \n%v\n≠\n%v", connectionEnd, prevConnection,
+			"connection end ≠ previous stored connection: \n%X\n≠\n%X", bz, data,
 		)
 	}
 
@@ -185,29 +201,121 @@ func (cs ClientState) VerifyConnectionState(
 func (cs ClientState) VerifyChannelState(
 	store sdk.KVStore,
 	cdc codec.BinaryCodec,
-	_ exported.Height,
+	height exported.Height,
 	prefix exported.Prefix,
 	_ []byte,
 	portID,
 	channelID string,
 	channel exported.ChannelI,
 ) error {
+	if err := cs.verifyHeight(height); err != nil {
+		return err
+	}
+
 	path := host.ChannelKey(portID, channelID)
-	bz := store.Get(path)
-	if bz == nil {
+	data := store.Get(path)
+	if len(data) == 0 {
 		return sdkerrors.Wrapf(clienttypes.ErrFailedChannelStateVerification, "not found for path %s", path)
 	}
 
-	var prevChannel channeltypes.Channel
-	err := cdc.Unmarshal(bz, &prevChannel)
+	channelEnd, ok := channel.(channeltypes.Channel)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid channel type %T", channel)
+	}
+
+	bz, err := cdc.Marshal(&channelEnd)
 	if err != nil {
 		return err
 	}
 
-	if !reflect.DeepEqual(&prevChannel, channel) {
+	if !bytes.Equal(data, bz) {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrFailedChannelStateVerification,
-			"channel end ≠ previous stored channel: \n%v\n≠\n%v", channel, prevChannel,
+			"channel end ≠ previous stored channel: \n%X\n≠\n%X", bz, data,
+		)
+	}
+
+	return nil
+}
+
+// VerifyChannelUpgrade verifies a proof of the upgrade proposed by the specified
+// channel end, under the specified port, stored on the local machine.
+func (cs ClientState) VerifyChannelUpgrade(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	upgrade exported.UpgradeI,
+) error {
+	if err := cs.verifyHeight(height); err != nil {
+		return err
+	}
+
+	path := host.ChannelUpgradeKey(portID, channelID)
+	data := store.Get(path)
+	if len(data) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedChannelUpgradeVerification, "not found for path %s", path)
+	}
+
+	channelUpgrade, ok := upgrade.(channeltypes.Upgrade)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid upgrade type %T", upgrade)
+	}
+
+	bz, err := cdc.Marshal(&channelUpgrade)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(data, bz) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedChannelUpgradeVerification,
+			"upgrade ≠ previous stored upgrade: \n%X\n≠\n%X", bz, data,
+		)
+	}
+
+	return nil
+}
+
+// VerifyChannelUpgradeError verifies a proof of the upgrade error receipt of the
+// specified channel end, under the specified port, stored on the local machine.
+func (cs ClientState) VerifyChannelUpgradeError(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	errorReceipt exported.ErrorReceiptI,
+) error {
+	if err := cs.verifyHeight(height); err != nil {
+		return err
+	}
+
+	path := host.ChannelUpgradeErrorKey(portID, channelID)
+	data := store.Get(path)
+	if len(data) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedChannelUpgradeVerification, "not found for path %s", path)
+	}
+
+	receipt, ok := errorReceipt.(channeltypes.ErrorReceipt)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid error receipt type %T", errorReceipt)
+	}
+
+	bz, err := cdc.Marshal(&receipt)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(data, bz) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedChannelUpgradeVerification,
+			"error receipt ≠ previous stored error receipt: \n%X\n≠\n%X", bz, data,
 		)
 	}
 
@@ -220,7 +328,7 @@ func (cs ClientState) VerifyPacketCommitment(
 	ctx sdk.Context,
 	store sdk.KVStore,
 	_ codec.BinaryCodec,
-	_ exported.Height,
+	height exported.Height,
 	_ uint64,
 	_ uint64,
 	_ exported.Prefix,
@@ -230,6 +338,10 @@ func (cs ClientState) VerifyPacketCommitment(
 	sequence uint64,
 	commitmentBytes []byte,
 ) error {
+	if err := cs.verifyHeight(height); err != nil {
+		return err
+	}
+
 	path := host.PacketCommitmentKey(portID, channelID, sequence)
 
 	data := store.Get(path)
@@ -247,13 +359,47 @@ func (cs ClientState) VerifyPacketCommitment(
 	return nil
 }
 
+// VerifyPacketCommitments verifies that each of the provided packet commitments is
+// stored at the specified port, specified channel, and its sequence.
+func (cs ClientState) VerifyPacketCommitments(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	commitments map[uint64][]byte,
+) error {
+	// iterate in ascending sequence order so that any returned error is deterministic
+	sequences := make([]uint64, 0, len(commitments))
+	for sequence := range commitments {
+		sequences = append(sequences, sequence)
+	}
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+
+	for _, sequence := range sequences {
+		if err := cs.VerifyPacketCommitment(
+			ctx, store, cdc, height, delayTimePeriod, delayBlockPeriod,
+			prefix, proof, portID, channelID, sequence, commitments[sequence],
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // VerifyPacketAcknowledgement verifies a proof of an incoming packet
 // acknowledgement at the specified port, specified channel, and specified sequence.
 func (cs ClientState) VerifyPacketAcknowledgement(
 	ctx sdk.Context,
 	store sdk.KVStore,
 	_ codec.BinaryCodec,
-	_ exported.Height,
+	height exported.Height,
 	_ uint64,
 	_ uint64,
 	_ exported.Prefix,
@@ -263,6 +409,10 @@ func (cs ClientState) VerifyPacketAcknowledgement(
 	sequence uint64,
 	acknowledgement []byte,
 ) error {
+	if err := cs.verifyHeight(height); err != nil {
+		return err
+	}
+
 	path := host.PacketAcknowledgementKey(portID, channelID, sequence)
 
 	data := store.Get(path)
@@ -270,10 +420,48 @@ func (cs ClientState) VerifyPacketAcknowledgement(
 		return sdkerrors.Wrapf(clienttypes.ErrFailedPacketAckVerification, "not found for path %s", path)
 	}
 
-	if !bytes.Equal(data, acknowledgement) {
+	ackCommitment := channeltypes.CommitAcknowledgement(acknowledgement)
+	if !bytes.Equal(data, ackCommitment) {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrFailedPacketAckVerification,
-			"ak bytes ≠ previous ack: \n%X\n≠\n%X", acknowledgement, data,
+			"ack commitment ≠ previous ack commitment: \n%X\n≠\n%X", ackCommitment, data,
+		)
+	}
+
+	return nil
+}
+
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketReceipt(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	if err := cs.verifyHeight(height); err != nil {
+		return err
+	}
+
+	path := host.PacketReceiptKey(portID, channelID, sequence)
+
+	data := store.Get(path)
+	if len(data) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedPacketReceiptVerification, "not found for path %s", path)
+	}
+
+	if !bytes.Equal(data, receipt) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedPacketReceiptVerification,
+			"receipt bytes ≠ previous receipt: \n%X\n≠\n%X", receipt, data,
 		)
 	}
 
@@ -287,7 +475,7 @@ func (cs ClientState) VerifyPacketReceiptAbsence(
 	ctx sdk.Context,
 	store sdk.KVStore,
 	_ codec.BinaryCodec,
-	_ exported.Height,
+	height exported.Height,
 	_ uint64,
 	_ uint64,
 	_ exported.Prefix,
@@ -296,6 +484,10 @@ func (cs ClientState) VerifyPacketReceiptAbsence(
 	channelID string,
 	sequence uint64,
 ) error {
+	if err := cs.verifyHeight(height); err != nil {
+		return err
+	}
+
 	path := host.PacketReceiptKey(portID, channelID, sequence)
 
 	data := store.Get(path)
@@ -312,7 +504,7 @@ func (cs ClientState) VerifyNextSequenceRecv(
 	ctx sdk.Context,
 	store sdk.KVStore,
 	_ codec.BinaryCodec,
-	_ exported.Height,
+	height exported.Height,
 	_ uint64,
 	_ uint64,
 	_ exported.Prefix,
@@ -321,6 +513,10 @@ func (cs ClientState) VerifyNextSequenceRecv(
 	channelID string,
 	nextSequenceRecv uint64,
 ) error {
+	if err := cs.verifyHeight(height); err != nil {
+		return err
+	}
+
 	path := host.NextSequenceRecvKey(portID, channelID)
 
 	data := store.Get(path)
@@ -338,3 +534,17 @@ func (cs ClientState) VerifyNextSequenceRecv(
 
 	return nil
 }
+
+// verifyHeight returns an error if the provided proof height is greater than the latest height of
+// the localhost client. The localhost client always verifies against the current state of the
+// executing chain and its latest height is the current height of the executing chain.
+func (cs ClientState) verifyHeight(height exported.Height) error {
+	if height.GT(cs.GetLatestHeight()) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"proof height %s is greater than the localhost client latest height %s", height, cs.GetLatestHeight(),
+		)
+	}
+
+	return nil
+}
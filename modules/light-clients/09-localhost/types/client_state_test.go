@@ -108,7 +108,7 @@ func (suite *LocalhostTestSuite) TestVerifyClientState() {
 			clientState: clientState,
 			malleate: func() {
 				bz := clienttypes.MustMarshalClientState(suite.cdc, clientState)
-				suite.store.Set(host.ClientStateKey(), bz)
+				suite.store.Set(host.FullClientStateKey(exported.Localhost), bz)
 			},
 			counterparty: clientState,
 			expPass:      true,
@@ -118,7 +118,7 @@ func (suite *LocalhostTestSuite) TestVerifyClientState() {
 			clientState: clientState,
 			malleate: func() {
 				bz := clienttypes.MustMarshalClientState(suite.cdc, clientState)
-				suite.store.Set(host.ClientStateKey(), bz)
+				suite.store.Set(host.FullClientStateKey(exported.Localhost), bz)
 			},
 			counterparty: invalidClient,
 			expPass:      false,
@@ -140,7 +140,7 @@ func (suite *LocalhostTestSuite) TestVerifyClientState() {
 			tc.malleate()
 
 			err := tc.clientState.VerifyClientState(
-				suite.store, suite.cdc, clienttypes.NewHeight(0, 10), nil, "", []byte{}, tc.counterparty,
+				suite.store, suite.cdc, clienttypes.NewHeight(0, 10), nil, exported.Localhost, []byte{}, tc.counterparty,
 			)
 
 			if tc.expPass {
@@ -244,7 +244,7 @@ func (suite *LocalhostTestSuite) TestVerifyConnectionState() {
 			tc.malleate()
 
 			err := tc.clientState.VerifyConnectionState(
-				suite.store, suite.cdc, clientHeight, nil, []byte{}, testConnectionID, &tc.connection,
+				suite.store, suite.cdc, clientHeight, nil, []byte{}, testConnectionID, tc.connection,
 			)
 
 			if tc.expPass {
@@ -318,7 +318,7 @@ func (suite *LocalhostTestSuite) TestVerifyChannelState() {
 			tc.malleate()
 
 			err := tc.clientState.VerifyChannelState(
-				suite.store, suite.cdc, clientHeight, nil, []byte{}, testPortID, testChannelID, &tc.channel,
+				suite.store, suite.cdc, clientHeight, nil, []byte{}, testPortID, testChannelID, tc.channel,
 			)
 
 			if tc.expPass {
@@ -367,6 +367,17 @@ func (suite *LocalhostTestSuite) TestVerifyPacketCommitment() {
 			commitment:  []byte{},
 			expPass:     false,
 		},
+		{
+			name:        "proof verification failed: proof height greater than client height",
+			clientState: types.NewClientState("chainID", clienttypes.NewHeight(0, clientHeight.RevisionHeight-1)),
+			malleate: func() {
+				suite.store.Set(
+					host.PacketCommitmentKey(testPortID, testChannelID, testSequence), []byte("commitment"),
+				)
+			},
+			commitment: []byte("commitment"),
+			expPass:    false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -402,7 +413,7 @@ func (suite *LocalhostTestSuite) TestVerifyPacketAcknowledgement() {
 			clientState: types.NewClientState("chainID", clientHeight),
 			malleate: func() {
 				suite.store.Set(
-					host.PacketAcknowledgementKey(testPortID, testChannelID, testSequence), []byte("acknowledgement"),
+					host.PacketAcknowledgementKey(testPortID, testChannelID, testSequence), channeltypes.CommitAcknowledgement([]byte("acknowledgement")),
 				)
 			},
 			ack:     []byte("acknowledgement"),
@@ -0,0 +1,7 @@
+/*
+Package committee implements a concrete `ClientState`, `ConsensusState`,
+`Header` and `Misbehaviour` types for counterparties, such as permissioned
+bridges, whose state roots are signed by a fixed, weighted committee. State is
+verified with ICS-23 proofs against the signed roots.
+*/
+package committee
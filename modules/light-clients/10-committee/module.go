@@ -0,0 +1,10 @@
+package committee
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+)
+
+// Name returns the IBC client name
+func Name() string {
+	return types.SubModuleName
+}
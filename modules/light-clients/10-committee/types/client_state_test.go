@@ -0,0 +1,956 @@
+package types_test
+
+import (
+	"time"
+
+	ics23 "github.com/confio/ics23/go"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+const (
+	testClientID = "clientidone"
+)
+
+var (
+	invalidProof = []byte("invalid proof")
+)
+
+// setupCommitteeClient creates a committee client on chainA tracking chainB, the chain of
+// endpoint B of the path, and returns the endpoint associated with the committee client
+// along with its client state. The consensus state of the committee client is at the
+// height of the proofs returned by chainB.QueryProof.
+func (suite *CommitteeTestSuite) setupCommitteeClient(path *ibctesting.Path) (*ibctesting.Endpoint, *types.ClientState) {
+	endpoint := suite.createCommitteeClient(path.EndpointB)
+
+	clientState, ok := suite.chainA.GetClientState(endpoint.ClientID).(*types.ClientState)
+	suite.Require().True(ok)
+
+	return endpoint, clientState
+}
+
+func (suite *CommitteeTestSuite) TestStatus() {
+	var (
+		endpoint    *ibctesting.Endpoint
+		clientState *types.ClientState
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{"client is active", func() {}, exported.Active},
+		{"client is frozen", func() {
+			clientState.FrozenHeight = types.FrozenHeight
+			endpoint.SetClientState(clientState)
+		}, exported.Frozen},
+		{"client status is unknown", func() {
+			clientState.LatestHeight = clientState.LatestHeight.Increment().(clienttypes.Height)
+			endpoint.SetClientState(clientState)
+		}, exported.Unknown},
+		{"client does not expire", func() {
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + ibctesting.UnbondingPeriod)
+		}, exported.Active},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			endpoint = suite.createCommitteeClient(ibctesting.NewDefaultEndpoint(suite.chainB))
+			clientState = suite.chainA.GetClientState(endpoint.ClientID).(*types.ClientState)
+
+			tc.malleate()
+
+			status := clientState.Status(suite.chainA.GetContext(), suite.clientStore(endpoint), suite.chainA.App.AppCodec())
+			suite.Require().Equal(tc.expStatus, status)
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestValidate() {
+	var clientState *types.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid client", func() {}, true,
+		},
+		{
+			"invalid chainID", func() {
+				clientState.ChainId = "  "
+			}, false,
+		},
+		{
+			"invalid committee", func() {
+				clientState.Committee.Threshold = 0
+			}, false,
+		},
+		{
+			"invalid latest height", func() {
+				clientState.LatestHeight = clienttypes.ZeroHeight()
+			}, false,
+		},
+		{
+			"invalid proof specs", func() {
+				clientState.ProofSpecs = nil
+			}, false,
+		},
+		{
+			"invalid proof spec", func() {
+				clientState.ProofSpecs = []*ics23.ProofSpec{ics23.IavlSpec, nil}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientState = types.NewClientState(
+				suite.chainB.ChainID, suite.committee.Committee(), clienttypes.NewHeight(0, 1),
+				commitmenttypes.GetSDKSpecs(), false,
+			)
+
+			tc.malleate()
+
+			err := clientState.Validate()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestInitialize() {
+	testCases := []struct {
+		name           string
+		consensusState exported.ConsensusState
+		expPass        bool
+	}{
+		{
+			name:           "valid consensus",
+			consensusState: suite.committee.CreateCounterpartyHeader(suite.chainB).ConsensusState(),
+			expPass:        true,
+		},
+		{
+			name:           "invalid consensus: consensus state is tendermint consensus state",
+			consensusState: suite.chainB.LastHeader.ConsensusState(),
+			expPass:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		clientState := types.NewClientState(
+			suite.chainB.ChainID, suite.committee.Committee(), suite.chainB.LastHeader.GetHeight().(clienttypes.Height),
+			commitmenttypes.GetSDKSpecs(), false,
+		)
+		store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), testClientID)
+
+		err := clientState.Initialize(suite.chainA.GetContext(), suite.chainA.Codec, store, tc.consensusState)
+
+		if tc.expPass {
+			suite.Require().NoError(err, "valid case returned an error")
+			_, found := types.GetProcessedTime(store, clientState.LatestHeight)
+			suite.Require().True(found)
+		} else {
+			suite.Require().Error(err, "invalid case didn't return an error")
+		}
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifyUpgradeAndUpdateState() {
+	endpoint := suite.createCommitteeClient(ibctesting.NewDefaultEndpoint(suite.chainB))
+	clientState := suite.chainA.GetClientState(endpoint.ClientID).(*types.ClientState)
+	consensusState := suite.committee.CreateCounterpartyHeader(suite.chainB).ConsensusState()
+
+	_, _, err := clientState.VerifyUpgradeAndUpdateState(
+		suite.chainA.GetContext(), suite.chainA.Codec, suite.clientStore(endpoint),
+		clientState, consensusState, nil, nil,
+	)
+	suite.Require().Error(err)
+}
+
+func (suite *CommitteeTestSuite) TestVerifyClientState() {
+	var (
+		clientState *types.ClientState
+		proof       []byte
+		proofHeight exported.Height
+		prefix      commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			var endpoint *ibctesting.Endpoint
+			endpoint, clientState = suite.setupCommitteeClient(path)
+			counterpartyClientState := path.EndpointB.GetClientState()
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make client state proof
+			clientKey := host.FullClientStateKey(path.EndpointB.ClientID)
+			proof, proofHeight = suite.chainB.QueryProof(clientKey)
+
+			tc.malleate() // make changes as necessary
+
+			err := clientState.VerifyClientState(
+				suite.clientStore(endpoint), suite.chainA.Codec, proofHeight, &prefix, path.EndpointB.ClientID, proof, counterpartyClientState,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifyClientConsensusState() {
+	var (
+		clientState *types.ClientState
+		proof       []byte
+		proofHeight exported.Height
+		prefix      commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			var endpoint *ibctesting.Endpoint
+			endpoint, clientState = suite.setupCommitteeClient(path)
+			consensusHeight := path.EndpointB.GetClientState().GetLatestHeight()
+			consensusState := path.EndpointB.GetConsensusState(consensusHeight)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make consensus state proof
+			consensusKey := host.FullConsensusStateKey(path.EndpointB.ClientID, consensusHeight)
+			proof, proofHeight = suite.chainB.QueryProof(consensusKey)
+
+			tc.malleate() // make changes as necessary
+
+			err := clientState.VerifyClientConsensusState(
+				suite.clientStore(endpoint), suite.chainA.Codec, proofHeight, path.EndpointB.ClientID, consensusHeight, &prefix, proof, consensusState,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifyConnectionState() {
+	var (
+		clientState *types.ClientState
+		proof       []byte
+		proofHeight exported.Height
+		prefix      commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+			connection := path.EndpointB.GetConnection()
+
+			var endpoint *ibctesting.Endpoint
+			endpoint, clientState = suite.setupCommitteeClient(path)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make connection proof
+			connectionKey := host.ConnectionKey(path.EndpointB.ConnectionID)
+			proof, proofHeight = suite.chainB.QueryProof(connectionKey)
+
+			tc.malleate() // make changes as necessary
+
+			err := clientState.VerifyConnectionState(
+				suite.clientStore(endpoint), suite.chainA.Codec, proofHeight, &prefix, proof, path.EndpointB.ConnectionID, connection,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifyChannelState() {
+	var (
+		clientState *types.ClientState
+		proof       []byte
+		proofHeight exported.Height
+		prefix      commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			channel := path.EndpointB.GetChannel()
+
+			var endpoint *ibctesting.Endpoint
+			endpoint, clientState = suite.setupCommitteeClient(path)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make channel proof
+			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			proof, proofHeight = suite.chainB.QueryProof(channelKey)
+
+			tc.malleate() // make changes as necessary
+
+			err := clientState.VerifyChannelState(
+				suite.clientStore(endpoint), suite.chainA.Codec, proofHeight, &prefix, proof,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, channel,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifyPacketCommitment() {
+	var (
+		clientState      *types.ClientState
+		proof            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		prefix           commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			name: "delay time period has passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+			},
+			expPass: true,
+		},
+		{
+			name: "delay time period has not passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			expPass: false,
+		},
+		{
+			name: "delay block period has passed",
+			malleate: func() {
+				delayBlockPeriod = 1
+			},
+			expPass: true,
+		},
+		{
+			name: "delay block period has not passed",
+			malleate: func() {
+				delayBlockPeriod = 1000
+			},
+			expPass: false,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+			err := path.EndpointB.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			var endpoint *ibctesting.Endpoint
+			endpoint, clientState = suite.setupCommitteeClient(path)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make packet commitment proof
+			packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight = suite.chainB.QueryProof(packetKey)
+
+			// advance the time and height of chainA past the processed time and height of the consensus state
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			commitment := channeltypes.CommitPacket(suite.chainA.App.GetIBCKeeper().Codec(), packet)
+			err = clientState.VerifyPacketCommitment(
+				suite.chainA.GetContext(), suite.clientStore(endpoint), suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod, &prefix, proof,
+				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifyPacketCommitments() {
+	var (
+		clientState *types.ClientState
+		proof       []byte
+		proofHeight exported.Height
+		prefix      commitmenttypes.MerklePrefix
+		commitments map[uint64][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"commitment does not match", func() {
+				commitments[2] = []byte("invalid commitment")
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			commitments = make(map[uint64][]byte)
+			var packetKeys [][]byte
+			for seq := uint64(1); seq <= 3; seq++ {
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, seq, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+				err := path.EndpointB.SendPacket(packet)
+				suite.Require().NoError(err)
+
+				commitments[seq] = channeltypes.CommitPacket(suite.chainA.App.GetIBCKeeper().Codec(), packet)
+				packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			}
+
+			var endpoint *ibctesting.Endpoint
+			endpoint, clientState = suite.setupCommitteeClient(path)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make batch packet commitment proof
+			proof, proofHeight = suite.chainB.QueryBatchProof(packetKeys)
+
+			tc.malleate() // make changes as necessary
+
+			err := clientState.VerifyPacketCommitments(
+				suite.chainA.GetContext(), suite.clientStore(endpoint), suite.chainA.Codec, proofHeight, 0, 0, &prefix, proof,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, commitments,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifyPacketAcknowledgement() {
+	var (
+		clientState *types.ClientState
+		proof       []byte
+		proofHeight exported.Height
+		prefix      commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			// send packet
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			// write receipt and ack
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			var endpoint *ibctesting.Endpoint
+			endpoint, clientState = suite.setupCommitteeClient(path)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make packet acknowledgement proof
+			acknowledgementKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			proof, proofHeight = suite.chainB.QueryProof(acknowledgementKey)
+
+			tc.malleate() // make changes as necessary
+
+			err = clientState.VerifyPacketAcknowledgement(
+				suite.chainA.GetContext(), suite.clientStore(endpoint), suite.chainA.Codec, proofHeight, 0, 0, &prefix, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), ibcmock.MockAcknowledgement.Acknowledgement(),
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifyPacketReceipt() {
+	var (
+		clientState *types.ClientState
+		proof       []byte
+		proofHeight exported.Height
+		prefix      commitmenttypes.MerklePrefix
+		receipt     []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"receipt does not match", func() {
+				receipt = []byte("invalid receipt")
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0)
+
+			// send packet and recv after it has timed out
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			var endpoint *ibctesting.Endpoint
+			endpoint, clientState = suite.setupCommitteeClient(path)
+
+			prefix = suite.chainB.GetPrefix()
+			receipt = channeltypes.TimeoutReceipt
+
+			// make packet receipt proof
+			receiptKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			proof, proofHeight = suite.chainB.QueryProof(receiptKey)
+
+			tc.malleate() // make changes as necessary
+
+			err = clientState.VerifyPacketReceipt(
+				suite.chainA.GetContext(), suite.clientStore(endpoint), suite.chainA.Codec, proofHeight, 0, 0, &prefix, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), receipt,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifyPacketReceiptAbsence() {
+	var (
+		clientState *types.ClientState
+		proof       []byte
+		proofHeight exported.Height
+		prefix      commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			// send packet, but no recv
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			var endpoint *ibctesting.Endpoint
+			endpoint, clientState = suite.setupCommitteeClient(path)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make packet receipt absence proof
+			receiptKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			proof, proofHeight = suite.chainB.QueryProof(receiptKey)
+
+			tc.malleate() // make changes as necessary
+
+			err = clientState.VerifyPacketReceiptAbsence(
+				suite.chainA.GetContext(), suite.clientStore(endpoint), suite.chainA.Codec, proofHeight, 0, 0, &prefix, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifyNextSeqRecv() {
+	var (
+		clientState *types.ClientState
+		proof       []byte
+		proofHeight exported.Height
+		prefix      commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			// send packet
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			// next seq recv incremented
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			var endpoint *ibctesting.Endpoint
+			endpoint, clientState = suite.setupCommitteeClient(path)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make next seq recv proof
+			nextSeqRecvKey := host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+			proof, proofHeight = suite.chainB.QueryProof(nextSeqRecvKey)
+
+			tc.malleate() // make changes as necessary
+
+			err = clientState.VerifyNextSequenceRecv(
+				suite.chainA.GetContext(), suite.clientStore(endpoint), suite.chainA.Codec, proofHeight, 0, 0, &prefix, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()+1,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/internal/signerset"
+)
+
+// Interface implementation checks.
+var _, _, _, _, _ codectypes.UnpackInterfacesMessage = &ClientState{}, &Committee{}, &Member{}, &Header{}, &Misbehaviour{}
+
+// NewMember creates a new Member instance.
+func NewMember(publicKey cryptotypes.PubKey, weight uint64) (Member, error) {
+	anyPublicKey, err := codectypes.NewAnyWithValue(publicKey)
+	if err != nil {
+		return Member{}, err
+	}
+
+	return Member{
+		PublicKey: anyPublicKey,
+		Weight:    weight,
+	}, nil
+}
+
+// GetPubKey unmarshals the public key into a cryptotypes.PubKey type.
+// An error is returned if the public key is nil or the cached value
+// is not a PubKey.
+func (m Member) GetPubKey() (cryptotypes.PubKey, error) {
+	if m.PublicKey == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidCommittee, "member PublicKey cannot be nil")
+	}
+
+	publicKey, ok := m.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCommittee, "member PublicKey is not cryptotypes.PubKey")
+	}
+
+	return publicKey, nil
+}
+
+// GetWeight returns the weight of the member.
+func (m Member) GetWeight() uint64 {
+	return m.Weight
+}
+
+// NewCommittee creates a new Committee instance.
+func NewCommittee(members []Member, threshold uint64) Committee {
+	return Committee{
+		Members:   members,
+		Threshold: threshold,
+	}
+}
+
+// ValidateBasic ensures that the committee is non-empty, that every member has a non-zero
+// weight and a unique, non-multisig public key, and that the threshold is non-zero and
+// reachable by the total weight of the members.
+func (c Committee) ValidateBasic() error {
+	if err := signerset.ValidateSignerSet(c.signers(), c.Threshold); err != nil {
+		return sdkerrors.Wrap(ErrInvalidCommittee, err.Error())
+	}
+
+	return nil
+}
+
+// VerifySignatures verifies that the signatures are valid signatures over the sign bytes by
+// members of the committee whose combined weight meets the threshold of the committee.
+// The signatures must be ordered by strictly increasing member index.
+func (c Committee) VerifySignatures(signBytes []byte, signatures []MemberSignature) error {
+	indexedSignatures := make([]signerset.IndexedSignature, len(signatures))
+	for i, sig := range signatures {
+		indexedSignatures[i] = signerset.IndexedSignature{SignerIndex: sig.MemberIndex, Signature: sig.Signature}
+	}
+
+	return signerset.VerifySignatures(c.signers(), c.Threshold, signBytes, indexedSignatures)
+}
+
+// signers returns the members of the committee as the signers of a weighted signer set.
+func (c Committee) signers() []signerset.Signer {
+	signers := make([]signerset.Signer, len(c.Members))
+	for i, member := range c.Members {
+		signers[i] = member
+	}
+
+	return signers
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (cs ClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return cs.Committee.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (c Committee) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, member := range c.Members {
+		if err := member.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m Member) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(m.PublicKey, new(cryptotypes.PubKey))
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (h Header) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if h.NewCommittee == nil {
+		return nil
+	}
+
+	return h.NewCommittee.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (misbehaviour Misbehaviour) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if misbehaviour.Header1 != nil {
+		if err := misbehaviour.Header1.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	if misbehaviour.Header2 != nil {
+		return misbehaviour.Header2.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
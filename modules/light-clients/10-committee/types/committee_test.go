@@ -0,0 +1,253 @@
+package types_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type CommitteeTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	// committee signing the state roots of chainB, the first member along
+	// with any other member is required to sign
+	committee *ibctesting.Committee
+}
+
+func (suite *CommitteeTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	// commit some blocks so that QueryProof returns valid proof (cannot return valid query if height <= 1)
+	suite.coordinator.CommitNBlocks(suite.chainA, 2)
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+
+	suite.committee = ibctesting.NewCommittee(suite.T(), suite.chainA.Codec, []uint64{3, 1, 1, 1}, 4)
+}
+
+func TestCommitteeTestSuite(t *testing.T) {
+	suite.Run(t, new(CommitteeTestSuite))
+}
+
+// createCommitteeClient creates a committee client on chainA tracking the chain of the
+// counterparty endpoint. The returned endpoint is associated with the committee client.
+func (suite *CommitteeTestSuite) createCommitteeClient(counterparty *ibctesting.Endpoint) *ibctesting.Endpoint {
+	endpoint := ibctesting.NewEndpoint(
+		suite.chainA, ibctesting.NewCommitteeConfig(suite.committee),
+		ibctesting.NewConnectionConfig(), ibctesting.NewChannelConfig(),
+	)
+	endpoint.Counterparty = counterparty
+
+	err := endpoint.CreateClient()
+	suite.Require().NoError(err)
+
+	return endpoint
+}
+
+// clientStore returns the client store of the client associated with the endpoint.
+func (suite *CommitteeTestSuite) clientStore(endpoint *ibctesting.Endpoint) sdk.KVStore {
+	return suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), endpoint.ClientID)
+}
+
+func (suite *CommitteeTestSuite) TestCommitteeValidateBasic() {
+	var committee types.Committee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid committee", func() {}, true,
+		},
+		{
+			"threshold equal to the total weight", func() {
+				committee.Threshold = 6
+			}, true,
+		},
+		{
+			"empty members", func() {
+				committee.Members = nil
+			}, false,
+		},
+		{
+			"nil public key", func() {
+				committee.Members[1].PublicKey = nil
+			}, false,
+		},
+		{
+			"public key is not a public key", func() {
+				anyMember, err := codectypes.NewAnyWithValue(&committee.Members[0])
+				suite.Require().NoError(err)
+
+				committee.Members[1].PublicKey = anyMember
+			}, false,
+		},
+		{
+			"multisig public key", func() {
+				multisigKey := kmultisig.NewLegacyAminoPubKey(2, suite.committee.PublicKeys[:2])
+				member, err := types.NewMember(multisigKey, 1)
+				suite.Require().NoError(err)
+
+				committee.Members[1] = member
+			}, false,
+		},
+		{
+			"duplicate public key", func() {
+				committee.Members[1].PublicKey = committee.Members[0].PublicKey
+			}, false,
+		},
+		{
+			"zero weight", func() {
+				committee.Members[1].Weight = 0
+			}, false,
+		},
+		{
+			"total weight overflows", func() {
+				committee.Members[1].Weight = ^uint64(0)
+			}, false,
+		},
+		{
+			"zero threshold", func() {
+				committee.Threshold = 0
+			}, false,
+		},
+		{
+			"threshold greater than the total weight", func() {
+				committee.Threshold = 7
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			committee = suite.committee.Committee()
+
+			tc.malleate()
+
+			err := committee.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestVerifySignatures() {
+	var (
+		signBytes  []byte
+		signatures []types.MemberSignature
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"signed by all members", func() {}, true,
+		},
+		{
+			"signed by a quorum", func() {
+				signatures = suite.committee.GenerateSignatures(signBytes, 0, 3)
+			}, true,
+		},
+		{
+			"signing weight is less than the threshold", func() {
+				signatures = suite.committee.GenerateSignatures(signBytes, 1, 2, 3)
+			}, false,
+		},
+		{
+			"empty signatures", func() {
+				signatures = nil
+			}, false,
+		},
+		{
+			"duplicate member", func() {
+				signatures = suite.committee.GenerateSignatures(signBytes, 0, 0)
+			}, false,
+		},
+		{
+			"member indices are not increasing", func() {
+				signatures = suite.committee.GenerateSignatures(signBytes, 1, 0)
+			}, false,
+		},
+		{
+			"member index out of range", func() {
+				signatures[3].MemberIndex = 4
+			}, false,
+		},
+		{
+			"signature over different bytes", func() {
+				signatures = suite.committee.GenerateSignatures([]byte("different sign bytes"))
+			}, false,
+		},
+		{
+			"signature by a key not in the committee", func() {
+				otherCommittee := ibctesting.NewCommittee(suite.T(), suite.chainA.Codec, []uint64{3, 1, 1, 1}, 4)
+				signatures = otherCommittee.GenerateSignatures(signBytes)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			signBytes = []byte("sign bytes")
+			signatures = suite.committee.GenerateSignatures(signBytes)
+
+			tc.malleate()
+
+			err := suite.committee.Committee().VerifySignatures(signBytes, signatures)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestUnpackInterfaces() {
+	newCommittee := ibctesting.NewCommittee(suite.T(), suite.chainA.Codec, []uint64{1}, 1).Committee()
+	header := suite.committee.CreateHeader(suite.chainB.ChainID, clienttypes.NewHeight(0, 10), 1, []byte("root"), &newCommittee)
+
+	bz, err := suite.chainA.Codec.MarshalInterface(header)
+	suite.Require().NoError(err)
+
+	var headerI exported.Header
+	err = suite.chainA.Codec.UnmarshalInterface(bz, &headerI)
+	suite.Require().NoError(err)
+
+	unpackedHeader, ok := headerI.(*types.Header)
+	suite.Require().True(ok)
+
+	publicKey, err := unpackedHeader.NewCommittee.Members[0].GetPubKey()
+	suite.Require().NoError(err)
+	suite.Require().Equal(newCommittee.Members[0].PublicKey.GetCachedValue(), publicKey)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.ConsensusState = &ConsensusState{}
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(timestamp uint64, root commitmenttypes.MerkleRoot) *ConsensusState {
+	return &ConsensusState{
+		Timestamp: timestamp,
+		Root:      root,
+	}
+}
+
+// ClientType returns Committee
+func (ConsensusState) ClientType() string {
+	return exported.Committee
+}
+
+// GetRoot returns the commitment root signed by the committee.
+func (cs ConsensusState) GetRoot() exported.Root {
+	return cs.Root
+}
+
+// GetTimestamp returns the timestamp in nanoseconds signed by the committee.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// ValidateBasic defines a basic validation for the committee consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.Root.Empty() {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "root cannot be empty")
+	}
+	if cs.Timestamp == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be 0")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+)
+
+func (suite *CommitteeTestSuite) TestConsensusStateValidateBasic() {
+	testCases := []struct {
+		msg            string
+		consensusState *types.ConsensusState
+		expectPass     bool
+	}{
+		{
+			"success",
+			types.NewConsensusState(1, suite.chainB.LastHeader.ConsensusState().Root),
+			true,
+		},
+		{
+			"timestamp is zero",
+			types.NewConsensusState(0, suite.chainB.LastHeader.ConsensusState().Root),
+			false,
+		},
+		{
+			"root is empty",
+			&types.ConsensusState{Timestamp: 1},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		// check just to increase coverage
+		suite.Require().Equal(exported.Committee, tc.consensusState.ClientType())
+		suite.Require().Equal(tc.consensusState.GetRoot(), tc.consensusState.Root)
+
+		err := tc.consensusState.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
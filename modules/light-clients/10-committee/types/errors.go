@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/internal/signerset"
+)
+
+const (
+	SubModuleName = "committee-client"
+)
+
+// IBC committee client sentinel errors
+var (
+	ErrInvalidChainID          = sdkerrors.Register(SubModuleName, 2, "invalid chain-id")
+	ErrInvalidCommittee        = sdkerrors.Register(SubModuleName, 3, "invalid committee")
+	ErrInvalidHeaderHeight     = sdkerrors.Register(SubModuleName, 4, "invalid header height")
+	ErrInvalidProofSpecs       = sdkerrors.Register(SubModuleName, 5, "invalid proof specs")
+	ErrProcessedTimeNotFound   = sdkerrors.Register(SubModuleName, 6, "processed time not found")
+	ErrProcessedHeightNotFound = sdkerrors.Register(SubModuleName, 7, "processed height not found")
+	ErrDelayPeriodNotPassed    = sdkerrors.Register(SubModuleName, 8, "packet-specified delay period has not been reached")
+)
+
+// The signature errors are the errors of the weighted signer set shared with the v3 solo machine client.
+var (
+	ErrInvalidSignatures         = signerset.ErrInvalidSignatures
+	ErrSignatureVerification     = signerset.ErrSignatureVerification
+	ErrInsufficientSigningWeight = signerset.ErrInsufficientSigningWeight
+)
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// expected export ordering:
+// processed height and processed time per height
+func (suite *CommitteeTestSuite) TestExportMetadata() {
+	// test intializing client and exporting metadata
+	endpoint := suite.createCommitteeClient(ibctesting.NewDefaultEndpoint(suite.chainB))
+	clientStore := suite.clientStore(endpoint)
+	clientState := endpoint.GetClientState()
+	height := clientState.GetLatestHeight()
+
+	initProcessedTime, found := types.GetProcessedTime(clientStore, height)
+	suite.Require().True(found)
+	initProcessedHeight, found := types.GetProcessedHeight(clientStore, height)
+	suite.Require().True(found)
+
+	gm := clientState.ExportMetadata(suite.clientStore(endpoint))
+	suite.Require().NotNil(gm, "client with metadata returned nil exported metadata")
+	suite.Require().Len(gm, 2, "exported metadata has unexpected length")
+
+	suite.Require().Equal(types.ProcessedHeightKey(height), gm[0].GetKey(), "metadata has unexpected key")
+	actualProcessedHeight, err := clienttypes.ParseHeight(string(gm[0].GetValue()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(initProcessedHeight, actualProcessedHeight, "metadata has unexpected value")
+
+	suite.Require().Equal(types.ProcessedTimeKey(height), gm[1].GetKey(), "metadata has unexpected key")
+	suite.Require().Equal(initProcessedTime, sdk.BigEndianToUint64(gm[1].GetValue()), "metadata has unexpected value")
+
+	// test updating client and exporting metadata
+	err = endpoint.UpdateClient()
+	suite.Require().NoError(err)
+
+	clientState = endpoint.GetClientState()
+	updateHeight := clientState.GetLatestHeight()
+
+	processedTime, found := types.GetProcessedTime(clientStore, updateHeight)
+	suite.Require().True(found)
+	processedHeight, found := types.GetProcessedHeight(clientStore, updateHeight)
+	suite.Require().True(found)
+
+	gm = clientState.ExportMetadata(suite.clientStore(endpoint))
+	suite.Require().NotNil(gm, "client with metadata returned nil exported metadata")
+	suite.Require().Len(gm, 4, "exported metadata has unexpected length")
+
+	// check the metadata of the update height, the keys of the initial height sort first
+	suite.Require().Equal(types.ProcessedHeightKey(updateHeight), gm[2].GetKey(), "metadata has unexpected key")
+	actualProcessedHeight, err = clienttypes.ParseHeight(string(gm[2].GetValue()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(processedHeight, actualProcessedHeight, "metadata has unexpected value")
+
+	suite.Require().Equal(types.ProcessedTimeKey(updateHeight), gm[3].GetKey(), "metadata has unexpected key")
+	suite.Require().Equal(processedTime, sdk.BigEndianToUint64(gm[3].GetValue()), "metadata has unexpected value")
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Header = &Header{}
+
+// ConsensusState returns the consensus state associated with the header
+func (h Header) ConsensusState() *ConsensusState {
+	return NewConsensusState(h.Timestamp, commitmenttypes.NewMerkleRoot(h.Root))
+}
+
+// ClientType defines that the Header is signed by a committee
+func (Header) ClientType() string {
+	return exported.Committee
+}
+
+// GetHeight returns the height of the counterparty signed by the committee.
+func (h Header) GetHeight() exported.Height {
+	return h.Height
+}
+
+// ValidateBasic ensures that the height, timestamp and root are not empty, that the
+// new committee, if set, is valid and that the signatures are non-empty and ordered
+// by strictly increasing member index.
+func (h Header) ValidateBasic() error {
+	if h.Height.RevisionHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeaderHeight, "header revision height cannot be zero")
+	}
+
+	if h.Timestamp == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "timestamp cannot be zero")
+	}
+
+	if len(h.Root) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "root cannot be empty")
+	}
+
+	if h.NewCommittee != nil {
+		if err := h.NewCommittee.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "new committee failed basic validation")
+		}
+	}
+
+	if len(h.Signatures) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignatures, "signatures cannot be empty")
+	}
+
+	for i, sig := range h.Signatures {
+		if len(sig.Signature) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidSignatures, "signature of member %d cannot be empty", sig.MemberIndex)
+		}
+
+		if i > 0 && sig.MemberIndex <= h.Signatures[i-1].MemberIndex {
+			return sdkerrors.Wrapf(ErrInvalidSignatures, "member indices must be strictly increasing (%d <= %d)", sig.MemberIndex, h.Signatures[i-1].MemberIndex)
+		}
+	}
+
+	return nil
+}
+
+// HeaderSignBytes returns the bytes signed by the committee members for the header
+// of the counterparty with the provided chain-id.
+func HeaderSignBytes(cdc codec.BinaryCodec, chainID string, header *Header) ([]byte, error) {
+	signBytes := &SignBytes{
+		ChainId:      chainID,
+		Height:       header.Height,
+		Timestamp:    header.Timestamp,
+		Root:         header.Root,
+		NewCommittee: header.NewCommittee,
+	}
+
+	return cdc.Marshal(signBytes)
+}
@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+)
+
+func (suite *CommitteeTestSuite) TestHeaderValidateBasic() {
+	var header *types.Header
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid header", func() {}, true,
+		},
+		{
+			"valid header with new committee", func() {
+				newCommittee := suite.committee.Committee()
+				newCommittee.Threshold = 6
+				header.NewCommittee = &newCommittee
+			}, true,
+		},
+		{
+			"revision height is zero", func() {
+				header.Height = clienttypes.NewHeight(1, 0)
+			}, false,
+		},
+		{
+			"timestamp is zero", func() {
+				header.Timestamp = 0
+			}, false,
+		},
+		{
+			"root is empty", func() {
+				header.Root = nil
+			}, false,
+		},
+		{
+			"new committee is invalid", func() {
+				header.NewCommittee = &types.Committee{}
+			}, false,
+		},
+		{
+			"signatures are empty", func() {
+				header.Signatures = nil
+			}, false,
+		},
+		{
+			"signature is empty", func() {
+				header.Signatures[1].Signature = nil
+			}, false,
+		},
+		{
+			"member indices are not increasing", func() {
+				header.Signatures[1].MemberIndex = header.Signatures[0].MemberIndex
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			header = suite.committee.CreateCounterpartyHeader(suite.chainB)
+			suite.Require().Equal(exported.Committee, header.ClientType())
+
+			tc.malleate()
+
+			err := header.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Misbehaviour = &Misbehaviour{}
+
+// FrozenHeight is used for all misbehaviour since it is only used as a boolean value (zero or non-zero)
+var FrozenHeight = clienttypes.NewHeight(0, 1)
+
+// NewMisbehaviour creates a new Misbehaviour instance.
+func NewMisbehaviour(clientID string, header1, header2 *Header) *Misbehaviour {
+	return &Misbehaviour{
+		ClientId: clientID,
+		Header1:  header1,
+		Header2:  header2,
+	}
+}
+
+// ClientType is Committee light client
+func (misbehaviour Misbehaviour) ClientType() string {
+	return exported.Committee
+}
+
+// GetClientID returns the ID of the client that committed a misbehaviour.
+func (misbehaviour Misbehaviour) GetClientID() string {
+	return misbehaviour.ClientId
+}
+
+// ValidateBasic implements Misbehaviour interface. It ensures that both headers are valid
+// and at the same height. The headers are checked to conflict when the signatures are verified.
+func (misbehaviour Misbehaviour) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(misbehaviour.ClientId); err != nil {
+		return sdkerrors.Wrap(err, "misbehaviour client ID is invalid")
+	}
+
+	if misbehaviour.Header1 == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "misbehaviour Header1 cannot be nil")
+	}
+	if misbehaviour.Header2 == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "misbehaviour Header2 cannot be nil")
+	}
+
+	if err := misbehaviour.Header1.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(
+			clienttypes.ErrInvalidMisbehaviour,
+			sdkerrors.Wrap(err, "header 1 failed validation").Error(),
+		)
+	}
+	if err := misbehaviour.Header2.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(
+			clienttypes.ErrInvalidMisbehaviour,
+			sdkerrors.Wrap(err, "header 2 failed validation").Error(),
+		)
+	}
+
+	if !misbehaviour.Header1.Height.EQ(misbehaviour.Header2.Height) {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidMisbehaviour, "headers must be at the same height (%s != %s)", misbehaviour.Header1.Height, misbehaviour.Header2.Height)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// CheckMisbehaviourAndUpdateState determines whether or not the committee of the client
+// state signed two conflicting headers at the same height. The headers conflict if they
+// differ in their timestamp, root or new committee.
+//
+// NOTE: misbehaviour of a committee which has since been rotated out by a header cannot be
+// detected, since only the committee of the client state is trusted.
+// Misbehaviour sets frozen height to {0, 1} since it is only used as a boolean value (zero or non-zero).
+func (cs ClientState) CheckMisbehaviourAndUpdateState(
+	ctx sdk.Context,
+	cdc codec.BinaryCodec,
+	clientStore sdk.KVStore,
+	misbehaviour exported.Misbehaviour,
+) (exported.ClientState, error) {
+	committeeMisbehaviour, ok := misbehaviour.(*Misbehaviour)
+	if !ok {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected type %T, got %T", &Misbehaviour{}, misbehaviour)
+	}
+
+	// The status of the client is checked in 02-client
+
+	signBytes1, err := HeaderSignBytes(cdc, cs.ChainId, committeeMisbehaviour.Header1)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes2, err := HeaderSignBytes(cdc, cs.ChainId, committeeMisbehaviour.Header2)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: header heights are checked to be equal in misbehaviour.ValidateBasic by the
+	// client keeper and msg.ValidateBasic by the base application.
+	if bytes.Equal(signBytes1, signBytes2) {
+		return nil, sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "headers are not conflicting")
+	}
+
+	if err := cs.Committee.VerifySignatures(signBytes1, committeeMisbehaviour.Header1.Signatures); err != nil {
+		return nil, sdkerrors.Wrap(err, "verifying Header1 in Misbehaviour failed")
+	}
+
+	if err := cs.Committee.VerifySignatures(signBytes2, committeeMisbehaviour.Header2.Signatures); err != nil {
+		return nil, sdkerrors.Wrap(err, "verifying Header2 in Misbehaviour failed")
+	}
+
+	cs.FrozenHeight = FrozenHeight
+
+	return &cs, nil
+}
@@ -0,0 +1,143 @@
+package types_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *CommitteeTestSuite) TestCheckMisbehaviourAndUpdateState() {
+	var (
+		clientState  *types.ClientState
+		misbehaviour exported.Misbehaviour
+		header1      *types.Header
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid misbehaviour with conflicting roots", func() {
+				header2 := suite.committee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp, []byte("conflicting root"), nil)
+				misbehaviour = types.NewMisbehaviour(testClientID, header1, header2)
+			}, true,
+		},
+		{
+			"valid misbehaviour with conflicting timestamps", func() {
+				header2 := suite.committee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp+1, header1.Root, nil)
+				misbehaviour = types.NewMisbehaviour(testClientID, header1, header2)
+			}, true,
+		},
+		{
+			"valid misbehaviour with conflicting committee rotations", func() {
+				rotated := ibctesting.NewCommittee(suite.T(), suite.chainA.Codec, []uint64{1}, 1).Committee()
+				header2 := suite.committee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp, header1.Root, &rotated)
+				misbehaviour = types.NewMisbehaviour(testClientID, header1, header2)
+			}, true,
+		},
+		{
+			"valid misbehaviour signed by different quorums", func() {
+				header1 = suite.committee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp, header1.Root, nil, 0, 1)
+				header2 := suite.committee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp, []byte("conflicting root"), nil, 0, 3)
+				misbehaviour = types.NewMisbehaviour(testClientID, header1, header2)
+			}, true,
+		},
+		{
+			"valid misbehaviour at a height less than the latest height", func() {
+				height := clienttypes.NewHeight(clientState.LatestHeight.RevisionNumber, 1)
+				header1 = suite.committee.CreateHeader(suite.chainB.ChainID, height, 1, []byte("root"), nil)
+				header2 := suite.committee.CreateHeader(suite.chainB.ChainID, height, 1, []byte("conflicting root"), nil)
+				misbehaviour = types.NewMisbehaviour(testClientID, header1, header2)
+			}, true,
+		},
+		{
+			"headers are not conflicting", func() {
+				misbehaviour = types.NewMisbehaviour(testClientID, header1, header1)
+			}, false,
+		},
+		{
+			"headers are not conflicting but signed by different quorums", func() {
+				header2 := suite.committee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp, header1.Root, nil, 0, 2)
+				misbehaviour = types.NewMisbehaviour(testClientID, header1, header2)
+			}, false,
+		},
+		{
+			"header 1 signing weight is less than the threshold", func() {
+				header1 = suite.committee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp, header1.Root, nil, 1, 2, 3)
+				header2 := suite.committee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp, []byte("conflicting root"), nil)
+				misbehaviour = types.NewMisbehaviour(testClientID, header1, header2)
+			}, false,
+		},
+		{
+			"header 2 signed by a committee which is not trusted", func() {
+				otherCommittee := ibctesting.NewCommittee(suite.T(), suite.chainA.Codec, []uint64{3, 1, 1, 1}, 4)
+				header2 := otherCommittee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp, []byte("conflicting root"), nil)
+				misbehaviour = types.NewMisbehaviour(testClientID, header1, header2)
+			}, false,
+		},
+		{
+			"headers signed for a different chain", func() {
+				header1 = suite.committee.CreateHeader(suite.chainA.ChainID, header1.Height, header1.Timestamp, header1.Root, nil)
+				header2 := suite.committee.CreateHeader(suite.chainA.ChainID, header1.Height, header1.Timestamp, []byte("conflicting root"), nil)
+				misbehaviour = types.NewMisbehaviour(testClientID, header1, header2)
+			}, false,
+		},
+		{
+			"misbehaviour is not committee misbehaviour", func() {
+				misbehaviour = &ibctmtypes.Misbehaviour{}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			endpoint := suite.createCommitteeClient(ibctesting.NewDefaultEndpoint(suite.chainB))
+			clientState = suite.chainA.GetClientState(endpoint.ClientID).(*types.ClientState)
+
+			suite.coordinator.CommitBlock(suite.chainB)
+			header1 = suite.committee.CreateCounterpartyHeader(suite.chainB)
+
+			tc.malleate()
+
+			newClientState, err := clientState.CheckMisbehaviourAndUpdateState(
+				suite.chainA.GetContext(), suite.chainA.Codec, suite.clientStore(endpoint), misbehaviour,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.FrozenHeight, newClientState.(*types.ClientState).FrozenHeight)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(newClientState)
+			}
+		})
+	}
+}
+
+// TestSubmitMisbehaviour tests that misbehaviour submitted to the client keeper freezes the
+// committee client and that the frozen client can no longer be updated.
+func (suite *CommitteeTestSuite) TestSubmitMisbehaviour() {
+	endpoint := suite.createCommitteeClient(ibctesting.NewDefaultEndpoint(suite.chainB))
+
+	suite.coordinator.CommitBlock(suite.chainB)
+	header1 := suite.committee.CreateCounterpartyHeader(suite.chainB)
+	header2 := suite.committee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp, []byte("conflicting root"), nil)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	err := clientKeeper.CheckMisbehaviourAndUpdateState(suite.chainA.GetContext(), types.NewMisbehaviour(endpoint.ClientID, header1, header2))
+	suite.Require().NoError(err)
+
+	clientState := endpoint.GetClientState()
+	suite.Require().Equal(exported.Frozen, clientState.Status(suite.chainA.GetContext(), suite.clientStore(endpoint), suite.chainA.Codec))
+
+	err = clientKeeper.UpdateClient(suite.chainA.GetContext(), endpoint.ClientID, header1)
+	suite.Require().Error(err)
+}
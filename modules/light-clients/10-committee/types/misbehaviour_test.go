@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+)
+
+func (suite *CommitteeTestSuite) TestMisbehaviour() {
+	header := suite.committee.CreateCounterpartyHeader(suite.chainB)
+	misbehaviour := types.NewMisbehaviour(testClientID, header, header)
+
+	suite.Require().Equal(exported.Committee, misbehaviour.ClientType())
+	suite.Require().Equal(testClientID, misbehaviour.GetClientID())
+}
+
+func (suite *CommitteeTestSuite) TestMisbehaviourValidateBasic() {
+	var misbehaviour *types.Misbehaviour
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid misbehaviour", func() {}, true,
+		},
+		{
+			"invalid client ID", func() {
+				misbehaviour.ClientId = "(badclientid)"
+			}, false,
+		},
+		{
+			"header 1 is nil", func() {
+				misbehaviour.Header1 = nil
+			}, false,
+		},
+		{
+			"header 2 is nil", func() {
+				misbehaviour.Header2 = nil
+			}, false,
+		},
+		{
+			"header 1 failed basic validation", func() {
+				misbehaviour.Header1.Signatures = nil
+			}, false,
+		},
+		{
+			"header 2 failed basic validation", func() {
+				misbehaviour.Header2.Root = nil
+			}, false,
+		},
+		{
+			"headers are at different heights", func() {
+				misbehaviour.Header2 = suite.committee.CreateHeader(
+					suite.chainB.ChainID, misbehaviour.Header1.Height.Increment().(clienttypes.Height),
+					misbehaviour.Header2.Timestamp, misbehaviour.Header2.Root, nil,
+				)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			header1 := suite.committee.CreateCounterpartyHeader(suite.chainB)
+			header2 := suite.committee.CreateHeader(suite.chainB.ChainID, header1.Height, header1.Timestamp, []byte("conflicting root"), nil)
+			misbehaviour = types.NewMisbehaviour(testClientID, header1, header2)
+
+			tc.malleate()
+
+			err := misbehaviour.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// CheckSubstituteAndUpdateState will try to update the client with the state of the
+// substitute if and only if the proposal passes, AllowUpdateAfterMisbehaviour is set to
+// true and the subject client is Frozen.
+//
+// The following must always be true:
+//   - The substitute client is the same type as the subject client
+//   - The subject and substitute client states match in all parameters (except frozen height, latest height, and committee)
+//
+// The client will be unfrozen by resetting the FrozenHeight to the zero Height. The
+// committee and latest consensus state of the substitute replace those of the subject.
+func (cs ClientState) CheckSubstituteAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore sdk.KVStore, substituteClient exported.ClientState,
+) (exported.ClientState, error) {
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, substituteClient,
+		)
+	}
+
+	if !IsMatchingClientState(cs, *substituteClientState) {
+		return nil, sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "subject client state does not match substitute client state")
+	}
+
+	if cs.Status(ctx, subjectClientStore, cdc) != exported.Frozen {
+		return nil, sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "client cannot be updated with proposal")
+	}
+
+	if !cs.AllowUpdateAfterMisbehaviour {
+		return nil, sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "client is not allowed to be unfrozen")
+	}
+
+	// copy the latest consensus state and its processed time and height from substitute to subject
+	height := substituteClientState.GetLatestHeight()
+
+	consensusState, err := GetConsensusState(substituteClientStore, cdc, height)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to retrieve latest consensus state for substitute client")
+	}
+
+	SetConsensusState(subjectClientStore, cdc, consensusState, height)
+
+	processedHeight, found := GetProcessedHeight(substituteClientStore, height)
+	if !found {
+		return nil, sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed height for substitute client latest height")
+	}
+
+	processedTime, found := GetProcessedTime(substituteClientStore, height)
+	if !found {
+		return nil, sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed time for substitute client latest height")
+	}
+
+	setConsensusMetadataWithValues(subjectClientStore, height, processedHeight, processedTime)
+
+	// unfreeze the client
+	cs.FrozenHeight = clienttypes.ZeroHeight()
+	cs.LatestHeight = substituteClientState.LatestHeight
+	cs.Committee = substituteClientState.Committee
+
+	// no validation is necessary since the substitute is verified to be Active
+	// in 02-client.
+
+	return &cs, nil
+}
+
+// IsMatchingClientState returns true if all the client state parameters match
+// except for frozen height, latest height, and committee.
+func IsMatchingClientState(subject, substitute ClientState) bool {
+	// zero out parameters which do not need to match
+	subject.LatestHeight = clienttypes.ZeroHeight()
+	subject.FrozenHeight = clienttypes.ZeroHeight()
+	subject.Committee = Committee{}
+	substitute.LatestHeight = clienttypes.ZeroHeight()
+	substitute.FrozenHeight = clienttypes.ZeroHeight()
+	substitute.Committee = Committee{}
+
+	return reflect.DeepEqual(subject, substitute)
+}
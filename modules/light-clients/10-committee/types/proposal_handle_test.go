@@ -0,0 +1,158 @@
+package types_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *CommitteeTestSuite) TestCheckSubstituteAndUpdateState() {
+	var (
+		subjectClientState    *types.ClientState
+		substituteClientState exported.ClientState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid substitute", func() {}, true,
+		},
+		{
+			"subject is not frozen", func() {
+				subjectClientState.FrozenHeight = clienttypes.ZeroHeight()
+			}, false,
+		},
+		{
+			"subject is not allowed to be updated after misbehaviour", func() {
+				subjectClientState.AllowUpdateAfterMisbehaviour = false
+			}, false,
+		},
+		{
+			"substitute does not match the subject", func() {
+				substituteClientState.(*types.ClientState).ChainId = suite.chainA.ChainID
+			}, false,
+		},
+		{
+			"substitute is not a committee client", func() {
+				substituteClientState = &ibctmtypes.ClientState{}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			subject := suite.createCommitteeClient(ibctesting.NewDefaultEndpoint(suite.chainB))
+			subjectClientState = subject.GetClientState().(*types.ClientState)
+			subjectClientState.AllowUpdateAfterMisbehaviour = true
+			subjectClientState.FrozenHeight = types.FrozenHeight
+
+			// the substitute is tracked by a rotated committee at a greater height
+			suite.committee = ibctesting.NewCommittee(suite.T(), suite.chainA.Codec, []uint64{1, 1, 1}, 2)
+			substitute := suite.createCommitteeClient(ibctesting.NewDefaultEndpoint(suite.chainB))
+			substituteClientState = substitute.GetClientState()
+			substituteClientState.(*types.ClientState).AllowUpdateAfterMisbehaviour = true
+
+			tc.malleate()
+
+			subjectClientStore := suite.clientStore(subject)
+			substituteClientStore := suite.clientStore(substitute)
+
+			updatedClient, err := subjectClientState.CheckSubstituteAndUpdateState(
+				suite.chainA.GetContext(), suite.chainA.Codec, subjectClientStore, substituteClientStore, substituteClientState,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				updatedClientState := updatedClient.(*types.ClientState)
+				suite.Require().True(updatedClientState.FrozenHeight.IsZero())
+				suite.Require().Equal(substituteClientState.GetLatestHeight(), updatedClientState.LatestHeight)
+				suite.Require().Equal(suite.committee.Committee(), updatedClientState.Committee)
+
+				// the latest consensus state of the substitute and its metadata are copied to the subject
+				latestHeight := updatedClientState.LatestHeight
+				subjectConsState, err := types.GetConsensusState(subjectClientStore, suite.chainA.Codec, latestHeight)
+				suite.Require().NoError(err)
+				substituteConsState, err := types.GetConsensusState(substituteClientStore, suite.chainA.Codec, latestHeight)
+				suite.Require().NoError(err)
+				suite.Require().Equal(substituteConsState, subjectConsState)
+
+				subjectProcessedTime, found := types.GetProcessedTime(subjectClientStore, latestHeight)
+				suite.Require().True(found)
+				substituteProcessedTime, _ := types.GetProcessedTime(substituteClientStore, latestHeight)
+				suite.Require().Equal(substituteProcessedTime, subjectProcessedTime)
+
+				subjectProcessedHeight, found := types.GetProcessedHeight(subjectClientStore, latestHeight)
+				suite.Require().True(found)
+				substituteProcessedHeight, _ := types.GetProcessedHeight(substituteClientStore, latestHeight)
+				suite.Require().Equal(substituteProcessedHeight, subjectProcessedHeight)
+
+				suite.Require().Equal(exported.Active, updatedClientState.Status(suite.chainA.GetContext(), subjectClientStore, suite.chainA.Codec))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(updatedClient)
+			}
+		})
+	}
+}
+
+func (suite *CommitteeTestSuite) TestIsMatchingClientState() {
+	var subject, substitute *types.ClientState
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		isMatchingCS bool
+	}{
+		{
+			"matching clients", func() {}, true,
+		},
+		{
+			"matching clients with different latest heights, frozen heights and committees", func() {
+				substitute.LatestHeight = substitute.LatestHeight.Increment().(clienttypes.Height)
+				substitute.FrozenHeight = types.FrozenHeight
+				substitute.Committee = ibctesting.NewCommittee(suite.T(), suite.chainA.Codec, []uint64{1}, 1).Committee()
+			}, true,
+		},
+		{
+			"not matching, chain id is different", func() {
+				substitute.ChainId = suite.chainA.ChainID
+			}, false,
+		},
+		{
+			"not matching, proof specs are different", func() {
+				substitute.ProofSpecs = substitute.ProofSpecs[:1]
+			}, false,
+		},
+		{
+			"not matching, allow update after misbehaviour is different", func() {
+				substitute.AllowUpdateAfterMisbehaviour = true
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			endpoint := suite.createCommitteeClient(ibctesting.NewDefaultEndpoint(suite.chainB))
+			subject = endpoint.GetClientState().(*types.ClientState)
+			substitute = endpoint.GetClientState().(*types.ClientState)
+
+			tc.malleate()
+
+			suite.Require().Equal(tc.isMatchingCS, types.IsMatchingClientState(*subject, *substitute))
+		})
+	}
+}
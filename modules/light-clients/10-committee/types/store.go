@@ -0,0 +1,150 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var (
+	// KeyProcessedTime is appended to consensus state key to store the processed time
+	KeyProcessedTime = []byte("/processedTime")
+	// KeyProcessedHeight is appended to consensus state key to store the processed height
+	KeyProcessedHeight = []byte("/processedHeight")
+)
+
+// SetConsensusState stores the consensus state at the given height.
+func SetConsensusState(clientStore sdk.KVStore, cdc codec.BinaryCodec, consensusState *ConsensusState, height exported.Height) {
+	key := host.ConsensusStateKey(height)
+	val := clienttypes.MustMarshalConsensusState(cdc, consensusState)
+	clientStore.Set(key, val)
+}
+
+// GetConsensusState retrieves the consensus state from the client prefixed
+// store. An error is returned if the consensus state does not exist.
+func GetConsensusState(store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height) (*ConsensusState, error) {
+	bz := store.Get(host.ConsensusStateKey(height))
+	if bz == nil {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrConsensusStateNotFound,
+			"consensus state does not exist for height %s", height,
+		)
+	}
+
+	consensusStateI, err := clienttypes.UnmarshalConsensusState(cdc, bz)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "unmarshal error: %v", err)
+	}
+
+	consensusState, ok := consensusStateI.(*ConsensusState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidConsensus,
+			"invalid consensus type %T, expected %T", consensusStateI, &ConsensusState{},
+		)
+	}
+
+	return consensusState, nil
+}
+
+// IterateConsensusMetadata iterates through the processed time and processed height
+// stored for each consensus state and applies the callback. If the cb returns true,
+// then iterator will close and stop.
+func IterateConsensusMetadata(store sdk.KVStore, cb func(key, val []byte) bool) {
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyConsensusStatePrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		// processed time key in prefix store has format: "consensusState/<height>/processedTime"
+		if len(keySplit) != 3 {
+			// ignore all consensus state keys
+			continue
+		}
+
+		if keySplit[2] != "processedTime" && keySplit[2] != "processedHeight" {
+			// only perform callback on consensus metadata
+			continue
+		}
+
+		if cb(iterator.Key(), iterator.Value()) {
+			break
+		}
+	}
+}
+
+// ProcessedTimeKey returns the key under which the processed time will be stored in the client store.
+func ProcessedTimeKey(height exported.Height) []byte {
+	return append(host.ConsensusStateKey(height), KeyProcessedTime...)
+}
+
+// SetProcessedTime stores the time at which a header was processed and the corresponding consensus state was created.
+// This is useful when validating whether a packet has reached the time specified delay period in the committee client's
+// verification functions
+func SetProcessedTime(clientStore sdk.KVStore, height exported.Height, timeNs uint64) {
+	key := ProcessedTimeKey(height)
+	val := sdk.Uint64ToBigEndian(timeNs)
+	clientStore.Set(key, val)
+}
+
+// GetProcessedTime gets the time (in nanoseconds) at which this chain received and processed a committee header.
+// This is used to validate that a received packet has passed the time delay period.
+func GetProcessedTime(clientStore sdk.KVStore, height exported.Height) (uint64, bool) {
+	key := ProcessedTimeKey(height)
+	bz := clientStore.Get(key)
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// ProcessedHeightKey returns the key under which the processed height will be stored in the client store.
+func ProcessedHeightKey(height exported.Height) []byte {
+	return append(host.ConsensusStateKey(height), KeyProcessedHeight...)
+}
+
+// SetProcessedHeight stores the height at which a header was processed and the corresponding consensus state was created.
+// This is useful when validating whether a packet has reached the specified block delay period in the committee client's
+// verification functions
+func SetProcessedHeight(clientStore sdk.KVStore, consHeight, processedHeight exported.Height) {
+	key := ProcessedHeightKey(consHeight)
+	val := []byte(processedHeight.String())
+	clientStore.Set(key, val)
+}
+
+// GetProcessedHeight gets the height at which this chain received and processed a committee header.
+// This is used to validate that a received packet has passed the block delay period.
+func GetProcessedHeight(clientStore sdk.KVStore, height exported.Height) (exported.Height, bool) {
+	key := ProcessedHeightKey(height)
+	bz := clientStore.Get(key)
+	if bz == nil {
+		return nil, false
+	}
+	processedHeight, err := clienttypes.ParseHeight(string(bz))
+	if err != nil {
+		return nil, false
+	}
+	return processedHeight, true
+}
+
+// setConsensusMetadata sets context time as processed time and context height as processed height.
+// The client state and consensus state are set by the client keeper.
+func setConsensusMetadata(ctx sdk.Context, clientStore sdk.KVStore, height exported.Height) {
+	setConsensusMetadataWithValues(clientStore, height, clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano()))
+}
+
+// setConsensusMetadataWithValues sets the consensus metadata with the provided values
+func setConsensusMetadataWithValues(
+	clientStore sdk.KVStore, height,
+	processedHeight exported.Height,
+	processedTime uint64,
+) {
+	SetProcessedTime(clientStore, height, processedTime)
+	SetProcessedHeight(clientStore, height, processedHeight)
+}
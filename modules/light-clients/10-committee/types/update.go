@@ -0,0 +1,123 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// CheckHeaderAndUpdateState checks if the provided header is valid, and if valid it will:
+// create the consensus state for the header.Height, update the latest height of the client
+// state and, if the header sets a new committee, rotate the committee of the client state.
+// It returns an error if:
+// - the header provided is not parseable to a committee header
+// - the header is not signed by members of the committee whose weight meets the threshold
+// - the header height is less than or equal to the latest height and no consensus state exists at the header height
+// - the header timestamp is less than or equal to the timestamp of the latest consensus state
+//
+// Headers must be signed by the committee of the client state. Thus updates are sequential and
+// a committee rotated in by a header is only trusted to sign headers at greater heights.
+//
+// Misbehaviour Detection:
+// UpdateClient will detect implicit misbehaviour and will return a frozen client if a valid header
+// creates a different consensus state for an already existing height.
+// Misbehaviour sets frozen height to {0, 1} since it is only used as a boolean value (zero or non-zero).
+func (cs ClientState) CheckHeaderAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	header exported.Header,
+) (exported.ClientState, exported.ConsensusState, error) {
+	committeeHeader, ok := header.(*Header)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader, "expected type %T, got %T", &Header{}, header,
+		)
+	}
+
+	// Check if the Client store already has a consensus state for the header's height
+	// If the consensus state exists, and it matches the header then we return early
+	// since header has already been submitted in a previous UpdateClient.
+	var conflictingHeader bool
+	consState := committeeHeader.ConsensusState()
+	prevConsState, _ := GetConsensusState(clientStore, cdc, header.GetHeight())
+	if prevConsState != nil {
+		// This header has already been submitted and the necessary state is already stored
+		// in client store, thus we can return early without further validation.
+		if isEqualConsensusState(prevConsState, consState) {
+			return &cs, prevConsState, nil
+		}
+		// A consensus state already exists for this height, but it does not match the provided header.
+		// Thus, we must check that this header is valid, and if so we will freeze the client.
+		conflictingHeader = true
+	}
+
+	if err := cs.verifyHeaderSignatures(cdc, committeeHeader); err != nil {
+		return nil, nil, err
+	}
+
+	// Header is different from existing consensus state and also valid, so freeze the client and return
+	if conflictingHeader {
+		cs.FrozenHeight = FrozenHeight
+		return &cs, consState, nil
+	}
+
+	if committeeHeader.Height.LTE(cs.LatestHeight) {
+		return nil, nil, sdkerrors.Wrapf(
+			ErrInvalidHeaderHeight,
+			"header height ≤ latest height (%s ≤ %s)", committeeHeader.Height, cs.LatestHeight,
+		)
+	}
+
+	latestConsState, err := GetConsensusState(clientStore, cdc, cs.LatestHeight)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(err, "could not get consensus state from clientstore at LatestHeight: %s", cs.LatestHeight)
+	}
+
+	if committeeHeader.Timestamp <= latestConsState.Timestamp {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader,
+			"header timestamp ≤ latest consensus state timestamp (%d ≤ %d)", committeeHeader.Timestamp, latestConsState.Timestamp,
+		)
+	}
+
+	newClientState, consensusState := update(ctx, clientStore, &cs, committeeHeader)
+	return newClientState, consensusState, nil
+}
+
+// verifyHeaderSignatures verifies that the header is signed by members of the committee of
+// the client state whose combined weight meets the threshold.
+func (cs ClientState) verifyHeaderSignatures(cdc codec.BinaryCodec, header *Header) error {
+	signBytes, err := HeaderSignBytes(cdc, cs.ChainId, header)
+	if err != nil {
+		return err
+	}
+
+	if err := cs.Committee.VerifySignatures(signBytes, header.Signatures); err != nil {
+		return sdkerrors.Wrap(err, "failed to verify header")
+	}
+
+	return nil
+}
+
+// isEqualConsensusState returns true if the consensus states have the same timestamp and root.
+func isEqualConsensusState(consStateA, consStateB *ConsensusState) bool {
+	return consStateA.Timestamp == consStateB.Timestamp && bytes.Equal(consStateA.Root.GetHash(), consStateB.Root.GetHash())
+}
+
+// update the latest height and committee of the client state from a new header and set
+// the processed time and height metadata of its consensus state
+func update(ctx sdk.Context, clientStore sdk.KVStore, clientState *ClientState, header *Header) (*ClientState, *ConsensusState) {
+	clientState.LatestHeight = header.Height
+	if header.NewCommittee != nil {
+		clientState.Committee = *header.NewCommittee
+	}
+
+	// set metadata for this consensus state
+	setConsensusMetadata(ctx, clientStore, header.GetHeight())
+
+	return clientState, header.ConsensusState()
+}
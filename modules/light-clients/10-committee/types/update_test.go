@@ -0,0 +1,233 @@
+package types_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *CommitteeTestSuite) TestCheckHeaderAndUpdateState() {
+	var (
+		endpoint     *ibctesting.Endpoint
+		clientState  *types.ClientState
+		header       exported.Header
+		newCommittee *ibctesting.Committee
+		expFrozen    bool
+	)
+
+	// createHeader creates a header at the given height which follows the latest
+	// consensus state of the committee client.
+	createHeader := func(height clienttypes.Height, signers ...uint32) *types.Header {
+		consensusState := endpoint.GetConsensusState(clientState.LatestHeight).(*types.ConsensusState)
+		return suite.committee.CreateHeader(suite.chainB.ChainID, height, consensusState.Timestamp+1, []byte("root"), nil, signers...)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful update signed by all members", func() {
+				header = suite.committee.CreateCounterpartyHeader(suite.chainB)
+			}, true,
+		},
+		{
+			"successful update signed by a quorum", func() {
+				header = createHeader(clientState.LatestHeight.Increment().(clienttypes.Height), 0, 2)
+			}, true,
+		},
+		{
+			"successful update at a non-consecutive height", func() {
+				header = createHeader(clienttypes.NewHeight(clientState.LatestHeight.RevisionNumber, clientState.LatestHeight.RevisionHeight+10))
+			}, true,
+		},
+		{
+			"successful update to a new revision", func() {
+				header = createHeader(clienttypes.NewHeight(clientState.LatestHeight.RevisionNumber+1, 1))
+			}, true,
+		},
+		{
+			"successful update rotating the committee", func() {
+				newCommittee = ibctesting.NewCommittee(suite.T(), suite.chainA.Codec, []uint64{1, 1, 1}, 2)
+				rotated := newCommittee.Committee()
+
+				h := createHeader(clientState.LatestHeight.Increment().(clienttypes.Height))
+				header = suite.committee.CreateHeader(suite.chainB.ChainID, h.Height, h.Timestamp, h.Root, &rotated, 0, 1)
+			}, true,
+		},
+		{
+			"header already submitted is a no-op", func() {
+				consensusState := endpoint.GetConsensusState(clientState.LatestHeight).(*types.ConsensusState)
+				header = suite.committee.CreateHeader(
+					suite.chainB.ChainID, clientState.LatestHeight, consensusState.Timestamp, consensusState.Root.GetHash(), nil,
+				)
+			}, true,
+		},
+		{
+			"conflicting header at an existing height freezes the client", func() {
+				consensusState := endpoint.GetConsensusState(clientState.LatestHeight).(*types.ConsensusState)
+				header = suite.committee.CreateHeader(
+					suite.chainB.ChainID, clientState.LatestHeight, consensusState.Timestamp, []byte("conflicting root"), nil,
+				)
+				expFrozen = true
+			}, true,
+		},
+		{
+			"conflicting header at an existing height not signed by a quorum", func() {
+				consensusState := endpoint.GetConsensusState(clientState.LatestHeight).(*types.ConsensusState)
+				header = suite.committee.CreateHeader(
+					suite.chainB.ChainID, clientState.LatestHeight, consensusState.Timestamp, []byte("conflicting root"), nil, 1, 2, 3,
+				)
+			}, false,
+		},
+		{
+			"signing weight is less than the threshold", func() {
+				header = createHeader(clientState.LatestHeight.Increment().(clienttypes.Height), 1, 2, 3)
+			}, false,
+		},
+		{
+			"member index out of range", func() {
+				h := createHeader(clientState.LatestHeight.Increment().(clienttypes.Height))
+				h.Signatures[3].MemberIndex = 4
+				header = h
+			}, false,
+		},
+		{
+			"header signed by a committee which is not trusted", func() {
+				otherCommittee := ibctesting.NewCommittee(suite.T(), suite.chainA.Codec, []uint64{3, 1, 1, 1}, 4)
+				h := createHeader(clientState.LatestHeight.Increment().(clienttypes.Height))
+				header = otherCommittee.CreateHeader(suite.chainB.ChainID, h.Height, h.Timestamp, h.Root, nil)
+			}, false,
+		},
+		{
+			"header signed for a different chain", func() {
+				h := createHeader(clientState.LatestHeight.Increment().(clienttypes.Height))
+				header = suite.committee.CreateHeader(suite.chainA.ChainID, h.Height, h.Timestamp, h.Root, nil)
+			}, false,
+		},
+		{
+			"signed data does not match the header", func() {
+				h := createHeader(clientState.LatestHeight.Increment().(clienttypes.Height))
+				h.Root = []byte("different root")
+				header = h
+			}, false,
+		},
+		{
+			"header height is less than the latest height", func() {
+				header = createHeader(clienttypes.NewHeight(clientState.LatestHeight.RevisionNumber, clientState.LatestHeight.RevisionHeight-1))
+			}, false,
+		},
+		{
+			"header timestamp is not greater than the latest consensus state timestamp", func() {
+				consensusState := endpoint.GetConsensusState(clientState.LatestHeight).(*types.ConsensusState)
+				header = suite.committee.CreateHeader(
+					suite.chainB.ChainID, clientState.LatestHeight.Increment().(clienttypes.Height), consensusState.Timestamp, []byte("root"), nil,
+				)
+			}, false,
+		},
+		{
+			"header is not a committee header", func() {
+				header = &ibctmtypes.Header{}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			newCommittee = nil
+			expFrozen = false
+
+			endpoint = suite.createCommitteeClient(ibctesting.NewDefaultEndpoint(suite.chainB))
+			clientState = suite.chainA.GetClientState(endpoint.ClientID).(*types.ClientState)
+
+			// ensure counterparty has committed state
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			clientStore := suite.clientStore(endpoint)
+			prevLatestHeight := clientState.LatestHeight
+
+			newClientState, consensusState, err := clientState.CheckHeaderAndUpdateState(
+				ctx, suite.chainA.Codec, clientStore, header,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				committeeHeader := header.(*types.Header)
+				suite.Require().Equal(committeeHeader.ConsensusState(), consensusState)
+
+				committeeClientState := newClientState.(*types.ClientState)
+				if expFrozen {
+					suite.Require().Equal(types.FrozenHeight, committeeClientState.FrozenHeight)
+					suite.Require().Equal(prevLatestHeight, committeeClientState.LatestHeight)
+					return
+				}
+
+				suite.Require().True(committeeClientState.FrozenHeight.IsZero())
+				suite.Require().True(committeeClientState.LatestHeight.GTE(committeeHeader.Height))
+
+				// ensure the processed time and height are set for the header height
+				_, found := types.GetProcessedTime(clientStore, committeeHeader.Height)
+				suite.Require().True(found)
+				_, found = types.GetProcessedHeight(clientStore, committeeHeader.Height)
+				suite.Require().True(found)
+
+				if newCommittee != nil {
+					suite.Require().Equal(newCommittee.Committee(), committeeClientState.Committee)
+				} else {
+					suite.Require().Equal(suite.committee.Committee(), committeeClientState.Committee)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(newClientState)
+				suite.Require().Nil(consensusState)
+			}
+		})
+	}
+}
+
+// TestUpdateClient tests the updates of a committee client submitted to the client keeper,
+// including headers rotating the committee of the client.
+func (suite *CommitteeTestSuite) TestUpdateClient() {
+	endpoint := suite.createCommitteeClient(ibctesting.NewDefaultEndpoint(suite.chainB))
+
+	err := endpoint.UpdateClient()
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainB.LastHeader.GetHeight(), endpoint.GetClientState().GetLatestHeight())
+
+	// rotate the committee with a header signed by a quorum of the current committee
+	newCommittee := ibctesting.NewCommittee(suite.T(), suite.chainA.Codec, []uint64{1, 1, 1}, 2)
+	rotated := newCommittee.Committee()
+
+	suite.coordinator.CommitBlock(suite.chainB)
+	header := suite.committee.CreateCounterpartyHeader(suite.chainB)
+	header = suite.committee.CreateHeader(suite.chainB.ChainID, header.Height, header.Timestamp, header.Root, &rotated, 0, 3)
+
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), endpoint.ClientID, header)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rotated, endpoint.GetClientState().(*types.ClientState).Committee)
+
+	// the previous committee is no longer trusted
+	suite.coordinator.CommitBlock(suite.chainB)
+	header = suite.committee.CreateCounterpartyHeader(suite.chainB)
+
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), endpoint.ClientID, header)
+	suite.Require().Error(err)
+
+	// the new committee signs subsequent headers
+	endpoint.ClientConfig.(*ibctesting.CommitteeConfig).Committee = newCommittee
+
+	err = endpoint.UpdateClient()
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainB.LastHeader.GetHeight(), endpoint.GetClientState().GetLatestHeight())
+	suite.Require().Equal(exported.Active, endpoint.GetClientState().Status(suite.chainA.GetContext(), suite.clientStore(endpoint), suite.chainA.Codec))
+}
@@ -0,0 +1,6 @@
+/*
+Package signerset implements the validation of weighted signer sets and the verification of
+signatures produced by a quorum of their signers. It is shared by the light clients whose
+consensus states are weighted signer sets, such as the v3 solo machine and committee clients.
+*/
+package signerset
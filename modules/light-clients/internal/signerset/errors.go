@@ -0,0 +1,17 @@
+package signerset
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	SubModuleName = "signer-set"
+)
+
+// Weighted signer set sentinel errors
+var (
+	ErrInvalidSignerSet          = sdkerrors.Register(SubModuleName, 2, "invalid signer set")
+	ErrInvalidSignatures         = sdkerrors.Register(SubModuleName, 3, "invalid signatures")
+	ErrSignatureVerification     = sdkerrors.Register(SubModuleName, 4, "signature verification failed")
+	ErrInsufficientSigningWeight = sdkerrors.Register(SubModuleName, 5, "signing weight is less than the threshold")
+)
@@ -0,0 +1,111 @@
+package signerset
+
+import (
+	"math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Signer defines a signer of a weighted signer set.
+type Signer interface {
+	GetPubKey() (cryptotypes.PubKey, error)
+	GetWeight() uint64
+}
+
+// IndexedSignature is a signature produced by the signer at the provided index of a signer set.
+type IndexedSignature struct {
+	SignerIndex uint32
+	Signature   []byte
+}
+
+// ValidateSignerSet ensures that the signer set is non-empty, that every signer has a
+// non-zero weight and a unique, non-multisig public key, and that the threshold is
+// non-zero and reachable by the total weight of the signer set.
+func ValidateSignerSet(signers []Signer, threshold uint64) error {
+	if len(signers) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignerSet, "signer set cannot be empty")
+	}
+
+	if uint64(len(signers)) > math.MaxUint32 {
+		return sdkerrors.Wrapf(ErrInvalidSignerSet, "signer set cannot contain more than %d signers", uint64(math.MaxUint32))
+	}
+
+	var totalWeight uint64
+	seen := make(map[string]bool, len(signers))
+	for i, signer := range signers {
+		publicKey, err := signer.GetPubKey()
+		if err != nil || publicKey == nil || len(publicKey.Bytes()) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidSignerSet, "public key of signer %d cannot be empty", i)
+		}
+
+		// every signer signs individually, thus multisig public keys cannot be used
+		if _, ok := publicKey.(multisig.PubKey); ok {
+			return sdkerrors.Wrapf(ErrInvalidSignerSet, "public key of signer %d cannot be a multisig public key", i)
+		}
+
+		if seen[string(publicKey.Bytes())] {
+			return sdkerrors.Wrapf(ErrInvalidSignerSet, "duplicate public key for signer %d", i)
+		}
+		seen[string(publicKey.Bytes())] = true
+
+		weight := signer.GetWeight()
+		if weight == 0 {
+			return sdkerrors.Wrapf(ErrInvalidSignerSet, "weight of signer %d cannot be 0", i)
+		}
+
+		if totalWeight > math.MaxUint64-weight {
+			return sdkerrors.Wrap(ErrInvalidSignerSet, "total weight of the signer set overflows uint64")
+		}
+		totalWeight += weight
+	}
+
+	if threshold == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignerSet, "threshold cannot be 0")
+	}
+
+	if threshold > totalWeight {
+		return sdkerrors.Wrapf(ErrInvalidSignerSet, "threshold cannot be greater than the total weight of the signer set (%d > %d)", threshold, totalWeight)
+	}
+
+	return nil
+}
+
+// VerifySignatures verifies that the signatures are valid signatures over the sign bytes by
+// signers of the signer set whose combined weight meets the threshold. The signatures must
+// be ordered by strictly increasing signer index.
+func VerifySignatures(signers []Signer, threshold uint64, signBytes []byte, signatures []IndexedSignature) error {
+	if len(signatures) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignatures, "signatures cannot be empty")
+	}
+
+	var weight uint64
+	for i, sig := range signatures {
+		if i > 0 && sig.SignerIndex <= signatures[i-1].SignerIndex {
+			return sdkerrors.Wrapf(ErrInvalidSignatures, "signer indices must be strictly increasing (%d <= %d)", sig.SignerIndex, signatures[i-1].SignerIndex)
+		}
+
+		if uint64(sig.SignerIndex) >= uint64(len(signers)) {
+			return sdkerrors.Wrapf(ErrInvalidSignatures, "signer index %d out of range of signer set of size %d", sig.SignerIndex, len(signers))
+		}
+
+		signer := signers[sig.SignerIndex]
+		publicKey, err := signer.GetPubKey()
+		if err != nil {
+			return err
+		}
+
+		if !publicKey.VerifySignature(signBytes, sig.Signature) {
+			return sdkerrors.Wrapf(ErrSignatureVerification, "invalid signature for signer %d", sig.SignerIndex)
+		}
+
+		weight += signer.GetWeight()
+	}
+
+	if weight < threshold {
+		return sdkerrors.Wrapf(ErrInsufficientSigningWeight, "signing weight is less than the threshold (%d < %d)", weight, threshold)
+	}
+
+	return nil
+}
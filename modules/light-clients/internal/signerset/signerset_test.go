@@ -0,0 +1,102 @@
+package signerset_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/internal/signerset"
+)
+
+type signer struct {
+	publicKey cryptotypes.PubKey
+	weight    uint64
+}
+
+func (s signer) GetPubKey() (cryptotypes.PubKey, error) {
+	return s.publicKey, nil
+}
+
+func (s signer) GetWeight() uint64 {
+	return s.weight
+}
+
+func TestValidateSignerSet(t *testing.T) {
+	privKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	multisigKey := multisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{privKeys[0].PubKey(), privKeys[1].PubKey()})
+
+	testCases := []struct {
+		name      string
+		signers   []signerset.Signer
+		threshold uint64
+		expPass   bool
+	}{
+		{"valid signer set", []signerset.Signer{signer{privKeys[0].PubKey(), 1}, signer{privKeys[1].PubKey(), 2}}, 3, true},
+		{"empty signer set", nil, 1, false},
+		{"empty public key", []signerset.Signer{signer{nil, 1}}, 1, false},
+		{"multisig public key", []signerset.Signer{signer{multisigKey, 1}}, 1, false},
+		{"duplicate public key", []signerset.Signer{signer{privKeys[0].PubKey(), 1}, signer{privKeys[0].PubKey(), 1}}, 1, false},
+		{"zero weight", []signerset.Signer{signer{privKeys[0].PubKey(), 0}}, 1, false},
+		{"total weight overflows", []signerset.Signer{signer{privKeys[0].PubKey(), math.MaxUint64}, signer{privKeys[1].PubKey(), 1}}, 1, false},
+		{"zero threshold", []signerset.Signer{signer{privKeys[0].PubKey(), 1}}, 0, false},
+		{"threshold greater than total weight", []signerset.Signer{signer{privKeys[0].PubKey(), 1}, signer{privKeys[1].PubKey(), 2}}, 4, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := signerset.ValidateSignerSet(tc.signers, tc.threshold)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, signerset.ErrInvalidSignerSet)
+			}
+		})
+	}
+}
+
+func TestVerifySignatures(t *testing.T) {
+	privKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	signers := []signerset.Signer{signer{privKeys[0].PubKey(), 1}, signer{privKeys[1].PubKey(), 1}, signer{privKeys[2].PubKey(), 2}}
+	signBytes := []byte("sign bytes")
+
+	sign := func(index uint32) signerset.IndexedSignature {
+		sig, err := privKeys[index].Sign(signBytes)
+		require.NoError(t, err)
+
+		return signerset.IndexedSignature{SignerIndex: index, Signature: sig}
+	}
+
+	testCases := []struct {
+		name       string
+		signatures []signerset.IndexedSignature
+		expErr     error
+	}{
+		{"threshold met", []signerset.IndexedSignature{sign(0), sign(2)}, nil},
+		{"threshold exceeded", []signerset.IndexedSignature{sign(0), sign(1), sign(2)}, nil},
+		{"empty signatures", nil, signerset.ErrInvalidSignatures},
+		{"signer indices not increasing", []signerset.IndexedSignature{sign(2), sign(0)}, signerset.ErrInvalidSignatures},
+		{"duplicate signer index", []signerset.IndexedSignature{sign(0), sign(0), sign(1)}, signerset.ErrInvalidSignatures},
+		{"signer index out of range", []signerset.IndexedSignature{sign(2), {SignerIndex: 3, Signature: []byte("signature")}}, signerset.ErrInvalidSignatures},
+		{"invalid signature", []signerset.IndexedSignature{sign(0), {SignerIndex: 2, Signature: []byte("signature")}}, signerset.ErrSignatureVerification},
+		{"threshold not met", []signerset.IndexedSignature{sign(0), sign(1)}, signerset.ErrInsufficientSigningWeight},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := signerset.VerifySignatures(signers, 3, signBytes, tc.signatures)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -172,6 +172,52 @@ func (chain *TestChain) QueryProofAtHeight(key []byte, height int64) ([]byte, cl
 	return proof, clienttypes.NewHeight(revision, uint64(res.Height)+1)
 }
 
+// QueryBatchProof performs an abci query for each of the given keys and returns the proto encoded
+// merkle proof proving all the keys in a single compressed batch proof along with the height at
+// which the proof will succeed on a tendermint verifier.
+func (chain *TestChain) QueryBatchProof(keys [][]byte) ([]byte, clienttypes.Height) {
+	return chain.QueryBatchProofAtHeight(keys, chain.App.LastBlockHeight())
+}
+
+// QueryBatchProofAtHeight performs an abci query for each of the given keys and returns the proto encoded
+// merkle proof proving all the keys in a single compressed batch proof along with the height at
+// which the proof will succeed on a tendermint verifier.
+func (chain *TestChain) QueryBatchProofAtHeight(keys [][]byte, height int64) ([]byte, clienttypes.Height) {
+	require.NotEmpty(chain.t, keys)
+
+	var (
+		merkleProofs []commitmenttypes.MerkleProof
+		proofHeight  int64
+	)
+	for _, key := range keys {
+		res := chain.App.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+			Height: height - 1,
+			Data:   key,
+			Prove:  true,
+		})
+
+		merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+		require.NoError(chain.t, err)
+
+		merkleProofs = append(merkleProofs, merkleProof)
+		proofHeight = res.Height
+	}
+
+	merkleProof, err := commitmenttypes.CombineMerkleProofs(merkleProofs)
+	require.NoError(chain.t, err)
+
+	proof, err := chain.App.AppCodec().Marshal(&merkleProof)
+	require.NoError(chain.t, err)
+
+	revision := clienttypes.ParseChainID(chain.ChainID)
+
+	// proof height + 1 is returned as the proof created corresponds to the height the proof
+	// was created in the IAVL tree. Tendermint and subsequently the clients that rely on it
+	// have heights 1 above the IAVL tree. Thus we return proof height + 1
+	return proof, clienttypes.NewHeight(revision, uint64(proofHeight)+1)
+}
+
 // QueryUpgradeProof performs an abci query with the given key and returns the proto encoded merkle proof
 // for the query and the height at which the proof will succeed on a tendermint verifier.
 func (chain *TestChain) QueryUpgradeProof(key []byte, height uint64) ([]byte, clienttypes.Height) {
@@ -268,6 +314,8 @@ func (chain *TestChain) SendMsgs(msgs ...sdk.Msg) (*sdk.Result, error) {
 
 	chain.Coordinator.IncrementTime()
 
+	chain.Coordinator.relayEvents(chain, r.GetEvents())
+
 	return r, nil
 }
 
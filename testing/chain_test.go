@@ -6,6 +6,11 @@ import (
 	"github.com/stretchr/testify/require"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	"github.com/cosmos/ibc-go/v3/testing/mock"
 )
@@ -45,3 +50,41 @@ func TestCreateSortedSignerArray(t *testing.T) {
 	actual = ibctesting.CreateSortedSignerArray(privVal2, privVal1, validator2, validator1)
 	require.Equal(t, expected, actual)
 }
+
+func TestQueryBatchProof(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	coordinator.Setup(path)
+
+	var (
+		keys  [][]byte
+		items = make(map[string][]byte)
+	)
+	for seq := uint64(1); seq <= 3; seq++ {
+		packet := channeltypes.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+		require.NoError(t, path.EndpointA.SendPacket(packet))
+
+		key := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		keys = append(keys, key)
+		items[string(key)] = channeltypes.CommitPacket(chainA.App.AppCodec(), packet)
+	}
+
+	proof, proofHeight := path.EndpointA.QueryBatchProof(keys...)
+
+	var merkleProof commitmenttypes.MerkleProof
+	require.NoError(t, chainB.App.AppCodec().Unmarshal(proof, &merkleProof))
+	require.NotNil(t, merkleProof.Proofs[0].GetCompressed())
+
+	consensusState, found := chainB.GetConsensusState(path.EndpointB.ClientID, proofHeight)
+	require.True(t, found)
+
+	prefixPath, err := commitmenttypes.ApplyPrefix(chainA.GetPrefix(), commitmenttypes.NewMerklePath())
+	require.NoError(t, err)
+
+	clientState := path.EndpointB.GetClientState().(*ibctmtypes.ClientState)
+	err = merkleProof.BatchVerifyMembership(clientState.ProofSpecs, consensusState.GetRoot(), prefixPath, items)
+	require.NoError(t, err)
+}
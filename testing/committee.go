@@ -0,0 +1,110 @@
+package ibctesting
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	committeetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
+)
+
+// Committee is a testing helper used to simulate the weighted committee
+// signing the state roots of a counterparty tracked by a committee client.
+type Committee struct {
+	t *testing.T
+
+	cdc         codec.BinaryCodec
+	PrivateKeys []cryptotypes.PrivKey // keys used for signing
+	PublicKeys  []cryptotypes.PubKey  // keys of the committee members
+	Weights     []uint64              // weights of the committee members
+	Threshold   uint64                // weight required to sign a header
+}
+
+// NewCommittee returns a new committee instance with a generated private/public
+// key pair for every provided weight.
+func NewCommittee(t *testing.T, cdc codec.BinaryCodec, weights []uint64, threshold uint64) *Committee {
+	privKeys, pubKeys, _ := GenerateKeys(t, uint64(len(weights)))
+
+	return &Committee{
+		t:           t,
+		cdc:         cdc,
+		PrivateKeys: privKeys,
+		PublicKeys:  pubKeys,
+		Weights:     weights,
+		Threshold:   threshold,
+	}
+}
+
+// Committee returns the committee client representation of the committee.
+func (committee *Committee) Committee() committeetypes.Committee {
+	members := make([]committeetypes.Member, len(committee.PublicKeys))
+	for i, pubKey := range committee.PublicKeys {
+		member, err := committeetypes.NewMember(pubKey, committee.Weights[i])
+		require.NoError(committee.t, err)
+
+		members[i] = member
+	}
+
+	return committeetypes.NewCommittee(members, committee.Threshold)
+}
+
+// CreateHeader creates a committee client header for the provided height, timestamp and
+// root of the counterparty with the given chain-id. The header rotates the committee if
+// newCommittee is non-nil. The header is signed by the members at the provided indices,
+// or by every member if no indices are provided.
+func (committee *Committee) CreateHeader(
+	chainID string, height clienttypes.Height, timestamp uint64, root []byte,
+	newCommittee *committeetypes.Committee, signers ...uint32,
+) *committeetypes.Header {
+	header := &committeetypes.Header{
+		Height:       height,
+		Timestamp:    timestamp,
+		Root:         root,
+		NewCommittee: newCommittee,
+	}
+
+	signBytes, err := committeetypes.HeaderSignBytes(committee.cdc, chainID, header)
+	require.NoError(committee.t, err)
+
+	header.Signatures = committee.GenerateSignatures(signBytes, signers...)
+
+	return header
+}
+
+// CreateCounterpartyHeader creates a committee client header, signed by every member,
+// for the last header committed by the counterparty chain.
+func (committee *Committee) CreateCounterpartyHeader(counterparty *TestChain) *committeetypes.Header {
+	lastHeader := counterparty.LastHeader
+
+	return committee.CreateHeader(
+		counterparty.ChainID, lastHeader.GetHeight().(clienttypes.Height),
+		uint64(lastHeader.GetTime().UnixNano()), lastHeader.Header.GetAppHash(), nil,
+	)
+}
+
+// GenerateSignatures signs the sign bytes with the private keys of the members at the
+// provided indices, or of every member if no indices are provided. The indices must be
+// strictly increasing for the signatures to be valid.
+func (committee *Committee) GenerateSignatures(signBytes []byte, signers ...uint32) []committeetypes.MemberSignature {
+	if len(signers) == 0 {
+		for i := range committee.PrivateKeys {
+			signers = append(signers, uint32(i))
+		}
+	}
+
+	signatures := make([]committeetypes.MemberSignature, len(signers))
+	for i, signer := range signers {
+		sig, err := committee.PrivateKeys[signer].Sign(signBytes)
+		require.NoError(committee.t, err)
+
+		signatures[i] = committeetypes.MemberSignature{
+			MemberIndex: signer,
+			Signature:   sig,
+		}
+	}
+
+	return signatures
+}
@@ -38,6 +38,35 @@ func (tmcfg *TendermintConfig) GetClientType() string {
 	return exported.Tendermint
 }
 
+// LocalhostConfig is the client configuration of endpoints using the localhost client.
+type LocalhostConfig struct{}
+
+func NewLocalhostConfig() *LocalhostConfig {
+	return &LocalhostConfig{}
+}
+
+func (lhcfg *LocalhostConfig) GetClientType() string {
+	return exported.Localhost
+}
+
+// CommitteeConfig is the client configuration of endpoints using the committee client.
+// The committee signs the headers used to create and update the client.
+type CommitteeConfig struct {
+	Committee                    *Committee
+	AllowUpdateAfterMisbehaviour bool
+}
+
+func NewCommitteeConfig(committee *Committee) *CommitteeConfig {
+	return &CommitteeConfig{
+		Committee:                    committee,
+		AllowUpdateAfterMisbehaviour: false,
+	}
+}
+
+func (cmcfg *CommitteeConfig) GetClientType() string {
+	return exported.Committee
+}
+
 type ConnectionConfig struct {
 	DelayPeriod uint64
 	Version     *connectiontypes.Version
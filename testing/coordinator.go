@@ -6,8 +6,11 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
 var (
@@ -17,12 +20,14 @@ var (
 )
 
 // Coordinator is a testing struct which contains N TestChain's. It handles keeping all chains
-// in sync with regards to time.
+// in sync with regards to time. If a Relayer is set, the packets and acknowledgements committed
+// on the chains are relayed automatically.
 type Coordinator struct {
 	t *testing.T
 
 	CurrentTime time.Time
 	Chains      map[string]*TestChain
+	Relayer     *Relayer
 }
 
 // NewCoordinator initializes Coordinator with N TestChain's
@@ -70,6 +75,31 @@ func (coord *Coordinator) UpdateTimeForChain(chain *TestChain) {
 	chain.App.BeginBlock(abci.RequestBeginBlock{Header: chain.CurrentHeader})
 }
 
+// EnableRelayer sets a Relayer relaying over the provided paths using the provided configuration.
+// The packets sent and the acknowledgements written on the chains of the paths are relayed
+// automatically once committed. The paths are expected to be set up.
+func (coord *Coordinator) EnableRelayer(config RelayerConfig, paths ...*Path) *Relayer {
+	coord.Relayer = NewRelayer(coord, config, paths...)
+	return coord.Relayer
+}
+
+// DisableRelayer removes the Relayer of the Coordinator. Packets are no longer relayed automatically.
+func (coord *Coordinator) DisableRelayer() {
+	coord.Relayer = nil
+}
+
+// relayEvents passes the events committed on the provided chain to the Relayer, if set, and relays
+// the packets and acknowledgements found. Relaying errors are retained by the Relayer and returned
+// by Relay.
+func (coord *Coordinator) relayEvents(chain *TestChain, events sdk.Events) {
+	if coord.Relayer == nil {
+		return
+	}
+
+	coord.Relayer.observe(chain, events)
+	_ = coord.Relayer.Relay()
+}
+
 // Setup constructs a TM client, connection, and channel on both chains provided. It will
 // fail if any error occurs. The clientID's, TestConnections, and TestChannels are returned
 // for both chains. The channels created are connected to the ibc-transfer application.
@@ -102,8 +132,13 @@ func (coord *Coordinator) SetupConnections(path *Path) {
 // CreateConnection constructs and executes connection handshake messages in order to create
 // OPEN channels on chainA and chainB. The connection information of for chainA and chainB
 // are returned within a TestConnection struct. The function expects the connections to be
-// successfully opened otherwise testing will fail.
+// successfully opened otherwise testing will fail. No handshake is executed for localhost
+// paths since the sentinel localhost connection is always OPEN.
 func (coord *Coordinator) CreateConnections(path *Path) {
+	if path.EndpointA.ClientID == exported.Localhost && path.EndpointB.ClientID == exported.Localhost {
+		return
+	}
+
 	err := path.EndpointA.ConnOpenInit()
 	require.NoError(coord.t, err)
 
@@ -13,6 +13,7 @@ import (
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	committeetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/10-committee/types"
 )
 
 // Endpoint is a which represents a channel endpoint and its associated
@@ -73,6 +74,22 @@ func (endpoint *Endpoint) QueryProofAtHeight(key []byte, height uint64) ([]byte,
 	return endpoint.Chain.QueryProofAtHeight(key, int64(height))
 }
 
+// QueryBatchProof queries a single batch proof for all the given keys associated with this endpoint
+// using the lastest client state height on the counterparty chain.
+func (endpoint *Endpoint) QueryBatchProof(keys ...[]byte) ([]byte, clienttypes.Height) {
+	// obtain the counterparty client representing the chain associated with the endpoint
+	clientState := endpoint.Counterparty.Chain.GetClientState(endpoint.Counterparty.ClientID)
+
+	// query proof on the counterparty using the latest height of the IBC client
+	return endpoint.QueryBatchProofAtHeight(clientState.GetLatestHeight().GetRevisionHeight(), keys...)
+}
+
+// QueryBatchProofAtHeight queries a single batch proof for all the given keys associated with this
+// endpoint using the proof height provided
+func (endpoint *Endpoint) QueryBatchProofAtHeight(height uint64, keys ...[]byte) ([]byte, clienttypes.Height) {
+	return endpoint.Chain.QueryBatchProofAtHeight(keys, int64(height))
+}
+
 // CreateClient creates an IBC client on the endpoint. It will update the
 // clientID for the endpoint if the message is successfully executed.
 // NOTE: a solo machine client will be created with an empty diversifier.
@@ -96,6 +113,20 @@ func (endpoint *Endpoint) CreateClient() (err error) {
 			height, commitmenttypes.GetSDKSpecs(), UpgradePath, tmConfig.AllowUpdateAfterExpiry, tmConfig.AllowUpdateAfterMisbehaviour,
 		)
 		consensusState = endpoint.Counterparty.Chain.LastHeader.ConsensusState()
+	case exported.Localhost:
+		return endpoint.createLocalhostClient()
+	case exported.Committee:
+		committeeConfig, ok := endpoint.ClientConfig.(*CommitteeConfig)
+		require.True(endpoint.Chain.t, ok)
+
+		endpoint.allowClient(exported.Committee)
+
+		header := committeeConfig.Committee.CreateCounterpartyHeader(endpoint.Counterparty.Chain)
+		clientState = committeetypes.NewClientState(
+			endpoint.Counterparty.Chain.ChainID, committeeConfig.Committee.Committee(), header.Height,
+			commitmenttypes.GetSDKSpecs(), committeeConfig.AllowUpdateAfterMisbehaviour,
+		)
+		consensusState = header.ConsensusState()
 	case exported.Solomachine:
 		// TODO
 		//		solo := NewSolomachine(chain.t, endpoint.Chain.Codec, clientID, "", 1)
@@ -126,6 +157,39 @@ func (endpoint *Endpoint) CreateClient() (err error) {
 	return nil
 }
 
+// createLocalhostClient registers the localhost client on the client allowlist of the endpoint
+// chain and commits a block so that the localhost client is created at BeginBlock. The endpoint
+// client and connection identifiers are set to the localhost client and the sentinel localhost
+// connection.
+func (endpoint *Endpoint) createLocalhostClient() error {
+	clientKeeper := endpoint.Chain.App.GetIBCKeeper().ClientKeeper
+
+	endpoint.allowClient(exported.Localhost)
+
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	if _, found := clientKeeper.GetClientState(endpoint.Chain.GetContext(), exported.Localhost); !found {
+		return fmt.Errorf("localhost client was not created")
+	}
+
+	endpoint.ClientID = exported.Localhost
+	endpoint.ConnectionID = exported.LocalhostConnectionID
+
+	return nil
+}
+
+// allowClient adds the client type to the client allowlist of the endpoint chain if it
+// is not already allowed.
+func (endpoint *Endpoint) allowClient(clientType string) {
+	clientKeeper := endpoint.Chain.App.GetIBCKeeper().ClientKeeper
+
+	params := clientKeeper.GetParams(endpoint.Chain.GetContext())
+	if !params.IsAllowedClient(clientType) {
+		params.AllowedClients = append(params.AllowedClients, clientType)
+		clientKeeper.SetParams(endpoint.Chain.GetContext(), params)
+	}
+}
+
 // UpdateClient updates the IBC client associated with the endpoint.
 func (endpoint *Endpoint) UpdateClient() (err error) {
 	// ensure counterparty has committed state
@@ -138,6 +202,14 @@ func (endpoint *Endpoint) UpdateClient() (err error) {
 	switch endpoint.ClientConfig.GetClientType() {
 	case exported.Tendermint:
 		header, err = endpoint.Chain.ConstructUpdateTMClientHeader(endpoint.Counterparty.Chain, endpoint.ClientID)
+	case exported.Localhost:
+		// the localhost client is updated by the client keeper at BeginBlock
+		return nil
+	case exported.Committee:
+		committeeConfig, ok := endpoint.ClientConfig.(*CommitteeConfig)
+		require.True(endpoint.Chain.t, ok)
+
+		header = committeeConfig.Committee.CreateCounterpartyHeader(endpoint.Counterparty.Chain)
 
 	default:
 		err = fmt.Errorf("client type %s is not supported", endpoint.ClientConfig.GetClientType())
@@ -355,19 +427,157 @@ func (endpoint *Endpoint) ChanCloseInit() error {
 	return endpoint.Chain.sendMsgs(msg)
 }
 
+// ChanUpgradeInit will construct and execute a MsgChannelUpgradeInit on the associated endpoint
+// proposing the provided upgrade.
+func (endpoint *Endpoint) ChanUpgradeInit(upgrade channeltypes.Upgrade) error {
+	msg := channeltypes.NewMsgChannelUpgradeInit(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		upgrade,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeTry will construct and execute a MsgChannelUpgradeTry on the associated endpoint
+// accepting the upgrade stored on the counterparty using the endpoint connection.
+func (endpoint *Endpoint) ChanUpgradeTry() error {
+	endpoint.UpdateClient()
+
+	counterpartyChannel, counterpartyUpgrade, proofChannel, proofUpgrade, height := endpoint.QueryChannelUpgradeProof()
+
+	msg := channeltypes.NewMsgChannelUpgradeTry(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		[]string{endpoint.ConnectionID},
+		counterpartyChannel, counterpartyUpgrade,
+		proofChannel, proofUpgrade, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeAck will construct and execute a MsgChannelUpgradeAck on the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeAck() error {
+	endpoint.UpdateClient()
+
+	counterpartyChannel, counterpartyUpgrade, proofChannel, proofUpgrade, height := endpoint.QueryChannelUpgradeProof()
+
+	msg := channeltypes.NewMsgChannelUpgradeAck(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		counterpartyChannel, counterpartyUpgrade,
+		proofChannel, proofUpgrade, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	endpoint.updateChannelConfig()
+	return nil
+}
+
+// ChanUpgradeConfirm will construct and execute a MsgChannelUpgradeConfirm on the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeConfirm() error {
+	endpoint.UpdateClient()
+
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.Counterparty.Chain.QueryProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelUpgradeConfirm(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Counterparty.GetChannel(),
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	endpoint.updateChannelConfig()
+	return nil
+}
+
+// ChanUpgradeTimeout will construct and execute a MsgChannelUpgradeTimeout on the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeTimeout() error {
+	endpoint.UpdateClient()
+
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.Counterparty.Chain.QueryProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelUpgradeTimeout(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Counterparty.GetChannel(),
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeCancel will construct and execute a MsgChannelUpgradeCancel on the associated endpoint
+// using the error receipt written by the counterparty.
+func (endpoint *Endpoint) ChanUpgradeCancel() error {
+	endpoint.UpdateClient()
+
+	errorReceipt, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(
+		endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID,
+	)
+	require.True(endpoint.Chain.t, found)
+
+	errorReceiptKey := host.ChannelUpgradeErrorKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.Counterparty.Chain.QueryProof(errorReceiptKey)
+
+	msg := channeltypes.NewMsgChannelUpgradeCancel(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		errorReceipt,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// QueryChannelUpgradeProof returns the counterparty channel and upgrade along with their
+// proofs at the same height.
+func (endpoint *Endpoint) QueryChannelUpgradeProof() (
+	counterpartyChannel channeltypes.Channel, counterpartyUpgrade channeltypes.Upgrade,
+	proofChannel, proofUpgrade []byte, height clienttypes.Height,
+) {
+	counterpartyChannel = endpoint.Counterparty.GetChannel()
+
+	counterpartyUpgrade, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(
+		endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID,
+	)
+	require.True(endpoint.Chain.t, found)
+
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proofChannel, height = endpoint.Counterparty.Chain.QueryProof(channelKey)
+
+	upgradeKey := host.ChannelUpgradeKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proofUpgrade, _ = endpoint.Counterparty.Chain.QueryProof(upgradeKey)
+
+	return counterpartyChannel, counterpartyUpgrade, proofChannel, proofUpgrade, height
+}
+
+// updateChannelConfig sets the endpoint channel config to the parameters of the channel in state.
+func (endpoint *Endpoint) updateChannelConfig() {
+	channel := endpoint.GetChannel()
+	endpoint.ChannelConfig.Order = channel.Ordering
+	endpoint.ChannelConfig.Version = channel.Version
+}
+
 // SendPacket sends a packet through the channel keeper using the associated endpoint
 // The counterparty client is updated so proofs can be sent to the counterparty chain.
 func (endpoint *Endpoint) SendPacket(packet exported.PacketI) error {
 	channelCap := endpoint.Chain.GetChannelCapability(packet.GetSourcePort(), packet.GetSourceChannel())
 
 	// no need to send message, acting as a module
-	err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(endpoint.Chain.GetContext(), channelCap, packet)
+	ctx := endpoint.Chain.GetContext()
+	err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap, packet)
 	if err != nil {
 		return err
 	}
 
 	// commit changes since no message was sent
 	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+	endpoint.Chain.Coordinator.relayEvents(endpoint.Chain, ctx.EventManager().Events())
 
 	return endpoint.Counterparty.UpdateClient()
 }
@@ -405,19 +615,58 @@ func (endpoint *Endpoint) RecvPacketWithResult(packet channeltypes.Packet) (*sdk
 	return res, nil
 }
 
+// RecvPackets receives a batch of packets on the associated endpoint using a single
+// batch proof of the packet commitments. The counterparty client is updated.
+func (endpoint *Endpoint) RecvPackets(packets ...channeltypes.Packet) error {
+	_, err := endpoint.RecvPacketsWithResult(packets...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecvPacketsWithResult receives a batch of packets on the associated endpoint using a
+// single batch proof of the packet commitments and the result of the transaction is
+// returned. The counterparty client is updated.
+func (endpoint *Endpoint) RecvPacketsWithResult(packets ...channeltypes.Packet) (*sdk.Result, error) {
+	// get batch proof of packet commitments on source
+	packetKeys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		packetKeys[i] = host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+	proof, proofHeight := endpoint.Counterparty.Chain.QueryBatchProof(packetKeys)
+
+	recvMsg := channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	// receive on counterparty and update source client
+	res, err := endpoint.Chain.SendMsgs(recvMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.Counterparty.UpdateClient(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // WriteAcknowledgement writes an acknowledgement on the channel associated with the endpoint.
 // The counterparty client is updated.
 func (endpoint *Endpoint) WriteAcknowledgement(ack exported.Acknowledgement, packet exported.PacketI) error {
 	channelCap := endpoint.Chain.GetChannelCapability(packet.GetDestPort(), packet.GetDestChannel())
 
 	// no need to send message, acting as a handler
-	err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.WriteAcknowledgement(endpoint.Chain.GetContext(), channelCap, packet, ack)
+	ctx := endpoint.Chain.GetContext()
+	err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.WriteAcknowledgement(ctx, channelCap, packet, ack)
 	if err != nil {
 		return err
 	}
 
 	// commit changes since no message was sent
 	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+	endpoint.Chain.Coordinator.relayEvents(endpoint.Chain, ctx.EventManager().Events())
 
 	return endpoint.Counterparty.UpdateClient()
 }
@@ -441,14 +690,15 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	switch endpoint.ChannelConfig.Order {
 	case channeltypes.ORDERED:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
-	case channeltypes.UNORDERED:
+	case channeltypes.UNORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT:
+		// UNORDERED channels prove receipt absence, ORDERED_ALLOW_TIMEOUT channels prove the timeout receipt
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
 	}
 
 	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
-	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
 	require.True(endpoint.Chain.t, found)
 
 	timeoutMsg := channeltypes.NewMsgTimeout(
@@ -1,6 +1,7 @@
 package ibctesting
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -62,58 +63,124 @@ func ParseChannelIDFromEvents(events sdk.Events) (string, error) {
 func ParsePacketFromEvents(events sdk.Events) (channeltypes.Packet, error) {
 	for _, ev := range events {
 		if ev.Type == channeltypes.EventTypeSendPacket {
-			packet := channeltypes.Packet{}
-			for _, attr := range ev.Attributes {
+			return parsePacketFromEvent(ev)
+		}
+	}
+	return channeltypes.Packet{}, fmt.Errorf("acknowledgement event attribute not found")
+}
+
+// ParsePacketsFromEvents parses events emitted when sending packets and returns all the
+// packets sent.
+func ParsePacketsFromEvents(events sdk.Events) ([]channeltypes.Packet, error) {
+	var packets []channeltypes.Packet
+	for _, ev := range events {
+		if ev.Type == channeltypes.EventTypeSendPacket {
+			packet, err := parsePacketFromEvent(ev)
+			if err != nil {
+				return nil, err
+			}
+
+			packets = append(packets, packet)
+		}
+	}
+	return packets, nil
+}
+
+// PacketAcknowledgement is a packet along with the acknowledgement written for it.
+type PacketAcknowledgement struct {
+	Packet          channeltypes.Packet
+	Acknowledgement []byte
+}
 
-				switch string(attr.Key) {
-				case channeltypes.AttributeKeyData:
-					packet.Data = attr.Value
+// ParseAcksFromEvents parses events emitted when writing acknowledgements, synchronously or
+// asynchronously, and returns all the packets acknowledged along with their acknowledgement.
+func ParseAcksFromEvents(events sdk.Events) ([]PacketAcknowledgement, error) {
+	var acks []PacketAcknowledgement
+	for _, ev := range events {
+		if ev.Type == channeltypes.EventTypeWriteAck {
+			packet, err := parsePacketFromEvent(ev)
+			if err != nil {
+				return nil, err
+			}
 
-				case channeltypes.AttributeKeySequence:
-					seq, err := strconv.ParseUint(string(attr.Value), 10, 64)
+			var ack []byte
+			for _, attr := range ev.Attributes {
+				if string(attr.Key) == channeltypes.AttributeKeyAckHex {
+					ack, err = hex.DecodeString(string(attr.Value))
 					if err != nil {
-						return channeltypes.Packet{}, err
+						return nil, err
 					}
+				}
+			}
 
-					packet.Sequence = seq
+			acks = append(acks, PacketAcknowledgement{Packet: packet, Acknowledgement: ack})
+		}
+	}
+	return acks, nil
+}
 
-				case channeltypes.AttributeKeySrcPort:
-					packet.SourcePort = string(attr.Value)
+// parsePacketFromEvent parses the packet from the attributes of a packet event. The hex encoded
+// packet data is used if present.
+func parsePacketFromEvent(ev sdk.Event) (channeltypes.Packet, error) {
+	packet := channeltypes.Packet{}
+	for _, attr := range ev.Attributes {
 
-				case channeltypes.AttributeKeySrcChannel:
-					packet.SourceChannel = string(attr.Value)
+		switch string(attr.Key) {
+		case channeltypes.AttributeKeyData:
+			if packet.Data == nil {
+				packet.Data = attr.Value
+			}
 
-				case channeltypes.AttributeKeyDstPort:
-					packet.DestinationPort = string(attr.Value)
+		case channeltypes.AttributeKeyDataHex:
+			data, err := hex.DecodeString(string(attr.Value))
+			if err != nil {
+				return channeltypes.Packet{}, err
+			}
 
-				case channeltypes.AttributeKeyDstChannel:
-					packet.DestinationChannel = string(attr.Value)
+			packet.Data = data
 
-				case channeltypes.AttributeKeyTimeoutHeight:
-					height, err := clienttypes.ParseHeight(string(attr.Value))
-					if err != nil {
-						return channeltypes.Packet{}, err
-					}
+		case channeltypes.AttributeKeySequence:
+			seq, err := strconv.ParseUint(string(attr.Value), 10, 64)
+			if err != nil {
+				return channeltypes.Packet{}, err
+			}
 
-					packet.TimeoutHeight = height
+			packet.Sequence = seq
 
-				case channeltypes.AttributeKeyTimeoutTimestamp:
-					timestamp, err := strconv.ParseUint(string(attr.Value), 10, 64)
-					if err != nil {
-						return channeltypes.Packet{}, err
-					}
+		case channeltypes.AttributeKeySrcPort:
+			packet.SourcePort = string(attr.Value)
 
-					packet.TimeoutTimestamp = timestamp
+		case channeltypes.AttributeKeySrcChannel:
+			packet.SourceChannel = string(attr.Value)
 
-				default:
-					continue
-				}
+		case channeltypes.AttributeKeyDstPort:
+			packet.DestinationPort = string(attr.Value)
+
+		case channeltypes.AttributeKeyDstChannel:
+			packet.DestinationChannel = string(attr.Value)
+
+		case channeltypes.AttributeKeyTimeoutHeight:
+			height, err := clienttypes.ParseHeight(string(attr.Value))
+			if err != nil {
+				return channeltypes.Packet{}, err
+			}
+
+			packet.TimeoutHeight = height
+
+		case channeltypes.AttributeKeyTimeoutTimestamp:
+			timestamp, err := strconv.ParseUint(string(attr.Value), 10, 64)
+			if err != nil {
+				return channeltypes.Packet{}, err
 			}
 
-			return packet, nil
+			packet.TimeoutTimestamp = timestamp
+
+		default:
+			continue
 		}
 	}
-	return channeltypes.Packet{}, fmt.Errorf("acknowledgement event attribute not found")
+
+	return packet, nil
 }
 
 // ParseAckFromEvents parses events emitted from a MsgRecvPacket and returns the
@@ -61,6 +61,43 @@ type MockIBCApp struct {
 		channelID string,
 	) error
 
+	OnChanUpgradeInit func(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	) error
+
+	OnChanUpgradeTry func(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		counterpartyVersion string,
+	) (version string, err error)
+
+	OnChanUpgradeAck func(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		counterpartyVersion string,
+	) error
+
+	OnChanUpgradeConfirm func(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	) error
+
+	OnChanUpgradeRestore func(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	)
+
 	// OnRecvPacket must return an acknowledgement that implements the Acknowledgement interface.
 	// In the case of an asynchronous acknowledgement, nil should be returned.
 	// If the acknowledgement returned is successful, the state changes on callback are written,
@@ -99,6 +99,50 @@ func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string
 	return nil
 }
 
+// OnChanUpgradeInit implements the IBCModule interface.
+func (im IBCModule) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, version string) error {
+	if im.IBCApp.OnChanUpgradeInit != nil {
+		return im.IBCApp.OnChanUpgradeInit(ctx, portID, channelID, order, connectionHops, version)
+	}
+
+	return nil
+}
+
+// OnChanUpgradeTry implements the IBCModule interface. The counterparty version is
+// accepted by default.
+func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, counterpartyVersion string) (string, error) {
+	if im.IBCApp.OnChanUpgradeTry != nil {
+		return im.IBCApp.OnChanUpgradeTry(ctx, portID, channelID, order, connectionHops, counterpartyVersion)
+	}
+
+	return counterpartyVersion, nil
+}
+
+// OnChanUpgradeAck implements the IBCModule interface.
+func (im IBCModule) OnChanUpgradeAck(ctx sdk.Context, portID, channelID string, counterpartyVersion string) error {
+	if im.IBCApp.OnChanUpgradeAck != nil {
+		return im.IBCApp.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+	}
+
+	return nil
+}
+
+// OnChanUpgradeConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanUpgradeConfirm(ctx sdk.Context, portID, channelID string) error {
+	if im.IBCApp.OnChanUpgradeConfirm != nil {
+		return im.IBCApp.OnChanUpgradeConfirm(ctx, portID, channelID)
+	}
+
+	return nil
+}
+
+// OnChanUpgradeRestore implements the IBCModule interface.
+func (im IBCModule) OnChanUpgradeRestore(ctx sdk.Context, portID, channelID string) {
+	if im.IBCApp.OnChanUpgradeRestore != nil {
+		im.IBCApp.OnChanUpgradeRestore(ctx, portID, channelID)
+	}
+}
+
 // OnRecvPacket implements the IBCModule interface.
 func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
 	if im.IBCApp.OnRecvPacket != nil {
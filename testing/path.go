@@ -29,12 +29,34 @@ func NewPath(chainA, chainB *TestChain) *Path {
 	}
 }
 
+// NewLocalhostPath constructs an endpoint for each side of a loopback path on the provided
+// chain. Both endpoints use the localhost client and the sentinel localhost connection, thus
+// the chain opens channels and relays packets to itself.
+func NewLocalhostPath(chain *TestChain) *Path {
+	endpointA := NewEndpoint(chain, NewLocalhostConfig(), NewConnectionConfig(), NewChannelConfig())
+	endpointB := NewEndpoint(chain, NewLocalhostConfig(), NewConnectionConfig(), NewChannelConfig())
+
+	endpointA.Counterparty = endpointB
+	endpointB.Counterparty = endpointA
+
+	return &Path{
+		EndpointA: endpointA,
+		EndpointB: endpointB,
+	}
+}
+
 // SetChannelOrdered sets the channel order for both endpoints to ORDERED.
 func (path *Path) SetChannelOrdered() {
 	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
 	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
 }
 
+// SetChannelOrderedAllowTimeout sets the channel order for both endpoints to ORDERED_ALLOW_TIMEOUT.
+func (path *Path) SetChannelOrderedAllowTimeout() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+}
+
 // RelayPacket attempts to relay the packet first on EndpointA and then on EndpointB
 // if EndpointA does not contain a packet commitment for that packet. An error is returned
 // if a relay step fails or the packet commitment does not exist on either endpoint.
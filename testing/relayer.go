@@ -0,0 +1,444 @@
+package ibctesting
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// RelayerConfig defines the behaviour of the relayer of the Coordinator.
+type RelayerConfig struct {
+	// Delay is the amount of time elapsed between a packet or acknowledgement being committed and
+	// it being relayed. The relayer advances the time of the Coordinator when waiting is required.
+	Delay time.Duration
+
+	// Drop returns true if the provided packet must not be received by its destination. Dropped
+	// packets are timed out once their timeout has elapsed. No packet is dropped if Drop is nil.
+	Drop func(packet channeltypes.Packet) bool
+
+	// Rand is used to reorder the packets and acknowledgements ready to be relayed. They are relayed
+	// in the order they were committed if Rand is nil.
+	Rand *rand.Rand
+
+	// ExpireClients disables the client updates performed by the relayer while advancing time, allowing
+	// the clients of the registered paths to expire if the delay exceeds their trusting period.
+	ExpireClients bool
+}
+
+// Relayer relays the packets sent and the acknowledgements written over the registered paths. It
+// observes the events of the blocks committed on the TestChains of the Coordinator and relays until
+// no more packet or acknowledgement can be relayed. Relaying a packet may result in new packets
+// being sent, such as when forwarding packets over several paths, which are relayed as well.
+type Relayer struct {
+	coord *Coordinator
+
+	Config RelayerConfig
+	Paths  []*Path
+
+	// Acknowledgements contains the packets acknowledged on their source chain by the relayer
+	Acknowledgements []PacketAcknowledgement
+	// Timeouts contains the packets timed out on their source chain by the relayer
+	Timeouts []channeltypes.Packet
+
+	pending  []*relayItem
+	relaying bool
+}
+
+// relayItem is a packet to be received or an acknowledgement to be relayed. The endpoint is the
+// endpoint on which the packet was sent or the acknowledgement was written.
+type relayItem struct {
+	endpoint        *Endpoint
+	packet          channeltypes.Packet
+	acknowledgement []byte
+	readyTime       time.Time
+	err             error
+}
+
+// NewRelayer creates a relayer relaying over the provided paths using the provided configuration.
+func NewRelayer(coord *Coordinator, config RelayerConfig, paths ...*Path) *Relayer {
+	return &Relayer{
+		coord:  coord,
+		Config: config,
+		Paths:  paths,
+	}
+}
+
+// AddPath registers a path the relayer relays over.
+func (relayer *Relayer) AddPath(path *Path) {
+	relayer.Paths = append(relayer.Paths, path)
+}
+
+// PendingPackets returns the packets which are yet to be received or acknowledged by the relayer.
+func (relayer *Relayer) PendingPackets() []channeltypes.Packet {
+	packets := make([]channeltypes.Packet, len(relayer.pending))
+	for i, item := range relayer.pending {
+		packets[i] = item.packet
+	}
+
+	return packets
+}
+
+// Relay relays the pending packets and acknowledgements until no more progress can be made,
+// advancing the time of the Coordinator to satisfy the configured delay. It is called automatically
+// whenever a block containing packets or acknowledgements is committed. An error is returned if
+// relaying any of the remaining packets or acknowledgements failed.
+func (relayer *Relayer) Relay() error {
+	// packets and acknowledgements committed while relaying are relayed by the outer call
+	if relayer.relaying {
+		return nil
+	}
+
+	relayer.relaying = true
+	defer func() { relayer.relaying = false }()
+
+	for {
+		if relayer.relayReady() {
+			continue
+		}
+
+		readyTime, found := relayer.nextReadyTime()
+		if !found {
+			break
+		}
+
+		if err := relayer.advanceTime(readyTime); err != nil {
+			return err
+		}
+	}
+
+	var errs []string
+	for _, item := range relayer.pending {
+		if item.err != nil {
+			errs = append(errs, fmt.Sprintf("packet %d on port %s channel %s: %s", item.packet.Sequence, item.packet.SourcePort, item.packet.SourceChannel, item.err))
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("failed to relay %d packets: %s", len(errs), strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// observe registers the packets sent and the acknowledgements written in the provided events
+// committed on the provided chain. Events of channels not belonging to a registered path are ignored.
+func (relayer *Relayer) observe(chain *TestChain, events sdk.Events) {
+	packets, err := ParsePacketsFromEvents(events)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, packet := range packets {
+		if endpoint, found := relayer.getEndpoint(chain, packet.SourcePort, packet.SourceChannel); found {
+			relayer.pending = append(relayer.pending, &relayItem{
+				endpoint:  endpoint,
+				packet:    packet,
+				readyTime: relayer.coord.CurrentTime.Add(relayer.Config.Delay),
+			})
+		}
+	}
+
+	acks, err := ParseAcksFromEvents(events)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, ack := range acks {
+		if endpoint, found := relayer.getEndpoint(chain, ack.Packet.DestinationPort, ack.Packet.DestinationChannel); found {
+			relayer.pending = append(relayer.pending, &relayItem{
+				endpoint:        endpoint,
+				packet:          ack.Packet,
+				acknowledgement: ack.Acknowledgement,
+				readyTime:       relayer.coord.CurrentTime.Add(relayer.Config.Delay),
+			})
+		}
+	}
+}
+
+// getEndpoint returns the endpoint of a registered path on the provided chain using the provided
+// port and channel identifiers.
+func (relayer *Relayer) getEndpoint(chain *TestChain, portID, channelID string) (*Endpoint, bool) {
+	for _, path := range relayer.Paths {
+		for _, endpoint := range []*Endpoint{path.EndpointA, path.EndpointB} {
+			if endpoint.Chain == chain && endpoint.ChannelConfig.PortID == portID && endpoint.ChannelID == channelID {
+				return endpoint, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// relayReady attempts to relay every pending packet and acknowledgement whose delay has elapsed.
+// It returns true if any of them was relayed.
+func (relayer *Relayer) relayReady() bool {
+	var ready []*relayItem
+	for _, item := range relayer.pending {
+		if !item.readyTime.After(relayer.coord.CurrentTime) {
+			ready = append(ready, item)
+		}
+	}
+
+	if relayer.Config.Rand != nil {
+		relayer.Config.Rand.Shuffle(len(ready), func(i, j int) {
+			ready[i], ready[j] = ready[j], ready[i]
+		})
+	}
+
+	var progress bool
+	for _, item := range ready {
+		var done bool
+		if item.acknowledgement == nil {
+			done, item.err = relayer.recvPacket(item)
+		} else {
+			done, item.err = relayer.acknowledgePacket(item)
+		}
+
+		if done {
+			relayer.remove(item)
+			progress = true
+		}
+	}
+
+	return progress
+}
+
+// recvPacket receives the packet on its destination or times it out on its source if its timeout
+// has elapsed. It returns true if the packet no longer needs to be relayed.
+func (relayer *Relayer) recvPacket(item *relayItem) (bool, error) {
+	source, destination := item.endpoint, item.endpoint.Counterparty
+
+	if !hasPacketCommitment(source, item.packet) {
+		return true, nil
+	}
+
+	channel := destination.GetChannel()
+	if channel.State != channeltypes.OPEN {
+		return false, fmt.Errorf("channel %s on chain %s is not OPEN (got %s)", destination.ChannelID, destination.Chain.ChainID, channel.State)
+	}
+
+	// packets timed out on ORDERED_ALLOW_TIMEOUT channels are received to write a timeout receipt
+	allowTimeout := channel.Ordering == channeltypes.ORDERED_ALLOW_TIMEOUT
+
+	if channel.Ordering.IsOrdered() {
+		nextSequenceRecv, _ := destination.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(
+			destination.Chain.GetContext(), destination.ChannelConfig.PortID, destination.ChannelID,
+		)
+
+		switch {
+		case item.packet.Sequence > nextSequenceRecv:
+			return false, fmt.Errorf("packet is not the next packet to be received (%d ≠ %d)", item.packet.Sequence, nextSequenceRecv)
+		case item.packet.Sequence < nextSequenceRecv:
+			if allowTimeout && isTimedOut(destination.Chain, item.packet) {
+				return relayer.timeoutPacket(item)
+			}
+
+			return true, nil
+		}
+	}
+
+	if isTimedOut(destination.Chain, item.packet) && !allowTimeout {
+		return relayer.timeoutPacket(item)
+	}
+
+	if !isTimedOut(destination.Chain, item.packet) && relayer.Config.Drop != nil && relayer.Config.Drop(item.packet) {
+		return false, nil
+	}
+
+	if err := updateClient(destination); err != nil {
+		return false, err
+	}
+
+	// the packet may time out while the client is updated
+	timedOut := isTimedOut(destination.Chain, item.packet)
+	if timedOut && !allowTimeout {
+		return relayer.timeoutPacket(item)
+	}
+
+	if _, err := destination.RecvPacketWithResult(item.packet); err != nil {
+		return false, err
+	}
+
+	if timedOut {
+		return relayer.timeoutPacket(item)
+	}
+
+	return true, nil
+}
+
+// timeoutPacket times out the packet on its source. It returns true if the packet was timed out.
+func (relayer *Relayer) timeoutPacket(item *relayItem) (bool, error) {
+	source := item.endpoint
+
+	if channel := source.GetChannel(); channel.State != channeltypes.OPEN {
+		return false, fmt.Errorf("channel %s on chain %s is not OPEN (got %s)", source.ChannelID, source.Chain.ChainID, channel.State)
+	}
+
+	if err := updateClient(source); err != nil {
+		return false, err
+	}
+
+	if err := source.TimeoutPacket(item.packet); err != nil {
+		return false, err
+	}
+
+	relayer.Timeouts = append(relayer.Timeouts, item.packet)
+
+	return true, nil
+}
+
+// acknowledgePacket relays the acknowledgement of the packet to its source. It returns true if the
+// acknowledgement no longer needs to be relayed.
+func (relayer *Relayer) acknowledgePacket(item *relayItem) (bool, error) {
+	source := item.endpoint.Counterparty
+
+	if !hasPacketCommitment(source, item.packet) {
+		return true, nil
+	}
+
+	channel := source.GetChannel()
+	if channel.State != channeltypes.OPEN {
+		return false, fmt.Errorf("channel %s on chain %s is not OPEN (got %s)", source.ChannelID, source.Chain.ChainID, channel.State)
+	}
+
+	if channel.Ordering.IsOrdered() {
+		nextSequenceAck, _ := source.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(
+			source.Chain.GetContext(), source.ChannelConfig.PortID, source.ChannelID,
+		)
+
+		if item.packet.Sequence != nextSequenceAck {
+			return false, fmt.Errorf("packet is not the next packet to be acknowledged (%d ≠ %d)", item.packet.Sequence, nextSequenceAck)
+		}
+	}
+
+	if err := updateClient(source); err != nil {
+		return false, err
+	}
+
+	if err := source.AcknowledgePacket(item.packet, item.acknowledgement); err != nil {
+		return false, err
+	}
+
+	relayer.Acknowledgements = append(relayer.Acknowledgements, PacketAcknowledgement{
+		Packet:          item.packet,
+		Acknowledgement: item.acknowledgement,
+	})
+
+	return true, nil
+}
+
+// nextReadyTime returns the earliest time at which a pending packet or acknowledgement which is
+// not yet ready to be relayed becomes ready.
+func (relayer *Relayer) nextReadyTime() (time.Time, bool) {
+	var (
+		readyTime time.Time
+		found     bool
+	)
+
+	for _, item := range relayer.pending {
+		if item.readyTime.After(relayer.coord.CurrentTime) && (!found || item.readyTime.Before(readyTime)) {
+			readyTime = item.readyTime
+			found = true
+		}
+	}
+
+	return readyTime, found
+}
+
+// advanceTime advances the time of the Coordinator to the provided time. Unless configured to let
+// clients expire, the time is advanced by steps of half of the shortest trusting period of the
+// clients of the registered paths, which are updated after each step.
+func (relayer *Relayer) advanceTime(readyTime time.Time) error {
+	if relayer.Config.ExpireClients {
+		relayer.coord.IncrementTimeBy(readyTime.Sub(relayer.coord.CurrentTime))
+		return nil
+	}
+
+	maxStep := relayer.maxTimeStep()
+	for relayer.coord.CurrentTime.Before(readyTime) {
+		step := readyTime.Sub(relayer.coord.CurrentTime)
+		if step > maxStep {
+			step = maxStep
+		}
+
+		relayer.coord.IncrementTimeBy(step)
+
+		for _, path := range relayer.Paths {
+			if err := updateClient(path.EndpointA); err != nil {
+				return err
+			}
+
+			if err := updateClient(path.EndpointB); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// maxTimeStep returns half of the shortest trusting period of the tendermint clients of the
+// registered paths.
+func (relayer *Relayer) maxTimeStep() time.Duration {
+	trustingPeriod := TrustingPeriod
+	for _, path := range relayer.Paths {
+		for _, endpoint := range []*Endpoint{path.EndpointA, path.EndpointB} {
+			if tmConfig, ok := endpoint.ClientConfig.(*TendermintConfig); ok && tmConfig.TrustingPeriod < trustingPeriod {
+				trustingPeriod = tmConfig.TrustingPeriod
+			}
+		}
+	}
+
+	return trustingPeriod / 2
+}
+
+// remove removes the provided item from the pending packets and acknowledgements.
+func (relayer *Relayer) remove(item *relayItem) {
+	for i, pending := range relayer.pending {
+		if pending == item {
+			relayer.pending = append(relayer.pending[:i], relayer.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+// updateClient updates the client of the endpoint. An error is returned without attempting the
+// update if the client is not active.
+func updateClient(endpoint *Endpoint) error {
+	ctx := endpoint.Chain.GetContext()
+	clientStore := endpoint.Chain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, endpoint.ClientID)
+
+	if status := endpoint.GetClientState().Status(ctx, clientStore, endpoint.Chain.Codec); status != exported.Active {
+		return fmt.Errorf("client %s on chain %s is not active (got %s)", endpoint.ClientID, endpoint.Chain.ChainID, status)
+	}
+
+	return endpoint.UpdateClient()
+}
+
+// hasPacketCommitment returns true if the commitment of the packet is stored on the provided endpoint.
+func hasPacketCommitment(endpoint *Endpoint, packet channeltypes.Packet) bool {
+	commitment := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(
+		endpoint.Chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+	)
+
+	return bytes.Equal(commitment, channeltypes.CommitPacket(endpoint.Chain.App.AppCodec(), packet))
+}
+
+// isTimedOut returns true if the packet can no longer be received on the provided chain.
+func isTimedOut(chain *TestChain, packet channeltypes.Packet) bool {
+	height := clienttypes.NewHeight(clienttypes.ParseChainID(chain.ChainID), uint64(chain.CurrentHeader.Height))
+	if !packet.GetTimeoutHeight().IsZero() && height.GTE(packet.GetTimeoutHeight()) {
+		return true
+	}
+
+	timestamp := uint64(chain.Coordinator.CurrentTime.UnixNano())
+	return packet.GetTimeoutTimestamp() != 0 && timestamp >= packet.GetTimeoutTimestamp()
+}
@@ -0,0 +1,205 @@
+package ibctesting_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	packetforwardtypes "github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// newTransferPath returns a path between the provided chains with transfer channels set up
+func newTransferPath(coord *ibctesting.Coordinator, chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	coord.Setup(path)
+
+	return path
+}
+
+// sendTransfer sends the test coin from the sender of the source endpoint to the receiver and returns the sent packet
+func sendTransfer(t *testing.T, endpoint *ibctesting.Endpoint, receiver string, timeoutTimestamp uint64) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID, ibctesting.TestCoin,
+		endpoint.Chain.SenderAccount.GetAddress().String(), receiver, clienttypes.ZeroHeight(), timeoutTimestamp, "",
+	)
+
+	res, err := endpoint.Chain.SendMsgs(msg)
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+
+	return packet
+}
+
+// voucherBalance returns the balance of the voucher of the test coin received over the endpoint
+func voucherBalance(endpoint *ibctesting.Endpoint, address sdk.AccAddress) sdk.Int {
+	trace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(endpoint.ChannelConfig.PortID, endpoint.ChannelID, ibctesting.TestCoin.Denom))
+	return endpoint.Chain.GetSimApp().BankKeeper.GetBalance(endpoint.Chain.GetContext(), address, trace.IBCDenom()).Amount
+}
+
+func TestRelayerTransfer(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := newTransferPath(coord, coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	relayer := coord.EnableRelayer(ibctesting.RelayerConfig{}, path)
+
+	receiver := path.EndpointB.Chain.SenderAccount.GetAddress()
+	packet := sendTransfer(t, path.EndpointA, receiver.String(), uint64(coord.CurrentTime.Add(time.Hour).UnixNano()))
+
+	require.Equal(t, ibctesting.TestCoin.Amount, voucherBalance(path.EndpointB, receiver))
+	require.Len(t, relayer.Acknowledgements, 1)
+	require.Equal(t, packet, relayer.Acknowledgements[0].Packet)
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), relayer.Acknowledgements[0].Acknowledgement)
+	require.Empty(t, relayer.PendingPackets())
+	require.NoError(t, relayer.Relay())
+}
+
+func TestRelayerMultiHop(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 3)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	chainC := coord.GetChain(ibctesting.GetChainID(3))
+
+	pathAToB := newTransferPath(coord, chainA, chainB)
+	pathBToC := newTransferPath(coord, chainB, chainC)
+	relayer := coord.EnableRelayer(ibctesting.RelayerConfig{}, pathAToB, pathBToC)
+
+	receiver := chainC.SenderAccount.GetAddress()
+	bz, err := json.Marshal(packetforwardtypes.PacketMetadata{
+		Forward: &packetforwardtypes.ForwardMetadata{
+			Receiver: receiver.String(),
+			Port:     pathBToC.EndpointA.ChannelConfig.PortID,
+			Channel:  pathBToC.EndpointA.ChannelID,
+		},
+	})
+	require.NoError(t, err)
+
+	packet := sendTransfer(t, pathAToB.EndpointA, string(bz), uint64(coord.CurrentTime.Add(time.Hour).UnixNano()))
+
+	// the voucher minted on chainB is forwarded to chainC
+	voucher := transfertypes.GetPrefixedDenom(pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID, ibctesting.TestCoin.Denom)
+	trace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(pathBToC.EndpointB.ChannelConfig.PortID, pathBToC.EndpointB.ChannelID, voucher))
+	require.Equal(t, ibctesting.TestCoin.Amount, chainC.GetSimApp().BankKeeper.GetBalance(chainC.GetContext(), receiver, trace.IBCDenom()).Amount)
+
+	// the forwarded packet is acknowledged on chainB before the original packet is acknowledged on chainA
+	require.Len(t, relayer.Acknowledgements, 2)
+	require.Equal(t, packet, relayer.Acknowledgements[1].Packet)
+	require.Empty(t, relayer.PendingPackets())
+}
+
+func TestRelayerDropAndTimeout(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := newTransferPath(coord, coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	relayer := coord.EnableRelayer(ibctesting.RelayerConfig{
+		Drop: func(packet channeltypes.Packet) bool { return true },
+	}, path)
+
+	receiver := path.EndpointB.Chain.SenderAccount.GetAddress()
+	packet := sendTransfer(t, path.EndpointA, receiver.String(), uint64(coord.CurrentTime.Add(time.Minute).UnixNano()))
+
+	// the dropped packet is pending until its timeout elapses
+	require.Equal(t, []channeltypes.Packet{packet}, relayer.PendingPackets())
+	require.NoError(t, relayer.Relay())
+
+	coord.IncrementTimeBy(time.Minute)
+	require.NoError(t, relayer.Relay())
+
+	require.True(t, voucherBalance(path.EndpointB, receiver).IsZero())
+	require.Equal(t, []channeltypes.Packet{packet}, relayer.Timeouts)
+	require.Empty(t, relayer.Acknowledgements)
+	require.Empty(t, relayer.PendingPackets())
+}
+
+func TestRelayerDelay(t *testing.T) {
+	testCases := []struct {
+		name          string
+		delay         time.Duration
+		expExpiration bool
+		expPass       bool
+	}{
+		{"packet received after delay", time.Minute * 30, false, true},
+		{"packet timed out after delay", time.Hour * 2, false, false},
+		{"clients kept alive during delay", ibctesting.TrustingPeriod * 2, false, false},
+		{"clients expire during delay", ibctesting.TrustingPeriod * 2, true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			coord := ibctesting.NewCoordinator(t, 2)
+			path := newTransferPath(coord, coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+			relayer := coord.EnableRelayer(ibctesting.RelayerConfig{Delay: tc.delay, ExpireClients: tc.expExpiration}, path)
+
+			sendTime := coord.CurrentTime
+			receiver := path.EndpointB.Chain.SenderAccount.GetAddress()
+			packet := sendTransfer(t, path.EndpointA, receiver.String(), uint64(sendTime.Add(time.Hour).UnixNano()))
+
+			require.False(t, coord.CurrentTime.Before(sendTime.Add(tc.delay)))
+
+			switch {
+			case tc.expPass:
+				require.Equal(t, ibctesting.TestCoin.Amount, voucherBalance(path.EndpointB, receiver))
+				require.Len(t, relayer.Acknowledgements, 1)
+				require.Empty(t, relayer.PendingPackets())
+			case tc.expExpiration:
+				require.Error(t, relayer.Relay())
+				require.Equal(t, []channeltypes.Packet{packet}, relayer.PendingPackets())
+			default:
+				require.True(t, voucherBalance(path.EndpointB, receiver).IsZero())
+				require.Equal(t, []channeltypes.Packet{packet}, relayer.Timeouts)
+				require.Empty(t, relayer.PendingPackets())
+			}
+		})
+	}
+}
+
+func TestRelayerReorderOrderedChannel(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	path.SetChannelOrdered()
+	coord.Setup(path)
+
+	drop := true
+	relayer := coord.EnableRelayer(ibctesting.RelayerConfig{
+		Drop: func(packet channeltypes.Packet) bool { return drop },
+		Rand: rand.New(rand.NewSource(1)),
+	}, path)
+
+	timeoutHeight := clienttypes.NewHeight(0, 1000)
+	for sequence := uint64(1); sequence <= 5; sequence++ {
+		packet := channeltypes.NewPacket(
+			ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0,
+		)
+		require.NoError(t, path.EndpointA.SendPacket(packet))
+	}
+
+	require.Len(t, relayer.PendingPackets(), 5)
+
+	// the packets are relayed in a random order, out of order packets being retried on the ordered channel
+	drop = false
+	require.NoError(t, relayer.Relay())
+
+	require.Len(t, relayer.Acknowledgements, 5)
+	require.Empty(t, relayer.PendingPackets())
+
+	nextSequenceRecv, found := path.EndpointB.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(
+		path.EndpointB.Chain.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+	)
+	require.True(t, found)
+	require.Equal(t, uint64(6), nextSequenceRecv)
+}
@@ -17,6 +17,7 @@ import (
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	solomachinev3types "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/v3/types"
 )
 
 // Solomachine is a testing helper used to simulate a counterparty
@@ -29,6 +30,8 @@ type Solomachine struct {
 	PrivateKeys []cryptotypes.PrivKey // keys used for signing
 	PublicKeys  []cryptotypes.PubKey  // keys used for generating solo machine pub key
 	PublicKey   cryptotypes.PubKey    // key used for verification
+	Weights     []uint64              // weights of the keys used by a v3 solo machine signer set
+	Threshold   uint64                // signing threshold used by a v3 solo machine
 	Sequence    uint64
 	Time        uint64
 	Diversifier string
@@ -36,9 +39,22 @@ type Solomachine struct {
 
 // NewSolomachine returns a new solomachine instance with an `nKeys` amount of
 // generated private/public key pairs and a sequence starting at 1. If nKeys
-// is greater than 1 then a multisig public key is used.
+// is greater than 1 then a multisig public key is used. As a v3 solo machine,
+// every key has a weight of 1 and all keys are required to sign.
 func NewSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier string, nKeys uint64) *Solomachine {
-	privKeys, pubKeys, pk := GenerateKeys(t, nKeys)
+	weights := make([]uint64, nKeys)
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	return NewMultiPartySolomachine(t, cdc, clientID, diversifier, weights, nKeys)
+}
+
+// NewMultiPartySolomachine returns a new solomachine instance with a generated
+// private/public key pair for every provided weight and a sequence starting at 1.
+// The weights and threshold are used by the signer set of a v3 solo machine.
+func NewMultiPartySolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier string, weights []uint64, threshold uint64) *Solomachine {
+	privKeys, pubKeys, pk := GenerateKeys(t, uint64(len(weights)))
 
 	return &Solomachine{
 		t:           t,
@@ -47,6 +63,8 @@ func NewSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier s
 		PrivateKeys: privKeys,
 		PublicKeys:  pubKeys,
 		PublicKey:   pk,
+		Weights:     weights,
+		Threshold:   threshold,
 		Sequence:    1,
 		Time:        10,
 		Diversifier: diversifier,
@@ -155,6 +173,19 @@ func (solo *Solomachine) CreateHeader() *solomachinetypes.Header {
 // CreateMisbehaviour constructs testing misbehaviour for the solo machine client
 // by signing over two different data bytes at the same sequence.
 func (solo *Solomachine) CreateMisbehaviour() *solomachinetypes.Misbehaviour {
+	signatureOne, signatureTwo := solo.createConflictingSignatures(solo.GenerateSignature, solo.GenerateSignature)
+
+	return &solomachinetypes.Misbehaviour{
+		ClientId:     solo.ClientID,
+		Sequence:     solo.Sequence,
+		SignatureOne: signatureOne,
+		SignatureTwo: signatureTwo,
+	}
+}
+
+// createConflictingSignatures signs over two different data bytes at the same sequence
+// using the provided signing functions.
+func (solo *Solomachine) createConflictingSignatures(signOne, signTwo func([]byte) []byte) (*solomachinetypes.SignatureAndData, *solomachinetypes.SignatureAndData) {
 	path := solo.GetClientStatePath("counterparty")
 	dataOne, err := solomachinetypes.ClientStateDataBytes(solo.cdc, path, solo.ClientState())
 	require.NoError(solo.t, err)
@@ -174,8 +205,8 @@ func (solo *Solomachine) CreateMisbehaviour() *solomachinetypes.Misbehaviour {
 	bz, err := solo.cdc.Marshal(signBytes)
 	require.NoError(solo.t, err)
 
-	sig := solo.GenerateSignature(bz)
-	signatureOne := solomachinetypes.SignatureAndData{
+	sig := signOne(bz)
+	signatureOne := &solomachinetypes.SignatureAndData{
 		Signature: sig,
 		DataType:  solomachinetypes.CLIENT,
 		Data:      dataOne,
@@ -196,20 +227,15 @@ func (solo *Solomachine) CreateMisbehaviour() *solomachinetypes.Misbehaviour {
 	bz, err = solo.cdc.Marshal(signBytes)
 	require.NoError(solo.t, err)
 
-	sig = solo.GenerateSignature(bz)
-	signatureTwo := solomachinetypes.SignatureAndData{
+	sig = signTwo(bz)
+	signatureTwo := &solomachinetypes.SignatureAndData{
 		Signature: sig,
 		DataType:  solomachinetypes.CONSENSUS,
 		Data:      dataTwo,
 		Timestamp: solo.Time,
 	}
 
-	return &solomachinetypes.Misbehaviour{
-		ClientId:     solo.ClientID,
-		Sequence:     solo.Sequence,
-		SignatureOne: &signatureOne,
-		SignatureTwo: &signatureTwo,
-	}
+	return signatureOne, signatureTwo
 }
 
 // GenerateSignature uses the stored private keys to generate a signature
@@ -247,6 +273,136 @@ func (solo *Solomachine) GenerateSignature(signBytes []byte) []byte {
 	return bz
 }
 
+// Signers returns the weighted signer set of a v3 solo machine made up of the stored
+// public keys and weights.
+func (solo *Solomachine) Signers() []solomachinev3types.Signer {
+	return solo.signers(solo.PublicKeys)
+}
+
+// signers returns the weighted signer set made up of the provided public keys and the stored weights.
+func (solo *Solomachine) signers(pubKeys []cryptotypes.PubKey) []solomachinev3types.Signer {
+	require.Equal(solo.t, len(pubKeys), len(solo.Weights), "every public key must have a weight")
+
+	signers := make([]solomachinev3types.Signer, len(pubKeys))
+	for i, pk := range pubKeys {
+		signer, err := solomachinev3types.NewSigner(pk, solo.Weights[i])
+		require.NoError(solo.t, err)
+
+		signers[i] = signer
+	}
+
+	return signers
+}
+
+// ClientStateV3 returns a new v3 solo machine ClientState instance. Default usage does not
+// allow update after governance proposal
+func (solo *Solomachine) ClientStateV3() *solomachinev3types.ClientState {
+	return solomachinev3types.NewClientState(solo.Sequence, solo.ConsensusStateV3(), false)
+}
+
+// ConsensusStateV3 returns a new v3 solo machine ConsensusState instance
+func (solo *Solomachine) ConsensusStateV3() *solomachinev3types.ConsensusState {
+	return &solomachinev3types.ConsensusState{
+		Signers:     solo.Signers(),
+		Threshold:   solo.Threshold,
+		Diversifier: solo.Diversifier,
+		Timestamp:   solo.Time,
+	}
+}
+
+// CreateHeaderV3 generates a new private/public key pair for every signer and creates
+// the signature of the provided signers necessary to construct a v3 solo machine header
+// rotating the signer set. The weights and threshold are kept. If no signers are
+// provided, all signers sign.
+func (solo *Solomachine) CreateHeaderV3(signers ...uint32) *solomachinev3types.Header {
+	// generate new private keys and signature for header
+	newPrivKeys, newPubKeys, newPubKey := GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
+
+	header := &solomachinev3types.Header{
+		Sequence:       solo.Sequence,
+		Timestamp:      solo.Time,
+		NewSigners:     solo.signers(newPubKeys),
+		NewThreshold:   solo.Threshold,
+		NewDiversifier: solo.Diversifier,
+	}
+
+	signBytes, err := solomachinev3types.HeaderSignBytes(solo.cdc, header)
+	require.NoError(solo.t, err)
+
+	header.Signature = solo.GenerateMultiPartySignature(signBytes, signers...)
+
+	// assumes successful header update
+	solo.Sequence++
+	solo.PrivateKeys = newPrivKeys
+	solo.PublicKeys = newPubKeys
+	solo.PublicKey = newPubKey
+
+	return header
+}
+
+// CreateMisbehaviourV3 constructs testing misbehaviour for the v3 solo machine client
+// by signing over two different data bytes at the same sequence. The first data bytes
+// are signed by signersOne and the second data bytes by signersTwo. Signers contained in
+// both are the misbehaving signers.
+func (solo *Solomachine) CreateMisbehaviourV3(signersOne, signersTwo []uint32) *solomachinev3types.Misbehaviour {
+	signatureOne, signatureTwo := solo.createConflictingSignatures(
+		func(signBytes []byte) []byte { return solo.GenerateMultiPartySignature(signBytes, signersOne...) },
+		func(signBytes []byte) []byte { return solo.GenerateMultiPartySignature(signBytes, signersTwo...) },
+	)
+
+	return &solomachinev3types.Misbehaviour{
+		ClientId:     solo.ClientID,
+		Sequence:     solo.Sequence,
+		SignatureOne: signatureOne,
+		SignatureTwo: signatureTwo,
+	}
+}
+
+// GenerateMultiPartySignature uses the stored private keys of the provided signers to
+// generate a v3 solo machine multi signature over the sign bytes. The signers are
+// identified by their index into the signer set and must be provided in increasing
+// order. If no signers are provided, all signers sign.
+func (solo *Solomachine) GenerateMultiPartySignature(signBytes []byte, signers ...uint32) []byte {
+	if len(signers) == 0 {
+		for i := range solo.PrivateKeys {
+			signers = append(signers, uint32(i))
+		}
+	}
+
+	multiSig := &solomachinev3types.MultiSignature{
+		Signatures: make([]solomachinev3types.IndexedSignature, len(signers)),
+	}
+	for i, index := range signers {
+		sig, err := solo.PrivateKeys[index].Sign(signBytes)
+		require.NoError(solo.t, err)
+
+		multiSig.Signatures[i] = solomachinev3types.IndexedSignature{
+			SignerIndex: index,
+			Signature:   sig,
+		}
+	}
+
+	bz, err := solo.cdc.Marshal(multiSig)
+	require.NoError(solo.t, err)
+
+	return bz
+}
+
+// GenerateMultiPartyProof returns a v3 solo machine proof over the sign bytes at the
+// current timestamp, signed by the provided signers. If no signers are provided, all
+// signers sign.
+func (solo *Solomachine) GenerateMultiPartyProof(signBytes []byte, signers ...uint32) []byte {
+	signatureDoc := &solomachinetypes.TimestampedSignatureData{
+		SignatureData: solo.GenerateMultiPartySignature(signBytes, signers...),
+		Timestamp:     solo.Time,
+	}
+
+	proof, err := solo.cdc.Marshal(signatureDoc)
+	require.NoError(solo.t, err)
+
+	return proof
+}
+
 // GetClientStatePath returns the commitment path for the client state.
 func (solo *Solomachine) GetClientStatePath(counterpartyClientIdentifier string) commitmenttypes.MerklePath {
 	path, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(host.FullClientStatePath(counterpartyClientIdentifier)))